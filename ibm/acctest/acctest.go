@@ -12,7 +12,9 @@ import (
 	"sync"
 	"testing"
 
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest/recorder"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/provider"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	terraformsdk "github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 )
@@ -2114,7 +2116,11 @@ var (
 var testAccProviderConfigure sync.Once
 
 func init() {
-	TestAccProvider = provider.Provider()
+	// The recorder is started before the pre-checks, which need the credentials it sets in replay mode
+	if err := recorder.Start(); err != nil {
+		fmt.Printf("[WARN] Error starting the acceptance test recorder: %s\n", err)
+	}
+	TestAccProvider = recorder.Provider(provider.Provider())
 	TestAccProviders = map[string]*schema.Provider{
 		ProviderName: TestAccProvider,
 	}
@@ -2131,6 +2137,7 @@ func TestProvider_impl(t *testing.T) {
 }
 
 func TestAccPreCheck(t *testing.T) {
+	recorder.Use(t)
 	if v := os.Getenv("IC_API_KEY"); v == "" {
 		t.Fatal("IC_API_KEY must be set for acceptance tests")
 	}
@@ -2451,10 +2458,32 @@ func TestAccPreCheckPartnerCenterSell(t *testing.T) {
 	}
 }
 
-func TestAccProviderFactories() map[string]func() (*schema.Provider, error) {
+// TestAccProviderFactories returns the providers of the test, which send their requests
+// through the acceptance test recorder when IBM_ACC_RECORDER is set.
+func TestAccProviderFactories(t *testing.T) map[string]func() (*schema.Provider, error) {
+	factory := func() (*schema.Provider, error) {
+		recorder.Use(t)
+		return recorder.Provider(provider.Provider()), nil
+	}
 	return map[string]func() (*schema.Provider, error){
-		ProviderName:          func() (*schema.Provider, error) { return provider.Provider(), nil },
-		ProviderNameAlternate: func() (*schema.Provider, error) { return provider.Provider(), nil },
+		ProviderName:          factory,
+		ProviderNameAlternate: factory,
+	}
+}
+
+// TestAccProtoV5ProviderFactories serves the provider through the mux server,
+// which is needed to test the types served by the framework provider, such as
+// ephemeral resources.
+func TestAccProtoV5ProviderFactories(t *testing.T) map[string]func() (tfprotov5.ProviderServer, error) {
+	return map[string]func() (tfprotov5.ProviderServer, error){
+		ProviderName: func() (tfprotov5.ProviderServer, error) {
+			recorder.Use(t)
+			providerServer, err := provider.ProtoV5ProviderServerFactory(context.Background())
+			if err != nil {
+				return nil, err
+			}
+			return providerServer(), nil
+		},
 	}
 }
 
@@ -0,0 +1,78 @@
+// Copyright IBM Corp. 2025 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package recorder
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"os"
+	"path/filepath"
+)
+
+// Cassette holds the HTTP interactions recorded for a test.
+type Cassette struct {
+	// Identity holds the claims of the IAM token used during the recording, which are
+	// replayed in the stub IAM token
+	Identity     map[string]interface{} `json:"identity,omitempty"`
+	Interactions []*Interaction         `json:"interactions"`
+
+	used []bool
+}
+
+// Interaction is a recorded request and its response. Requests are matched on their method
+// and URL, so the request headers and body are not recorded.
+type Interaction struct {
+	Method     string      `json:"method"`
+	URL        string      `json:"url"`
+	StatusCode int         `json:"status_code"`
+	Header     http.Header `json:"header,omitempty"`
+	Body       string      `json:"body"`
+}
+
+// next returns the first interaction for the request that was not replayed yet. Once they are
+// all replayed, the last one is repeated, so that polling for a status ends as it was recorded.
+func (c *Cassette) next(method, url string) (*Interaction, bool) {
+	if len(c.used) != len(c.Interactions) {
+		c.used = make([]bool, len(c.Interactions))
+	}
+	var last *Interaction
+	for i, interaction := range c.Interactions {
+		if interaction.Method != method || interaction.URL != url {
+			continue
+		}
+		if !c.used[i] {
+			c.used[i] = true
+			return interaction, true
+		}
+		last = interaction
+	}
+	return last, last != nil
+}
+
+func readCassette(path string) (*Cassette, error) {
+	content, err := os.ReadFile(path)
+	if os.IsNotExist(err) {
+		return nil, fmt.Errorf("cassette %s does not exist, record it with %s=%s", path, ModeEnv, ModeRecord)
+	}
+	if err != nil {
+		return nil, err
+	}
+	cassette := &Cassette{}
+	if err := json.Unmarshal(content, cassette); err != nil {
+		return nil, fmt.Errorf("Error reading cassette %s: %s", path, err)
+	}
+	return cassette, nil
+}
+
+func (c *Cassette) save(path string) error {
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return err
+	}
+	content, err := json.MarshalIndent(c, "", "  ")
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(path, append(content, '\n'), 0644)
+}
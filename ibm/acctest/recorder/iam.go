@@ -0,0 +1,111 @@
+// Copyright IBM Corp. 2025 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package recorder
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"net/http"
+	"strings"
+	"time"
+)
+
+// identityClaims are the claims of the IAM token that are recorded. The provider reads the
+// account and the user from them.
+var identityClaims = []string{"account", "iam_id", "id", "iss", "sub", "sub_type"}
+
+// defaultIdentity is replayed when no identity was recorded
+var defaultIdentity = map[string]interface{}{
+	"account":  map[string]interface{}{"bss": "replay-account"},
+	"iam_id":   "IBMid-replay",
+	"id":       "IBMid-replay",
+	"iss":      "https://iam.cloud.ibm.com/identity",
+	"sub":      "replay@ibm.com",
+	"sub_type": "ServiceId",
+}
+
+// serveToken forwards the IAM token request in record mode, keeping the identity of the token,
+// and answers it with a stub token in replay mode. Tokens are never recorded.
+func (r *Recorder) serveToken(w http.ResponseWriter, req *http.Request, target string, body []byte) {
+	if r.mode == ModeReplay {
+		r.mu.Lock()
+		identity := r.identity
+		r.mu.Unlock()
+		writeTokenResponse(w, identity, time.Now())
+		return
+	}
+
+	status, header, respBody, err := r.forward(req, target, body)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadGateway)
+		return
+	}
+	if status == http.StatusOK {
+		var token struct {
+			AccessToken string `json:"access_token"`
+		}
+		if err := json.Unmarshal(respBody, &token); err == nil {
+			if identity := tokenIdentity(token.AccessToken); identity != nil {
+				r.mu.Lock()
+				r.identity = identity
+				r.mu.Unlock()
+			}
+		}
+	}
+	writeResponse(w, status, header, respBody)
+}
+
+// tokenIdentity returns the identity claims of an access token, or nil when it cannot be decoded
+func tokenIdentity(accessToken string) map[string]interface{} {
+	parts := strings.Split(accessToken, ".")
+	if len(parts) != 3 {
+		return nil
+	}
+	payload, err := base64.RawURLEncoding.DecodeString(parts[1])
+	if err != nil {
+		return nil
+	}
+	claims := map[string]interface{}{}
+	if err := json.Unmarshal(payload, &claims); err != nil {
+		return nil
+	}
+	identity := map[string]interface{}{}
+	for _, claim := range identityClaims {
+		if v, ok := claims[claim]; ok {
+			identity[claim] = v
+		}
+	}
+	return identity
+}
+
+// stubToken returns an unsigned access token with the identity claims. The provider does not
+// verify the signature of the token.
+func stubToken(identity map[string]interface{}, now time.Time) string {
+	claims := map[string]interface{}{}
+	for claim, v := range defaultIdentity {
+		claims[claim] = v
+	}
+	for claim, v := range identity {
+		claims[claim] = v
+	}
+	claims["iat"] = now.Unix()
+	claims["exp"] = now.Add(time.Hour).Unix()
+
+	header, _ := json.Marshal(map[string]string{"alg": "HS256", "typ": "JWT"})
+	payload, _ := json.Marshal(claims)
+	encoding := base64.RawURLEncoding
+	return encoding.EncodeToString(header) + "." + encoding.EncodeToString(payload) + "." + encoding.EncodeToString([]byte("replay"))
+}
+
+func writeTokenResponse(w http.ResponseWriter, identity map[string]interface{}, now time.Time) {
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(map[string]interface{}{
+		"access_token":  stubToken(identity, now),
+		"refresh_token": "replay",
+		"token_type":    "Bearer",
+		"expires_in":    3600,
+		"expiration":    now.Add(time.Hour).Unix(),
+		"scope":         "ibm openid",
+	})
+}
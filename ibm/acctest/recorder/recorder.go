@@ -0,0 +1,414 @@
+// Copyright IBM Corp. 2025 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+// Package recorder records the HTTP interactions of the acceptance tests with IBM Cloud
+// into cassettes, and replays them so that the acceptance tests can run without an
+// IBM Cloud account.
+//
+// The service clients are pointed to a local server through the IBMCLOUD_*_API_ENDPOINT
+// overrides and an endpoints file, which conns.Config already honors. Run the tests with
+// IBM_ACC_RECORDER=record to call IBM Cloud and save a cassette per test, and with
+// IBM_ACC_RECORDER=replay to serve the saved cassettes:
+//
+//	TF_ACC=1 IBM_ACC_RECORDER=record go test ./ibm/service/vpc -run TestAccIBMISVPC_basic
+//	TF_ACC=1 IBM_ACC_RECORDER=replay go test ./ibm/service/vpc -run TestAccIBMISVPC_basic
+//
+// IAM token requests are never recorded. In replay mode they are answered with a token
+// for the account that was used during the recording.
+//
+// A replayed test must send the same requests as the recorded one, so the names in its
+// configuration must not be random. Services that do not support endpoint overrides
+// cannot be replayed.
+package recorder
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const (
+	// ModeEnv selects the recorder mode, either ModeRecord or ModeReplay. The recorder is not used when it is not set.
+	ModeEnv = "IBM_ACC_RECORDER"
+	// CassetteDirEnv overrides the directory of the cassettes, relative to the package of the test.
+	CassetteDirEnv = "IBM_ACC_CASSETTE_DIR"
+
+	ModeRecord = "record"
+	ModeReplay = "replay"
+
+	defaultCassetteDir = "testdata/cassettes"
+	iamEndpointEnv     = "IBMCLOUD_IAM_API_ENDPOINT"
+	endpointsFileEnv   = "IBMCLOUD_ENDPOINTS_FILE_PATH"
+)
+
+// upstreamEndpoints maps the endpoint overrides routed through the recorder to the public
+// endpoints they replace. %s is replaced with the region.
+var upstreamEndpoints = map[string]string{
+	"IBMCLOUD_IAM_API_ENDPOINT":                 "https://iam.cloud.ibm.com",
+	"IBMCLOUD_IS_NG_API_ENDPOINT":               "https://%s.iaas.cloud.ibm.com/v1",
+	"IBMCLOUD_RESOURCE_CONTROLLER_API_ENDPOINT": "https://resource-controller.cloud.ibm.com",
+	"IBMCLOUD_RESOURCE_MANAGEMENT_API_ENDPOINT": "https://resource-controller.cloud.ibm.com",
+	"IBMCLOUD_RESOURCE_CATALOG_API_ENDPOINT":    "https://globalcatalog.cloud.ibm.com",
+	"IBMCLOUD_GT_API_ENDPOINT":                  "https://tags.global-search-tagging.cloud.ibm.com",
+	"IBMCLOUD_GS_API_ENDPOINT":                  "https://api.global-search-tagging.cloud.ibm.com",
+	"IBMCLOUD_TG_API_ENDPOINT":                  "https://transit.cloud.ibm.com/v1",
+	"IBMCLOUD_DL_API_ENDPOINT":                  "https://directlink.cloud.ibm.com/v1",
+	"IBMCLOUD_PRIVATE_DNS_API_ENDPOINT":         "https://api.dns-svcs.cloud.ibm.com/v1",
+	"IBMCLOUD_SCHEMATICS_API_ENDPOINT":          "https://%s.schematics.cloud.ibm.com",
+	"IBMCLOUD_DATABASES_API_ENDPOINT":           "https://api.%s.databases.cloud.ibm.com/v5/ibm",
+	"IBMCLOUD_KP_API_ENDPOINT":                  "https://%s.kms.cloud.ibm.com",
+	"IBMCLOUD_CIS_API_ENDPOINT":                 "https://api.cis.cloud.ibm.com",
+	"IAAS_CLASSIC_ENDPOINT_URL":                 "https://api.softlayer.com/rest/v3",
+}
+
+// replayCredentials are set in replay mode when missing, so that the acceptance test pre-checks pass
+var replayCredentials = map[string]string{
+	"IC_API_KEY":            "replay",
+	"IAAS_CLASSIC_API_KEY":  "replay",
+	"IAAS_CLASSIC_USERNAME": "replay",
+}
+
+var (
+	startOnce sync.Once
+	shared    *Recorder
+	startErr  error
+
+	// testMu runs the tests that use the recorder one at a time, since they share the endpoint overrides
+	testMu sync.Mutex
+	// active holds the tests that loaded their cassette, since a test may run several pre-checks
+	active sync.Map
+)
+
+// Start starts the recorder shared by the tests of the package when IBM_ACC_RECORDER is set,
+// which points the endpoint overrides to it. It is safe to call several times.
+func Start() error {
+	mode := os.Getenv(ModeEnv)
+	if mode == "" {
+		return nil
+	}
+	startOnce.Do(func() {
+		shared, startErr = start(mode)
+	})
+	return startErr
+}
+
+// Use routes the requests of the provider through the recorder for the duration of the test,
+// when IBM_ACC_RECORDER is set. It must be called before the provider is configured.
+func Use(t *testing.T) {
+	if os.Getenv(ModeEnv) == "" {
+		return
+	}
+	if err := Start(); err != nil {
+		t.Fatalf("Error starting the acceptance test recorder: %s", err)
+	}
+	if _, loaded := active.LoadOrStore(t, true); loaded {
+		return
+	}
+
+	testMu.Lock()
+	if err := shared.Load(t.Name()); err != nil {
+		testMu.Unlock()
+		active.Delete(t)
+		t.Fatalf("Error loading cassette: %s", err)
+	}
+	t.Cleanup(func() {
+		defer active.Delete(t)
+		defer testMu.Unlock()
+		if err := shared.Eject(); err != nil {
+			t.Errorf("Error ejecting cassette: %s", err)
+		}
+	})
+}
+
+// Provider makes the provider fail to configure when the recorder is used but no test loaded a cassette,
+// so that a test that does not go through Use can not send its requests to IBM Cloud unrecorded.
+func Provider(p *schema.Provider) *schema.Provider {
+	configure := p.ConfigureFunc
+	p.ConfigureFunc = func(d *schema.ResourceData) (interface{}, error) {
+		if err := Start(); err != nil {
+			return nil, fmt.Errorf("Error starting the acceptance test recorder: %s", err)
+		}
+		if shared != nil && !shared.loaded() {
+			return nil, fmt.Errorf("%s is set but no cassette is loaded, use the provider factories of the acctest package", ModeEnv)
+		}
+		return configure(d)
+	}
+	return p
+}
+
+// start creates the recorder shared by the tests of the package and points the endpoint overrides to it.
+// The server runs until the test binary exits, since the provider used by the checks is configured once.
+func start(mode string) (*Recorder, error) {
+	if mode != ModeRecord && mode != ModeReplay {
+		return nil, fmt.Errorf("%s must be %q or %q, got %q", ModeEnv, ModeRecord, ModeReplay, mode)
+	}
+	dir := conns.EnvFallBack([]string{CassetteDirEnv}, defaultCassetteDir)
+	region := conns.EnvFallBack([]string{"IC_REGION", "IBMCLOUD_REGION", "BM_REGION", "BLUEMIX_REGION"}, "us-south")
+
+	// The endpoints that are already overridden are recorded instead of the public ones
+	endpointsFile := os.Getenv(endpointsFileEnv)
+	upstreams := make(map[string]string, len(upstreamEndpoints))
+	for env, endpoint := range upstreamEndpoints {
+		if strings.Contains(endpoint, "%s") {
+			endpoint = fmt.Sprintf(endpoint, region)
+		}
+		if endpointsFile != "" {
+			endpoint = conns.FileFallBack(endpointsFile, "public", env, region, endpoint)
+		}
+		upstreams[env] = conns.EnvFallBack([]string{env}, endpoint)
+	}
+
+	r := New(mode, dir, upstreams)
+	if err := r.writeEndpointsFile(region); err != nil {
+		r.Close()
+		return nil, err
+	}
+	for env := range upstreams {
+		os.Setenv(env, r.EndpointURL(env))
+	}
+	if mode == ModeReplay {
+		for env, value := range replayCredentials {
+			if os.Getenv(env) == "" {
+				os.Setenv(env, value)
+			}
+		}
+	}
+	log.Printf("[INFO] Acceptance test recorder in %s mode listening on %s", mode, r.server.URL)
+	return r, nil
+}
+
+// Recorder is a local HTTP server that stands in for the IBM Cloud endpoints. In record mode it
+// forwards the requests to the upstream endpoints and records the responses in the loaded
+// cassette. In replay mode it answers with the responses of the loaded cassette.
+type Recorder struct {
+	mode      string
+	dir       string
+	upstreams map[string]string
+	server    *httptest.Server
+	client    *http.Client
+
+	mu       sync.Mutex
+	name     string
+	cassette *Cassette
+	identity map[string]interface{}
+	missing  []string
+}
+
+// New starts a recorder that routes the endpoint overrides in upstreams, keyed by environment
+// variable, to the upstream endpoints. The cassettes are read from and saved to dir.
+func New(mode, dir string, upstreams map[string]string) *Recorder {
+	r := &Recorder{
+		mode:      mode,
+		dir:       dir,
+		upstreams: upstreams,
+		client: &http.Client{
+			CheckRedirect: func(*http.Request, []*http.Request) error {
+				return http.ErrUseLastResponse
+			},
+		},
+	}
+	r.server = httptest.NewServer(r)
+	return r
+}
+
+// EndpointURL returns the URL that replaces the endpoint override env.
+func (r *Recorder) EndpointURL(env string) string {
+	return r.server.URL + "/" + env
+}
+
+// Close stops the server of the recorder.
+func (r *Recorder) Close() {
+	r.server.Close()
+}
+
+// Load loads the cassette of the test name. In record mode the cassette starts empty.
+func (r *Recorder) Load(name string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	cassette := &Cassette{}
+	if r.mode == ModeReplay {
+		var err error
+		cassette, err = readCassette(r.cassettePath(name))
+		if err != nil {
+			return err
+		}
+		if cassette.Identity != nil {
+			r.identity = cassette.Identity
+		}
+	}
+	r.name = name
+	r.cassette = cassette
+	r.missing = nil
+	return nil
+}
+
+func (r *Recorder) loaded() bool {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.cassette != nil
+}
+
+// Eject unloads the cassette. In record mode the cassette is saved; in replay mode an error
+// is returned for the requests that were not found in the cassette.
+func (r *Recorder) Eject() error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	cassette, missing := r.cassette, r.missing
+	r.cassette, r.missing = nil, nil
+	if cassette == nil {
+		return nil
+	}
+	if r.mode == ModeRecord {
+		cassette.Identity = r.identity
+		return cassette.save(r.cassettePath(r.name))
+	}
+	if len(missing) > 0 {
+		return fmt.Errorf("cassette %s has no recorded response for:\n%s", r.cassettePath(r.name), strings.Join(missing, "\n"))
+	}
+	return nil
+}
+
+func (r *Recorder) cassettePath(name string) string {
+	return filepath.Join(r.dir, strings.ReplaceAll(name, "/", "_")+".json")
+}
+
+// writeEndpointsFile points the services that only read the endpoints file to the recorder
+func (r *Recorder) writeEndpointsFile(region string) error {
+	endpoints := make(map[string]map[string]map[string]string, len(r.upstreams))
+	for env := range r.upstreams {
+		url := map[string]string{region: r.EndpointURL(env)}
+		endpoints[env] = map[string]map[string]string{"public": url, "private": url}
+	}
+	content, err := json.Marshal(endpoints)
+	if err != nil {
+		return err
+	}
+	file, err := os.CreateTemp("", "ibm-acc-endpoints-*.json")
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+	if _, err := file.Write(content); err != nil {
+		return err
+	}
+	return os.Setenv(endpointsFileEnv, file.Name())
+}
+
+// ServeHTTP handles a request sent to EndpointURL(env) + path.
+func (r *Recorder) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	env, path, _ := strings.Cut(strings.TrimPrefix(req.URL.Path, "/"), "/")
+	path = "/" + path
+	upstream, ok := r.upstreams[env]
+	if !ok {
+		http.Error(w, fmt.Sprintf("no endpoint is routed through %s", env), http.StatusNotFound)
+		return
+	}
+	body, err := io.ReadAll(req.Body)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	if env == iamEndpointEnv && strings.HasSuffix(path, "/identity/token") {
+		r.serveToken(w, req, upstream+path, body)
+		return
+	}
+	if r.mode == ModeReplay {
+		r.replay(w, req.Method, req.URL.RequestURI())
+		return
+	}
+
+	target := upstream + path
+	if req.URL.RawQuery != "" {
+		target += "?" + req.URL.RawQuery
+	}
+	status, header, respBody, err := r.forward(req, target, body)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadGateway)
+		return
+	}
+	r.mu.Lock()
+	if r.cassette != nil {
+		r.cassette.Interactions = append(r.cassette.Interactions, &Interaction{
+			Method:     req.Method,
+			URL:        req.URL.RequestURI(),
+			StatusCode: status,
+			Header:     recordedHeader(header),
+			Body:       string(respBody),
+		})
+	}
+	r.mu.Unlock()
+	writeResponse(w, status, header, respBody)
+}
+
+func (r *Recorder) replay(w http.ResponseWriter, method, uri string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.cassette == nil {
+		http.Error(w, "no cassette is loaded", http.StatusInternalServerError)
+		return
+	}
+	interaction, ok := r.cassette.next(method, uri)
+	if !ok {
+		r.missing = append(r.missing, method+" "+uri)
+		http.Error(w, fmt.Sprintf("no recorded response for %s %s", method, uri), http.StatusNotImplemented)
+		return
+	}
+	writeResponse(w, interaction.StatusCode, interaction.Header, []byte(interaction.Body))
+}
+
+func (r *Recorder) forward(req *http.Request, target string, body []byte) (int, http.Header, []byte, error) {
+	out, err := http.NewRequestWithContext(req.Context(), req.Method, target, bytes.NewReader(body))
+	if err != nil {
+		return 0, nil, nil, err
+	}
+	out.Header = req.Header.Clone()
+	// Let the transport negotiate the compression, so that the body is recorded uncompressed
+	out.Header.Del("Accept-Encoding")
+
+	resp, err := r.client.Do(out)
+	if err != nil {
+		return 0, nil, nil, err
+	}
+	defer resp.Body.Close()
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return 0, nil, nil, err
+	}
+	return resp.StatusCode, resp.Header, respBody, nil
+}
+
+// unrecordedHeaders are response headers that are not recorded, since they are recomputed
+// on replay or must not be stored
+var unrecordedHeaders = []string{"Connection", "Content-Encoding", "Content-Length", "Date", "Set-Cookie", "Transfer-Encoding"}
+
+func recordedHeader(header http.Header) http.Header {
+	recorded := header.Clone()
+	for _, name := range unrecordedHeaders {
+		recorded.Del(name)
+	}
+	return recorded
+}
+
+func writeResponse(w http.ResponseWriter, status int, header http.Header, body []byte) {
+	for name, values := range recordedHeader(header) {
+		w.Header()[name] = values
+	}
+	w.WriteHeader(status)
+	w.Write(body)
+}
@@ -0,0 +1,164 @@
+// Copyright IBM Corp. 2025 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package recorder
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	jwt "github.com/golang-jwt/jwt/v5"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func testUpstream(t *testing.T) *httptest.Server {
+	t.Helper()
+	calls := 0
+	upstream := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		switch req.URL.Path {
+		case "/identity/token":
+			identity := map[string]interface{}{"account": map[string]interface{}{"bss": "recorded-account"}, "id": "IBMid-recorded"}
+			writeTokenResponse(w, identity, time.Now())
+		case "/v1/vpcs":
+			calls++
+			w.Header().Set("Etag", "W/\"etag\"")
+			w.Header().Set("Set-Cookie", "session=secret")
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(http.StatusOK)
+			io.WriteString(w, `{"vpcs":[],"call":`+strconv.Itoa(calls)+`}`)
+		default:
+			http.NotFound(w, req)
+		}
+	}))
+	t.Cleanup(upstream.Close)
+	return upstream
+}
+
+func get(t *testing.T, url string) (*http.Response, string) {
+	t.Helper()
+	resp, err := http.Get(url)
+	require.NoError(t, err)
+	defer resp.Body.Close()
+	body, err := io.ReadAll(resp.Body)
+	require.NoError(t, err)
+	return resp, string(body)
+}
+
+func postToken(t *testing.T, r *Recorder) string {
+	t.Helper()
+	resp, err := http.Post(r.EndpointURL(iamEndpointEnv)+"/identity/token", "application/x-www-form-urlencoded", strings.NewReader("grant_type=urn:ibm:params:oauth:grant-type:apikey&apikey=secret"))
+	require.NoError(t, err)
+	defer resp.Body.Close()
+	var token struct {
+		AccessToken string `json:"access_token"`
+	}
+	require.NoError(t, json.NewDecoder(resp.Body).Decode(&token))
+	return token.AccessToken
+}
+
+func TestRecordAndReplay(t *testing.T) {
+	dir := t.TempDir()
+	upstream := testUpstream(t)
+	upstreams := map[string]string{
+		iamEndpointEnv:                upstream.URL,
+		"IBMCLOUD_IS_NG_API_ENDPOINT": upstream.URL + "/v1",
+	}
+
+	recorder := New(ModeRecord, dir, upstreams)
+	defer recorder.Close()
+	require.NoError(t, recorder.Load("TestAccVPC/basic"))
+	postToken(t, recorder)
+	for i := 0; i < 2; i++ {
+		resp, _ := get(t, recorder.EndpointURL("IBMCLOUD_IS_NG_API_ENDPOINT")+"/vpcs?version=2025-01-01")
+		assert.Equal(t, http.StatusOK, resp.StatusCode)
+	}
+	require.NoError(t, recorder.Eject())
+
+	path := filepath.Join(dir, "TestAccVPC_basic.json")
+	content, err := os.ReadFile(path)
+	require.NoError(t, err)
+	assert.NotContains(t, string(content), "access_token")
+	assert.NotContains(t, string(content), "session=secret")
+	assert.Contains(t, string(content), "recorded-account")
+
+	replayer := New(ModeReplay, dir, map[string]string{
+		iamEndpointEnv:                "http://127.0.0.1:1",
+		"IBMCLOUD_IS_NG_API_ENDPOINT": "http://127.0.0.1:1/v1",
+	})
+	defer replayer.Close()
+	require.NoError(t, replayer.Load("TestAccVPC/basic"))
+
+	token, _ := jwt.Parse(postToken(t, replayer), func(token *jwt.Token) (interface{}, error) {
+		return "", nil
+	})
+	require.NotNil(t, token)
+	claims := token.Claims.(jwt.MapClaims)
+	assert.Equal(t, "recorded-account", claims["account"].(map[string]interface{})["bss"])
+	assert.Equal(t, "IBMid-recorded", claims["id"])
+
+	url := replayer.EndpointURL("IBMCLOUD_IS_NG_API_ENDPOINT") + "/vpcs?version=2025-01-01"
+	for _, expected := range []string{`"call":1`, `"call":2`, `"call":2`} {
+		resp, body := get(t, url)
+		assert.Equal(t, http.StatusOK, resp.StatusCode)
+		assert.Equal(t, `W/"etag"`, resp.Header.Get("Etag"))
+		assert.Contains(t, body, expected)
+	}
+	require.NoError(t, replayer.Eject())
+
+	require.NoError(t, replayer.Load("TestAccVPC/basic"))
+	resp, _ := get(t, replayer.EndpointURL("IBMCLOUD_IS_NG_API_ENDPOINT")+"/subnets?version=2025-01-01")
+	assert.Equal(t, http.StatusNotImplemented, resp.StatusCode)
+	err = replayer.Eject()
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "GET /IBMCLOUD_IS_NG_API_ENDPOINT/subnets?version=2025-01-01")
+}
+
+func TestReplayMissingCassette(t *testing.T) {
+	replayer := New(ModeReplay, t.TempDir(), map[string]string{})
+	defer replayer.Close()
+	err := replayer.Load("TestAccMissing")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), ModeEnv+"="+ModeRecord)
+}
+
+func TestProviderWithoutCassette(t *testing.T) {
+	t.Setenv(ModeEnv, "")
+	shared = New(ModeRecord, t.TempDir(), map[string]string{})
+	defer func() {
+		shared.Close()
+		shared = nil
+	}()
+
+	configured := false
+	p := Provider(&schema.Provider{
+		ConfigureFunc: func(*schema.ResourceData) (interface{}, error) {
+			configured = true
+			return nil, nil
+		},
+	})
+	_, err := p.ConfigureFunc(nil)
+	require.Error(t, err)
+	assert.False(t, configured)
+
+	require.NoError(t, shared.Load(t.Name()))
+	_, err = p.ConfigureFunc(nil)
+	require.NoError(t, err)
+	assert.True(t, configured)
+}
+
+func TestStubTokenDefaultIdentity(t *testing.T) {
+	identity := tokenIdentity(stubToken(nil, time.Now()))
+	assert.Equal(t, "replay-account", identity["account"].(map[string]interface{})["bss"])
+	assert.Equal(t, "https://iam.cloud.ibm.com/identity", identity["iss"])
+	assert.Nil(t, tokenIdentity("not-a-token"))
+}
@@ -16,6 +16,7 @@ import (
 	"net/url"
 	"os"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/IBM/cloud-db2-go-sdk/db2saasv1"
@@ -185,6 +186,9 @@ type Config struct {
 	// Constant Retry Delay for API calls
 	RetryDelay time.Duration
 
+	// Limits of the requests sent to each service, keyed by service name
+	ServiceLimits map[string]ServiceLimit
+
 	// FunctionNameSpace ...
 	FunctionNameSpace string
 
@@ -208,6 +212,10 @@ type Config struct {
 	Visibility          string
 	PrivateEndpointType string
 	EndpointsFile       string
+
+	// Tags attached to every taggable resource in addition to its own tags
+	DefaultTags       []string
+	DefaultAccessTags []string
 }
 
 // Session stores the information required for communication with the SoftLayer and Bluemix API
@@ -249,6 +257,8 @@ type ClientSession interface {
 	BackupRecoveryV1Connector() (*backuprecoveryv1.BackupRecoveryV1Connector, error)
 	IBMCloudLogsRoutingV0() (*ibmcloudlogsroutingv0.IBMCloudLogsRoutingV0, error)
 	SoftLayerSession() *slsession.Session
+	DefaultTags() []string
+	DefaultAccessTags() []string
 	IBMPISession() (*ibmpisession.IBMPISession, error)
 	UserManagementAPI() (usermanagementv2.UserManagementAPI, error)
 	PushServiceV1() (*pushservicev1.PushServiceV1, error)
@@ -329,6 +339,19 @@ type ClientSession interface {
 
 type clientSession struct {
 	session *Session
+	config  *Config
+
+	// Shared inputs for the service clients, which are built on first use
+	fileMap       map[string]interface{}
+	iamURL        string
+	authenticator core.Authenticator
+	cisEndPoint   string
+
+	// Limiters shared by the clients of each service
+	serviceLimiters *serviceLimiters
+
+	lazyMu   sync.Mutex
+	lazyInit map[string]*sync.Once
 
 	appidErr error
 	appidAPI *appid.AppIDManagementV4
@@ -697,65 +720,76 @@ type clientSession struct {
 }
 
 // Usage Reports
-func (session clientSession) UsageReportsV4() (*usagereportsv4.UsageReportsV4, error) {
+func (session *clientSession) UsageReportsV4() (*usagereportsv4.UsageReportsV4, error) {
+	session.configureOnce("UsageReports", session.configureUsageReports)
 	return session.usageReportsClient, session.usageReportsClientErr
 }
 
-func (session clientSession) PartnerCenterSellV1() (*partnercentersellv1.PartnerCenterSellV1, error) {
+func (session *clientSession) PartnerCenterSellV1() (*partnercentersellv1.PartnerCenterSellV1, error) {
+	session.configureOnce("PartnerCenterSell", session.configurePartnerCenterSell)
 	return session.partnerCenterSellClient, session.partnerCenterSellClientErr
 }
 
 // Configuration Aggregator
-func (session clientSession) ConfigurationAggregatorV1() (*configurationaggregatorv1.ConfigurationAggregatorV1, error) {
+func (session *clientSession) ConfigurationAggregatorV1() (*configurationaggregatorv1.ConfigurationAggregatorV1, error) {
+	session.configureOnce("ConfigurationAggregator", session.configureConfigurationAggregator)
 	return session.configurationAggregatorClient, session.configurationAggregatorClientErr
 }
 
 // AppIDAPI provides AppID Service APIs ...
-func (session clientSession) AppIDAPI() (*appid.AppIDManagementV4, error) {
+func (session *clientSession) AppIDAPI() (*appid.AppIDManagementV4, error) {
+	session.configureOnce("AppID", session.configureAppID)
 	return session.appidAPI, session.appidErr
 }
 
-func (session clientSession) CatalogManagementV1() (*catalogmanagementv1.CatalogManagementV1, error) {
+func (session *clientSession) CatalogManagementV1() (*catalogmanagementv1.CatalogManagementV1, error) {
+	session.configureOnce("CatalogManagement", session.configureCatalogManagement)
 	return session.catalogManagementClient, session.catalogManagementClientErr
 }
 
 // BluemixAcccountAPI ...
-func (sess clientSession) BluemixAcccountAPI() (accountv2.AccountServiceAPI, error) {
+func (sess *clientSession) BluemixAcccountAPI() (accountv2.AccountServiceAPI, error) {
+	sess.configureOnce("AccountV2", sess.configureAccountV2)
 	return sess.bmxAccountServiceAPI, sess.accountConfigErr
 }
 
 // BluemixAcccountAPI ...
-func (sess clientSession) BluemixAcccountv1API() (accountv1.AccountServiceAPI, error) {
+func (sess *clientSession) BluemixAcccountv1API() (accountv1.AccountServiceAPI, error) {
+	sess.configureOnce("AccountV1", sess.configureAccountV1)
 	return sess.bmxAccountv1ServiceAPI, sess.accountV1ConfigErr
 }
 
 // BluemixSession to provide the Bluemix Session
-func (sess clientSession) BluemixSession() (*bxsession.Session, error) {
+func (sess *clientSession) BluemixSession() (*bxsession.Session, error) {
 	return sess.session.BluemixSession, sess.bluemixSessionErr
 }
 
 // BluemixUserDetails ...
-func (sess clientSession) BluemixUserDetails() (*UserConfig, error) {
+func (sess *clientSession) BluemixUserDetails() (*UserConfig, error) {
 	return sess.bmxUserDetails, sess.bmxUserFetchErr
 }
 
 // ContainerAPI provides Container Service APIs ...
-func (sess clientSession) ContainerAPI() (containerv1.ContainerServiceAPI, error) {
+func (sess *clientSession) ContainerAPI() (containerv1.ContainerServiceAPI, error) {
+	sess.configureOnce("ContainerV1", sess.configureContainerV1)
 	return sess.csServiceAPI, sess.csConfigErr
 }
 
 // VpcContainerAPI provides v2Container Service APIs ...
-func (sess clientSession) VpcContainerAPI() (containerv2.ContainerServiceAPI, error) {
+func (sess *clientSession) VpcContainerAPI() (containerv2.ContainerServiceAPI, error) {
+	sess.configureOnce("ContainerV2", sess.configureContainerV2)
 	return sess.csv2ServiceAPI, sess.csv2ConfigErr
 }
 
 // ContainerRegistryV1 provides Container Registry Service APIs ...
-func (session clientSession) ContainerRegistryV1() (*containerregistryv1.ContainerRegistryV1, error) {
+func (session *clientSession) ContainerRegistryV1() (*containerregistryv1.ContainerRegistryV1, error) {
+	session.configureOnce("ContainerRegistry", session.configureContainerRegistry)
 	return session.containerRegistryClient, session.containerRegistryClientErr
 }
 
 // SchematicsAPI provides schematics Service APIs ...
-func (sess clientSession) SchematicsV1() (*schematicsv1.SchematicsV1, error) {
+func (sess *clientSession) SchematicsV1() (*schematicsv1.SchematicsV1, error) {
+	sess.configureOnce("Schematics", sess.configureSchematics)
 	if sess.schematicsClientErr != nil {
 		return sess.schematicsClient, sess.schematicsClientErr
 	}
@@ -764,122 +798,156 @@ func (sess clientSession) SchematicsV1() (*schematicsv1.SchematicsV1, error) {
 }
 
 // FunctionClient ...
-func (sess clientSession) FunctionClient() (*whisk.Client, error) {
+func (sess *clientSession) FunctionClient() (*whisk.Client, error) {
+	sess.configureOnce("Function", sess.configureFunction)
 	return sess.functionClient, sess.functionConfigErr
 }
 
 // GlobalSearchAPI provides Global Search  APIs ...
-func (sess clientSession) GlobalSearchAPI() (globalsearchv2.GlobalSearchServiceAPI, error) {
+func (sess *clientSession) GlobalSearchAPI() (globalsearchv2.GlobalSearchServiceAPI, error) {
+	sess.configureOnce("GlobalSearch", sess.configureGlobalSearch)
 	return sess.globalSearchServiceAPI, sess.globalSearchConfigErr
 }
 
 // GlobalTaggingAPI provides Global Search  APIs ...
-func (sess clientSession) GlobalTaggingAPI() (globaltaggingv3.GlobalTaggingServiceAPI, error) {
+func (sess *clientSession) GlobalTaggingAPI() (globaltaggingv3.GlobalTaggingServiceAPI, error) {
+	sess.configureOnce("GlobalTagging", sess.configureGlobalTagging)
 	return sess.globalTaggingServiceAPI, sess.globalTaggingConfigErr
 }
 
 // GlobalTaggingAPIV1 provides Platform-go Global Tagging  APIs ...
-func (sess clientSession) GlobalTaggingAPIv1() (globaltaggingv1.GlobalTaggingV1, error) {
+func (sess *clientSession) GlobalTaggingAPIv1() (globaltaggingv1.GlobalTaggingV1, error) {
+	sess.configureOnce("GlobalTaggingV1", sess.configureGlobalTaggingV1)
 	return sess.globalTaggingServiceAPIV1, sess.globalTaggingConfigErrV1
 }
 
 // GlobalSearchAPIV2 provides Platform-go Global Search  APIs ...
-func (sess clientSession) GlobalSearchAPIV2() (searchv2.GlobalSearchV2, error) {
+func (sess *clientSession) GlobalSearchAPIV2() (searchv2.GlobalSearchV2, error) {
+	sess.configureOnce("GlobalSearchV2", sess.configureGlobalSearchV2)
 	return sess.globalSearchServiceAPIV2, sess.globalSearchConfigErrV2
 }
 
 // HpcsEndpointAPI provides Hpcs Endpoint generator APIs ...
-func (sess clientSession) HpcsEndpointAPI() (hpcs.HPCSV2, error) {
+func (sess *clientSession) HpcsEndpointAPI() (hpcs.HPCSV2, error) {
+	sess.configureOnce("Hpcs", sess.configureHpcs)
 	return sess.hpcsEndpointAPI, sess.hpcsEndpointErr
 }
 
 // UKO
-func (session clientSession) UkoV4() (*ukov4.UkoV4, error) {
+func (session *clientSession) UkoV4() (*ukov4.UkoV4, error) {
+	session.configureOnce("Uko", session.configureUko)
 	return session.ukoClient, session.ukoClientErr
 }
 
 // UserManagementAPI provides User management APIs ...
-func (sess clientSession) UserManagementAPI() (usermanagementv2.UserManagementAPI, error) {
+func (sess *clientSession) UserManagementAPI() (usermanagementv2.UserManagementAPI, error) {
+	sess.configureOnce("UserManagement", sess.configureUserManagement)
 	return sess.userManagementAPI, sess.userManagementErr
 }
 
 // IAM Policy Management
-func (sess clientSession) IAMPolicyManagementV1API() (*iampolicymanagement.IamPolicyManagementV1, error) {
+func (sess *clientSession) IAMPolicyManagementV1API() (*iampolicymanagement.IamPolicyManagementV1, error) {
+	sess.configureOnce("IAMPolicyManagement", sess.configureIAMPolicyManagement)
 	return sess.iamPolicyManagementAPI, sess.iamPolicyManagementErr
 }
 
 // IAMAccessGroupsV2 provides IAM AG APIs ...
-func (sess clientSession) IAMAccessGroupsV2() (*iamaccessgroups.IamAccessGroupsV2, error) {
+func (sess *clientSession) IAMAccessGroupsV2() (*iamaccessgroups.IamAccessGroupsV2, error) {
+	sess.configureOnce("IAMAccessGroups", sess.configureIAMAccessGroups)
 	return sess.iamAccessGroupsAPI, sess.iamAccessGroupsErr
 }
 
 // IBM Cloud Shell
-func (session clientSession) IBMCloudShellV1() (*ibmcloudshellv1.IBMCloudShellV1, error) {
+func (session *clientSession) IBMCloudShellV1() (*ibmcloudshellv1.IBMCloudShellV1, error) {
+	session.configureOnce("CloudShell", session.configureCloudShell)
 	return session.ibmCloudShellClient, session.ibmCloudShellClientErr
 }
 
 // IcdAPI provides IBM Cloud Databases APIs ...
-func (sess clientSession) ICDAPI() (icdv4.ICDServiceAPI, error) {
+func (sess *clientSession) ICDAPI() (icdv4.ICDServiceAPI, error) {
+	sess.configureOnce("ICD", sess.configureICD)
 	return sess.icdServiceAPI, sess.icdConfigErr
 }
 
 // The IBM Cloud Databases API
-func (session clientSession) CloudDatabasesV5() (*clouddatabasesv5.CloudDatabasesV5, error) {
+func (session *clientSession) CloudDatabasesV5() (*clouddatabasesv5.CloudDatabasesV5, error) {
+	session.configureOnce("CloudDatabases", session.configureCloudDatabases)
 	return session.cloudDatabasesClient, session.cloudDatabasesClientErr
 }
 
 // IBM Db2 SaaS on Cloud REST API
-func (session clientSession) Db2saasV1() (*db2saasv1.Db2saasV1, error) {
+func (session *clientSession) Db2saasV1() (*db2saasv1.Db2saasV1, error) {
+	session.configureOnce("Db2saas", session.configureDb2saas)
 	return session.db2saasClient, session.db2saasClientErr
 }
 
 // MccpAPI provides Multi Cloud Controller Proxy APIs ...
-func (sess clientSession) MccpAPI() (mccpv2.MccpServiceAPI, error) {
+func (sess *clientSession) MccpAPI() (mccpv2.MccpServiceAPI, error) {
+	sess.configureOnce("Mccp", sess.configureMccp)
 	return sess.cfServiceAPI, sess.cfConfigErr
 }
 
 // ResourceCatalogAPI ...
-func (sess clientSession) ResourceCatalogAPI() (catalog.ResourceCatalogAPI, error) {
+func (sess *clientSession) ResourceCatalogAPI() (catalog.ResourceCatalogAPI, error) {
+	sess.configureOnce("ResourceCatalog", sess.configureResourceCatalog)
 	return sess.resourceCatalogServiceAPI, sess.resourceCatalogConfigErr
 }
 
 // ResourceManagementAPIv2 ...
-func (sess clientSession) ResourceManagementAPIv2() (managementv2.ResourceManagementAPIv2, error) {
+func (sess *clientSession) ResourceManagementAPIv2() (managementv2.ResourceManagementAPIv2, error) {
+	sess.configureOnce("ResourceManagementV2", sess.configureResourceManagementV2)
 	return sess.resourceManagementServiceAPIv2, sess.resourceManagementConfigErrv2
 }
 
 // ResourceControllerAPI ...
-func (sess clientSession) ResourceControllerAPI() (controller.ResourceControllerAPI, error) {
+func (sess *clientSession) ResourceControllerAPI() (controller.ResourceControllerAPI, error) {
+	sess.configureOnce("ResourceControllerV1", sess.configureResourceControllerV1)
 	return sess.resourceControllerServiceAPI, sess.resourceControllerConfigErr
 }
 
 // ResourceControllerAPIv2 ...
-func (sess clientSession) ResourceControllerAPIV2() (controllerv2.ResourceControllerAPIV2, error) {
+func (sess *clientSession) ResourceControllerAPIV2() (controllerv2.ResourceControllerAPIV2, error) {
+	sess.configureOnce("ResourceControllerV2", sess.configureResourceControllerV2)
 	return sess.resourceControllerServiceAPIv2, sess.resourceControllerConfigErrv2
 }
 
 // SoftLayerSession providers SoftLayer Session
-func (sess clientSession) SoftLayerSession() *slsession.Session {
+func (sess *clientSession) SoftLayerSession() *slsession.Session {
 	return sess.session.SoftLayerSession
 }
 
-func (session clientSession) PushServiceV1() (*pushservicev1.PushServiceV1, error) {
+// DefaultTags returns the user tags configured in the provider block with default_tags
+func (sess *clientSession) DefaultTags() []string {
+	return sess.config.DefaultTags
+}
+
+// DefaultAccessTags returns the access tags configured in the provider block with default_access_tags
+func (sess *clientSession) DefaultAccessTags() []string {
+	return sess.config.DefaultAccessTags
+}
+
+func (session *clientSession) PushServiceV1() (*pushservicev1.PushServiceV1, error) {
+	session.configureOnce("PushService", session.configurePushService)
 	return session.pushServiceClient, session.pushServiceClientErr
 }
 
-func (session clientSession) EventNotificationsApiV1() (*eventnotificationsv1.EventNotificationsV1, error) {
+func (session *clientSession) EventNotificationsApiV1() (*eventnotificationsv1.EventNotificationsV1, error) {
+	session.configureOnce("EventNotifications", session.configureEventNotifications)
 	return session.eventNotificationsApiClient, session.eventNotificationsApiClientErr
 }
 
-func (session clientSession) AppConfigurationV1() (*appconfigurationv1.AppConfigurationV1, error) {
+func (session *clientSession) AppConfigurationV1() (*appconfigurationv1.AppConfigurationV1, error) {
+	session.configureOnce("AppConfiguration", session.configureAppConfiguration)
 	return session.appConfigurationClient, session.appConfigurationClientErr
 }
 
-func (sess clientSession) KeyProtectAPI() (*kp.Client, error) {
+func (sess *clientSession) KeyProtectAPI() (*kp.Client, error) {
+	sess.configureOnce("KeyProtect", sess.configureKeyProtect)
 	return sess.kpAPI, sess.kpErr
 }
 
-func (sess clientSession) KeyManagementAPI() (*kp.Client, error) {
+func (sess *clientSession) KeyManagementAPI() (*kp.Client, error) {
+	sess.configureOnce("KeyManagement", sess.configureKeyManagement)
 	if sess.kmsErr == nil {
 		var clientConfig *kp.ClientConfig
 		if sess.kmsAPI.Config.APIKey != "" {
@@ -900,57 +968,67 @@ func (sess clientSession) KeyManagementAPI() (*kp.Client, error) {
 
 		kpClient, err := kp.New(*clientConfig, DefaultTransport())
 		if err != nil {
-			sess.kpErr = fmt.Errorf("[ERROR] Error occured while configuring Key Protect Service: %q", err)
+			return nil, fmt.Errorf("[ERROR] Error occured while configuring Key Protect Service: %q", err)
 		}
 		return kpClient, nil
 	}
 	return sess.kmsAPI, sess.kmsErr
 }
 
-func (sess clientSession) VpcV1API() (*vpc.VpcV1, error) {
+func (sess *clientSession) VpcV1API() (*vpc.VpcV1, error) {
+	sess.configureOnce("Vpc", sess.configureVpc)
 	return sess.vpcAPI, sess.vpcErr
 }
 
-func (sess clientSession) VpcV1BetaAPI() (*vpcbeta.VpcbetaV1, error) {
+func (sess *clientSession) VpcV1BetaAPI() (*vpcbeta.VpcbetaV1, error) {
+	sess.configureOnce("Vpc", sess.configureVpc)
 	return sess.vpcBetaAPI, sess.vpcbetaErr
 }
 
-func (sess clientSession) DirectlinkV1API() (*dl.DirectLinkV1, error) {
+func (sess *clientSession) DirectlinkV1API() (*dl.DirectLinkV1, error) {
+	sess.configureOnce("DirectLink", sess.configureDirectLink)
 	return sess.directlinkAPI, sess.directlinkErr
 }
 
-func (sess clientSession) DirectlinkProviderV2API() (*dlProviderV2.DirectLinkProviderV2, error) {
+func (sess *clientSession) DirectlinkProviderV2API() (*dlProviderV2.DirectLinkProviderV2, error) {
+	sess.configureOnce("DirectLinkProvider", sess.configureDirectLinkProvider)
 	return sess.dlProviderAPI, sess.dlProviderErr
 }
 
-func (sess clientSession) CosConfigV1API() (*cosconfig.ResourceConfigurationV1, error) {
+func (sess *clientSession) CosConfigV1API() (*cosconfig.ResourceConfigurationV1, error) {
+	sess.configureOnce("CosConfig", sess.configureCosConfig)
 	return sess.cosConfigAPI, sess.cosConfigErr
 }
 
-func (sess clientSession) TransitGatewayV1API() (*tg.TransitGatewayApisV1, error) {
+func (sess *clientSession) TransitGatewayV1API() (*tg.TransitGatewayApisV1, error) {
+	sess.configureOnce("TransitGateway", sess.configureTransitGateway)
 	return sess.transitgatewayAPI, sess.transitgatewayErr
 }
 
 // Session to the Power Colo Service
 
-func (sess clientSession) IBMPISession() (*ibmpisession.IBMPISession, error) {
+func (sess *clientSession) IBMPISession() (*ibmpisession.IBMPISession, error) {
+	sess.configureOnce("IBMPI", sess.configureIBMPI)
 	return sess.ibmpiSession, sess.ibmpiConfigErr
 }
 
 // Private DNS Service
 
-func (sess clientSession) PrivateDNSClientSession() (*dns.DnsSvcsV1, error) {
+func (sess *clientSession) PrivateDNSClientSession() (*dns.DnsSvcsV1, error) {
+	sess.configureOnce("PrivateDNS", sess.configurePrivateDNS)
 	return sess.pDNSClient, sess.pDNSErr
 }
 
 // Session to the Namespace cloud function
 
-func (sess clientSession) FunctionIAMNamespaceAPI() (functions.FunctionServiceAPI, error) {
+func (sess *clientSession) FunctionIAMNamespaceAPI() (functions.FunctionServiceAPI, error) {
+	sess.configureOnce("FunctionIAMNamespace", sess.configureFunctionIAMNamespace)
 	return sess.functionIAMNamespaceAPI, sess.functionIAMNamespaceErr
 }
 
 // CIS Zones Service
-func (sess clientSession) CisZonesV1ClientSession() (*ciszonesv1.ZonesV1, error) {
+func (sess *clientSession) CisZonesV1ClientSession() (*ciszonesv1.ZonesV1, error) {
+	sess.configureOnce("CisZones", sess.configureCisZones)
 	if sess.cisZonesErr != nil {
 		return sess.cisZonesV1Client, sess.cisZonesErr
 	}
@@ -958,7 +1036,8 @@ func (sess clientSession) CisZonesV1ClientSession() (*ciszonesv1.ZonesV1, error)
 }
 
 // CIS DNS Service
-func (sess clientSession) CisDNSRecordClientSession() (*cisdnsrecordsv1.DnsRecordsV1, error) {
+func (sess *clientSession) CisDNSRecordClientSession() (*cisdnsrecordsv1.DnsRecordsV1, error) {
+	sess.configureOnce("CisDNSRecords", sess.configureCisDNSRecords)
 	if sess.cisDNSErr != nil {
 		return sess.cisDNSRecordsClient, sess.cisDNSErr
 	}
@@ -966,7 +1045,8 @@ func (sess clientSession) CisDNSRecordClientSession() (*cisdnsrecordsv1.DnsRecor
 }
 
 // CIS DNS Bulk Service
-func (sess clientSession) CisDNSRecordBulkClientSession() (*cisdnsbulkv1.DnsRecordBulkV1, error) {
+func (sess *clientSession) CisDNSRecordBulkClientSession() (*cisdnsbulkv1.DnsRecordBulkV1, error) {
+	sess.configureOnce("CisDNSRecordBulk", sess.configureCisDNSRecordBulk)
 	if sess.cisDNSBulkErr != nil {
 		return sess.cisDNSRecordBulkClient, sess.cisDNSBulkErr
 	}
@@ -974,7 +1054,8 @@ func (sess clientSession) CisDNSRecordBulkClientSession() (*cisdnsbulkv1.DnsReco
 }
 
 // CIS GLB Pool
-func (sess clientSession) CisGLBPoolClientSession() (*cisglbpoolv0.GlobalLoadBalancerPoolsV0, error) {
+func (sess *clientSession) CisGLBPoolClientSession() (*cisglbpoolv0.GlobalLoadBalancerPoolsV0, error) {
+	sess.configureOnce("CisGLBPool", sess.configureCisGLBPool)
 	if sess.cisGLBPoolErr != nil {
 		return sess.cisGLBPoolClient, sess.cisGLBPoolErr
 	}
@@ -982,7 +1063,8 @@ func (sess clientSession) CisGLBPoolClientSession() (*cisglbpoolv0.GlobalLoadBal
 }
 
 // CIS GLB
-func (sess clientSession) CisGLBClientSession() (*cisglbv1.GlobalLoadBalancerV1, error) {
+func (sess *clientSession) CisGLBClientSession() (*cisglbv1.GlobalLoadBalancerV1, error) {
+	sess.configureOnce("CisGLB", sess.configureCisGLB)
 	if sess.cisGLBErr != nil {
 		return sess.cisGLBClient, sess.cisGLBErr
 	}
@@ -990,7 +1072,8 @@ func (sess clientSession) CisGLBClientSession() (*cisglbv1.GlobalLoadBalancerV1,
 }
 
 // CIS GLB Health Check/Monitor
-func (sess clientSession) CisGLBHealthCheckClientSession() (*cisglbhealthcheckv1.GlobalLoadBalancerMonitorV1, error) {
+func (sess *clientSession) CisGLBHealthCheckClientSession() (*cisglbhealthcheckv1.GlobalLoadBalancerMonitorV1, error) {
+	sess.configureOnce("CisGLBHealthCheck", sess.configureCisGLBHealthCheck)
 	if sess.cisGLBHealthCheckErr != nil {
 		return sess.cisGLBHealthCheckClient, sess.cisGLBHealthCheckErr
 	}
@@ -998,7 +1081,8 @@ func (sess clientSession) CisGLBHealthCheckClientSession() (*cisglbhealthcheckv1
 }
 
 // CIS Zone Rate Limits
-func (sess clientSession) CisRLClientSession() (*cisratelimitv1.ZoneRateLimitsV1, error) {
+func (sess *clientSession) CisRLClientSession() (*cisratelimitv1.ZoneRateLimitsV1, error) {
+	sess.configureOnce("CisRateLimit", sess.configureCisRateLimit)
 	if sess.cisRLErr != nil {
 		return sess.cisRLClient, sess.cisRLErr
 	}
@@ -1006,7 +1090,8 @@ func (sess clientSession) CisRLClientSession() (*cisratelimitv1.ZoneRateLimitsV1
 }
 
 // CIS IP
-func (sess clientSession) CisIPClientSession() (*cisipv1.CisIpApiV1, error) {
+func (sess *clientSession) CisIPClientSession() (*cisipv1.CisIpApiV1, error) {
+	sess.configureOnce("CisIP", sess.configureCisIP)
 	if sess.cisIPErr != nil {
 		return sess.cisIPClient, sess.cisIPErr
 	}
@@ -1014,7 +1099,8 @@ func (sess clientSession) CisIPClientSession() (*cisipv1.CisIpApiV1, error) {
 }
 
 // CIS Page Rules
-func (sess clientSession) CisPageRuleClientSession() (*cispagerulev1.PageRuleApiV1, error) {
+func (sess *clientSession) CisPageRuleClientSession() (*cispagerulev1.PageRuleApiV1, error) {
+	sess.configureOnce("CisPageRule", sess.configureCisPageRule)
 	if sess.cisPageRuleErr != nil {
 		return sess.cisPageRuleClient, sess.cisPageRuleErr
 	}
@@ -1022,7 +1108,8 @@ func (sess clientSession) CisPageRuleClientSession() (*cispagerulev1.PageRuleApi
 }
 
 // CIS Edge Function
-func (sess clientSession) CisEdgeFunctionClientSession() (*cisedgefunctionv1.EdgeFunctionsApiV1, error) {
+func (sess *clientSession) CisEdgeFunctionClientSession() (*cisedgefunctionv1.EdgeFunctionsApiV1, error) {
+	sess.configureOnce("CisEdgeFunction", sess.configureCisEdgeFunction)
 	if sess.cisEdgeFunctionErr != nil {
 		return sess.cisEdgeFunctionClient, sess.cisEdgeFunctionErr
 	}
@@ -1030,7 +1117,8 @@ func (sess clientSession) CisEdgeFunctionClientSession() (*cisedgefunctionv1.Edg
 }
 
 // CIS SSL certificate
-func (sess clientSession) CisSSLClientSession() (*cissslv1.SslCertificateApiV1, error) {
+func (sess *clientSession) CisSSLClientSession() (*cissslv1.SslCertificateApiV1, error) {
+	sess.configureOnce("CisSSL", sess.configureCisSSL)
 	if sess.cisSSLErr != nil {
 		return sess.cisSSLClient, sess.cisSSLErr
 	}
@@ -1038,7 +1126,8 @@ func (sess clientSession) CisSSLClientSession() (*cissslv1.SslCertificateApiV1,
 }
 
 // CIS WAF Packages
-func (sess clientSession) CisWAFPackageClientSession() (*ciswafpackagev1.WafRulePackagesApiV1, error) {
+func (sess *clientSession) CisWAFPackageClientSession() (*ciswafpackagev1.WafRulePackagesApiV1, error) {
+	sess.configureOnce("CisWAFPackage", sess.configureCisWAFPackage)
 	if sess.cisWAFPackageErr != nil {
 		return sess.cisWAFPackageClient, sess.cisWAFPackageErr
 	}
@@ -1046,7 +1135,8 @@ func (sess clientSession) CisWAFPackageClientSession() (*ciswafpackagev1.WafRule
 }
 
 // CIS Zone Settings
-func (sess clientSession) CisDomainSettingsClientSession() (*cisdomainsettingsv1.ZonesSettingsV1, error) {
+func (sess *clientSession) CisDomainSettingsClientSession() (*cisdomainsettingsv1.ZonesSettingsV1, error) {
+	sess.configureOnce("CisDomainSettings", sess.configureCisDomainSettings)
 	if sess.cisDomainSettingsErr != nil {
 		return sess.cisDomainSettingsClient, sess.cisDomainSettingsErr
 	}
@@ -1054,7 +1144,8 @@ func (sess clientSession) CisDomainSettingsClientSession() (*cisdomainsettingsv1
 }
 
 // CIS Alerts
-func (sess clientSession) CisAlertsSession() (*cisalertsv1.AlertsV1, error) {
+func (sess *clientSession) CisAlertsSession() (*cisalertsv1.AlertsV1, error) {
+	sess.configureOnce("CisAlerts", sess.configureCisAlerts)
 	if sess.cisAlertsErr != nil {
 		return sess.cisAlertsClient, sess.cisAlertsErr
 	}
@@ -1062,7 +1153,8 @@ func (sess clientSession) CisAlertsSession() (*cisalertsv1.AlertsV1, error) {
 }
 
 // CIS Rulesets
-func (sess clientSession) CisRulesetsSession() (*cisrulesetsv1.RulesetsV1, error) {
+func (sess *clientSession) CisRulesetsSession() (*cisrulesetsv1.RulesetsV1, error) {
+	sess.configureOnce("CisRulesets", sess.configureCisRulesets)
 	if sess.cisRulesetsErr != nil {
 		return sess.cisRulesetsClient, sess.cisRulesetsErr
 	}
@@ -1070,7 +1162,8 @@ func (sess clientSession) CisRulesetsSession() (*cisrulesetsv1.RulesetsV1, error
 }
 
 // CIS Routing
-func (sess clientSession) CisRoutingClientSession() (*cisroutingv1.RoutingV1, error) {
+func (sess *clientSession) CisRoutingClientSession() (*cisroutingv1.RoutingV1, error) {
+	sess.configureOnce("CisRouting", sess.configureCisRouting)
 	if sess.cisRoutingErr != nil {
 		return sess.cisRoutingClient, sess.cisRoutingErr
 	}
@@ -1078,7 +1171,8 @@ func (sess clientSession) CisRoutingClientSession() (*cisroutingv1.RoutingV1, er
 }
 
 // CIS WAF Group
-func (sess clientSession) CisWAFGroupClientSession() (*ciswafgroupv1.WafRuleGroupsApiV1, error) {
+func (sess *clientSession) CisWAFGroupClientSession() (*ciswafgroupv1.WafRuleGroupsApiV1, error) {
+	sess.configureOnce("CisWAFGroup", sess.configureCisWAFGroup)
 	if sess.cisWAFGroupErr != nil {
 		return sess.cisWAFGroupClient, sess.cisWAFGroupErr
 	}
@@ -1086,7 +1180,8 @@ func (sess clientSession) CisWAFGroupClientSession() (*ciswafgroupv1.WafRuleGrou
 }
 
 // CIS Cache service
-func (sess clientSession) CisCacheClientSession() (*ciscachev1.CachingApiV1, error) {
+func (sess *clientSession) CisCacheClientSession() (*ciscachev1.CachingApiV1, error) {
+	sess.configureOnce("CisCache", sess.configureCisCache)
 	if sess.cisCacheErr != nil {
 		return sess.cisCacheClient, sess.cisCacheErr
 	}
@@ -1094,7 +1189,8 @@ func (sess clientSession) CisCacheClientSession() (*ciscachev1.CachingApiV1, err
 }
 
 // CIS Zone Settings
-func (sess clientSession) CisCustomPageClientSession() (*ciscustompagev1.CustomPagesV1, error) {
+func (sess *clientSession) CisCustomPageClientSession() (*ciscustompagev1.CustomPagesV1, error) {
+	sess.configureOnce("CisCustomPage", sess.configureCisCustomPage)
 	if sess.cisCustomPageErr != nil {
 		return sess.cisCustomPageClient, sess.cisCustomPageErr
 	}
@@ -1102,7 +1198,8 @@ func (sess clientSession) CisCustomPageClientSession() (*ciscustompagev1.CustomP
 }
 
 // CIS Firewall access rule
-func (sess clientSession) CisAccessRuleClientSession() (*cisaccessrulev1.ZoneFirewallAccessRulesV1, error) {
+func (sess *clientSession) CisAccessRuleClientSession() (*cisaccessrulev1.ZoneFirewallAccessRulesV1, error) {
+	sess.configureOnce("CisAccessRule", sess.configureCisAccessRule)
 	if sess.cisAccessRuleErr != nil {
 		return sess.cisAccessRuleClient, sess.cisAccessRuleErr
 	}
@@ -1110,7 +1207,8 @@ func (sess clientSession) CisAccessRuleClientSession() (*cisaccessrulev1.ZoneFir
 }
 
 // CIS User Agent Blocking rule
-func (sess clientSession) CisUARuleClientSession() (*cisuarulev1.UserAgentBlockingRulesV1, error) {
+func (sess *clientSession) CisUARuleClientSession() (*cisuarulev1.UserAgentBlockingRulesV1, error) {
+	sess.configureOnce("CisUARule", sess.configureCisUARule)
 	if sess.cisUARuleErr != nil {
 		return sess.cisUARuleClient, sess.cisUARuleErr
 	}
@@ -1118,7 +1216,8 @@ func (sess clientSession) CisUARuleClientSession() (*cisuarulev1.UserAgentBlocki
 }
 
 // CIS Firewall Lockdown rule
-func (sess clientSession) CisLockdownClientSession() (*cislockdownv1.ZoneLockdownV1, error) {
+func (sess *clientSession) CisLockdownClientSession() (*cislockdownv1.ZoneLockdownV1, error) {
+	sess.configureOnce("CisLockdown", sess.configureCisLockdown)
 	if sess.cisLockdownErr != nil {
 		return sess.cisLockdownClient, sess.cisLockdownErr
 	}
@@ -1126,7 +1225,8 @@ func (sess clientSession) CisLockdownClientSession() (*cislockdownv1.ZoneLockdow
 }
 
 // CIS Range app rule
-func (sess clientSession) CisRangeAppClientSession() (*cisrangeappv1.RangeApplicationsV1, error) {
+func (sess *clientSession) CisRangeAppClientSession() (*cisrangeappv1.RangeApplicationsV1, error) {
+	sess.configureOnce("CisRangeApp", sess.configureCisRangeApp)
 	if sess.cisRangeAppErr != nil {
 		return sess.cisRangeAppClient, sess.cisRangeAppErr
 	}
@@ -1134,7 +1234,8 @@ func (sess clientSession) CisRangeAppClientSession() (*cisrangeappv1.RangeApplic
 }
 
 // CIS WAF Rule
-func (sess clientSession) CisWAFRuleClientSession() (*ciswafrulev1.WafRulesApiV1, error) {
+func (sess *clientSession) CisWAFRuleClientSession() (*ciswafrulev1.WafRulesApiV1, error) {
+	sess.configureOnce("CisWAFRule", sess.configureCisWAFRule)
 	if sess.cisWAFRuleErr != nil {
 		return sess.cisWAFRuleClient, sess.cisWAFRuleErr
 	}
@@ -1142,7 +1243,8 @@ func (sess clientSession) CisWAFRuleClientSession() (*ciswafrulev1.WafRulesApiV1
 }
 
 // CIS Authenticated Origin Pull
-func (sess clientSession) CisOrigAuthSession() (*cisoriginpull.AuthenticatedOriginPullApiV1, error) {
+func (sess *clientSession) CisOrigAuthSession() (*cisoriginpull.AuthenticatedOriginPullApiV1, error) {
+	sess.configureOnce("CisOriginAuth", sess.configureCisOriginAuth)
 	if sess.cisOriginAuthPullErr != nil {
 		return sess.cisOriginAuthClient, sess.cisOriginAuthPullErr
 	}
@@ -1150,51 +1252,61 @@ func (sess clientSession) CisOrigAuthSession() (*cisoriginpull.AuthenticatedOrig
 }
 
 // IAM Identity Session
-func (sess clientSession) IAMIdentityV1API() (*iamidentity.IamIdentityV1, error) {
+func (sess *clientSession) IAMIdentityV1API() (*iamidentity.IamIdentityV1, error) {
+	sess.configureOnce("IAMIdentity", sess.configureIAMIdentity)
 	return sess.iamIdentityAPI, sess.iamIdentityErr
 }
 
 // ResourceMAanger Session
-func (sess clientSession) ResourceManagerV2API() (*resourcemanager.ResourceManagerV2, error) {
+func (sess *clientSession) ResourceManagerV2API() (*resourcemanager.ResourceManagerV2, error) {
+	sess.configureOnce("ResourceManager", sess.configureResourceManager)
 	return sess.resourceManagerAPI, sess.resourceManagerErr
 }
 
-func (session clientSession) EnterpriseManagementV1() (*enterprisemanagementv1.EnterpriseManagementV1, error) {
+func (session *clientSession) EnterpriseManagementV1() (*enterprisemanagementv1.EnterpriseManagementV1, error) {
+	session.configureOnce("EnterpriseManagement", session.configureEnterpriseManagement)
 	return session.enterpriseManagementClient, session.enterpriseManagementClientErr
 }
 
 // ResourceController Session
-func (sess clientSession) ResourceControllerV2API() (*resourcecontroller.ResourceControllerV2, error) {
+func (sess *clientSession) ResourceControllerV2API() (*resourcecontroller.ResourceControllerV2, error) {
+	sess.configureOnce("ResourceController", sess.configureResourceController)
 	return sess.resourceControllerAPI, sess.resourceControllerErr
 }
 
-func (session clientSession) BackupRecoveryV1() (*backuprecoveryv1.BackupRecoveryV1, error) {
+func (session *clientSession) BackupRecoveryV1() (*backuprecoveryv1.BackupRecoveryV1, error) {
+	session.configureOnce("BackupRecovery", session.configureBackupRecovery)
 	return session.backupRecoveryClient, session.backupRecoveryClientErr
 }
 
-func (session clientSession) BackupRecoveryV1Connector() (*backuprecoveryv1.BackupRecoveryV1Connector, error) {
+func (session *clientSession) BackupRecoveryV1Connector() (*backuprecoveryv1.BackupRecoveryV1Connector, error) {
+	session.configureOnce("BackupRecovery", session.configureBackupRecovery)
 	return session.backupRecoveryConnectorClient, session.backupRecoveryConnectorClientErr
 }
 
 // IBM Cloud Secrets Manager V2 Basic API
-func (session clientSession) SecretsManagerV2() (*secretsmanagerv2.SecretsManagerV2, error) {
+func (session *clientSession) SecretsManagerV2() (*secretsmanagerv2.SecretsManagerV2, error) {
+	session.configureOnce("SecretsManager", session.configureSecretsManager)
 	return session.secretsManagerClient, session.secretsManagerClientErr
 }
 
 // Satellite Link
-func (session clientSession) SatellitLinkClientSession() (*satellitelinkv1.SatelliteLinkV1, error) {
+func (session *clientSession) SatellitLinkClientSession() (*satellitelinkv1.SatelliteLinkV1, error) {
+	session.configureOnce("SatelliteLink", session.configureSatelliteLink)
 	return session.satelliteLinkClient, session.satelliteLinkClientErr
 }
 
 var cloudEndpoint = "cloud.ibm.com"
 
 // Session to the Satellite client
-func (sess clientSession) SatelliteClientSession() (*kubernetesserviceapiv1.KubernetesServiceApiV1, error) {
+func (sess *clientSession) SatelliteClientSession() (*kubernetesserviceapiv1.KubernetesServiceApiV1, error) {
+	sess.configureOnce("Satellite", sess.configureSatellite)
 	return sess.satelliteClient, sess.satelliteClientErr
 }
 
 // CIS LogPushJob
-func (sess clientSession) CisLogpushJobsSession() (*cislogpushjobsapiv1.LogpushJobsApiV1, error) {
+func (sess *clientSession) CisLogpushJobsSession() (*cislogpushjobsapiv1.LogpushJobsApiV1, error) {
+	sess.configureOnce("CisLogpushJobs", sess.configureCisLogpushJobs)
 	if sess.cisLogpushJobsErr != nil {
 		return sess.cisLogpushJobsClient, sess.cisLogpushJobsErr
 	}
@@ -1202,7 +1314,8 @@ func (sess clientSession) CisLogpushJobsSession() (*cislogpushjobsapiv1.LogpushJ
 }
 
 // CIS MTLS session
-func (sess clientSession) CisMtlsSession() (*cismtlsv1.MtlsV1, error) {
+func (sess *clientSession) CisMtlsSession() (*cismtlsv1.MtlsV1, error) {
+	sess.configureOnce("CisMtls", sess.configureCisMtls)
 	if sess.cisMtlsErr != nil {
 		return sess.cisMtlsClient, sess.cisMtlsErr
 	}
@@ -1210,7 +1323,8 @@ func (sess clientSession) CisMtlsSession() (*cismtlsv1.MtlsV1, error) {
 }
 
 // CIS Bot Management
-func (sess clientSession) CisBotManagementSession() (*cisbotmanagementv1.BotManagementV1, error) {
+func (sess *clientSession) CisBotManagementSession() (*cisbotmanagementv1.BotManagementV1, error) {
+	sess.configureOnce("CisBotManagement", sess.configureCisBotManagement)
 	if sess.cisBotManagementErr != nil {
 		return sess.cisBotManagementClient, sess.cisBotManagementErr
 	}
@@ -1218,7 +1332,8 @@ func (sess clientSession) CisBotManagementSession() (*cisbotmanagementv1.BotMana
 }
 
 // CIS Bot Analytics
-func (sess clientSession) CisBotAnalyticsSession() (*cisbotanalyticsv1.BotAnalyticsV1, error) {
+func (sess *clientSession) CisBotAnalyticsSession() (*cisbotanalyticsv1.BotAnalyticsV1, error) {
+	sess.configureOnce("CisBotAnalytics", sess.configureCisBotAnalytics)
 	if sess.cisBotAnalyticsErr != nil {
 		return sess.cisBotAnalyticsClient, sess.cisBotAnalyticsErr
 	}
@@ -1226,7 +1341,8 @@ func (sess clientSession) CisBotAnalyticsSession() (*cisbotanalyticsv1.BotAnalyt
 }
 
 // CIS Webhooks
-func (sess clientSession) CisWebhookSession() (*ciswebhooksv1.WebhooksV1, error) {
+func (sess *clientSession) CisWebhookSession() (*ciswebhooksv1.WebhooksV1, error) {
+	sess.configureOnce("CisWebhooks", sess.configureCisWebhooks)
 	if sess.cisWebhooksErr != nil {
 		return sess.cisWebhooksClient, sess.cisWebhooksErr
 	}
@@ -1234,7 +1350,8 @@ func (sess clientSession) CisWebhookSession() (*ciswebhooksv1.WebhooksV1, error)
 }
 
 // CIS Filters
-func (sess clientSession) CisFiltersSession() (*cisfiltersv1.FiltersV1, error) {
+func (sess *clientSession) CisFiltersSession() (*cisfiltersv1.FiltersV1, error) {
+	sess.configureOnce("CisFilters", sess.configureCisFilters)
 	if sess.cisFiltersErr != nil {
 		return sess.cisFiltersClient, sess.cisFiltersErr
 	}
@@ -1242,7 +1359,8 @@ func (sess clientSession) CisFiltersSession() (*cisfiltersv1.FiltersV1, error) {
 }
 
 // CIS FirewallRules
-func (sess clientSession) CisFirewallRulesSession() (*cisfirewallrulesv1.FirewallRulesV1, error) {
+func (sess *clientSession) CisFirewallRulesSession() (*cisfirewallrulesv1.FirewallRulesV1, error) {
+	sess.configureOnce("CisFirewallRules", sess.configureCisFirewallRules)
 	if sess.cisFirewallRulesErr != nil {
 		return sess.cisFirewallRulesClient, sess.cisFirewallRulesErr
 	}
@@ -1250,55 +1368,66 @@ func (sess clientSession) CisFirewallRulesSession() (*cisfirewallrulesv1.Firewal
 }
 
 // Activity Tracker API
-func (session clientSession) AtrackerV2() (*atrackerv2.AtrackerV2, error) {
+func (session *clientSession) AtrackerV2() (*atrackerv2.AtrackerV2, error) {
+	session.configureOnce("Atracker", session.configureAtracker)
 	return session.atrackerClientV2, session.atrackerClientV2Err
 }
 
 // Metrics Router API Version 3
-func (session clientSession) MetricsRouterV3() (*metricsrouterv3.MetricsRouterV3, error) {
+func (session *clientSession) MetricsRouterV3() (*metricsrouterv3.MetricsRouterV3, error) {
+	session.configureOnce("MetricsRouter", session.configureMetricsRouter)
 	return session.metricsRouterClient, session.metricsRouterClientErr
 }
 
-func (session clientSession) ESschemaRegistrySession() (*schemaregistryv1.SchemaregistryV1, error) {
+func (session *clientSession) ESschemaRegistrySession() (*schemaregistryv1.SchemaregistryV1, error) {
+	session.configureOnce("ESSchemaRegistry", session.configureESSchemaRegistry)
 	return session.esSchemaRegistryClient, session.esSchemaRegistryErr
 }
 
-func (session clientSession) ESadminRestSession() (*adminrestv1.AdminrestV1, error) {
+func (session *clientSession) ESadminRestSession() (*adminrestv1.AdminrestV1, error) {
+	session.configureOnce("ESAdminRest", session.configureESAdminRest)
 	return session.esAdminRestClient, session.esAdminRestErr
 }
 
 // Security and Compliance center Admin API
-func (session clientSession) SecurityAndComplianceCenterV3() (*scc.SecurityAndComplianceCenterApiV3, error) {
+func (session *clientSession) SecurityAndComplianceCenterV3() (*scc.SecurityAndComplianceCenterApiV3, error) {
+	session.configureOnce("SecurityAndComplianceCenter", session.configureSecurityAndComplianceCenter)
 	return session.securityAndComplianceCenterClient, session.securityAndComplianceCenterClientErr
 }
 
 // Context Based Restrictions
-func (session clientSession) ContextBasedRestrictionsV1() (*contextbasedrestrictionsv1.ContextBasedRestrictionsV1, error) {
+func (session *clientSession) ContextBasedRestrictionsV1() (*contextbasedrestrictionsv1.ContextBasedRestrictionsV1, error) {
+	session.configureOnce("ContextBasedRestrictions", session.configureContextBasedRestrictions)
 	return session.contextBasedRestrictionsClient, session.contextBasedRestrictionsClientErr
 }
 
 // CD Toolchain
-func (session clientSession) CdToolchainV2() (*cdtoolchainv2.CdToolchainV2, error) {
+func (session *clientSession) CdToolchainV2() (*cdtoolchainv2.CdToolchainV2, error) {
+	session.configureOnce("CdToolchain", session.configureCdToolchain)
 	return session.cdToolchainClient, session.cdToolchainClientErr
 }
 
 // CD Tekton Pipeline
-func (session clientSession) CdTektonPipelineV2() (*cdtektonpipelinev2.CdTektonPipelineV2, error) {
+func (session *clientSession) CdTektonPipelineV2() (*cdtektonpipelinev2.CdTektonPipelineV2, error) {
+	session.configureOnce("CdTektonPipeline", session.configureCdTektonPipeline)
 	return session.cdTektonPipelineClient, session.cdTektonPipelineClientErr
 }
 
 // Code Engine
-func (session clientSession) CodeEngineV2() (*codeengine.CodeEngineV2, error) {
+func (session *clientSession) CodeEngineV2() (*codeengine.CodeEngineV2, error) {
+	session.configureOnce("CodeEngine", session.configureCodeEngine)
 	return session.codeEngineClient, session.codeEngineClientErr
 }
 
 // Projects API Specification
-func (session clientSession) ProjectV1() (*project.ProjectV1, error) {
+func (session *clientSession) ProjectV1() (*project.ProjectV1, error) {
+	session.configureOnce("Project", session.configureProject)
 	return session.projectClient, session.projectClientErr
 }
 
 // MQaaS
-func (session clientSession) MqcloudV1() (*mqcloudv1.MqcloudV1, error) {
+func (session *clientSession) MqcloudV1() (*mqcloudv1.MqcloudV1, error) {
+	session.configureOnce("Mqcloud", session.configureMqcloud)
 	if session.mqcloudClientErr != nil {
 		sessionMqcloudClient := session.mqcloudClient
 		sessionMqcloudClient.EnableRetries(0, 0)
@@ -1308,31 +1437,55 @@ func (session clientSession) MqcloudV1() (*mqcloudv1.MqcloudV1, error) {
 }
 
 // sdsaas
-func (session clientSession) SdsaasV1() (*sdsaasv1.SdsaasV1, error) {
+func (session *clientSession) SdsaasV1() (*sdsaasv1.SdsaasV1, error) {
+	session.configureOnce("Sdsaas", session.configureSdsaas)
 	return session.sdsaasClient, session.sdsaasClientErr
 }
 
 // VMware as a Service API
-func (session clientSession) VmwareV1() (*vmwarev1.VmwareV1, error) {
+func (session *clientSession) VmwareV1() (*vmwarev1.VmwareV1, error) {
+	session.configureOnce("Vmware", session.configureVmware)
 	return session.vmwareClient, session.vmwareClientErr
 }
 
 // Cloud Logs
-func (session clientSession) LogsV0() (*logsv0.LogsV0, error) {
+func (session *clientSession) LogsV0() (*logsv0.LogsV0, error) {
+	session.configureOnce("Logs", session.configureLogs)
 	return session.logsClient, session.logsClientErr
 }
 
 // IBM Cloud Logs Routing
-func (session clientSession) IBMCloudLogsRoutingV0() (*ibmcloudlogsroutingv0.IBMCloudLogsRoutingV0, error) {
+func (session *clientSession) IBMCloudLogsRoutingV0() (*ibmcloudlogsroutingv0.IBMCloudLogsRoutingV0, error) {
+	session.configureOnce("LogsRouting", session.configureLogsRouting)
 	return session.ibmCloudLogsRoutingClient, session.ibmCloudLogsRoutingClientErr
 }
 
 // GlobalCatalog Session
-func (sess clientSession) GlobalCatalogV1API() (*globalcatalogv1.GlobalCatalogV1, error) {
+func (sess *clientSession) GlobalCatalogV1API() (*globalcatalogv1.GlobalCatalogV1, error) {
+	sess.configureOnce("GlobalCatalog", sess.configureGlobalCatalog)
 	return sess.globalCatalogClient, sess.globalCatalogClientErr
 }
 
-// ClientSession configures and returns a fully initialized ClientSession
+// configureOnce builds the named service client(s) the first time they are
+// requested and memoizes the result, so construction errors only surface for
+// services that are actually used.
+func (session *clientSession) configureOnce(name string, configure func()) {
+	if session.session.BluemixSession == nil {
+		// Clients keep the errEmptyBluemixCredentials set by ClientSession
+		return
+	}
+	session.lazyMu.Lock()
+	once, ok := session.lazyInit[name]
+	if !ok {
+		once = new(sync.Once)
+		session.lazyInit[name] = once
+	}
+	session.lazyMu.Unlock()
+	once.Do(configure)
+}
+
+// ClientSession authenticates and returns a ClientSession whose service
+// clients are configured lazily on first use
 func (c *Config) ClientSession() (interface{}, error) {
 	sess, err := newSession(c)
 	if err != nil {
@@ -1340,7 +1493,10 @@ func (c *Config) ClientSession() (interface{}, error) {
 	}
 	log.Printf("[INFO] Configured Region: %s\n", c.Region)
 	session := clientSession{
-		session: sess,
+		session:         sess,
+		config:          c,
+		serviceLimiters: newServiceLimiters(c.ServiceLimits),
+		lazyInit:        make(map[string]*sync.Once),
 	}
 
 	if sess.BluemixSession == nil {
@@ -1431,7 +1587,7 @@ func (c *Config) ClientSession() (interface{}, error) {
 		session.logsClientErr = errEmptyBluemixCredentials
 		session.ibmCloudLogsRoutingClientErr = errEmptyBluemixCredentials
 
-		return session, nil
+		return &session, nil
 	}
 
 	if sess.BluemixSession.Config.BluemixAPIKey != "" {
@@ -1482,8 +1638,6 @@ func (c *Config) ClientSession() (interface{}, error) {
 		sess.SoftLayerSession.IAMRefreshToken = sess.BluemixSession.Config.IAMRefreshToken
 	}
 
-	session.functionClient, session.functionConfigErr = FunctionClient(sess.BluemixSession.Config)
-
 	BluemixRegion = sess.BluemixSession.Config.Region
 	var fileMap map[string]interface{}
 	if f := EnvFallBack([]string{"IBMCLOUD_ENDPOINTS_FILE_PATH", "IC_ENDPOINTS_FILE_PATH"}, c.EndpointsFile); f != "" {
@@ -1501,42 +1655,158 @@ func (c *Config) ClientSession() (interface{}, error) {
 			log.Fatalf("Unable to unmarshal Endpoints File %s", err)
 		}
 	}
+	session.fileMap = fileMap
+
+	iamURL := iamidentity.DefaultServiceURL
+	if c.Visibility == "private" || c.Visibility == "public-and-private" {
+		if c.Region == "us-south" || c.Region == "us-east" {
+			iamURL = ContructEndpoint(fmt.Sprintf("private.%s.iam", c.Region), cloudEndpoint)
+		} else {
+			iamURL = ContructEndpoint("private.iam", cloudEndpoint)
+		}
+	}
+	if fileMap != nil && c.Visibility != "public-and-private" {
+		iamURL = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_IAM_API_ENDPOINT", c.Region, iamURL)
+	}
+	session.iamURL = iamURL
+
+	var authenticator core.Authenticator
+
+	if c.BluemixAPIKey != "" || sess.BluemixSession.Config.IAMRefreshToken != "" {
+		if c.BluemixAPIKey != "" {
+			authenticator = &core.IamAuthenticator{
+				ApiKey: c.BluemixAPIKey,
+				URL:    EnvFallBack([]string{"IBMCLOUD_IAM_API_ENDPOINT"}, iamURL),
+			}
+		} else {
+			// Construct the IamAuthenticator with the IAM refresh token.
+			authenticator = &core.IamAuthenticator{
+				RefreshToken: sess.BluemixSession.Config.IAMRefreshToken,
+				ClientId:     "bx",
+				ClientSecret: "bx",
+				URL:          EnvFallBack([]string{"IBMCLOUD_IAM_API_ENDPOINT"}, iamURL),
+			}
+		}
+	} else if strings.HasPrefix(sess.BluemixSession.Config.IAMAccessToken, "Bearer") {
+		authenticator = &core.BearerTokenAuthenticator{
+			BearerToken: sess.BluemixSession.Config.IAMAccessToken[7:],
+		}
+	} else {
+		authenticator = &core.BearerTokenAuthenticator{
+			BearerToken: sess.BluemixSession.Config.IAMAccessToken,
+		}
+	}
+	session.authenticator = authenticator
+
+	// CIS Service instances starts here.
+	cisURL := ContructEndpoint("api.cis", cloudEndpoint)
+	if c.Visibility == "private" {
+		// cisURL = ContructEndpoint("api.private.cis", cloudEndpoint)
+		session.cisZonesErr = fmt.Errorf("CIS Service doesnt support private endpoints.")
+		session.cisDNSBulkErr = fmt.Errorf("CIS Service doesnt support private endpoints.")
+		session.cisGLBPoolErr = fmt.Errorf("CIS Service doesnt support private endpoints.")
+		session.cisGLBErr = fmt.Errorf("CIS Service doesnt support private endpoints.")
+		session.cisGLBHealthCheckErr = fmt.Errorf("CIS Service doesnt support private endpoints.")
+		session.cisIPErr = fmt.Errorf("CIS Service doesnt support private endpoints.")
+		session.cisRLErr = fmt.Errorf("CIS Service doesnt support private endpoints.")
+		session.cisPageRuleErr = fmt.Errorf("CIS Service doesnt support private endpoints.")
+		session.cisEdgeFunctionErr = fmt.Errorf("CIS Service doesnt support private endpoints.")
+		session.cisSSLErr = fmt.Errorf("CIS Service doesnt support private endpoints.")
+		session.cisWAFPackageErr = fmt.Errorf("CIS Service doesnt support private endpoints.")
+		session.cisDomainSettingsErr = fmt.Errorf("CIS Service doesnt support private endpoints.")
+		session.cisRoutingErr = fmt.Errorf("CIS Service doesnt support private endpoints.")
+		session.cisWAFGroupErr = fmt.Errorf("CIS Service doesnt support private endpoints.")
+		session.cisCacheErr = fmt.Errorf("CIS Service doesnt support private endpoints.")
+		session.cisCustomPageErr = fmt.Errorf("CIS Service doesnt support private endpoints.")
+		session.cisAccessRuleErr = fmt.Errorf("CIS Service doesnt support private endpoints.")
+		session.cisUARuleErr = fmt.Errorf("CIS Service doesnt support private endpoints.")
+		session.cisLockdownErr = fmt.Errorf("CIS Service doesnt support private endpoints.")
+		session.cisRangeAppErr = fmt.Errorf("CIS Service doesnt support private endpoints.")
+		session.cisWAFRuleErr = fmt.Errorf("CIS Service doesnt support private endpoints.")
+		session.cisFiltersErr = fmt.Errorf("CIS Service doesnt support private endpoints.")
+		session.cisWebhooksErr = fmt.Errorf("CIS Service doesnt support private endpoints.")
+		session.cisMtlsErr = fmt.Errorf("CIS Service doesnt support private endpoints.")
+
+	}
+	if fileMap != nil && c.Visibility != "public-and-private" {
+		cisURL = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_CIS_API_ENDPOINT", c.Region, cisURL)
+	}
+	session.cisEndPoint = EnvFallBack([]string{"IBMCLOUD_CIS_API_ENDPOINT"}, cisURL)
+
+	if os.Getenv("TF_LOG") != "" {
+		logDestination := log.Writer()
+		goLogger := log.New(logDestination, "", log.LstdFlags)
+		core.SetLogger(core.NewLogger(core.LevelDebug, goLogger, goLogger))
+	}
+
+	// setting UserAgent for vpc-go-sdk common
+	common.UserAgent = fmt.Sprintf("terraform-provider-ibm/%s", version.Version)
+	return &session, nil
+}
+
+func (session *clientSession) configureFunction() {
+	sess := session.session
+	session.functionClient, session.functionConfigErr = FunctionClient(sess.BluemixSession.Config)
+}
+
+func (session *clientSession) configureAccountV1() {
+	sess := session.session
 	accv1API, err := accountv1.New(sess.BluemixSession)
 	if err != nil {
 		session.accountV1ConfigErr = fmt.Errorf("[ERROR] Error occured while configuring Bluemix Accountv1 Service: %q", err)
 	}
 	session.bmxAccountv1ServiceAPI = accv1API
+}
 
+func (session *clientSession) configureAccountV2() {
+	sess := session.session
 	accAPI, err := accountv2.New(sess.BluemixSession)
 	if err != nil {
 		session.accountConfigErr = fmt.Errorf("[ERROR] Error occured while configuring  Account Service: %q", err)
 	}
 	session.bmxAccountServiceAPI = accAPI
+}
 
+func (session *clientSession) configureMccp() {
+	sess := session.session
 	cfAPI, err := mccpv2.New(sess.BluemixSession)
 	if err != nil {
 		session.cfConfigErr = fmt.Errorf("[ERROR] Error occured while configuring MCCP service: %q", err)
 	}
 	session.cfServiceAPI = cfAPI
+}
 
+func (session *clientSession) configureContainerV1() {
+	sess := session.session
 	clusterAPI, err := containerv1.New(sess.BluemixSession)
 	if err != nil {
 		session.csConfigErr = fmt.Errorf("[ERROR] Error occured while configuring Container Service for K8s cluster: %q", err)
 	}
 	session.csServiceAPI = clusterAPI
+}
 
+func (session *clientSession) configureContainerV2() {
+	sess := session.session
 	v2clusterAPI, err := containerv2.New(sess.BluemixSession)
 	if err != nil {
 		session.csv2ConfigErr = fmt.Errorf("[ERROR] Error occured while configuring vpc Container Service for K8s cluster: %q", err)
 	}
 	session.csv2ServiceAPI = v2clusterAPI
+}
 
+func (session *clientSession) configureHpcs() {
+	sess := session.session
 	hpcsAPI, err := hpcs.New(sess.BluemixSession)
 	if err != nil {
 		session.hpcsEndpointErr = fmt.Errorf("[ERROR] Error occured while configuring hpcs Endpoint: %q", err)
 	}
 	session.hpcsEndpointAPI = hpcsAPI
+}
 
+func (session *clientSession) configureKeyProtect() {
+	c := session.config
+	sess := session.session
+	fileMap := session.fileMap
 	kpurl := ContructEndpoint(fmt.Sprintf("%s.kms", c.Region), cloudEndpoint)
 	if c.Visibility == "private" || c.Visibility == "public-and-private" {
 		kpurl = ContructEndpoint(fmt.Sprintf("private.%s.kms", c.Region), cloudEndpoint)
@@ -1565,20 +1835,14 @@ func (c *Config) ClientSession() (interface{}, error) {
 		session.kpErr = fmt.Errorf("[ERROR] Error occured while configuring Key Protect Service: %q", err)
 	}
 	session.kpAPI = kpAPIclient
+}
 
-	iamURL := iamidentity.DefaultServiceURL
-	if c.Visibility == "private" || c.Visibility == "public-and-private" {
-		if c.Region == "us-south" || c.Region == "us-east" {
-			iamURL = ContructEndpoint(fmt.Sprintf("private.%s.iam", c.Region), cloudEndpoint)
-		} else {
-			iamURL = ContructEndpoint("private.iam", cloudEndpoint)
-		}
-	}
-	if fileMap != nil && c.Visibility != "public-and-private" {
-		iamURL = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_IAM_API_ENDPOINT", c.Region, iamURL)
-	}
-
-	// KEY MANAGEMENT Service
+// KEY MANAGEMENT Service
+func (session *clientSession) configureKeyManagement() {
+	c := session.config
+	sess := session.session
+	fileMap := session.fileMap
+	iamURL := session.iamURL
 	kmsurl := ContructEndpoint(fmt.Sprintf("%s.kms", c.Region), cloudEndpoint)
 	if c.Visibility == "private" || c.Visibility == "public-and-private" {
 		kmsurl = ContructEndpoint(fmt.Sprintf("private.%s.kms", c.Region), cloudEndpoint)
@@ -1609,35 +1873,14 @@ func (c *Config) ClientSession() (interface{}, error) {
 		session.kmsErr = fmt.Errorf("[ERROR] Error occured while configuring key Service: %q", err)
 	}
 	session.kmsAPI = kmsAPIclient
+}
 
-	var authenticator core.Authenticator
-
-	if c.BluemixAPIKey != "" || sess.BluemixSession.Config.IAMRefreshToken != "" {
-		if c.BluemixAPIKey != "" {
-			authenticator = &core.IamAuthenticator{
-				ApiKey: c.BluemixAPIKey,
-				URL:    EnvFallBack([]string{"IBMCLOUD_IAM_API_ENDPOINT"}, iamURL),
-			}
-		} else {
-			// Construct the IamAuthenticator with the IAM refresh token.
-			authenticator = &core.IamAuthenticator{
-				RefreshToken: sess.BluemixSession.Config.IAMRefreshToken,
-				ClientId:     "bx",
-				ClientSecret: "bx",
-				URL:          EnvFallBack([]string{"IBMCLOUD_IAM_API_ENDPOINT"}, iamURL),
-			}
-		}
-	} else if strings.HasPrefix(sess.BluemixSession.Config.IAMAccessToken, "Bearer") {
-		authenticator = &core.BearerTokenAuthenticator{
-			BearerToken: sess.BluemixSession.Config.IAMAccessToken[7:],
-		}
-	} else {
-		authenticator = &core.BearerTokenAuthenticator{
-			BearerToken: sess.BluemixSession.Config.IAMAccessToken,
-		}
-	}
-
-	// Construct the service options.
+// BACKUP RECOVERY Service
+func (session *clientSession) configureBackupRecovery() {
+	c := session.config
+	fileMap := session.fileMap
+	authenticator := session.authenticator
+	var err error
 	var backupRecoveryURL string
 	var backupRecoveryConnectorURL string
 
@@ -1660,7 +1903,7 @@ func (c *Config) ClientSession() (interface{}, error) {
 	}
 	if session.backupRecoveryClient != nil && session.backupRecoveryClient.Service != nil {
 		// Enable retries for API calls
-		session.backupRecoveryClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		session.enableRetries(session.backupRecoveryClient.Service, "backup_recovery")
 		// Add custom header for analytics
 		session.backupRecoveryClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
@@ -1682,13 +1925,19 @@ func (c *Config) ClientSession() (interface{}, error) {
 	}
 	if session.backupRecoveryConnectorClient != nil && session.backupRecoveryConnectorClient.Service != nil {
 		// Enable retries for API calls
-		session.backupRecoveryConnectorClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		session.enableRetries(session.backupRecoveryConnectorClient.Service, "backup_recovery")
 		// Add custom header for analytics
 		session.backupRecoveryConnectorClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
 	}
+}
 
+func (session *clientSession) configureProject() {
+	c := session.config
+	fileMap := session.fileMap
+	authenticator := session.authenticator
+	var err error
 	projectEndpoint := project.DefaultServiceURL
 	// Construct an "options" struct for creating the service client.
 	if fileMap != nil && c.Visibility != "public-and-private" {
@@ -1707,7 +1956,7 @@ func (c *Config) ClientSession() (interface{}, error) {
 	session.projectClient, err = project.NewProjectV1(projectClientOptions)
 	if err == nil {
 		// Enable retries for API calls
-		session.projectClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		session.enableRetries(session.projectClient.Service, "project")
 		// Add custom header for analytics
 		session.projectClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
@@ -1715,8 +1964,14 @@ func (c *Config) ClientSession() (interface{}, error) {
 	} else {
 		session.projectClientErr = fmt.Errorf("Error occurred while configuring Projects API Specification service: %q", err)
 	}
+}
 
-	// Construct an "options" struct for creating the service client.
+// CLOUD LOGS Service
+func (session *clientSession) configureLogs() {
+	c := session.config
+	fileMap := session.fileMap
+	authenticator := session.authenticator
+	var err error
 	logsEndpoint := ContructEndpoint(fmt.Sprintf("api.%s.logs", c.Region), cloudEndpoint)
 
 	if c.Visibility == "private" || c.Visibility == "public-and-private" {
@@ -1734,7 +1989,7 @@ func (c *Config) ClientSession() (interface{}, error) {
 	session.logsClient, err = logsv0.NewLogsV0(logsClientOptions)
 	if err == nil {
 		// Enable retries for API calls
-		session.logsClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		session.enableRetries(session.logsClient.Service, "logs")
 		// Add custom header for analytics
 		session.logsClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
@@ -1742,8 +1997,14 @@ func (c *Config) ClientSession() (interface{}, error) {
 	} else {
 		session.logsClientErr = fmt.Errorf("Error occurred while configuring Cloud Logs API service: %q", err)
 	}
+}
 
-	// LOGS ROUTER Version 0
+// LOGS ROUTER Version 0
+func (session *clientSession) configureLogsRouting() {
+	c := session.config
+	fileMap := session.fileMap
+	authenticator := session.authenticator
+	var err error
 	var logsrouterClientURL string
 	var logsrouterURLErr error
 
@@ -1766,7 +2027,7 @@ func (c *Config) ClientSession() (interface{}, error) {
 	session.ibmCloudLogsRoutingClient, err = ibmcloudlogsroutingv0.NewIBMCloudLogsRoutingV0(ibmCloudLogsRoutingClientOptions)
 	if err == nil {
 		// Enable retries for API calls
-		session.ibmCloudLogsRoutingClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		session.enableRetries(session.ibmCloudLogsRoutingClient.Service, "logs_routing")
 		// Add custom header for analytics
 		session.ibmCloudLogsRoutingClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
@@ -1774,8 +2035,12 @@ func (c *Config) ClientSession() (interface{}, error) {
 	} else {
 		session.ibmCloudLogsRoutingClientErr = fmt.Errorf("Error occurred while configuring IBM Cloud Logs Routing service: %q", err)
 	}
+}
 
-	// Construct an "options" struct for creating the service client.
+// UKO Service
+func (session *clientSession) configureUko() {
+	authenticator := session.authenticator
+	var err error
 	ukoClientOptions := &ukov4.UkoV4Options{
 		Authenticator: authenticator,
 	}
@@ -1784,7 +2049,7 @@ func (c *Config) ClientSession() (interface{}, error) {
 	session.ukoClient, err = ukov4.NewUkoV4(ukoClientOptions)
 	if err == nil {
 		// Enable retries for API calls
-		session.ukoClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		session.enableRetries(session.ukoClient.Service, "uko")
 		// Add custom header for analytics
 		session.ukoClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
@@ -1792,8 +2057,13 @@ func (c *Config) ClientSession() (interface{}, error) {
 	} else {
 		session.ukoClientErr = fmt.Errorf("Error occurred while configuring HPCS UKO service: %q", err)
 	}
+}
 
-	// APP ID Service
+// APP ID Service
+func (session *clientSession) configureAppID() {
+	c := session.config
+	fileMap := session.fileMap
+	authenticator := session.authenticator
 	appIDEndpoint := fmt.Sprintf("https://%s.appid.cloud.ibm.com", c.Region)
 	if c.Visibility == "private" {
 		session.appidErr = fmt.Errorf("App Id resources doesnot support private endpoints")
@@ -1810,14 +2080,20 @@ func (c *Config) ClientSession() (interface{}, error) {
 		session.appidErr = fmt.Errorf("error occured while configuring AppID service: #{err}")
 	}
 	if appIDClient != nil && appIDClient.Service != nil {
-		appIDClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		session.enableRetries(appIDClient.Service, "appid")
 		appIDClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
 	}
 	session.appidAPI = appIDClient
+}
 
-	// Construct an "options" struct for creating Context Based Restrictions service client.
+// CONTEXT BASED RESTRICTIONS Service
+func (session *clientSession) configureContextBasedRestrictions() {
+	c := session.config
+	fileMap := session.fileMap
+	authenticator := session.authenticator
+	var err error
 	cbrURL := contextbasedrestrictionsv1.DefaultServiceURL
 	if c.Visibility == "private" || c.Visibility == "public-and-private" {
 		if c.Region == "us-south" || c.Region == "us-east" || c.Region == "eu-de" {
@@ -1838,7 +2114,7 @@ func (c *Config) ClientSession() (interface{}, error) {
 	session.contextBasedRestrictionsClient, err = contextbasedrestrictionsv1.NewContextBasedRestrictionsV1(contextBasedRestrictionsClientOptions)
 	if err == nil && session.contextBasedRestrictionsClient != nil {
 		// Enable retries for API calls
-		session.contextBasedRestrictionsClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		session.enableRetries(session.contextBasedRestrictionsClient.Service, "context_based_restrictions")
 		// Add custom header for analytics
 		session.contextBasedRestrictionsClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
@@ -1846,8 +2122,14 @@ func (c *Config) ClientSession() (interface{}, error) {
 	} else {
 		session.contextBasedRestrictionsClientErr = fmt.Errorf("[ERROR] Error occurred while configuring Context Based Restrictions service: %q", err)
 	}
+}
 
-	// PARTNER CENTER SELL (product lifecycle) service
+// PARTNER CENTER SELL (product lifecycle) service
+func (session *clientSession) configurePartnerCenterSell() {
+	c := session.config
+	fileMap := session.fileMap
+	authenticator := session.authenticator
+	var err error
 	partnerCenterSellURL := "https://product-lifecycle.api.cloud.ibm.com/openapi/v1"
 	if c.Visibility == "private" {
 		session.partnerCenterSellClientErr = fmt.Errorf("partner center sell does not support private endpoints")
@@ -1866,14 +2148,19 @@ func (c *Config) ClientSession() (interface{}, error) {
 	}
 	if session.partnerCenterSellClient != nil && session.partnerCenterSellClient.Service != nil {
 		// Enable retries for API calls
-		session.partnerCenterSellClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		session.enableRetries(session.partnerCenterSellClient.Service, "partner_center_sell")
 		// Add custom header for analytics
 		session.partnerCenterSellClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
 	}
+}
 
-	//Usage Reports Service Client
+// Usage Reports Service Client
+func (session *clientSession) configureUsageReports() {
+	c := session.config
+	fileMap := session.fileMap
+	authenticator := session.authenticator
 	usageReportsURL := usagereportsv4.DefaultServiceURL
 	if c.Visibility == "private" {
 		if c.Region == "us-south" || c.Region == "us-east" {
@@ -1903,14 +2190,20 @@ func (c *Config) ClientSession() (interface{}, error) {
 		session.usageReportsClientErr = fmt.Errorf("[ERROR] Error occurred while configuring IBM Cloud Usage Reports API service: %q", err)
 	}
 	if usageReportsClient != nil && usageReportsClient.Service != nil {
-		usageReportsClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		session.enableRetries(usageReportsClient.Service, "usage_reports")
 		usageReportsClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
 	}
 	session.usageReportsClient = usageReportsClient
+}
 
-	// CATALOG MANAGEMENT Service
+// CATALOG MANAGEMENT Service
+func (session *clientSession) configureCatalogManagement() {
+	c := session.config
+	fileMap := session.fileMap
+	authenticator := session.authenticator
+	var err error
 	catalogManagementURL := "https://cm.globalcatalog.cloud.ibm.com/api/v1-beta"
 	if c.Visibility == "private" {
 		session.catalogManagementClientErr = fmt.Errorf("Catalog Management resource doesnot support private endpoints")
@@ -1929,20 +2222,26 @@ func (c *Config) ClientSession() (interface{}, error) {
 	}
 	if session.catalogManagementClient != nil && session.catalogManagementClient.Service != nil {
 		// Enable retries for API calls
-		session.catalogManagementClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		session.enableRetries(session.catalogManagementClient.Service, "catalog_management")
 		// Add custom header for analytics
 		session.catalogManagementClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
 	}
+}
 
-	// ATRACKER Version 2
+// ATRACKER Version 2
+func (session *clientSession) configureAtracker() {
+	c := session.config
+	fileMap := session.fileMap
+	authenticator := session.authenticator
+	var err error
 	var atrackerClientV2URL string
 	var atrackerURLV2Err error
 
 	if c.Visibility == "private" || c.Visibility == "public-and-private" {
 		atrackerClientV2URL, atrackerURLV2Err = atrackerv2.GetServiceURLForRegion("private." + c.Region)
-		if err != nil && c.Visibility == "public-and-private" {
+		if atrackerURLV2Err != nil && c.Visibility == "public-and-private" {
 			atrackerClientV2URL, atrackerURLV2Err = atrackerv2.GetServiceURLForRegion(c.Region)
 		}
 	} else {
@@ -1966,7 +2265,7 @@ func (c *Config) ClientSession() (interface{}, error) {
 	session.atrackerClientV2, err = atrackerv2.NewAtrackerV2(atrackerClientV2Options)
 	if err == nil {
 		// Enable retries for API calls
-		session.atrackerClientV2.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		session.enableRetries(session.atrackerClientV2.Service, "atracker")
 		// Add custom header for analytics
 		session.atrackerClientV2.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
@@ -1974,8 +2273,14 @@ func (c *Config) ClientSession() (interface{}, error) {
 	} else {
 		session.atrackerClientV2Err = fmt.Errorf("Error occurred while configuring Activity Tracker API Version 2 service: %q", err)
 	}
+}
 
-	// Construct an "options" struct for creating the service client for Metrics Router
+// METRICS ROUTER Version 3
+func (session *clientSession) configureMetricsRouter() {
+	c := session.config
+	fileMap := session.fileMap
+	authenticator := session.authenticator
+	var err error
 	var metricsRouterClientURL string
 	var metricsRouterURLV3Err error
 
@@ -2002,7 +2307,7 @@ func (c *Config) ClientSession() (interface{}, error) {
 	session.metricsRouterClient, err = metricsrouterv3.NewMetricsRouterV3(metricsRouterClientOptions)
 	if err == nil {
 		// Enable retries for API calls
-		session.metricsRouterClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		session.enableRetries(session.metricsRouterClient.Service, "metrics_router")
 		// Add custom header for analytics
 		session.metricsRouterClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
@@ -2010,8 +2315,13 @@ func (c *Config) ClientSession() (interface{}, error) {
 	} else {
 		session.metricsRouterClientErr = fmt.Errorf("Error occurred while configuring Metrics Router API Version 3 service: %q", err)
 	}
+}
 
-	// SCC (Security and Compliance Center) Service
+// SCC (Security and Compliance Center) Service
+func (session *clientSession) configureSecurityAndComplianceCenter() {
+	c := session.config
+	authenticator := session.authenticator
+	var err error
 	sccApiClientURL := scc.DefaultServiceURL
 	// Construct the service options.
 	if regionURL, sccRegionErr := scc.GetServiceURLForRegion(c.Region); sccRegionErr == nil {
@@ -2026,7 +2336,7 @@ func (c *Config) ClientSession() (interface{}, error) {
 	session.securityAndComplianceCenterClient, err = scc.NewSecurityAndComplianceCenterV3(sccApiClientOptions)
 	if err == nil {
 		// Enable retries for API calls
-		session.securityAndComplianceCenterClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		session.enableRetries(session.securityAndComplianceCenterClient.Service, "scc")
 		// Add custom header for analytics
 		session.securityAndComplianceCenterClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
@@ -2034,9 +2344,13 @@ func (c *Config) ClientSession() (interface{}, error) {
 	} else {
 		session.securityAndComplianceCenterClientErr = fmt.Errorf("Error occurred while configuring Security And Compliance Center service: %q", err)
 	}
+}
 
-	// SCHEMATICS Service
-	// schematicsEndpoint := "https://schematics.cloud.ibm.com"
+// SCHEMATICS Service
+func (session *clientSession) configureSchematics() {
+	c := session.config
+	fileMap := session.fileMap
+	authenticator := session.authenticator
 	schematicsEndpoint := ContructEndpoint(fmt.Sprintf("%s.schematics", c.Region), cloudEndpoint)
 	if c.Visibility == "private" || c.Visibility == "public-and-private" {
 		schematicsEndpoint = ContructEndpoint(fmt.Sprintf("private-%s.schematics", c.Region), cloudEndpoint)
@@ -2055,14 +2369,19 @@ func (c *Config) ClientSession() (interface{}, error) {
 	}
 	// Enable retries for API calls
 	if schematicsClient != nil && schematicsClient.Service != nil {
-		schematicsClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		session.enableRetries(schematicsClient.Service, "schematics")
 		schematicsClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
 	}
 	session.schematicsClient = schematicsClient
+}
 
-	// VPC Service
+// VPC Service
+func (session *clientSession) configureVpc() {
+	c := session.config
+	fileMap := session.fileMap
+	authenticator := session.authenticator
 	vpcurl := ContructEndpoint(fmt.Sprintf("%s.iaas", c.Region), fmt.Sprintf("%s/v1", cloudEndpoint))
 	if c.Visibility == "private" || c.Visibility == "public-and-private" {
 		vpcurl = ContructEndpoint(fmt.Sprintf("%s.private.iaas", c.Region), fmt.Sprintf("%s/v1", cloudEndpoint))
@@ -2079,7 +2398,7 @@ func (c *Config) ClientSession() (interface{}, error) {
 		session.vpcErr = fmt.Errorf("[ERROR] Error occured while configuring vpc service: %q", err)
 	}
 	if vpcclient != nil && vpcclient.Service != nil {
-		vpcclient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		session.enableRetries(vpcclient.Service, "is")
 		vpcclient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
@@ -2095,14 +2414,19 @@ func (c *Config) ClientSession() (interface{}, error) {
 		session.vpcbetaErr = fmt.Errorf("[ERROR] Error occured while configuring vpc beta service: %q", err)
 	}
 	if vpcbetaclient != nil && vpcbetaclient.Service != nil {
-		vpcbetaclient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		session.enableRetries(vpcbetaclient.Service, "is")
 		vpcbetaclient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
 	}
 	session.vpcBetaAPI = vpcbetaclient
+}
 
-	// PUSH NOTIFICATIONS Service
+// PUSH NOTIFICATIONS Service
+func (session *clientSession) configurePushService() {
+	c := session.config
+	fileMap := session.fileMap
+	authenticator := session.authenticator
 	pnurl := fmt.Sprintf("https://%s.imfpush.cloud.ibm.com/imfpush/v1", c.Region)
 	if c.Visibility == "private" {
 		session.pushServiceClientErr = fmt.Errorf("Push Notifications Service API doesnot support private endpoints")
@@ -2120,14 +2444,20 @@ func (c *Config) ClientSession() (interface{}, error) {
 	}
 	if pnclient != nil && pnclient.Service != nil {
 		// Enable retries for API calls
-		pnclient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		session.enableRetries(pnclient.Service, "push")
 		pnclient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
 	}
 	session.pushServiceClient = pnclient
+}
 
-	// event notifications
+// event notifications
+func (session *clientSession) configureEventNotifications() {
+	c := session.config
+	fileMap := session.fileMap
+	authenticator := session.authenticator
+	var err error
 	enurl := fmt.Sprintf("https://%s.event-notifications.cloud.ibm.com/event-notifications", c.Region)
 
 	if c.Visibility == "private" || c.Visibility == "public-and-private" {
@@ -2149,13 +2479,18 @@ func (c *Config) ClientSession() (interface{}, error) {
 	}
 	if session.eventNotificationsApiClient != nil && session.eventNotificationsApiClient.Service != nil {
 		// Enable retries for API calls
-		session.eventNotificationsApiClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		session.enableRetries(session.eventNotificationsApiClient.Service, "event_notifications")
 		session.eventNotificationsApiClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
 	}
+}
 
-	// APP CONFIGURATION Service
+// APP CONFIGURATION Service
+func (session *clientSession) configureAppConfiguration() {
+	c := session.config
+	fileMap := session.fileMap
+	authenticator := session.authenticator
 	appconfigurl := ContructEndpoint(fmt.Sprintf("%s", c.Region), fmt.Sprintf("%s.apprapp.", cloudEndpoint))
 	if c.Visibility == "private" || c.Visibility == "public-and-private" {
 		appconfigurl = ContructEndpoint(fmt.Sprintf("%s.private", c.Region), fmt.Sprintf("%s.apprapp", cloudEndpoint))
@@ -2171,14 +2506,20 @@ func (c *Config) ClientSession() (interface{}, error) {
 	appConfigClient, err := appconfigurationv1.NewAppConfigurationV1(appConfigurationClientOptions)
 	if appConfigClient != nil {
 		// Enable retries for API calls
-		appConfigClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		session.enableRetries(appConfigClient.Service, "app_configuration")
 		session.appConfigurationClient = appConfigClient
 	} else {
 		session.appConfigurationClientErr = fmt.Errorf("[ERROR] Error occurred while configuring App Configuration service: %q", err)
 	}
+}
 
-	// CONTAINER REGISTRY Service
-	// Construct an "options" struct for creating the service client.
+// CONTAINER REGISTRY Service
+func (session *clientSession) configureContainerRegistry() {
+	c := session.config
+	fileMap := session.fileMap
+	authenticator := session.authenticator
+	userConfig := session.bmxUserDetails
+	var err error
 	containerRegistryClientURL, err := containerregistryv1.GetServiceURLForRegion(c.Region)
 	if err != nil {
 		containerRegistryClientURL = containerregistryv1.DefaultServiceURL
@@ -2204,14 +2545,19 @@ func (c *Config) ClientSession() (interface{}, error) {
 	}
 	if session.containerRegistryClient != nil && session.containerRegistryClient.Service != nil {
 		// Enable retries for API calls
-		session.containerRegistryClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		session.enableRetries(session.containerRegistryClient.Service, "container_registry")
 		// Add custom header for analytics
 		session.containerRegistryClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
 	}
+}
 
-	// OBJECT STORAGE Service
+// OBJECT STORAGE Service
+func (session *clientSession) configureCosConfig() {
+	c := session.config
+	fileMap := session.fileMap
+	authenticator := session.authenticator
 	cosconfigurl := "https://config.cloud-object-storage.cloud.ibm.com/v1"
 	if fileMap != nil && c.Visibility != "public-and-private" {
 		cosconfigurl = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_COS_CONFIG_ENDPOINT", c.Region, cosconfigurl)
@@ -2225,20 +2571,32 @@ func (c *Config) ClientSession() (interface{}, error) {
 		session.cosConfigErr = fmt.Errorf("[ERROR] Error occured while configuring COS config service: %q", err)
 	}
 	session.cosConfigAPI = cosconfigclient
+}
 
+func (session *clientSession) configureGlobalSearch() {
+	sess := session.session
 	globalSearchAPI, err := globalsearchv2.New(sess.BluemixSession)
 	if err != nil {
 		session.globalSearchConfigErr = fmt.Errorf("[ERROR] Error occured while configuring Global Search: %q", err)
 	}
 	session.globalSearchServiceAPI = globalSearchAPI
-	// Global Tagging Bluemix-go
+}
+
+// Global Tagging Bluemix-go
+func (session *clientSession) configureGlobalTagging() {
+	sess := session.session
 	globalTaggingAPI, err := globaltaggingv3.New(sess.BluemixSession)
 	if err != nil {
 		session.globalTaggingConfigErr = fmt.Errorf("[ERROR] Error occured while configuring Global Tagging: %q", err)
 	}
 	session.globalTaggingServiceAPI = globalTaggingAPI
+}
 
-	// GLOBAL TAGGING Service
+// GLOBAL TAGGING Service
+func (session *clientSession) configureGlobalTaggingV1() {
+	c := session.config
+	fileMap := session.fileMap
+	authenticator := session.authenticator
 	globalTaggingEndpoint := "https://tags.global-search-tagging.cloud.ibm.com"
 	if c.Visibility == "private" || c.Visibility == "public-and-private" {
 		var globalTaggingRegion string
@@ -2262,12 +2620,18 @@ func (c *Config) ClientSession() (interface{}, error) {
 	}
 	if globalTaggingAPIV1 != nil && globalTaggingAPIV1.Service != nil {
 		session.globalTaggingServiceAPIV1 = *globalTaggingAPIV1
-		session.globalTaggingServiceAPIV1.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		session.enableRetries(session.globalTaggingServiceAPIV1.Service, "global_tagging")
 		session.globalTaggingServiceAPIV1.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
 	}
-	// GLOBAL TAGGING Service
+}
+
+// GLOBAL SEARCH Service
+func (session *clientSession) configureGlobalSearchV2() {
+	c := session.config
+	fileMap := session.fileMap
+	authenticator := session.authenticator
 	globalSearchEndpoint := "https://api.global-search-tagging.cloud.ibm.com"
 	if c.Visibility == "private" || c.Visibility == "public-and-private" {
 		var globalSearchRegion string
@@ -2287,22 +2651,30 @@ func (c *Config) ClientSession() (interface{}, error) {
 	}
 	globalSearchAPIV2, err := searchv2.NewGlobalSearchV2(globalSearchV2Options)
 	if err != nil {
-		session.globalTaggingConfigErrV1 = fmt.Errorf("[ERROR] Error occured while configuring Global Search: %q", err)
+		session.globalSearchConfigErrV2 = fmt.Errorf("[ERROR] Error occured while configuring Global Search: %q", err)
 	}
 	if globalSearchAPIV2 != nil && globalSearchAPIV2.Service != nil {
 		session.globalSearchServiceAPIV2 = *globalSearchAPIV2
-		session.globalSearchServiceAPIV2.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		session.enableRetries(session.globalSearchServiceAPIV2.Service, "global_search")
 		session.globalSearchServiceAPIV2.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
 	}
+}
 
+func (session *clientSession) configureICD() {
+	sess := session.session
 	icdAPI, err := icdv4.New(sess.BluemixSession)
 	if err != nil {
 		session.icdConfigErr = fmt.Errorf("[ERROR] Error occured while configuring IBM Cloud Database Services: %q", err)
 	}
 	session.icdServiceAPI = icdAPI
+}
 
+func (session *clientSession) configureCloudDatabases() {
+	c := session.config
+	authenticator := session.authenticator
+	var err error
 	var cloudDatabasesEndpoint string
 
 	if c.Visibility == "private" || c.Visibility == "public-and-private" {
@@ -2321,7 +2693,7 @@ func (c *Config) ClientSession() (interface{}, error) {
 	session.cloudDatabasesClient, err = clouddatabasesv5.NewCloudDatabasesV5(cloudDatabasesClientOptions)
 	if err == nil {
 		// Enable retries for API calls
-		session.cloudDatabasesClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		session.enableRetries(session.cloudDatabasesClient.Service, "databases")
 		// Add custom header for analytics
 		session.cloudDatabasesClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
@@ -2329,44 +2701,67 @@ func (c *Config) ClientSession() (interface{}, error) {
 	} else {
 		session.cloudDatabasesClientErr = fmt.Errorf("Error occurred while configuring The IBM Cloud Databases API service: %q", err)
 	}
+}
 
+func (session *clientSession) configureResourceCatalog() {
+	sess := session.session
 	resourceCatalogAPI, err := catalog.New(sess.BluemixSession)
 	if err != nil {
 		session.resourceCatalogConfigErr = fmt.Errorf("[ERROR] Error occured while configuring Resource Catalog service: %q", err)
 	}
 	session.resourceCatalogServiceAPI = resourceCatalogAPI
+}
 
+func (session *clientSession) configureResourceManagementV2() {
+	sess := session.session
 	resourceManagementAPIv2, err := managementv2.New(sess.BluemixSession)
 	if err != nil {
 		session.resourceManagementConfigErrv2 = fmt.Errorf("[ERROR] Error occured while configuring Resource Management service: %q", err)
 	}
 	session.resourceManagementServiceAPIv2 = resourceManagementAPIv2
+}
 
+func (session *clientSession) configureResourceControllerV1() {
+	sess := session.session
 	resourceControllerAPI, err := controller.New(sess.BluemixSession)
 	if err != nil {
 		session.resourceControllerConfigErr = fmt.Errorf("[ERROR] Error occured while configuring Resource Controller service: %q", err)
 	}
 	session.resourceControllerServiceAPI = resourceControllerAPI
+}
 
+func (session *clientSession) configureResourceControllerV2() {
+	sess := session.session
 	ResourceControllerAPIv2, err := controllerv2.New(sess.BluemixSession)
 	if err != nil {
 		session.resourceControllerConfigErrv2 = fmt.Errorf("[ERROR] Error occured while configuring Resource Controller v2 service: %q", err)
 	}
 	session.resourceControllerServiceAPIv2 = ResourceControllerAPIv2
+}
 
+func (session *clientSession) configureUserManagement() {
+	sess := session.session
 	userManagementAPI, err := usermanagementv2.New(sess.BluemixSession)
 	if err != nil {
 		session.userManagementErr = fmt.Errorf("[ERROR] Error occured while configuring user management service: %q", err)
 	}
 	session.userManagementAPI = userManagementAPI
+}
 
+func (session *clientSession) configureFunctionIAMNamespace() {
+	sess := session.session
 	namespaceFunction, err := functions.New(sess.BluemixSession)
 	if err != nil {
 		session.functionIAMNamespaceErr = fmt.Errorf("[ERROR] Error occured while configuring Cloud Funciton Service : %q", err)
 	}
 	session.functionIAMNamespaceAPI = namespaceFunction
+}
 
-	// POWER SYSTEMS Service
+// POWER SYSTEMS Service
+func (session *clientSession) configureIBMPI() {
+	c := session.config
+	authenticator := session.authenticator
+	userConfig := session.bmxUserDetails
 	piURL := ContructEndpoint(c.Region, "power-iaas.cloud.ibm.com")
 	ibmPIOptions := &ibmpisession.IBMPIOptions{
 		Authenticator: authenticator,
@@ -2381,8 +2776,13 @@ func (c *Config) ClientSession() (interface{}, error) {
 		session.ibmpiConfigErr = fmt.Errorf("Error occured while configuring ibmpisession: %q", err)
 	}
 	session.ibmpiSession = ibmpisession
+}
 
-	// PRIVATE DNS Service
+// PRIVATE DNS Service
+func (session *clientSession) configurePrivateDNS() {
+	c := session.config
+	fileMap := session.fileMap
+	authenticator := session.authenticator
 	pdnsURL := dns.DefaultServiceURL
 	if c.Visibility == "private" || c.Visibility == "public-and-private" {
 		pdnsURL = ContructEndpoint("api.private.dns-svcs", fmt.Sprintf("%s/v1", cloudEndpoint))
@@ -2399,13 +2799,18 @@ func (c *Config) ClientSession() (interface{}, error) {
 		session.pDNSErr = fmt.Errorf("[ERROR] Error occured while configuring PrivateDNS Service: %s", session.pDNSErr)
 	}
 	if session.pDNSClient != nil && session.pDNSClient.Service != nil {
-		session.pDNSClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		session.enableRetries(session.pDNSClient.Service, "private_dns")
 		session.pDNSClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
 	}
+}
 
-	// DIRECT LINK Service
+// DIRECT LINK Service
+func (session *clientSession) configureDirectLink() {
+	c := session.config
+	fileMap := session.fileMap
+	authenticator := session.authenticator
 	ver := time.Now().Format("2006-01-02")
 	dlURL := dl.DefaultServiceURL
 	if c.Visibility == "private" || c.Visibility == "public-and-private" {
@@ -2424,13 +2829,19 @@ func (c *Config) ClientSession() (interface{}, error) {
 		session.directlinkErr = fmt.Errorf("[ERROR] Error occured while configuring Direct Link Service: %s", session.directlinkErr)
 	}
 	if session.directlinkAPI != nil && session.directlinkAPI.Service != nil {
-		session.directlinkAPI.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		session.enableRetries(session.directlinkAPI.Service, "directlink")
 		session.directlinkAPI.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
 	}
+}
 
-	// DIRECT LINK PROVIDER Service
+// DIRECT LINK PROVIDER Service
+func (session *clientSession) configureDirectLinkProvider() {
+	c := session.config
+	fileMap := session.fileMap
+	authenticator := session.authenticator
+	ver := time.Now().Format("2006-01-02")
 	dlproviderURL := dlProviderV2.DefaultServiceURL
 	if c.Visibility == "private" || c.Visibility == "public-and-private" {
 		dlproviderURL = ContructEndpoint("private.directlink", fmt.Sprintf("%s/provider/v2", cloudEndpoint))
@@ -2448,13 +2859,18 @@ func (c *Config) ClientSession() (interface{}, error) {
 		session.dlProviderErr = fmt.Errorf("[ERROR] Error occured while configuring Direct Link Provider Service: %s", session.dlProviderErr)
 	}
 	if session.dlProviderAPI != nil && session.dlProviderAPI.Service != nil {
-		session.dlProviderAPI.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		session.enableRetries(session.dlProviderAPI.Service, "directlink")
 		session.dlProviderAPI.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
 	}
+}
 
-	// TRANSIT GATEWAY Service
+// TRANSIT GATEWAY Service
+func (session *clientSession) configureTransitGateway() {
+	c := session.config
+	fileMap := session.fileMap
+	authenticator := session.authenticator
 	tgURL := tg.DefaultServiceURL
 	if c.Visibility == "private" || c.Visibility == "public-and-private" {
 		tgURL = ContructEndpoint("private.transit", fmt.Sprintf("%s/v1", cloudEndpoint))
@@ -2472,13 +2888,18 @@ func (c *Config) ClientSession() (interface{}, error) {
 		session.transitgatewayErr = fmt.Errorf("[ERROR] Error occured while configuring Transit Gateway Service: %s", session.transitgatewayErr)
 	}
 	if session.transitgatewayAPI != nil && session.transitgatewayAPI.Service != nil {
-		session.transitgatewayAPI.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		session.enableRetries(session.transitgatewayAPI.Service, "transit_gateway")
 		// session.transitgatewayAPI.SetDefaultHeaders(gohttp.Header{
 		// 	"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		// })
 	}
+}
 
-	// Construct an instance of the 'Configuration Aggregator' service.
+// Construct an instance of the 'Configuration Aggregator' service.
+func (session *clientSession) configureConfigurationAggregator() {
+	c := session.config
+	authenticator := session.authenticator
+	var err error
 	var configBaseURL string
 	configBaseURL = ContructEndpoint(fmt.Sprintf("%s.apprapp", c.Region), cloudEndpoint)
 
@@ -2491,7 +2912,7 @@ func (c *Config) ClientSession() (interface{}, error) {
 	session.configurationAggregatorClient, err = configurationaggregatorv1.NewConfigurationAggregatorV1(configurationAggregatorClientOptions)
 	if err == nil {
 		// Enable retries for API calls
-		session.configurationAggregatorClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		session.enableRetries(session.configurationAggregatorClient.Service, "config_aggregator")
 		// Add custom header for analytics
 		session.configurationAggregatorClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
@@ -2499,8 +2920,12 @@ func (c *Config) ClientSession() (interface{}, error) {
 	} else {
 		session.configurationAggregatorClientErr = fmt.Errorf("Error occurred while constructing 'Configuration Aggregator' service client: %q", err)
 	}
+}
 
-	// Construct an instance of the 'IBM Db2 SaaS on Cloud REST API' service.
+// Construct an instance of the 'IBM Db2 SaaS on Cloud REST API' service.
+func (session *clientSession) configureDb2saas() {
+	authenticator := session.authenticator
+	var err error
 	if session.db2saasClientErr == nil {
 		// Construct the service options.
 		defaultServiceEndpoint := "https://us-south.db2.saas.ibm.com/dbapi/v4"
@@ -2513,7 +2938,7 @@ func (c *Config) ClientSession() (interface{}, error) {
 		session.db2saasClient, err = db2saasv1.NewDb2saasV1(db2saasClientOptions)
 		if err == nil {
 			// Enable retries for API calls
-			session.db2saasClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+			session.enableRetries(session.db2saasClient.Service, "db2")
 			// Add custom header for analytics
 			session.db2saasClient.SetDefaultHeaders(gohttp.Header{
 				"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
@@ -2522,43 +2947,12 @@ func (c *Config) ClientSession() (interface{}, error) {
 			session.db2saasClientErr = fmt.Errorf("Error occurred while constructing 'IBM Db2 SaaS on Cloud REST API' service client: %q", err)
 		}
 	}
+}
 
-	// CIS Service instances starts here.
-	cisURL := ContructEndpoint("api.cis", cloudEndpoint)
-	if c.Visibility == "private" {
-		// cisURL = ContructEndpoint("api.private.cis", cloudEndpoint)
-		session.cisZonesErr = fmt.Errorf("CIS Service doesnt support private endpoints.")
-		session.cisDNSBulkErr = fmt.Errorf("CIS Service doesnt support private endpoints.")
-		session.cisGLBPoolErr = fmt.Errorf("CIS Service doesnt support private endpoints.")
-		session.cisGLBErr = fmt.Errorf("CIS Service doesnt support private endpoints.")
-		session.cisGLBHealthCheckErr = fmt.Errorf("CIS Service doesnt support private endpoints.")
-		session.cisIPErr = fmt.Errorf("CIS Service doesnt support private endpoints.")
-		session.cisRLErr = fmt.Errorf("CIS Service doesnt support private endpoints.")
-		session.cisPageRuleErr = fmt.Errorf("CIS Service doesnt support private endpoints.")
-		session.cisEdgeFunctionErr = fmt.Errorf("CIS Service doesnt support private endpoints.")
-		session.cisSSLErr = fmt.Errorf("CIS Service doesnt support private endpoints.")
-		session.cisWAFPackageErr = fmt.Errorf("CIS Service doesnt support private endpoints.")
-		session.cisDomainSettingsErr = fmt.Errorf("CIS Service doesnt support private endpoints.")
-		session.cisRoutingErr = fmt.Errorf("CIS Service doesnt support private endpoints.")
-		session.cisWAFGroupErr = fmt.Errorf("CIS Service doesnt support private endpoints.")
-		session.cisCacheErr = fmt.Errorf("CIS Service doesnt support private endpoints.")
-		session.cisCustomPageErr = fmt.Errorf("CIS Service doesnt support private endpoints.")
-		session.cisAccessRuleErr = fmt.Errorf("CIS Service doesnt support private endpoints.")
-		session.cisUARuleErr = fmt.Errorf("CIS Service doesnt support private endpoints.")
-		session.cisLockdownErr = fmt.Errorf("CIS Service doesnt support private endpoints.")
-		session.cisRangeAppErr = fmt.Errorf("CIS Service doesnt support private endpoints.")
-		session.cisWAFRuleErr = fmt.Errorf("CIS Service doesnt support private endpoints.")
-		session.cisFiltersErr = fmt.Errorf("CIS Service doesnt support private endpoints.")
-		session.cisWebhooksErr = fmt.Errorf("CIS Service doesnt support private endpoints.")
-		session.cisMtlsErr = fmt.Errorf("CIS Service doesnt support private endpoints.")
-
-	}
-	if fileMap != nil && c.Visibility != "public-and-private" {
-		cisURL = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_CIS_API_ENDPOINT", c.Region, cisURL)
-	}
-	cisEndPoint := EnvFallBack([]string{"IBMCLOUD_CIS_API_ENDPOINT"}, cisURL)
-
-	// IBM Network CIS Zones service
+// IBM Network CIS Zones service
+func (session *clientSession) configureCisZones() {
+	authenticator := session.authenticator
+	cisEndPoint := session.cisEndPoint
 	cisZonesV1Opt := &ciszonesv1.ZonesV1Options{
 		URL:           cisEndPoint,
 		Crn:           core.StringPtr(""),
@@ -2571,13 +2965,17 @@ func (c *Config) ClientSession() (interface{}, error) {
 			session.cisZonesErr)
 	}
 	if session.cisZonesV1Client != nil && session.cisZonesV1Client.Service != nil {
-		session.cisZonesV1Client.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		session.enableRetries(session.cisZonesV1Client.Service, "cis")
 		session.cisZonesV1Client.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
 	}
+}
 
-	// IBM Network CIS DNS Record service
+// IBM Network CIS DNS Record service
+func (session *clientSession) configureCisDNSRecords() {
+	authenticator := session.authenticator
+	cisEndPoint := session.cisEndPoint
 	cisDNSRecordsOpt := &cisdnsrecordsv1.DnsRecordsV1Options{
 		URL:            cisEndPoint,
 		Crn:            core.StringPtr(""),
@@ -2589,13 +2987,17 @@ func (c *Config) ClientSession() (interface{}, error) {
 		session.cisDNSErr = fmt.Errorf("[ERROR] Error occured while configuring CIS DNS Service: %s", session.cisDNSErr)
 	}
 	if session.cisDNSRecordsClient != nil && session.cisDNSRecordsClient.Service != nil {
-		session.cisDNSRecordsClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		session.enableRetries(session.cisDNSRecordsClient.Service, "cis")
 		session.cisDNSRecordsClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
 	}
+}
 
-	// IBM Network CIS DNS Record bulk service
+// IBM Network CIS DNS Record bulk service
+func (session *clientSession) configureCisDNSRecordBulk() {
+	authenticator := session.authenticator
+	cisEndPoint := session.cisEndPoint
 	cisDNSRecordBulkOpt := &cisdnsbulkv1.DnsRecordBulkV1Options{
 		URL:            cisEndPoint,
 		Crn:            core.StringPtr(""),
@@ -2609,13 +3011,17 @@ func (c *Config) ClientSession() (interface{}, error) {
 			session.cisDNSBulkErr)
 	}
 	if session.cisDNSRecordBulkClient != nil && session.cisDNSRecordBulkClient.Service != nil {
-		session.cisDNSRecordBulkClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		session.enableRetries(session.cisDNSRecordBulkClient.Service, "cis")
 		session.cisDNSRecordBulkClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
 	}
+}
 
-	// IBM Network CIS Global load balancer pool
+// IBM Network CIS Global load balancer pool
+func (session *clientSession) configureCisGLBPool() {
+	authenticator := session.authenticator
+	cisEndPoint := session.cisEndPoint
 	cisGLBPoolOpt := &cisglbpoolv0.GlobalLoadBalancerPoolsV0Options{
 		URL:           cisEndPoint,
 		Crn:           core.StringPtr(""),
@@ -2627,13 +3033,17 @@ func (c *Config) ClientSession() (interface{}, error) {
 			session.cisGLBPoolErr)
 	}
 	if session.cisGLBPoolClient != nil && session.cisGLBPoolClient.Service != nil {
-		session.cisGLBPoolClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		session.enableRetries(session.cisGLBPoolClient.Service, "cis")
 		session.cisGLBPoolClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
 	}
+}
 
-	// IBM Network CIS Global load balancer
+// IBM Network CIS Global load balancer
+func (session *clientSession) configureCisGLB() {
+	authenticator := session.authenticator
+	cisEndPoint := session.cisEndPoint
 	cisGLBOpt := &cisglbv1.GlobalLoadBalancerV1Options{
 		URL:            cisEndPoint,
 		Authenticator:  authenticator,
@@ -2646,13 +3056,17 @@ func (c *Config) ClientSession() (interface{}, error) {
 			session.cisGLBErr)
 	}
 	if session.cisGLBClient != nil && session.cisGLBClient.Service != nil {
-		session.cisGLBClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		session.enableRetries(session.cisGLBClient.Service, "cis")
 		session.cisGLBClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
 	}
+}
 
-	// IBM Network CIS Global load balancer health check/monitor
+// IBM Network CIS Global load balancer health check/monitor
+func (session *clientSession) configureCisGLBHealthCheck() {
+	authenticator := session.authenticator
+	cisEndPoint := session.cisEndPoint
 	cisGLBHealthCheckOpt := &cisglbhealthcheckv1.GlobalLoadBalancerMonitorV1Options{
 		URL:           cisEndPoint,
 		Crn:           core.StringPtr(""),
@@ -2664,13 +3078,17 @@ func (c *Config) ClientSession() (interface{}, error) {
 			session.cisGLBHealthCheckErr)
 	}
 	if session.cisGLBHealthCheckClient != nil && session.cisGLBHealthCheckClient.Service != nil {
-		session.cisGLBHealthCheckClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		session.enableRetries(session.cisGLBHealthCheckClient.Service, "cis")
 		session.cisGLBHealthCheckClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
 	}
+}
 
-	// IBM Network CIS IP
+// IBM Network CIS IP
+func (session *clientSession) configureCisIP() {
+	authenticator := session.authenticator
+	cisEndPoint := session.cisEndPoint
 	cisIPOpt := &cisipv1.CisIpApiV1Options{
 		URL:           cisEndPoint,
 		Authenticator: authenticator,
@@ -2681,13 +3099,17 @@ func (c *Config) ClientSession() (interface{}, error) {
 			session.cisIPErr)
 	}
 	if session.cisIPClient != nil && session.cisIPClient.Service != nil {
-		session.cisIPClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		session.enableRetries(session.cisIPClient.Service, "cis")
 		session.cisIPClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
 	}
+}
 
-	// IBM Network CIS Zone Rate Limit
+// IBM Network CIS Zone Rate Limit
+func (session *clientSession) configureCisRateLimit() {
+	authenticator := session.authenticator
+	cisEndPoint := session.cisEndPoint
 	cisRLOpt := &cisratelimitv1.ZoneRateLimitsV1Options{
 		URL:            cisEndPoint,
 		Crn:            core.StringPtr(""),
@@ -2701,12 +3123,17 @@ func (c *Config) ClientSession() (interface{}, error) {
 			session.cisRLErr)
 	}
 	if session.cisRLClient != nil && session.cisRLClient.Service != nil {
-		session.cisRLClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		session.enableRetries(session.cisRLClient.Service, "cis")
 		session.cisRLClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
 	}
-	// IBM Network CIS Alerts
+}
+
+// IBM Network CIS Alerts
+func (session *clientSession) configureCisAlerts() {
+	authenticator := session.authenticator
+	cisEndPoint := session.cisEndPoint
 	cisAlertsOpt := &cisalertsv1.AlertsV1Options{
 		URL:           cisEndPoint,
 		Crn:           core.StringPtr(""),
@@ -2718,13 +3145,17 @@ func (c *Config) ClientSession() (interface{}, error) {
 			session.cisAlertsErr)
 	}
 	if session.cisAlertsClient != nil && session.cisAlertsClient.Service != nil {
-		session.cisAlertsClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		session.enableRetries(session.cisAlertsClient.Service, "cis")
 		session.cisAlertsClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
 	}
+}
 
-	// IBM Network CIS Rulesets
+// IBM Network CIS Rulesets
+func (session *clientSession) configureCisRulesets() {
+	authenticator := session.authenticator
+	cisEndPoint := session.cisEndPoint
 	cisRulesetsOpt := &cisrulesetsv1.RulesetsV1Options{
 		URL:            cisEndPoint,
 		Crn:            core.StringPtr(""),
@@ -2737,13 +3168,17 @@ func (c *Config) ClientSession() (interface{}, error) {
 			session.cisRulesetsErr)
 	}
 	if session.cisRulesetsClient != nil && session.cisRulesetsClient.Service != nil {
-		session.cisRulesetsClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		session.enableRetries(session.cisRulesetsClient.Service, "cis")
 		session.cisRulesetsClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
 	}
+}
 
-	// IBM Network CIS Page Rules
+// IBM Network CIS Page Rules
+func (session *clientSession) configureCisPageRule() {
+	authenticator := session.authenticator
+	cisEndPoint := session.cisEndPoint
 	cisPageRuleOpt := &cispagerulev1.PageRuleApiV1Options{
 		URL:           cisEndPoint,
 		Crn:           core.StringPtr(""),
@@ -2757,13 +3192,17 @@ func (c *Config) ClientSession() (interface{}, error) {
 			session.cisPageRuleErr)
 	}
 	if session.cisPageRuleClient != nil && session.cisPageRuleClient.Service != nil {
-		session.cisPageRuleClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		session.enableRetries(session.cisPageRuleClient.Service, "cis")
 		session.cisPageRuleClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
 	}
+}
 
-	// IBM Network CIS Edge Function
+// IBM Network CIS Edge Function
+func (session *clientSession) configureCisEdgeFunction() {
+	authenticator := session.authenticator
+	cisEndPoint := session.cisEndPoint
 	cisEdgeFunctionOpt := &cisedgefunctionv1.EdgeFunctionsApiV1Options{
 		URL:            cisEndPoint,
 		Crn:            core.StringPtr(""),
@@ -2776,13 +3215,17 @@ func (c *Config) ClientSession() (interface{}, error) {
 			session.cisEdgeFunctionErr)
 	}
 	if session.cisEdgeFunctionClient != nil && session.cisEdgeFunctionClient.Service != nil {
-		session.cisEdgeFunctionClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		session.enableRetries(session.cisEdgeFunctionClient.Service, "cis")
 		session.cisEdgeFunctionClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
 	}
+}
 
-	// IBM Network CIS SSL certificate
+// IBM Network CIS SSL certificate
+func (session *clientSession) configureCisSSL() {
+	authenticator := session.authenticator
+	cisEndPoint := session.cisEndPoint
 	cisSSLOpt := &cissslv1.SslCertificateApiV1Options{
 		URL:            cisEndPoint,
 		Crn:            core.StringPtr(""),
@@ -2796,13 +3239,17 @@ func (c *Config) ClientSession() (interface{}, error) {
 			session.cisSSLErr)
 	}
 	if session.cisSSLClient != nil && session.cisSSLClient.Service != nil {
-		session.cisSSLClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		session.enableRetries(session.cisSSLClient.Service, "cis")
 		session.cisSSLClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
 	}
+}
 
-	// IBM Network CIS WAF Package
+// IBM Network CIS WAF Package
+func (session *clientSession) configureCisWAFPackage() {
+	authenticator := session.authenticator
+	cisEndPoint := session.cisEndPoint
 	cisWAFPackageOpt := &ciswafpackagev1.WafRulePackagesApiV1Options{
 		URL:           cisEndPoint,
 		Crn:           core.StringPtr(""),
@@ -2815,13 +3262,17 @@ func (c *Config) ClientSession() (interface{}, error) {
 			session.cisWAFPackageErr)
 	}
 	if session.cisWAFPackageClient != nil && session.cisWAFPackageClient.Service != nil {
-		session.cisWAFPackageClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		session.enableRetries(session.cisWAFPackageClient.Service, "cis")
 		session.cisWAFPackageClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
 	}
+}
 
-	// IBM Network CIS Domain settings
+// IBM Network CIS Domain settings
+func (session *clientSession) configureCisDomainSettings() {
+	authenticator := session.authenticator
+	cisEndPoint := session.cisEndPoint
 	cisDomainSettingsOpt := &cisdomainsettingsv1.ZonesSettingsV1Options{
 		URL:            cisEndPoint,
 		Crn:            core.StringPtr(""),
@@ -2834,13 +3285,17 @@ func (c *Config) ClientSession() (interface{}, error) {
 			session.cisDomainSettingsErr)
 	}
 	if session.cisDomainSettingsClient != nil && session.cisDomainSettingsClient.Service != nil {
-		session.cisDomainSettingsClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		session.enableRetries(session.cisDomainSettingsClient.Service, "cis")
 		session.cisDomainSettingsClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
 	}
+}
 
-	// IBM Network CIS Routing
+// IBM Network CIS Routing
+func (session *clientSession) configureCisRouting() {
+	authenticator := session.authenticator
+	cisEndPoint := session.cisEndPoint
 	cisRoutingOpt := &cisroutingv1.RoutingV1Options{
 		URL:            cisEndPoint,
 		Crn:            core.StringPtr(""),
@@ -2853,13 +3308,17 @@ func (c *Config) ClientSession() (interface{}, error) {
 			session.cisRoutingErr)
 	}
 	if session.cisRoutingClient != nil && session.cisRoutingClient.Service != nil {
-		session.cisRoutingClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		session.enableRetries(session.cisRoutingClient.Service, "cis")
 		session.cisRoutingClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
 	}
+}
 
-	// IBM Network CIS WAF Group
+// IBM Network CIS WAF Group
+func (session *clientSession) configureCisWAFGroup() {
+	authenticator := session.authenticator
+	cisEndPoint := session.cisEndPoint
 	cisWAFGroupOpt := &ciswafgroupv1.WafRuleGroupsApiV1Options{
 		URL:           cisEndPoint,
 		Crn:           core.StringPtr(""),
@@ -2872,13 +3331,17 @@ func (c *Config) ClientSession() (interface{}, error) {
 			session.cisWAFGroupErr)
 	}
 	if session.cisWAFGroupClient != nil && session.cisWAFGroupClient.Service != nil {
-		session.cisWAFGroupClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		session.enableRetries(session.cisWAFGroupClient.Service, "cis")
 		session.cisWAFGroupClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
 	}
+}
 
-	// IBM Network CIS Cache service
+// IBM Network CIS Cache service
+func (session *clientSession) configureCisCache() {
+	authenticator := session.authenticator
+	cisEndPoint := session.cisEndPoint
 	cisCacheOpt := &ciscachev1.CachingApiV1Options{
 		URL:           cisEndPoint,
 		Crn:           core.StringPtr(""),
@@ -2891,13 +3354,17 @@ func (c *Config) ClientSession() (interface{}, error) {
 			session.cisCacheErr)
 	}
 	if session.cisCacheClient != nil && session.cisCacheClient.Service != nil {
-		session.cisCacheClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		session.enableRetries(session.cisCacheClient.Service, "cis")
 		session.cisCacheClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
 	}
+}
 
-	// IBM Network CIS Custom pages service
+// IBM Network CIS Custom pages service
+func (session *clientSession) configureCisCustomPage() {
+	authenticator := session.authenticator
+	cisEndPoint := session.cisEndPoint
 	cisCustomPageOpt := &ciscustompagev1.CustomPagesV1Options{
 		URL:            cisEndPoint,
 		Crn:            core.StringPtr(""),
@@ -2911,13 +3378,17 @@ func (c *Config) ClientSession() (interface{}, error) {
 			session.cisCustomPageErr)
 	}
 	if session.cisCustomPageClient != nil && session.cisCustomPageClient.Service != nil {
-		session.cisCustomPageClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		session.enableRetries(session.cisCustomPageClient.Service, "cis")
 		session.cisCustomPageClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
 	}
+}
 
-	// IBM Network CIS Firewall Access rule
+// IBM Network CIS Firewall Access rule
+func (session *clientSession) configureCisAccessRule() {
+	authenticator := session.authenticator
+	cisEndPoint := session.cisEndPoint
 	cisAccessRuleOpt := &cisaccessrulev1.ZoneFirewallAccessRulesV1Options{
 		URL:            cisEndPoint,
 		Crn:            core.StringPtr(""),
@@ -2930,13 +3401,17 @@ func (c *Config) ClientSession() (interface{}, error) {
 			session.cisAccessRuleErr)
 	}
 	if session.cisAccessRuleClient != nil && session.cisAccessRuleClient.Service != nil {
-		session.cisAccessRuleClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		session.enableRetries(session.cisAccessRuleClient.Service, "cis")
 		session.cisAccessRuleClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
 	}
+}
 
-	// IBM Network CIS Firewall User Agent Blocking rule
+// IBM Network CIS Firewall User Agent Blocking rule
+func (session *clientSession) configureCisUARule() {
+	authenticator := session.authenticator
+	cisEndPoint := session.cisEndPoint
 	cisUARuleOpt := &cisuarulev1.UserAgentBlockingRulesV1Options{
 		URL:            cisEndPoint,
 		Crn:            core.StringPtr(""),
@@ -2949,13 +3424,17 @@ func (c *Config) ClientSession() (interface{}, error) {
 			session.cisUARuleErr)
 	}
 	if session.cisUARuleClient != nil && session.cisUARuleClient.Service != nil {
-		session.cisUARuleClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		session.enableRetries(session.cisUARuleClient.Service, "cis")
 		session.cisUARuleClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
 	}
+}
 
-	// IBM Network CIS Firewall Lockdown rule
+// IBM Network CIS Firewall Lockdown rule
+func (session *clientSession) configureCisLockdown() {
+	authenticator := session.authenticator
+	cisEndPoint := session.cisEndPoint
 	cisLockdownOpt := &cislockdownv1.ZoneLockdownV1Options{
 		URL:            cisEndPoint,
 		Crn:            core.StringPtr(""),
@@ -2968,13 +3447,17 @@ func (c *Config) ClientSession() (interface{}, error) {
 			session.cisLockdownErr)
 	}
 	if session.cisLockdownClient != nil && session.cisLockdownClient.Service != nil {
-		session.cisLockdownClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		session.enableRetries(session.cisLockdownClient.Service, "cis")
 		session.cisLockdownClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
 	}
+}
 
-	// IBM Network CIS Range Application rule
+// IBM Network CIS Range Application rule
+func (session *clientSession) configureCisRangeApp() {
+	authenticator := session.authenticator
+	cisEndPoint := session.cisEndPoint
 	cisRangeAppOpt := &cisrangeappv1.RangeApplicationsV1Options{
 		URL:            cisEndPoint,
 		Crn:            core.StringPtr(""),
@@ -2987,13 +3470,17 @@ func (c *Config) ClientSession() (interface{}, error) {
 			session.cisRangeAppErr)
 	}
 	if session.cisRangeAppClient != nil && session.cisRangeAppClient.Service != nil {
-		session.cisRangeAppClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		session.enableRetries(session.cisRangeAppClient.Service, "cis")
 		session.cisRangeAppClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
 	}
+}
 
-	// IBM Network CIS WAF Rule Service
+// IBM Network CIS WAF Rule Service
+func (session *clientSession) configureCisWAFRule() {
+	authenticator := session.authenticator
+	cisEndPoint := session.cisEndPoint
 	cisWAFRuleOpt := &ciswafrulev1.WafRulesApiV1Options{
 		URL:           cisEndPoint,
 		Crn:           core.StringPtr(""),
@@ -3007,13 +3494,17 @@ func (c *Config) ClientSession() (interface{}, error) {
 			session.cisWAFRuleErr)
 	}
 	if session.cisWAFRuleClient != nil && session.cisWAFRuleClient.Service != nil {
-		session.cisWAFRuleClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		session.enableRetries(session.cisWAFRuleClient.Service, "cis")
 		session.cisWAFRuleClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
 	}
+}
 
-	// IBM Network CIS LogpushJobs
+// IBM Network CIS LogpushJobs
+func (session *clientSession) configureCisLogpushJobs() {
+	authenticator := session.authenticator
+	cisEndPoint := session.cisEndPoint
 	cisLogpushJobOpt := &cislogpushjobsapiv1.LogpushJobsApiV1Options{
 		URL:           cisEndPoint,
 		Crn:           core.StringPtr(""),
@@ -3027,13 +3518,17 @@ func (c *Config) ClientSession() (interface{}, error) {
 			session.cisLogpushJobsErr)
 	}
 	if session.cisLogpushJobsClient != nil && session.cisLogpushJobsClient.Service != nil {
-		session.cisLogpushJobsClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		session.enableRetries(session.cisLogpushJobsClient.Service, "cis")
 		session.cisLogpushJobsClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
 	}
+}
 
-	// IBM MTLS Session
+// IBM MTLS Session
+func (session *clientSession) configureCisMtls() {
+	authenticator := session.authenticator
+	cisEndPoint := session.cisEndPoint
 	cisMtlsOpt := &cismtlsv1.MtlsV1Options{
 		URL:           cisEndPoint,
 		Crn:           core.StringPtr(""),
@@ -3045,13 +3540,17 @@ func (c *Config) ClientSession() (interface{}, error) {
 			session.cisMtlsErr)
 	}
 	if session.cisMtlsClient != nil && session.cisMtlsClient.Service != nil {
-		session.cisMtlsClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		session.enableRetries(session.cisMtlsClient.Service, "cis")
 		session.cisMtlsClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
 	}
+}
 
-	// IBM Bot Management
+// IBM Bot Management
+func (session *clientSession) configureCisBotManagement() {
+	authenticator := session.authenticator
+	cisEndPoint := session.cisEndPoint
 	cisBotManagementOpt := &cisbotmanagementv1.BotManagementV1Options{
 		URL:            cisEndPoint,
 		Crn:            core.StringPtr(""),
@@ -3064,13 +3563,17 @@ func (c *Config) ClientSession() (interface{}, error) {
 			session.cisBotManagementErr)
 	}
 	if session.cisBotManagementClient != nil && session.cisBotManagementClient.Service != nil {
-		session.cisBotManagementClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		session.enableRetries(session.cisBotManagementClient.Service, "cis")
 		session.cisBotManagementClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
 	}
+}
 
-	// IBM Bot Analytics
+// IBM Bot Analytics
+func (session *clientSession) configureCisBotAnalytics() {
+	authenticator := session.authenticator
+	cisEndPoint := session.cisEndPoint
 	cisBotAnalyticsOpt := &cisbotanalyticsv1.BotAnalyticsV1Options{
 		URL:            cisEndPoint,
 		Crn:            core.StringPtr(""),
@@ -3083,13 +3586,17 @@ func (c *Config) ClientSession() (interface{}, error) {
 			session.cisBotAnalyticsErr)
 	}
 	if session.cisBotAnalyticsClient != nil && session.cisBotAnalyticsClient.Service != nil {
-		session.cisBotAnalyticsClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		session.enableRetries(session.cisBotAnalyticsClient.Service, "cis")
 		session.cisBotAnalyticsClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
 	}
+}
 
-	// IBM Network CIS Webhooks
+// IBM Network CIS Webhooks
+func (session *clientSession) configureCisWebhooks() {
+	authenticator := session.authenticator
+	cisEndPoint := session.cisEndPoint
 	cisWebhooksOpt := &ciswebhooksv1.WebhooksV1Options{
 		URL:           cisEndPoint,
 		Crn:           core.StringPtr(""),
@@ -3101,12 +3608,17 @@ func (c *Config) ClientSession() (interface{}, error) {
 			session.cisWebhooksErr)
 	}
 	if session.cisWebhooksClient != nil && session.cisWebhooksClient.Service != nil {
-		session.cisWebhooksClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		session.enableRetries(session.cisWebhooksClient.Service, "cis")
 		session.cisWebhooksClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
 	}
-	// IBM Network CIS Filters
+}
+
+// IBM Network CIS Filters
+func (session *clientSession) configureCisFilters() {
+	authenticator := session.authenticator
+	cisEndPoint := session.cisEndPoint
 	cisFiltersOpt := &cisfiltersv1.FiltersV1Options{
 		URL:           cisEndPoint,
 		Authenticator: authenticator,
@@ -3117,13 +3629,17 @@ func (c *Config) ClientSession() (interface{}, error) {
 			session.cisFiltersErr)
 	}
 	if session.cisFiltersClient != nil && session.cisFiltersClient.Service != nil {
-		session.cisFiltersClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		session.enableRetries(session.cisFiltersClient.Service, "cis")
 		session.cisFiltersClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
 	}
+}
 
-	// IBM Network CIS Firewall rules
+// IBM Network CIS Firewall rules
+func (session *clientSession) configureCisFirewallRules() {
+	authenticator := session.authenticator
+	cisEndPoint := session.cisEndPoint
 	cisFirewallrulesOpt := &cisfirewallrulesv1.FirewallRulesV1Options{
 		URL:           cisEndPoint,
 		Authenticator: authenticator,
@@ -3134,13 +3650,17 @@ func (c *Config) ClientSession() (interface{}, error) {
 			session.cisFirewallRulesErr)
 	}
 	if session.cisFirewallRulesClient != nil && session.cisFirewallRulesClient.Service != nil {
-		session.cisFirewallRulesClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		session.enableRetries(session.cisFirewallRulesClient.Service, "cis")
 		session.cisFirewallRulesClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
 	}
+}
 
-	// IBM Network CIS Authenticated Origin Pull
+// IBM Network CIS Authenticated Origin Pull
+func (session *clientSession) configureCisOriginAuth() {
+	authenticator := session.authenticator
+	cisEndPoint := session.cisEndPoint
 	cisOriginAuthOptions := &cisoriginpull.AuthenticatedOriginPullApiV1Options{
 		URL:            cisEndPoint,
 		Authenticator:  authenticator,
@@ -3155,14 +3675,18 @@ func (c *Config) ClientSession() (interface{}, error) {
 			session.cisOriginAuthPullErr)
 	}
 	if session.cisOriginAuthClient != nil && session.cisOriginAuthClient.Service != nil {
-		session.cisOriginAuthClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		session.enableRetries(session.cisOriginAuthClient.Service, "cis")
 		session.cisOriginAuthClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
 	}
+}
 
-	// IAM IDENTITY Service
-	// iamIdenityURL := fmt.Sprintf("https://%s.iam.cloud.ibm.com/v1", c.Region)
+// IAM IDENTITY Service
+func (session *clientSession) configureIAMIdentity() {
+	c := session.config
+	fileMap := session.fileMap
+	authenticator := session.authenticator
 	iamIdenityURL := iamidentity.DefaultServiceURL
 	if c.Visibility == "private" || c.Visibility == "public-and-private" {
 		if c.Region == "us-south" || c.Region == "us-east" {
@@ -3183,14 +3707,19 @@ func (c *Config) ClientSession() (interface{}, error) {
 		session.iamIdentityErr = fmt.Errorf("[ERROR] Error occured while configuring IAM Identity service: %q", err)
 	}
 	if iamIdentityClient != nil && iamIdentityClient.Service != nil {
-		iamIdentityClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		session.enableRetries(iamIdentityClient.Service, "iam")
 		iamIdentityClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
 	}
 	session.iamIdentityAPI = iamIdentityClient
+}
 
-	// IAM POLICY MANAGEMENT Service
+// IAM POLICY MANAGEMENT Service
+func (session *clientSession) configureIAMPolicyManagement() {
+	c := session.config
+	fileMap := session.fileMap
+	authenticator := session.authenticator
 	iamPolicyManagementURL := iampolicymanagement.DefaultServiceURL
 	if c.Visibility == "private" || c.Visibility == "public-and-private" {
 		if c.Region == "us-south" || c.Region == "us-east" {
@@ -3211,14 +3740,19 @@ func (c *Config) ClientSession() (interface{}, error) {
 		session.iamPolicyManagementErr = fmt.Errorf("[ERROR] Error occured while configuring IAM Policy Management service: %q", err)
 	}
 	if iamPolicyManagementClient != nil && iamPolicyManagementClient.Service != nil {
-		iamPolicyManagementClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		session.enableRetries(iamPolicyManagementClient.Service, "iam")
 		iamPolicyManagementClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
 	}
 	session.iamPolicyManagementAPI = iamPolicyManagementClient
+}
 
-	// IAM ACCESS GROUP
+// IAM ACCESS GROUP
+func (session *clientSession) configureIAMAccessGroups() {
+	c := session.config
+	fileMap := session.fileMap
+	authenticator := session.authenticator
 	iamAccessGroupsURL := iamaccessgroups.DefaultServiceURL
 	if c.Visibility == "private" || c.Visibility == "public-and-private" {
 		if c.Region == "us-south" || c.Region == "us-east" {
@@ -3239,14 +3773,19 @@ func (c *Config) ClientSession() (interface{}, error) {
 		session.iamAccessGroupsErr = fmt.Errorf("[ERROR] Error occured while configuring IAM Access Group service: %q", err)
 	}
 	if iamAccessGroupsClient != nil && iamAccessGroupsClient.Service != nil {
-		iamAccessGroupsClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		session.enableRetries(iamAccessGroupsClient.Service, "iam")
 		iamAccessGroupsClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
 	}
 	session.iamAccessGroupsAPI = iamAccessGroupsClient
+}
 
-	// RESOURCE MANAGEMENT Service
+// RESOURCE MANAGEMENT Service
+func (session *clientSession) configureResourceManager() {
+	c := session.config
+	fileMap := session.fileMap
+	authenticator := session.authenticator
 	rmURL := resourcemanager.DefaultServiceURL
 	if c.Visibility == "private" {
 		if c.Region == "us-south" || c.Region == "us-east" {
@@ -3275,14 +3814,20 @@ func (c *Config) ClientSession() (interface{}, error) {
 		session.resourceManagerErr = fmt.Errorf("[ERROR] Error occured while configuring Resource Manager service: %q", err)
 	}
 	if resourceManagerClient != nil && resourceManagerClient.Service != nil {
-		resourceManagerClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		session.enableRetries(resourceManagerClient.Service, "resource_manager")
 		resourceManagerClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
 	}
 	session.resourceManagerAPI = resourceManagerClient
+}
 
-	// CLOUD SHELL Service
+// CLOUD SHELL Service
+func (session *clientSession) configureCloudShell() {
+	c := session.config
+	fileMap := session.fileMap
+	authenticator := session.authenticator
+	var err error
 	cloudShellUrl := ibmcloudshellv1.DefaultServiceURL
 	if fileMap != nil && c.Visibility != "public-and-private" {
 		cloudShellUrl = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_CLOUD_SHELL_API_ENDPOINT", c.Region, cloudShellUrl)
@@ -3296,13 +3841,18 @@ func (c *Config) ClientSession() (interface{}, error) {
 		session.ibmCloudShellClientErr = fmt.Errorf("[ERROR] Error occurred while configuring IBM Cloud Shell service: %q", err)
 	}
 	if session.ibmCloudShellClient != nil && session.ibmCloudShellClient.Service != nil {
-		session.ibmCloudShellClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		session.enableRetries(session.ibmCloudShellClient.Service, "cloud_shell")
 		session.ibmCloudShellClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
 	}
+}
 
-	// ENTERPRISE Service
+// ENTERPRISE Service
+func (session *clientSession) configureEnterpriseManagement() {
+	c := session.config
+	fileMap := session.fileMap
+	authenticator := session.authenticator
 	enterpriseURL := enterprisemanagementv1.DefaultServiceURL
 	if c.Visibility == "private" {
 		if c.Region == "us-south" || c.Region == "us-east" || c.Region == "eu-fr" {
@@ -3332,14 +3882,19 @@ func (c *Config) ClientSession() (interface{}, error) {
 		session.enterpriseManagementClientErr = fmt.Errorf("[ERROR] Error occurred while configuring IBM Cloud Enterprise Management API service: %q", err)
 	}
 	if enterpriseManagementClient != nil && enterpriseManagementClient.Service != nil {
-		enterpriseManagementClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		session.enableRetries(enterpriseManagementClient.Service, "enterprise")
 		enterpriseManagementClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
 	}
 	session.enterpriseManagementClient = enterpriseManagementClient
+}
 
-	// RESOURCE CONTROLLER Service
+// RESOURCE CONTROLLER Service
+func (session *clientSession) configureResourceController() {
+	c := session.config
+	fileMap := session.fileMap
+	authenticator := session.authenticator
 	rcURL := resourcecontroller.DefaultServiceURL
 	if c.Visibility == "private" {
 		if c.Region == "us-south" || c.Region == "us-east" {
@@ -3368,15 +3923,19 @@ func (c *Config) ClientSession() (interface{}, error) {
 		session.resourceControllerErr = fmt.Errorf("[ERROR] Error occured while configuring Resource Controller service: %q", err)
 	}
 	if resourceControllerClient != nil && resourceControllerClient.Service != nil {
-		resourceControllerClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		session.enableRetries(resourceControllerClient.Service, "resource_controller")
 		resourceControllerClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
 	}
 	session.resourceControllerAPI = resourceControllerClient
+}
 
-	// SECRETS MANAGER Service V2
-	// Construct an "options" struct for creating the service client.
+// SECRETS MANAGER Service V2
+func (session *clientSession) configureSecretsManager() {
+	c := session.config
+	authenticator := session.authenticator
+	var err error
 	var smBaseUrl string
 	if c.Visibility == "private" || c.Visibility == "public-and-private" {
 		smBaseUrl = ContructEndpoint(fmt.Sprintf("private.secrets-manager.%s", c.Region), cloudEndpoint)
@@ -3393,7 +3952,7 @@ func (c *Config) ClientSession() (interface{}, error) {
 	session.secretsManagerClient, err = secretsmanagerv2.NewSecretsManagerV2UsingExternalConfig(secretsManagerClientOptionsV2)
 	if err == nil {
 		// Enable retries for API calls
-		session.secretsManagerClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		session.enableRetries(session.secretsManagerClient.Service, "secrets_manager")
 		// Add custom header for analytics
 		session.secretsManagerClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
@@ -3401,8 +3960,14 @@ func (c *Config) ClientSession() (interface{}, error) {
 	} else {
 		session.secretsManagerClientErr = fmt.Errorf("Error occurred while configuring IBM Cloud Secrets Manager Basic API service: %q", err)
 	}
+}
 
-	// SATELLITE Service
+// SATELLITE Service
+func (session *clientSession) configureSatellite() {
+	c := session.config
+	fileMap := session.fileMap
+	authenticator := session.authenticator
+	var err error
 	containerEndpoint := kubernetesserviceapiv1.DefaultServiceURL
 	if c.Visibility == "private" || c.Visibility == "public-and-private" {
 		containerEndpoint = ContructEndpoint(fmt.Sprintf("private.%s.containers", c.Region), fmt.Sprintf("%s/global", cloudEndpoint))
@@ -3421,14 +3986,19 @@ func (c *Config) ClientSession() (interface{}, error) {
 
 	// Enable retries for API calls
 	if session.satelliteClient != nil && session.satelliteClient.Service != nil {
-		session.satelliteClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		session.enableRetries(session.satelliteClient.Service, "satellite")
 		session.satelliteClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
 	}
+}
 
-	// SATELLITE LINK Service
-	// Construct an "options" struct for creating the service client.
+// SATELLITE LINK Service
+func (session *clientSession) configureSatelliteLink() {
+	c := session.config
+	fileMap := session.fileMap
+	authenticator := session.authenticator
+	var err error
 	satelliteLinkEndpoint := satellitelinkv1.DefaultServiceURL
 	if c.Visibility == "private" || c.Visibility == "public-and-private" {
 		satelliteLinkEndpoint = ContructEndpoint("private.api.link.satellite", cloudEndpoint)
@@ -3446,13 +4016,17 @@ func (c *Config) ClientSession() (interface{}, error) {
 	}
 	if session.satelliteLinkClient != nil && session.satelliteLinkClient.Service != nil {
 		// Enable retries for API calls
-		session.satelliteLinkClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		session.enableRetries(session.satelliteLinkClient.Service, "satellite")
 		// Add custom header for analytics
 		session.satelliteLinkClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
 	}
+}
 
+func (session *clientSession) configureESSchemaRegistry() {
+	authenticator := session.authenticator
+	var err error
 	esSchemaRegistryV1Options := &schemaregistryv1.SchemaregistryV1Options{
 		Authenticator: authenticator,
 	}
@@ -3461,12 +4035,16 @@ func (c *Config) ClientSession() (interface{}, error) {
 		session.esSchemaRegistryErr = fmt.Errorf("[ERROR] Error occured while configuring Event Streams schema registry: %q", err)
 	}
 	if session.esSchemaRegistryClient != nil && session.esSchemaRegistryClient.Service != nil {
-		session.esSchemaRegistryClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		session.enableRetries(session.esSchemaRegistryClient.Service, "event_streams")
 		session.esSchemaRegistryClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
 	}
+}
 
+func (session *clientSession) configureESAdminRest() {
+	authenticator := session.authenticator
+	var err error
 	esAdminRestV1Options := &adminrestv1.AdminrestV1Options{
 		Authenticator: authenticator,
 	}
@@ -3475,13 +4053,19 @@ func (c *Config) ClientSession() (interface{}, error) {
 		session.esAdminRestErr = fmt.Errorf("[ERROR] Error occured while configuring Event Streams admin rest: %q", err)
 	}
 	if session.esAdminRestClient != nil && session.esAdminRestClient.Service != nil {
-		session.esAdminRestClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		session.enableRetries(session.esAdminRestClient.Service, "event_streams")
 		session.esAdminRestClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
 	}
+}
 
-	// Construct an "options" struct for creating the service client.
+// CD TOOLCHAIN Service
+func (session *clientSession) configureCdToolchain() {
+	c := session.config
+	fileMap := session.fileMap
+	authenticator := session.authenticator
+	var err error
 	var cdToolchainClientURL string
 	if c.Visibility == "private" || c.Visibility == "public-and-private" {
 		cdToolchainClientURL, err = cdtoolchainv2.GetServiceURLForRegion("private." + c.Region)
@@ -3506,7 +4090,7 @@ func (c *Config) ClientSession() (interface{}, error) {
 	session.cdToolchainClient, err = cdtoolchainv2.NewCdToolchainV2(cdToolchainClientOptions)
 	if err == nil {
 		// Enable retries for API calls
-		session.cdToolchainClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		session.enableRetries(session.cdToolchainClient.Service, "continuous_delivery")
 		// Add custom header for analytics
 		session.cdToolchainClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
@@ -3514,8 +4098,14 @@ func (c *Config) ClientSession() (interface{}, error) {
 	} else {
 		session.cdToolchainClientErr = fmt.Errorf("Error occurred while configuring Toolchain service: %q", err)
 	}
+}
 
-	// Construct an "options" struct for creating the tekton pipeline service client.
+// CD TEKTON PIPELINE Service
+func (session *clientSession) configureCdTektonPipeline() {
+	c := session.config
+	fileMap := session.fileMap
+	authenticator := session.authenticator
+	var err error
 	var cdTektonPipelineClientURL string
 	if c.Visibility == "private" || c.Visibility == "public-and-private" {
 		cdTektonPipelineClientURL, err = cdtektonpipelinev2.GetServiceURLForRegion("private." + c.Region)
@@ -3539,7 +4129,7 @@ func (c *Config) ClientSession() (interface{}, error) {
 	session.cdTektonPipelineClient, err = cdtektonpipelinev2.NewCdTektonPipelineV2(cdTektonPipelineClientOptions)
 	if err == nil {
 		// Enable retries for API calls
-		session.cdTektonPipelineClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		session.enableRetries(session.cdTektonPipelineClient.Service, "continuous_delivery")
 		// Add custom header for analytics
 		session.cdTektonPipelineClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
@@ -3547,8 +4137,14 @@ func (c *Config) ClientSession() (interface{}, error) {
 	} else {
 		session.cdTektonPipelineClientErr = fmt.Errorf("Error occurred while configuring CD Tekton Pipeline service: %q", err)
 	}
+}
 
-	// MQaaS Service Configuration
+// MQaaS Service Configuration
+func (session *clientSession) configureMqcloud() {
+	c := session.config
+	fileMap := session.fileMap
+	authenticator := session.authenticator
+	var err error
 	mqCloudURL := ContructEndpoint(fmt.Sprintf("api.%s.mq2", c.Region), cloudEndpoint)
 	if c.Visibility == "private" || c.Visibility == "public-and-private" {
 		mqCloudURL = ContructEndpoint(fmt.Sprintf("api.private.%s.mq2", c.Region), cloudEndpoint)
@@ -3566,7 +4162,7 @@ func (c *Config) ClientSession() (interface{}, error) {
 	session.mqcloudClient, err = mqcloudv1.NewMqcloudV1(mqcloudClientOptions)
 	if err == nil {
 		// Enable retries for API calls
-		session.mqcloudClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		session.enableRetries(session.mqcloudClient.Service, "mqcloud")
 		// Add custom header for analytics
 		session.mqcloudClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
@@ -3574,9 +4170,13 @@ func (c *Config) ClientSession() (interface{}, error) {
 	} else {
 		session.mqcloudClientErr = fmt.Errorf("Error occurred while configuringMQaaS service: %q", err)
 	}
+}
 
-	// VMware Cloud Foundation as a Service
-	// Construct an instance of the 'VMware Cloud Foundation as a Service API' service.
+// VMware Cloud Foundation as a Service
+func (session *clientSession) configureVmware() {
+	c := session.config
+	authenticator := session.authenticator
+	var err error
 	if session.vmwareClientErr == nil {
 		// Construct the service options.
 		vmwareURL := ContructEndpoint(fmt.Sprintf("api.%s.vmware", c.Region), cloudEndpoint+"/v1")
@@ -3589,7 +4189,7 @@ func (c *Config) ClientSession() (interface{}, error) {
 		session.vmwareClient, err = vmwarev1.NewVmwareV1(vmwareClientOptions)
 		if err == nil {
 			// Enable retries for API calls
-			session.vmwareClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+			session.enableRetries(session.vmwareClient.Service, "vmware")
 			// Add custom header for analytics
 			session.vmwareClient.SetDefaultHeaders(gohttp.Header{
 				"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
@@ -3598,8 +4198,14 @@ func (c *Config) ClientSession() (interface{}, error) {
 			session.vmwareClientErr = fmt.Errorf("Error occurred while constructing 'VMware Cloud Foundation as a Service API' service client: %q", err)
 		}
 	}
+}
 
-	// Construct the service options.
+// CODE ENGINE Service
+func (session *clientSession) configureCodeEngine() {
+	c := session.config
+	fileMap := session.fileMap
+	authenticator := session.authenticator
+	var err error
 	codeEngineEndpoint := ContructEndpoint(fmt.Sprintf("api.%s.codeengine", c.Region), cloudEndpoint+"/v2")
 	if c.Visibility == "private" || c.Visibility == "public-and-private" {
 		codeEngineEndpoint = ContructEndpoint(fmt.Sprintf("api.private.%s.codeengine", c.Region), cloudEndpoint+"/v2")
@@ -3617,7 +4223,7 @@ func (c *Config) ClientSession() (interface{}, error) {
 	session.codeEngineClient, err = codeengine.NewCodeEngineV2(codeEngineClientOptions)
 	if err == nil {
 		// Enable retries for API calls
-		session.codeEngineClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		session.enableRetries(session.codeEngineClient.Service, "code_engine")
 		// Add custom header for analytics
 		session.codeEngineClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
@@ -3625,8 +4231,12 @@ func (c *Config) ClientSession() (interface{}, error) {
 	} else {
 		session.codeEngineClientErr = fmt.Errorf("Error occurred while configuring Code Engine service: %q", err)
 	}
+}
 
-	// Construct an instance of the 'sdsaas' service.
+// Construct an instance of the 'sdsaas' service.
+func (session *clientSession) configureSdsaas() {
+	authenticator := session.authenticator
+	var err error
 	if session.sdsaasClientErr == nil {
 		// Construct the service options.
 		sdsaasClientOptions := &sdsaasv1.SdsaasV1Options{
@@ -3637,7 +4247,7 @@ func (c *Config) ClientSession() (interface{}, error) {
 		session.sdsaasClient, err = sdsaasv1.NewSdsaasV1(sdsaasClientOptions)
 		if err == nil {
 			// Enable retries for API calls
-			session.sdsaasClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+			session.enableRetries(session.sdsaasClient.Service, "sds")
 			// Add custom header for analytics
 			session.sdsaasClient.SetDefaultHeaders(gohttp.Header{
 				"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
@@ -3646,8 +4256,14 @@ func (c *Config) ClientSession() (interface{}, error) {
 			session.sdsaasClientErr = fmt.Errorf("Error occurred while constructing 'sdsaas' service client: %q", err)
 		}
 	}
+}
 
-	// CATALOG MANAGEMENT Service
+// GLOBAL CATALOG Service
+func (session *clientSession) configureGlobalCatalog() {
+	c := session.config
+	fileMap := session.fileMap
+	authenticator := session.authenticator
+	var err error
 	globalcatalogURL := globalcatalogv1.DefaultServiceURL
 	if c.Visibility == "private" || c.Visibility == "public-and-private" {
 		if c.Region == "us-south" || c.Region == "us-east" {
@@ -3682,22 +4298,12 @@ func (c *Config) ClientSession() (interface{}, error) {
 	}
 	if session.globalCatalogClient != nil && session.globalCatalogClient.Service != nil {
 		// Enable retries for API calls
-		session.globalCatalogClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		session.enableRetries(session.globalCatalogClient.Service, "global_catalog")
 		// Add custom header for analytics
 		session.globalCatalogClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
 	}
-
-	if os.Getenv("TF_LOG") != "" {
-		logDestination := log.Writer()
-		goLogger := log.New(logDestination, "", log.LstdFlags)
-		core.SetLogger(core.NewLogger(core.LevelDebug, goLogger, goLogger))
-	}
-
-	// setting UserAgent for vpc-go-sdk common
-	common.UserAgent = fmt.Sprintf("terraform-provider-ibm/%s", version.Version)
-	return session, nil
 }
 
 // CreateVersionDate requires mandatory version attribute. Any date from 2019-12-13 up to the currentdate may be provided. Specify the current date to request the latest version.
@@ -3864,6 +4470,34 @@ func RefreshToken(sess *bxsession.Session) error {
 	return err
 }
 
+// IAMAccessToken refreshes the IAM access token of the session when it has a
+// refresh token, and returns the access token along with its expiration time.
+func IAMAccessToken(sess *bxsession.Session) (string, time.Time, error) {
+	config := sess.Config
+	if config.IAMRefreshToken != "" {
+		if err := RefreshToken(sess); err != nil {
+			return "", time.Time{}, err
+		}
+	}
+	if config.IAMAccessToken == "" {
+		return "", time.Time{}, errors.New("the provider has no IAM access token, please configure ibmcloud_api_key or iam_token")
+	}
+
+	claims := jwt.MapClaims{}
+	_, _, err := jwt.NewParser().ParseUnverified(strings.TrimPrefix(config.IAMAccessToken, "Bearer "), claims)
+	if err != nil {
+		return "", time.Time{}, err
+	}
+	expiration, err := claims.GetExpirationTime()
+	if err != nil {
+		return "", time.Time{}, err
+	}
+	if expiration == nil {
+		return config.IAMAccessToken, time.Time{}, nil
+	}
+	return config.IAMAccessToken, expiration.Time, nil
+}
+
 func EnvFallBack(envs []string, defaultValue string) string {
 	for _, k := range envs {
 		if v := os.Getenv(k); v != "" {
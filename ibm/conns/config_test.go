@@ -0,0 +1,115 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+package conns
+
+import (
+	"sync"
+	"testing"
+	"time"
+
+	bluemix "github.com/IBM-Cloud/bluemix-go"
+	bxsession "github.com/IBM-Cloud/bluemix-go/session"
+	"github.com/IBM/go-sdk-core/v5/core"
+	jwt "github.com/golang-jwt/jwt/v5"
+)
+
+func testClientSession() *clientSession {
+	return &clientSession{
+		session: &Session{
+			BluemixSession: &bxsession.Session{Config: &bluemix.Config{Region: "us-south"}},
+		},
+		config:        &Config{Region: "us-south"},
+		authenticator: &core.BearerTokenAuthenticator{BearerToken: "token"},
+		lazyInit:      make(map[string]*sync.Once),
+	}
+}
+
+func TestClientSessionConfiguresOnFirstUse(t *testing.T) {
+	session := testClientSession()
+
+	if session.vpcAPI != nil || session.cisDNSRecordsClient != nil {
+		t.Fatal("Service clients were configured before they were requested.")
+	}
+
+	vpcAPI, err := session.VpcV1API()
+	if err != nil {
+		t.Fatalf("Unexpected error configuring vpc service: %s", err)
+	}
+	if vpcAPI == nil {
+		t.Fatal("Expected vpc service client to be configured.")
+	}
+	if session.cisDNSRecordsClient != nil || session.schematicsClient != nil {
+		t.Fatal("Requesting the vpc client configured unrelated service clients.")
+	}
+}
+
+func TestClientSessionMemoizesClients(t *testing.T) {
+	session := testClientSession()
+
+	var wg sync.WaitGroup
+	clients := make(chan interface{}, 10)
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			vpcAPI, _ := session.VpcV1API()
+			clients <- vpcAPI
+		}()
+	}
+	wg.Wait()
+	close(clients)
+
+	first, _ := session.VpcV1API()
+	for client := range clients {
+		if client != first {
+			t.Fatal("Expected every call to return the same vpc service client.")
+		}
+	}
+}
+
+func TestClientSessionWithoutCredentials(t *testing.T) {
+	session := &clientSession{
+		session:  &Session{},
+		config:   &Config{Region: "us-south"},
+		lazyInit: make(map[string]*sync.Once),
+		vpcErr:   errEmptyBluemixCredentials,
+	}
+
+	vpcAPI, err := session.VpcV1API()
+	if err != errEmptyBluemixCredentials {
+		t.Fatalf("Expected errEmptyBluemixCredentials, got %v", err)
+	}
+	if vpcAPI != nil {
+		t.Fatal("Expected no vpc service client without credentials.")
+	}
+}
+
+func TestIAMAccessToken(t *testing.T) {
+	expiration := time.Now().Add(time.Hour).Truncate(time.Second)
+	token, err := jwt.NewWithClaims(jwt.SigningMethodHS256, jwt.MapClaims{
+		"exp": expiration.Unix(),
+	}).SignedString([]byte("secret"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	sess := &bxsession.Session{Config: &bluemix.Config{IAMAccessToken: "Bearer " + token}}
+
+	accessToken, expiresAt, err := IAMAccessToken(sess)
+	if err != nil {
+		t.Fatalf("Unexpected error getting the IAM access token: %s", err)
+	}
+	if accessToken != "Bearer "+token {
+		t.Fatalf("Expected the access token of the session, got %q", accessToken)
+	}
+	if !expiresAt.Equal(expiration) {
+		t.Fatalf("Expected the token to expire at %s, got %s", expiration, expiresAt)
+	}
+}
+
+func TestIAMAccessTokenWithoutToken(t *testing.T) {
+	sess := &bxsession.Session{Config: &bluemix.Config{}}
+
+	if _, _, err := IAMAccessToken(sess); err == nil {
+		t.Fatal("Expected an error without an IAM access token.")
+	}
+}
@@ -0,0 +1,103 @@
+// Copyright IBM Corp. 2025 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package conns
+
+import (
+	"context"
+	"log"
+	"sync"
+	"time"
+
+	"golang.org/x/sync/semaphore"
+)
+
+// maxReaders is the weight of a write lock, a reader holds a weight of one.
+const maxReaders = 1 << 30
+
+// IbmKeyedLock serializes the changes made by resources that share knowledge
+// of a key, such as the ID of the security group that their rules belong to.
+var IbmKeyedLock = NewKeyedLock()
+
+// KeyedLock is a set of read/write locks addressed by key. The lock of a key is
+// created when it is first requested and removed once it is no longer held or
+// waited for. Waiters are served in order, so a reader that arrives after a
+// waiting writer waits for the writer.
+type KeyedLock struct {
+	mu    sync.Mutex
+	locks map[string]*keyedLockEntry
+}
+
+type keyedLockEntry struct {
+	sem  *semaphore.Weighted
+	refs int
+}
+
+// NewKeyedLock returns an empty KeyedLock
+func NewKeyedLock() *KeyedLock {
+	return &KeyedLock{
+		locks: make(map[string]*keyedLockEntry),
+	}
+}
+
+// Lock waits for the exclusive lock of the key, or until ctx is done. On success
+// the caller must call the returned function to release the lock.
+func (l *KeyedLock) Lock(ctx context.Context, key string) (func(), error) {
+	return l.acquire(ctx, key, maxReaders, "write")
+}
+
+// RLock waits for a shared lock of the key, or until ctx is done. Shared locks
+// of the same key are held concurrently. On success the caller must call the
+// returned function to release the lock.
+func (l *KeyedLock) RLock(ctx context.Context, key string) (func(), error) {
+	return l.acquire(ctx, key, 1, "read")
+}
+
+func (l *KeyedLock) acquire(ctx context.Context, key string, weight int64, mode string) (func(), error) {
+	entry := l.ref(key)
+	start := time.Now()
+	log.Printf("[DEBUG] Acquiring %s lock %q", mode, key)
+	if err := entry.sem.Acquire(ctx, weight); err != nil {
+		l.unref(key, entry)
+		log.Printf("[DEBUG] Gave up on %s lock %q after %s: %s", mode, key, time.Since(start).Round(time.Millisecond), err)
+		return nil, err
+	}
+	log.Printf("[DEBUG] Acquired %s lock %q after %s", mode, key, time.Since(start).Round(time.Millisecond))
+
+	var once sync.Once
+	return func() {
+		once.Do(func() {
+			entry.sem.Release(weight)
+			l.unref(key, entry)
+			log.Printf("[DEBUG] Released %s lock %q", mode, key)
+		})
+	}, nil
+}
+
+func (l *KeyedLock) ref(key string) *keyedLockEntry {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	entry, ok := l.locks[key]
+	if !ok {
+		entry = &keyedLockEntry{sem: semaphore.NewWeighted(maxReaders)}
+		l.locks[key] = entry
+	}
+	entry.refs++
+	return entry
+}
+
+func (l *KeyedLock) unref(key string, entry *keyedLockEntry) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	entry.refs--
+	if entry.refs == 0 {
+		delete(l.locks, key)
+	}
+}
+
+// len returns the number of keys that are held or waited for
+func (l *KeyedLock) len() int {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return len(l.locks)
+}
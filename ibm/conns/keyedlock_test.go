@@ -0,0 +1,113 @@
+// Copyright IBM Corp. 2025 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+package conns
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestKeyedLockExclusive(t *testing.T) {
+	locks := NewKeyedLock()
+	unlock, err := locks.Lock(context.Background(), "sg")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+	if _, err := locks.Lock(ctx, "sg"); err != context.DeadlineExceeded {
+		t.Fatalf("Expected context.DeadlineExceeded, got %v", err)
+	}
+	if _, err := locks.RLock(ctx, "sg"); err != context.DeadlineExceeded {
+		t.Fatalf("Expected context.DeadlineExceeded for a reader, got %v", err)
+	}
+
+	other, err := locks.Lock(context.Background(), "acl")
+	if err != nil {
+		t.Fatalf("Expected the lock of another key to be free, got %v", err)
+	}
+	other()
+
+	unlock()
+	unlock()
+	relock, err := locks.Lock(context.Background(), "sg")
+	if err != nil {
+		t.Fatal(err)
+	}
+	relock()
+}
+
+func TestKeyedLockShared(t *testing.T) {
+	locks := NewKeyedLock()
+	first, err := locks.RLock(context.Background(), "sg")
+	if err != nil {
+		t.Fatal(err)
+	}
+	second, err := locks.RLock(context.Background(), "sg")
+	if err != nil {
+		t.Fatal("Expected readers to hold the lock concurrently.")
+	}
+
+	acquired := make(chan struct{})
+	go func() {
+		unlock, err := locks.Lock(context.Background(), "sg")
+		if err == nil {
+			close(acquired)
+			unlock()
+		}
+	}()
+	first()
+	select {
+	case <-acquired:
+		t.Fatal("Expected the writer to wait for all the readers.")
+	case <-time.After(20 * time.Millisecond):
+	}
+	second()
+	select {
+	case <-acquired:
+	case <-time.After(time.Second):
+		t.Fatal("Expected the writer to acquire the lock once the readers released it.")
+	}
+}
+
+func TestKeyedLockCleanup(t *testing.T) {
+	locks := NewKeyedLock()
+	var wg sync.WaitGroup
+	for i := 0; i < 20; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			ctx, cancel := context.WithTimeout(context.Background(), time.Duration(i)*time.Millisecond)
+			defer cancel()
+			if unlock, err := locks.Lock(ctx, "sg"); err == nil {
+				time.Sleep(time.Millisecond)
+				unlock()
+			}
+		}(i)
+	}
+	wg.Wait()
+
+	if n := locks.len(); n != 0 {
+		t.Fatalf("Expected no lock to be kept once released, got %d", n)
+	}
+}
+
+func TestMutexKVSharesKeyedLock(t *testing.T) {
+	locks := NewKeyedLock()
+	mutexKV := newMutexKV(locks)
+	mutexKV.Lock("sg")
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+	if _, err := locks.Lock(ctx, "sg"); err != context.DeadlineExceeded {
+		t.Fatalf("Expected MutexKV to hold the keyed lock, got %v", err)
+	}
+
+	mutexKV.Unlock("sg")
+	if n := locks.len(); n != 0 {
+		t.Fatalf("Expected no lock to be kept once released, got %d", n)
+	}
+}
@@ -4,7 +4,7 @@
 package conns
 
 import (
-	"log"
+	"context"
 	"sync"
 )
 
@@ -12,59 +12,57 @@ import (
 // serialize changes across arbitrary collaborators that share knowledge of the
 // keys they must serialize on.
 //
-// Deprecated: This will be removed in v2 without replacement. If you need
-// its functionality, you can copy it or reference the v1 package.
+// Deprecated: Use IbmKeyedLock, which takes the lock with a context and supports
+// shared locks.
 //
 // The initial use case is to let aws_security_group_rule resources serialize
 // their access to individual security groups based on SG ID.
 
-// This is a global MutexKV for use within this plugin.
-var IbmMutexKV = NewMutexKV()
+// This is a global MutexKV for use within this plugin. It shares its locks
+// with IbmKeyedLock.
+var IbmMutexKV = newMutexKV(IbmKeyedLock)
 
 type MutexKV struct {
-	lock  sync.Mutex
-	store map[string]*sync.Mutex
+	locks   *KeyedLock
+	lock    sync.Mutex
+	unlocks map[string]func()
 }
 
 // Lock the mutex for the given key. Caller is responsible for calling Unlock
 // for the same key
 //
-// Deprecated: This will be removed in v2 without replacement. If you need
-// its functionality, you can copy it or reference the v1 package.
+// Deprecated: Use IbmKeyedLock.Lock, which stops waiting when the context is done.
 func (m *MutexKV) Lock(key string) {
-	log.Printf("[DEBUG] Locking %q", key)
-	m.get(key).Lock()
-	log.Printf("[DEBUG] Locked %q", key)
+	unlock, _ := m.locks.Lock(context.Background(), key)
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	m.unlocks[key] = unlock
 }
 
 // Unlock the mutex for the given key. Caller must have called Lock for the same key first
 //
-// Deprecated: This will be removed in v2 without replacement. If you need
-// its functionality, you can copy it or reference the v1 package.
+// Deprecated: Use the function returned by IbmKeyedLock.Lock.
 func (m *MutexKV) Unlock(key string) {
-	log.Printf("[DEBUG] Unlocking %q", key)
-	m.get(key).Unlock()
-	log.Printf("[DEBUG] Unlocked %q", key)
-}
-
-// Returns a mutex for the given key, no guarantee of its lock status
-func (m *MutexKV) get(key string) *sync.Mutex {
 	m.lock.Lock()
-	defer m.lock.Unlock()
-	mutex, ok := m.store[key]
+	unlock, ok := m.unlocks[key]
+	delete(m.unlocks, key)
+	m.lock.Unlock()
 	if !ok {
-		mutex = &sync.Mutex{}
-		m.store[key] = mutex
+		panic("conns: unlock of unlocked key " + key)
 	}
-	return mutex
+	unlock()
 }
 
 // NewMutexKV Returns a properly initalized MutexKV
 //
-// Deprecated: This will be removed in v2 without replacement. If you need
-// its functionality, you can copy it or reference the v1 package.
+// Deprecated: Use NewKeyedLock.
 func NewMutexKV() *MutexKV {
+	return newMutexKV(NewKeyedLock())
+}
+
+func newMutexKV(locks *KeyedLock) *MutexKV {
 	return &MutexKV{
-		store: make(map[string]*sync.Mutex),
+		locks:   locks,
+		unlocks: make(map[string]func()),
 	}
 }
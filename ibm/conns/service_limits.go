@@ -0,0 +1,225 @@
+// Copyright IBM Corp. 2025 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package conns
+
+import (
+	"context"
+	"io"
+	"log"
+	"math"
+	"math/rand"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/hashicorp/go-retryablehttp"
+	"golang.org/x/time/rate"
+)
+
+// DefaultServiceLimit is the name of the service limit applied to the services
+// that do not have their own limit.
+const DefaultServiceLimit = "default"
+
+// ServiceLimit limits the requests sent by the clients of a service. A zero value
+// disables the corresponding limit.
+type ServiceLimit struct {
+	// MaxInFlight is the maximum number of requests waiting for a response.
+	MaxInFlight int
+	// RequestsPerSecond is the rate at which the tokens of the bucket are refilled.
+	RequestsPerSecond float64
+	// Burst is the size of the token bucket. It defaults to the rate, rounded up.
+	Burst int
+}
+
+// serviceLimiter enforces a ServiceLimit for all the clients of a service.
+type serviceLimiter struct {
+	name     string
+	inFlight chan struct{}
+	rate     *rate.Limiter
+}
+
+func newServiceLimiter(name string, limit ServiceLimit) *serviceLimiter {
+	limiter := &serviceLimiter{name: name}
+	if limit.MaxInFlight > 0 {
+		limiter.inFlight = make(chan struct{}, limit.MaxInFlight)
+	}
+	if limit.RequestsPerSecond > 0 {
+		burst := limit.Burst
+		if burst <= 0 {
+			burst = int(math.Ceil(limit.RequestsPerSecond))
+		}
+		limiter.rate = rate.NewLimiter(rate.Limit(limit.RequestsPerSecond), burst)
+	}
+	return limiter
+}
+
+// acquire waits for a request slot and a token, and returns the function that
+// releases the slot once the response is consumed.
+func (l *serviceLimiter) acquire(ctx context.Context) (func(), error) {
+	start := time.Now()
+	release := func() {}
+	if l.inFlight != nil {
+		select {
+		case l.inFlight <- struct{}{}:
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+		var once sync.Once
+		release = func() {
+			once.Do(func() { <-l.inFlight })
+		}
+	}
+	if l.rate != nil {
+		if err := l.rate.Wait(ctx); err != nil {
+			release()
+			return nil, err
+		}
+	}
+	if wait := time.Since(start); wait >= time.Second {
+		log.Printf("[DEBUG] Request to %s service delayed by %s to honour the service limits", l.name, wait.Round(time.Millisecond))
+	}
+	return release, nil
+}
+
+// serviceLimiters holds the limiters of a client session, shared by all the
+// clients of the same service.
+type serviceLimiters struct {
+	limits   map[string]ServiceLimit
+	mu       sync.Mutex
+	limiters map[string]*serviceLimiter
+}
+
+func newServiceLimiters(limits map[string]ServiceLimit) *serviceLimiters {
+	return &serviceLimiters{
+		limits:   limits,
+		limiters: make(map[string]*serviceLimiter),
+	}
+}
+
+// get returns the limiter of the service, or nil if the service is not limited.
+func (s *serviceLimiters) get(name string) *serviceLimiter {
+	if s == nil {
+		return nil
+	}
+	key := name
+	limit, ok := s.limits[name]
+	if !ok {
+		key = DefaultServiceLimit
+		if limit, ok = s.limits[DefaultServiceLimit]; !ok {
+			return nil
+		}
+	}
+	if limit.MaxInFlight <= 0 && limit.RequestsPerSecond <= 0 {
+		return nil
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	// The services without their own limit share the default limiter
+	limiter, ok := s.limiters[key]
+	if !ok {
+		limiter = newServiceLimiter(key, limit)
+		s.limiters[key] = limiter
+	}
+	return limiter
+}
+
+// limitedTransport applies the limiter of a service to every request, including
+// the retries, before sending it with the next transport.
+type limitedTransport struct {
+	limiter *serviceLimiter
+	next    http.RoundTripper
+}
+
+func (t *limitedTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	release, err := t.limiter.acquire(req.Context())
+	if err != nil {
+		return nil, err
+	}
+	resp, err := t.next.RoundTrip(req)
+	if err != nil || resp.Body == nil {
+		release()
+		return resp, err
+	}
+	// The request is in flight until its response is read
+	resp.Body = &releaseOnClose{ReadCloser: resp.Body, release: release}
+	return resp, nil
+}
+
+type releaseOnClose struct {
+	io.ReadCloser
+	release func()
+}
+
+func (r *releaseOnClose) Close() error {
+	err := r.ReadCloser.Close()
+	r.release()
+	return err
+}
+
+// retryBackoff waits for the duration requested by the Retry-After header of
+// the response, otherwise for an exponential backoff between min and max. Both
+// are jittered so that the parallel operations throttled at the same time do not
+// retry at the same time.
+func retryBackoff(min, max time.Duration, attemptNum int, resp *http.Response) time.Duration {
+	if resp != nil {
+		if wait, ok := retryAfter(resp.Header.Get("Retry-After")); ok {
+			return wait + jitter(min)
+		}
+	}
+	backoff := float64(min) * math.Pow(2, float64(attemptNum))
+	if backoff <= 0 || backoff > float64(max) {
+		backoff = float64(max)
+	}
+	// Equal jitter, half of the backoff is kept so that the wait still grows
+	return time.Duration(backoff/2) + jitter(time.Duration(backoff/2))
+}
+
+func retryAfter(value string) (time.Duration, bool) {
+	if value == "" {
+		return 0, false
+	}
+	if seconds, err := strconv.ParseInt(value, 10, 64); err == nil && seconds >= 0 {
+		return time.Duration(seconds) * time.Second, true
+	}
+	if at, err := http.ParseTime(value); err == nil {
+		if wait := time.Until(at); wait > 0 {
+			return wait, true
+		}
+		return 0, true
+	}
+	return 0, false
+}
+
+func jitter(d time.Duration) time.Duration {
+	if d <= 0 {
+		return 0
+	}
+	return time.Duration(rand.Int63n(int64(d) + 1))
+}
+
+// enableRetries enables the retries of a service client with the retry backoff,
+// and applies the service limits of the provider to its requests.
+func (session *clientSession) enableRetries(service *core.BaseService, name string) {
+	c := session.config
+	service.EnableRetries(c.RetryCount, c.RetryDelay)
+	if tr, ok := service.Client.Transport.(*retryablehttp.RoundTripper); ok {
+		tr.Client.Backoff = retryBackoff
+	}
+
+	limiter := session.serviceLimiters.get(name)
+	if limiter == nil {
+		return
+	}
+	client := service.GetHTTPClient()
+	if _, ok := client.Transport.(*limitedTransport); ok {
+		return
+	}
+	next := client.Transport
+	if next == nil {
+		next = http.DefaultTransport
+	}
+	client.Transport = &limitedTransport{limiter: limiter, next: next}
+}
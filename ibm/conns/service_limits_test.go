@@ -0,0 +1,139 @@
+// Copyright IBM Corp. 2025 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+package conns
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/hashicorp/go-retryablehttp"
+)
+
+func TestServiceLimitersDefault(t *testing.T) {
+	limiters := newServiceLimiters(map[string]ServiceLimit{
+		"is":                {MaxInFlight: 2},
+		"cis":               {},
+		DefaultServiceLimit: {RequestsPerSecond: 5},
+	})
+
+	if limiters.get("is") != limiters.get("is") {
+		t.Fatal("Expected the clients of a service to share a limiter.")
+	}
+	if limiters.get("cis") != nil {
+		t.Fatal("Expected no limiter for a service limit without limits.")
+	}
+	if limiters.get("schematics") == nil || limiters.get("schematics") != limiters.get("iam") {
+		t.Fatal("Expected the services without their own limit to share the default limiter.")
+	}
+	if newServiceLimiters(nil).get("is") != nil {
+		t.Fatal("Expected no limiter without service limits.")
+	}
+}
+
+func TestLimitedTransportMaxInFlight(t *testing.T) {
+	var inFlight, maxInFlight int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		n := atomic.AddInt32(&inFlight, 1)
+		defer atomic.AddInt32(&inFlight, -1)
+		for {
+			max := atomic.LoadInt32(&maxInFlight)
+			if n <= max || atomic.CompareAndSwapInt32(&maxInFlight, max, n) {
+				break
+			}
+		}
+		time.Sleep(20 * time.Millisecond)
+		io.WriteString(w, "{}")
+	}))
+	defer server.Close()
+
+	client := &http.Client{Transport: &limitedTransport{
+		limiter: newServiceLimiter("is", ServiceLimit{MaxInFlight: 2}),
+		next:    http.DefaultTransport,
+	}}
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			resp, err := client.Get(server.URL)
+			if err != nil {
+				t.Error(err)
+				return
+			}
+			io.Copy(io.Discard, resp.Body)
+			resp.Body.Close()
+		}()
+	}
+	wg.Wait()
+
+	if maxInFlight > 2 {
+		t.Fatalf("Expected at most 2 requests in flight, got %d", maxInFlight)
+	}
+}
+
+func TestServiceLimiterContextCanceled(t *testing.T) {
+	limiter := newServiceLimiter("is", ServiceLimit{MaxInFlight: 1})
+	release, err := limiter.acquire(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer release()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	if _, err := limiter.acquire(ctx); err != context.DeadlineExceeded {
+		t.Fatalf("Expected context.DeadlineExceeded, got %v", err)
+	}
+}
+
+func TestRetryBackoff(t *testing.T) {
+	min, max := time.Second, 30*time.Second
+
+	resp := &http.Response{Header: http.Header{"Retry-After": {"10"}}}
+	if wait := retryBackoff(min, max, 0, resp); wait < 10*time.Second || wait > 11*time.Second {
+		t.Fatalf("Expected the Retry-After header to be honoured, got %s", wait)
+	}
+
+	for attempt := 0; attempt < 10; attempt++ {
+		backoff := min << attempt
+		if backoff > max {
+			backoff = max
+		}
+		wait := retryBackoff(min, max, attempt, &http.Response{Header: http.Header{}})
+		if wait < backoff/2 || wait > backoff {
+			t.Fatalf("Expected a backoff between %s and %s for attempt %d, got %s", backoff/2, backoff, attempt, wait)
+		}
+	}
+}
+
+func TestEnableRetriesServiceLimits(t *testing.T) {
+	session := testClientSession()
+	session.config.RetryCount = 3
+	session.config.RetryDelay = time.Second
+	session.serviceLimiters = newServiceLimiters(map[string]ServiceLimit{"is": {MaxInFlight: 1}})
+
+	service, err := core.NewBaseService(&core.ServiceOptions{URL: "https://example.com", Authenticator: &core.NoAuthAuthenticator{}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	session.enableRetries(service, "is")
+	session.enableRetries(service, "is")
+
+	transport, ok := service.GetHTTPClient().Transport.(*limitedTransport)
+	if !ok {
+		t.Fatal("Expected the service client to use the limited transport.")
+	}
+	if _, ok := transport.next.(*limitedTransport); ok {
+		t.Fatal("Expected the limited transport to be applied once.")
+	}
+	if _, ok := service.Client.Transport.(*retryablehttp.RoundTripper); !ok {
+		t.Fatal("Expected retries to be enabled.")
+	}
+}
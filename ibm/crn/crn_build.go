@@ -0,0 +1,134 @@
+// Copyright IBM Corp. 2025 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package crn
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ function.Function = CRNBuildFunction{}
+
+// crnComponentTypes describes the object accepted by crn_build. It is a subset
+// of the crn_parse result, so a parsed CRN can be merged with overrides and
+// passed back in.
+var crnComponentTypes = map[string]attr.Type{
+	"cname":            types.StringType,
+	"ctype":            types.StringType,
+	"service_name":     types.StringType,
+	"region":           types.StringType,
+	"scope_type":       types.StringType,
+	"scope":            types.StringType,
+	"service_instance": types.StringType,
+	"resource_type":    types.StringType,
+	"resource":         types.StringType,
+}
+
+type crnComponentsModel struct {
+	CName           types.String `tfsdk:"cname"`
+	CType           types.String `tfsdk:"ctype"`
+	ServiceName     types.String `tfsdk:"service_name"`
+	Region          types.String `tfsdk:"region"`
+	ScopeType       types.String `tfsdk:"scope_type"`
+	Scope           types.String `tfsdk:"scope"`
+	ServiceInstance types.String `tfsdk:"service_instance"`
+	ResourceType    types.String `tfsdk:"resource_type"`
+	Resource        types.String `tfsdk:"resource"`
+}
+
+type CRNBuildFunction struct{}
+
+func NewCRNBuildFunction() function.Function {
+	return CRNBuildFunction{}
+}
+
+func (f CRNBuildFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "crn_build"
+}
+
+func (f CRNBuildFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:     "Builds a Cloud Resource Name from its components",
+		Description: "Assembles a version 1 CRN from an object with the cname, ctype, service_name, region, scope_type, scope, service_instance, resource_type and resource attributes. Null attributes are left empty, and the result of crn_parse is accepted as is.",
+		Parameters: []function.Parameter{
+			function.ObjectParameter{
+				Name:           "components",
+				Description:    "The CRN components.",
+				AttributeTypes: crnComponentTypes,
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f CRNBuildFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var components crnComponentsModel
+
+	resp.Error = req.Arguments.Get(ctx, &components)
+	if resp.Error != nil {
+		return
+	}
+
+	crn := flex.CRN{
+		Scheme:          "crn",
+		Version:         "v1",
+		CName:           components.CName.ValueString(),
+		CType:           components.CType.ValueString(),
+		ServiceName:     components.ServiceName.ValueString(),
+		Region:          components.Region.ValueString(),
+		ScopeType:       components.ScopeType.ValueString(),
+		Scope:           components.Scope.ValueString(),
+		ServiceInstance: components.ServiceInstance.ValueString(),
+		ResourceType:    components.ResourceType.ValueString(),
+		Resource:        components.Resource.ValueString(),
+	}
+
+	if err := validateCRNComponents(crn); err != nil {
+		resp.Error = function.NewArgumentFuncError(0, err.Error())
+		return
+	}
+
+	resp.Error = resp.Result.Set(ctx, crn.String())
+}
+
+// validateCRNComponents rejects components that would not parse back into the
+// same CRN.
+func validateCRNComponents(crn flex.CRN) error {
+	if crn.CName == "" || crn.CType == "" || crn.ServiceName == "" {
+		return fmt.Errorf("cname, ctype and service_name must be set")
+	}
+	if crn.ScopeType != "" && crn.Scope == "" {
+		return fmt.Errorf("scope must be set when scope_type is %q", crn.ScopeType)
+	}
+	if crn.ScopeType == "" && crn.Scope != "" && crn.Scope != "global" {
+		return fmt.Errorf("scope_type must be set when scope is %q", crn.Scope)
+	}
+	if strings.Contains(crn.ScopeType, "/") || strings.Contains(crn.Scope, "/") {
+		return fmt.Errorf("scope_type and scope must not contain %q", "/")
+	}
+
+	components := map[string]string{
+		"cname":            crn.CName,
+		"ctype":            crn.CType,
+		"service_name":     crn.ServiceName,
+		"region":           crn.Region,
+		"scope_type":       crn.ScopeType,
+		"scope":            crn.Scope,
+		"service_instance": crn.ServiceInstance,
+		"resource_type":    crn.ResourceType,
+		"resource":         crn.Resource,
+	}
+	for name, value := range components {
+		if strings.Contains(value, ":") {
+			return fmt.Errorf("%s must not contain %q", name, ":")
+		}
+	}
+	return nil
+}
@@ -0,0 +1,91 @@
+// Copyright IBM Corp. 2025 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package crn
+
+import (
+	"context"
+	"testing"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func runCRNBuild(t *testing.T, components map[string]attr.Value) function.RunResponse {
+	t.Helper()
+	for name := range crnComponentTypes {
+		if _, ok := components[name]; !ok {
+			components[name] = types.StringNull()
+		}
+	}
+	req := function.RunRequest{
+		Arguments: function.NewArgumentsData([]attr.Value{types.ObjectValueMust(crnComponentTypes, components)}),
+	}
+	resp := function.RunResponse{
+		Result: function.NewResultData(types.StringUnknown()),
+	}
+	NewCRNBuildFunction().Run(context.Background(), req, &resp)
+	return resp
+}
+
+func TestCRNBuildRoundTripsFlexParse(t *testing.T) {
+	for _, value := range []string{testAccountCRN, testGlobalCRN, testStagingCRN, testPrivateCRN} {
+		crn, err := flex.Parse(value)
+		assert.Nil(t, err, value)
+
+		resp := runCRNBuild(t, map[string]attr.Value{
+			"cname":            types.StringValue(crn.CName),
+			"ctype":            types.StringValue(crn.CType),
+			"service_name":     types.StringValue(crn.ServiceName),
+			"region":           types.StringValue(crn.Region),
+			"scope_type":       types.StringValue(crn.ScopeType),
+			"scope":            types.StringValue(crn.Scope),
+			"service_instance": types.StringValue(crn.ServiceInstance),
+			"resource_type":    types.StringValue(crn.ResourceType),
+			"resource":         types.StringValue(crn.Resource),
+		})
+		assert.Nil(t, resp.Error, value)
+		assert.Equal(t, types.StringValue(value), resp.Result.Value(), value)
+	}
+}
+
+func TestCRNBuildNullComponents(t *testing.T) {
+	resp := runCRNBuild(t, map[string]attr.Value{
+		"cname":        types.StringValue("bluemix"),
+		"ctype":        types.StringValue("public"),
+		"service_name": types.StringValue("iam"),
+		"scope":        types.StringValue("global"),
+	})
+	assert.Nil(t, resp.Error)
+	assert.Equal(t, types.StringValue("crn:v1:bluemix:public:iam::global:::"), resp.Result.Value())
+}
+
+func TestCRNBuildInvalidComponents(t *testing.T) {
+	for name, components := range map[string]map[string]attr.Value{
+		"missing service_name": {
+			"cname": types.StringValue("bluemix"),
+			"ctype": types.StringValue("public"),
+		},
+		"scope without scope_type": {
+			"cname":        types.StringValue("bluemix"),
+			"ctype":        types.StringValue("public"),
+			"service_name": types.StringValue("is"),
+			"scope":        types.StringValue("2e2f1b1a5c2d4e4b9d8a1b2c3d4e5f60"),
+		},
+		"separator in resource": {
+			"cname":         types.StringValue("bluemix"),
+			"ctype":         types.StringValue("public"),
+			"service_name":  types.StringValue("is"),
+			"resource_type": types.StringValue("instance"),
+			"resource":      types.StringValue("a:b"),
+		},
+	} {
+		resp := runCRNBuild(t, components)
+		if assert.NotNil(t, resp.Error, name) {
+			assert.Equal(t, int64(0), *resp.Error.FunctionArgument, name)
+		}
+	}
+}
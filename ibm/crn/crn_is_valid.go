@@ -0,0 +1,48 @@
+// Copyright IBM Corp. 2025 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package crn
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+)
+
+var _ function.Function = CRNIsValidFunction{}
+
+type CRNIsValidFunction struct{}
+
+func NewCRNIsValidFunction() function.Function {
+	return CRNIsValidFunction{}
+}
+
+func (f CRNIsValidFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "crn_is_valid"
+}
+
+func (f CRNIsValidFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:     "Checks whether a string is a well formed Cloud Resource Name",
+		Description: "Returns true when the value has the ten CRN segments and a valid scope, so it can be used in variable validations without raising an error.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "crn",
+				Description: "The CRN to check.",
+			},
+		},
+		Return: function.BoolReturn{},
+	}
+}
+
+func (f CRNIsValidFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var value string
+
+	resp.Error = req.Arguments.Get(ctx, &value)
+	if resp.Error != nil {
+		return
+	}
+
+	_, err := parseCRN(value)
+	resp.Error = resp.Result.Set(ctx, err == nil)
+}
@@ -0,0 +1,37 @@
+// Copyright IBM Corp. 2025 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package crn
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCRNIsValid(t *testing.T) {
+	for value, expected := range map[string]bool{
+		testAccountCRN:                        true,
+		testGlobalCRN:                         true,
+		testStagingCRN:                        true,
+		testPrivateCRN:                        true,
+		"":                                    false,
+		"crn:v1:bluemix:public":               false,
+		"crn:v1:bluemix:public:iam::a/b/c:::": false,
+	} {
+		req := function.RunRequest{
+			Arguments: function.NewArgumentsData([]attr.Value{types.StringValue(value)}),
+		}
+		resp := function.RunResponse{
+			Result: function.NewResultData(types.BoolUnknown()),
+		}
+		NewCRNIsValidFunction().Run(context.Background(), req, &resp)
+
+		assert.Nil(t, resp.Error, value)
+		assert.Equal(t, types.BoolValue(expected), resp.Result.Value(), value)
+	}
+}
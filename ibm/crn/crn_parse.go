@@ -0,0 +1,111 @@
+// Copyright IBM Corp. 2025 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package crn
+
+import (
+	"context"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ function.Function = CRNParseFunction{}
+
+// crnAttributeTypes describes the object returned by crn_parse.
+var crnAttributeTypes = map[string]attr.Type{
+	"version":          types.StringType,
+	"cname":            types.StringType,
+	"ctype":            types.StringType,
+	"service_name":     types.StringType,
+	"region":           types.StringType,
+	"location":         types.StringType,
+	"scope_type":       types.StringType,
+	"scope":            types.StringType,
+	"account_id":       types.StringType,
+	"service_instance": types.StringType,
+	"resource_type":    types.StringType,
+	"resource":         types.StringType,
+}
+
+type crnModel struct {
+	Version         string `tfsdk:"version"`
+	CName           string `tfsdk:"cname"`
+	CType           string `tfsdk:"ctype"`
+	ServiceName     string `tfsdk:"service_name"`
+	Region          string `tfsdk:"region"`
+	Location        string `tfsdk:"location"`
+	ScopeType       string `tfsdk:"scope_type"`
+	Scope           string `tfsdk:"scope"`
+	AccountID       string `tfsdk:"account_id"`
+	ServiceInstance string `tfsdk:"service_instance"`
+	ResourceType    string `tfsdk:"resource_type"`
+	Resource        string `tfsdk:"resource"`
+}
+
+type CRNParseFunction struct{}
+
+func NewCRNParseFunction() function.Function {
+	return CRNParseFunction{}
+}
+
+func (f CRNParseFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "crn_parse"
+}
+
+func (f CRNParseFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:     "Parses a Cloud Resource Name into its components",
+		Description: "Splits a CRN of the form crn:version:cname:ctype:service-name:location:scope:service-instance:resource-type:resource into an object. The location attribute matches the location reported by resource instances, and account_id is set for account scoped CRNs.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "crn",
+				Description: "The CRN to parse.",
+			},
+		},
+		Return: function.ObjectReturn{
+			AttributeTypes: crnAttributeTypes,
+		},
+	}
+}
+
+func (f CRNParseFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var value string
+
+	resp.Error = req.Arguments.Get(ctx, &value)
+	if resp.Error != nil {
+		return
+	}
+
+	crn, err := parseCRN(value)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(0, err.Error())
+		return
+	}
+
+	resp.Error = resp.Result.Set(ctx, crnModel{
+		Version:         crn.Version,
+		CName:           crn.CName,
+		CType:           crn.CType,
+		ServiceName:     crn.ServiceName,
+		Region:          crn.Region,
+		Location:        crn.Location(),
+		ScopeType:       crn.ScopeType,
+		Scope:           crn.Scope,
+		AccountID:       crn.AccountID(),
+		ServiceInstance: crn.ServiceInstance,
+		ResourceType:    crn.ResourceType,
+		Resource:        crn.Resource,
+	})
+}
+
+// parseCRN wraps flex.Parse, which accepts an empty string, so that an empty
+// value is reported as malformed too.
+func parseCRN(value string) (flex.CRN, error) {
+	if value == "" {
+		return flex.CRN{}, flex.ErrMalformedCRN
+	}
+	return flex.Parse(value)
+}
@@ -0,0 +1,92 @@
+// Copyright IBM Corp. 2025 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package crn
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	bxcrn "github.com/IBM-Cloud/bluemix-go/crn"
+	"github.com/IBM-Cloud/bluemix-go/models"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+)
+
+const (
+	testAccountCRN = "crn:v1:bluemix:public:cloud-object-storage:global:a/2e2f1b1a5c2d4e4b9d8a1b2c3d4e5f60:1a2b3c4d-5e6f-7a8b-9c0d-1e2f3a4b5c6d:bucket:my-bucket"
+	testGlobalCRN  = "crn:v1:bluemix:public:iam::::role:Viewer"
+	testStagingCRN = "crn:v1:staging:public:is:us-south-1:a/2e2f1b1a5c2d4e4b9d8a1b2c3d4e5f60::instance:0717-1234"
+	testPrivateCRN = "crn:v1:ys1:private:databases-for-redis:eu-de:a/2e2f1b1a5c2d4e4b9d8a1b2c3d4e5f60:f00d::"
+)
+
+func runCRNParse(t *testing.T, value string) (function.RunResponse, map[string]attr.Value) {
+	t.Helper()
+	req := function.RunRequest{
+		Arguments: function.NewArgumentsData([]attr.Value{types.StringValue(value)}),
+	}
+	resp := function.RunResponse{
+		Result: function.NewResultData(types.ObjectUnknown(crnAttributeTypes)),
+	}
+	NewCRNParseFunction().Run(context.Background(), req, &resp)
+	object, _ := resp.Result.Value().(types.Object)
+	return resp, object.Attributes()
+}
+
+func TestCRNParseMatchesFlexParse(t *testing.T) {
+	for _, value := range []string{testAccountCRN, testGlobalCRN, testStagingCRN, testPrivateCRN} {
+		resp, attributes := runCRNParse(t, value)
+		assert.Nil(t, resp.Error, value)
+
+		expected, err := flex.Parse(value)
+		assert.Nil(t, err, value)
+		assert.Equal(t, types.StringValue(expected.Version), attributes["version"], value)
+		assert.Equal(t, types.StringValue(expected.CName), attributes["cname"], value)
+		assert.Equal(t, types.StringValue(expected.CType), attributes["ctype"], value)
+		assert.Equal(t, types.StringValue(expected.ServiceName), attributes["service_name"], value)
+		assert.Equal(t, types.StringValue(expected.Region), attributes["region"], value)
+		assert.Equal(t, types.StringValue(expected.ScopeType), attributes["scope_type"], value)
+		assert.Equal(t, types.StringValue(expected.Scope), attributes["scope"], value)
+		assert.Equal(t, types.StringValue(expected.ServiceInstance), attributes["service_instance"], value)
+		assert.Equal(t, types.StringValue(expected.ResourceType), attributes["resource_type"], value)
+		assert.Equal(t, types.StringValue(expected.Resource), attributes["resource"], value)
+	}
+}
+
+func TestCRNParseLocationMatchesGetLocation(t *testing.T) {
+	for _, value := range []string{testAccountCRN, testStagingCRN, testPrivateCRN} {
+		_, attributes := runCRNParse(t, value)
+
+		instanceCRN, err := bxcrn.Parse(value)
+		assert.Nil(t, err, value)
+		instance := models.ServiceInstanceV2{}
+		instance.Crn = instanceCRN
+		expected := flex.GetLocation(instance)
+		assert.Equal(t, types.StringValue(expected), attributes["location"], value)
+	}
+}
+
+func TestCRNParseAccountIDMatchesIdParts(t *testing.T) {
+	_, attributes := runCRNParse(t, testAccountCRN)
+
+	parts, err := flex.IdParts(strings.Split(testAccountCRN, ":")[6])
+	assert.Nil(t, err)
+	assert.Equal(t, types.StringValue(parts[1]), attributes["account_id"])
+
+	_, attributes = runCRNParse(t, "crn:v1:bluemix:public:iam-identity::global::apikey:ApiKey-1234")
+	assert.Equal(t, types.StringValue(""), attributes["account_id"])
+	assert.Equal(t, types.StringValue("global"), attributes["scope"])
+}
+
+func TestCRNParseMalformed(t *testing.T) {
+	for _, value := range []string{"", "crn:v1:bluemix:public", "arn:v1:bluemix:public:iam::::role:Viewer", "crn:v1:bluemix:public:iam::a/b/c:::"} {
+		resp, _ := runCRNParse(t, value)
+		if assert.NotNil(t, resp.Error, value) {
+			assert.Equal(t, int64(0), *resp.Error.FunctionArgument, value)
+		}
+	}
+}
@@ -0,0 +1,269 @@
+// Copyright IBM Corp. 2025 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package flex
+
+import (
+	"errors"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+// ProblemHint is a remediation hint for a known problem signature. Except for Name and
+// Remediation, the fields select the problems that the hint applies to. An empty field
+// matches any problem, and a problem must match every field that is set.
+type ProblemHint struct {
+	// Name identifies the hint in the catalog, it does not change between releases.
+	Name string
+
+	// Resources lists the resource names. A name that ends with "*" matches a prefix.
+	Resources []string
+	// Operations lists the operations, such as "create" or "delete".
+	Operations []string
+	// StatusCodes lists the HTTP status codes of the failed request.
+	StatusCodes []int
+	// ErrorCodes lists the error codes in the response of the failed request.
+	ErrorCodes []string
+	// Messages lists case-insensitive substrings of the error message.
+	Messages []string
+
+	Remediation string
+}
+
+// problemCatalog lists the known problem signatures per service. The first hint that
+// matches is used, so specific hints are listed before the generic ones.
+var problemCatalog = [][]ProblemHint{
+	vpcProblemHints,
+	powerProblemHints,
+	kubernetesProblemHints,
+	resourceControllerProblemHints,
+	cosProblemHints,
+	genericProblemHints,
+}
+
+var vpcProblemHints = []ProblemHint{
+	{
+		Name:        "vpc-instance-create-forbidden",
+		Resources:   []string{"ibm_is_instance"},
+		Operations:  []string{"create"},
+		StatusCodes: []int{403},
+		Remediation: "The credentials are not authorized to create the instance. Creating an instance requires the Editor role on the VPC Infrastructure Services in the resource group of the instance, and access to the image, the subnets, the SSH keys and the volumes that it uses.",
+	},
+	{
+		Name:        "vpc-over-quota",
+		Resources:   []string{"ibm_is_*"},
+		ErrorCodes:  []string{"over_quota", "quota_exceeded"},
+		Remediation: "The account has reached a VPC quota for this type of resource. Delete the resources that are not used, or request a quota increase through a support case.",
+	},
+	{
+		Name:        "vpc-delete-conflict",
+		Resources:   []string{"ibm_is_*"},
+		Operations:  []string{"delete"},
+		StatusCodes: []int{409},
+		Remediation: "The resource is still in use, for example a subnet with network interfaces or a security group with targets. Delete or detach the resources that depend on it first, or declare the dependency in the configuration so that Terraform deletes them in order.",
+	},
+	{
+		Name:        "vpc-conflict",
+		Resources:   []string{"ibm_is_*"},
+		StatusCodes: []int{409, 412},
+		Remediation: "The resource changed since it was read, or it is in a transitional state. Run terraform apply again once the resource is stable.",
+	},
+}
+
+var powerProblemHints = []ProblemHint{
+	{
+		Name:        "power-instance-quota-exceeded",
+		Resources:   []string{"ibm_pi_instance"},
+		Messages:    []string{"quota", "not enough", "insufficient"},
+		Remediation: "The workspace does not have enough processors, memory or storage quota for the instance. Reduce pi_processors, pi_memory or the volume sizes, or request a quota increase for the Power Virtual Server workspace.",
+	},
+	{
+		Name:        "power-conflict",
+		Resources:   []string{"ibm_pi_*"},
+		StatusCodes: []int{409},
+		Remediation: "Another operation is in progress on the resource or on the instance that it is attached to. Wait for the operation to complete, then run terraform apply again.",
+	},
+}
+
+var kubernetesProblemHints = []ProblemHint{
+	{
+		Name:        "container-conflict",
+		Resources:   []string{"ibm_container_*"},
+		StatusCodes: []int{409},
+		Remediation: "Another operation is in progress on the cluster or the worker pool, such as a master or worker update. Wait for the cluster to be in the normal state, then run terraform apply again.",
+	},
+}
+
+var resourceControllerProblemHints = []ProblemHint{
+	{
+		Name:        "resource-instance-conflict",
+		Resources:   []string{"ibm_resource_instance", "ibm_resource_key"},
+		StatusCodes: []int{409},
+		Remediation: "The service instance has a pending operation, or a reclaimed instance with the same name exists. Wait for the operation to complete, or restore or delete the reclaimed instance with `ibmcloud resource reclamations`.",
+	},
+}
+
+var cosProblemHints = []ProblemHint{
+	{
+		Name:        "cos-bucket-name-conflict",
+		Resources:   []string{"ibm_cos_bucket"},
+		Operations:  []string{"create"},
+		Messages:    []string{"BucketAlreadyExists"},
+		Remediation: "Bucket names are unique across all of IBM Cloud Object Storage. Choose another bucket_name.",
+	},
+}
+
+var genericProblemHints = []ProblemHint{
+	{
+		Name:        "iam-unauthorized",
+		StatusCodes: []int{401},
+		Remediation: "The IAM token was rejected. Check that ibmcloud_api_key, iam_token or iam_profile_id is valid and that the API key was not deleted or locked.",
+	},
+	{
+		Name:        "iam-forbidden",
+		StatusCodes: []int{403},
+		Remediation: "The credentials are not authorized for this operation. Check the IAM access policies of the user, service ID or trusted profile used by the provider, including the access to the resource group.",
+	},
+	{
+		Name:        "rate-limited",
+		StatusCodes: []int{429},
+		Remediation: "The requests were rate limited. Reduce the number of concurrent operations with terraform apply -parallelism, or increase max_retries in the provider block.",
+	},
+}
+
+// FindProblemHint returns the first hint of the catalog that matches the problem, or nil.
+func FindProblemHint(problem *TerraformProblem) *ProblemHint {
+	if problem == nil {
+		return nil
+	}
+	statusCode, errorCode := problemResponse(problem)
+	message := strings.ToLower(problem.Error())
+	for _, hints := range problemCatalog {
+		for i := range hints {
+			if hints[i].matches(problem.Resource, problem.Operation, statusCode, errorCode, message) {
+				return &hints[i]
+			}
+		}
+	}
+	return nil
+}
+
+// LookupProblemHint returns the hint of the catalog with the given name, or nil.
+func LookupProblemHint(name string) *ProblemHint {
+	for _, hints := range problemCatalog {
+		for i := range hints {
+			if hints[i].Name == name {
+				return &hints[i]
+			}
+		}
+	}
+	return nil
+}
+
+func (h *ProblemHint) matches(resource, operation string, statusCode int, errorCode, message string) bool {
+	if len(h.Resources) > 0 && !matchesResource(h.Resources, resource) {
+		return false
+	}
+	if len(h.Operations) > 0 && !containsString(h.Operations, operation) {
+		return false
+	}
+	if len(h.StatusCodes) > 0 && !containsInt(h.StatusCodes, statusCode) {
+		return false
+	}
+	if len(h.ErrorCodes) > 0 && !containsString(h.ErrorCodes, errorCode) && !containsAny(message, h.ErrorCodes) {
+		return false
+	}
+	if len(h.Messages) > 0 && !containsAny(message, h.Messages) {
+		return false
+	}
+	return true
+}
+
+func matchesResource(patterns []string, resource string) bool {
+	// Data sources are reported as "(Data) <name>" by the provider
+	resource = strings.TrimPrefix(resource, "(Data) ")
+	for _, pattern := range patterns {
+		if prefix, ok := strings.CutSuffix(pattern, "*"); ok {
+			if strings.HasPrefix(resource, prefix) {
+				return true
+			}
+		} else if pattern == resource {
+			return true
+		}
+	}
+	return false
+}
+
+func containsString(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
+
+func containsInt(values []int, value int) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
+
+func containsAny(message string, substrings []string) bool {
+	for _, s := range substrings {
+		if strings.Contains(message, strings.ToLower(s)) {
+			return true
+		}
+	}
+	return false
+}
+
+// statusCodePatterns find the status code in the messages of the errors that do not come from
+// the IBM Go SDK core, such as the errors of the Power clients: "[POST /pcloud/v1/...][409] ..."
+var statusCodePatterns = []*regexp.Regexp{
+	regexp.MustCompile(`\]\[(\d{3})\]`),
+	regexp.MustCompile(`"StatusCode":\s*(\d{3})`),
+	regexp.MustCompile(`(?i)status code:?\s*(\d{3})`),
+}
+
+// problemResponse returns the status code and the error code of the failed request that caused the problem
+func problemResponse(problem *TerraformProblem) (int, string) {
+	var httpProblem *core.HTTPProblem
+	if errors.As(problem, &httpProblem) && httpProblem.Response != nil {
+		return httpProblem.Response.GetStatusCode(), responseErrorCode(httpProblem.Response.GetResult())
+	}
+	message := problem.Error()
+	for _, pattern := range statusCodePatterns {
+		if match := pattern.FindStringSubmatch(message); match != nil {
+			statusCode, _ := strconv.Atoi(match[1])
+			return statusCode, ""
+		}
+	}
+	return 0, ""
+}
+
+func responseErrorCode(result interface{}) string {
+	response, ok := result.(map[string]interface{})
+	if !ok {
+		return ""
+	}
+	if errs, ok := response["errors"].([]interface{}); ok && len(errs) > 0 {
+		if first, ok := errs[0].(map[string]interface{}); ok {
+			if code, ok := first["code"].(string); ok {
+				return code
+			}
+		}
+	}
+	for _, key := range []string{"code", "errorCode"} {
+		if code, ok := response[key].(string); ok {
+			return code
+		}
+	}
+	return ""
+}
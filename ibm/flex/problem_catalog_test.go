@@ -0,0 +1,122 @@
+// Copyright IBM Corp. 2025 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package flex
+
+import (
+	"encoding/json"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/stretchr/testify/assert"
+)
+
+func getHTTPTerraformProblem(statusCode int, result interface{}, resource, operation string) *TerraformProblem {
+	httpProblem := &core.HTTPProblem{
+		IBMProblem: &core.IBMProblem{
+			Summary:   "Request failed.",
+			Component: core.NewProblemComponent("github.com/IBM/vpc-go-sdk", "1.0.0"),
+			Severity:  core.ErrorSeverity,
+		},
+		OperationID: "operation",
+		Response:    &core.DetailedResponse{StatusCode: statusCode, Result: result},
+	}
+	return TerraformErrorf(httpProblem, "CreateInstanceWithContext failed", resource, operation)
+}
+
+func TestFindProblemHint(t *testing.T) {
+	hint := FindProblemHint(getHTTPTerraformProblem(403, nil, "ibm_is_instance", "create"))
+	assert.NotNil(t, hint)
+	assert.Equal(t, "vpc-instance-create-forbidden", hint.Name)
+
+	hint = FindProblemHint(getHTTPTerraformProblem(403, nil, "ibm_is_vpc", "create"))
+	assert.NotNil(t, hint)
+	assert.Equal(t, "iam-forbidden", hint.Name)
+
+	result := map[string]interface{}{"errors": []interface{}{map[string]interface{}{"code": "over_quota"}}}
+	hint = FindProblemHint(getHTTPTerraformProblem(400, result, "(Data) ibm_is_volume", "create"))
+	assert.NotNil(t, hint)
+	assert.Equal(t, "vpc-over-quota", hint.Name)
+
+	hint = FindProblemHint(getHTTPTerraformProblem(409, nil, "ibm_is_subnet", "delete"))
+	assert.NotNil(t, hint)
+	assert.Equal(t, "vpc-delete-conflict", hint.Name)
+
+	hint = FindProblemHint(getHTTPTerraformProblem(404, nil, "ibm_is_subnet", "read"))
+	assert.Nil(t, hint)
+	assert.Nil(t, FindProblemHint(nil))
+}
+
+func TestFindProblemHintFromMessage(t *testing.T) {
+	err := errors.New("[POST /pcloud/v1/cloud-instances/{cloud_instance_id}/pvm-instances][400] pcloudPvminstancesPostBadRequest: not enough processors quota available")
+	hint := FindProblemHint(TerraformErrorf(err, "", "ibm_pi_instance", "create"))
+	assert.NotNil(t, hint)
+	assert.Equal(t, "power-instance-quota-exceeded", hint.Name)
+
+	err = errors.New("[PUT /pcloud/v1/cloud-instances/{cloud_instance_id}/volumes/{volume_id}][409] pcloudCloudinstancesVolumesPutConflict")
+	hint = FindProblemHint(TerraformErrorf(err, "", "ibm_pi_volume", "update"))
+	assert.NotNil(t, hint)
+	assert.Equal(t, "power-conflict", hint.Name)
+}
+
+func TestLookupProblemHint(t *testing.T) {
+	assert.Equal(t, "rate-limited", LookupProblemHint("rate-limited").Name)
+	assert.Nil(t, LookupProblemHint("unknown"))
+
+	names := map[string]bool{}
+	for _, hints := range problemCatalog {
+		for _, hint := range hints {
+			assert.NotEmpty(t, hint.Remediation, hint.Name)
+			assert.False(t, names[hint.Name], "duplicate hint %s", hint.Name)
+			names[hint.Name] = true
+		}
+	}
+}
+
+func TestTerraformProblemHintOutput(t *testing.T) {
+	terraformProb := getPopulatedTerraformProblem()
+	terraformProb.Hint = LookupProblemHint("iam-forbidden")
+
+	assert.Contains(t, terraformProb.GetConsoleMessage(), "hint: iam-forbidden\n")
+
+	var output map[string]interface{}
+	assert.Nil(t, json.Unmarshal([]byte(terraformProb.GetConsoleJSON()), &output))
+	assert.Equal(t, "terraform-98c0e1fd", output["id"])
+	assert.Equal(t, "iam-forbidden", output["hint"])
+	assert.Equal(t, terraformProb.Hint.Remediation, output["remediation"])
+	assert.Equal(t, map[string]interface{}{"name": MODULE_NAME, "version": MOCK_VERSION}, output["component"])
+
+	t.Setenv(ErrorOutputEnv, "json")
+	assert.Equal(t, terraformProb.GetConsoleJSON(), terraformProb.GetConsoleOutput())
+	t.Setenv(ErrorOutputEnv, "")
+	assert.Equal(t, terraformProb.GetConsoleMessage(), terraformProb.GetConsoleOutput())
+}
+
+func TestTerraformProblemDiagHint(t *testing.T) {
+	diags := getHTTPTerraformProblem(403, nil, "ibm_is_instance", "create").GetDiag()
+	assert.True(t, diags.HasError())
+	assert.Contains(t, diags[0].Summary, "hint: vpc-instance-create-forbidden\n")
+
+	t.Setenv(ErrorOutputEnv, "json")
+	diags = getHTTPTerraformProblem(409, nil, "ibm_is_subnet", "delete").GetDiag()
+	var output map[string]interface{}
+	assert.Nil(t, json.Unmarshal([]byte(diags[0].Summary), &output))
+	assert.Equal(t, "vpc-delete-conflict", output["hint"])
+	assert.Equal(t, "ibm_is_subnet", output["resource"])
+
+	fwDiags := getHTTPTerraformProblem(429, nil, "ibm_sm_kv_secret", "open").GetFrameworkDiag()
+	assert.Nil(t, json.Unmarshal([]byte(fwDiags[0].Summary()), &output))
+	assert.Equal(t, "rate-limited", output["hint"])
+}
+
+func TestTerraformProblemConsoleOutputKeepsProblem(t *testing.T) {
+	terraformProb := getHTTPTerraformProblem(403, nil, "ibm_is_instance", "create")
+
+	output := terraformProb.GetConsoleOutput()
+	assert.Contains(t, output, "hint: vpc-instance-create-forbidden\n")
+	assert.Equal(t, 1, strings.Count(output, "hint:"))
+	assert.Equal(t, output, terraformProb.GetConsoleOutput())
+	assert.Nil(t, terraformProb.Hint)
+}
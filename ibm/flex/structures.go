@@ -2646,18 +2646,50 @@ func Parse(s string) (CRN, error) {
 
 	return crn, nil
 }
+
+// String assembles the CRN segments back into their "crn:v1:..." form.
+func (c CRN) String() string {
+	scope := c.Scope
+	if c.ScopeType != "" {
+		scope = c.ScopeType + scopeSeparator + c.Scope
+	}
+	return strings.Join([]string{
+		c.Scheme,
+		c.Version,
+		c.CName,
+		c.CType,
+		c.ServiceName,
+		c.Region,
+		scope,
+		c.ServiceInstance,
+		c.ResourceType,
+		c.Resource,
+	}, crnSeparator)
+}
+
+// Location returns the region for the public cloud and prefixes it with the
+// cloud name for any other environment, as GetLocation does.
+func (c CRN) Location() string {
+	if c.CName == "bluemix" || c.CName == "staging" {
+		return c.Region
+	}
+	return c.CName + "-" + c.Region
+}
+
+// AccountID returns the account ID of an account scoped CRN.
+func (c CRN) AccountID() string {
+	if c.ScopeType == "a" {
+		return c.Scope
+	}
+	return ""
+}
+
 func GetLocationV2(instance rc.ResourceInstance) string {
 	crn, err := Parse(*instance.CRN)
 	if err != nil {
 		log.Fatal(err)
 	}
-	region := crn.Region
-	cName := crn.CName
-	if cName == "bluemix" || cName == "staging" {
-		return region
-	} else {
-		return cName + "-" + region
-	}
+	return crn.Location()
 }
 
 func GetTags(d *schema.ResourceData, meta interface{}) error {
@@ -2830,6 +2862,12 @@ func UpdateGlobalTagsUsingCRN(oldList, newList interface{}, meta interface{}, re
 	r := globaltaggingv1.Resource{ResourceID: PtrToString(resourceID), ResourceType: PtrToString(resourceType)}
 	resources = append(resources, r)
 
+	// A nil new list detaches the old tags without attaching the provider default tags,
+	// as done when a resource that only manages tags is deleted
+	var defaultTags []string
+	if newList != nil {
+		defaultTags = DefaultTags(meta, tagType)
+	}
 	if oldList == nil {
 		oldList = new(schema.Set)
 	}
@@ -2837,7 +2875,7 @@ func UpdateGlobalTagsUsingCRN(oldList, newList interface{}, meta interface{}, re
 		newList = new(schema.Set)
 	}
 	olds := oldList.(*schema.Set)
-	news := newList.(*schema.Set)
+	news := MergeDefaultTags(newList.(*schema.Set), defaultTags)
 	removeInt := olds.Difference(news).List()
 	addInt := news.Difference(olds).List()
 	add := make([]string, len(addInt))
@@ -2980,6 +3018,10 @@ func UpdateTagsUsingCRN(oldList, newList interface{}, meta interface{}, resource
 	if err != nil {
 		return fmt.Errorf("[ERROR] Error getting global tagging client settings: %s", err)
 	}
+	var defaultTags []string
+	if newList != nil {
+		defaultTags = DefaultTags(meta, "user")
+	}
 	if oldList == nil {
 		oldList = new(schema.Set)
 	}
@@ -2987,7 +3029,7 @@ func UpdateTagsUsingCRN(oldList, newList interface{}, meta interface{}, resource
 		newList = new(schema.Set)
 	}
 	olds := oldList.(*schema.Set)
-	news := newList.(*schema.Set)
+	news := MergeDefaultTags(newList.(*schema.Set), defaultTags)
 	removeInt := olds.Difference(news).List()
 	addInt := news.Difference(olds).List()
 	add := make([]string, len(addInt))
@@ -3099,7 +3141,74 @@ func FlattenSSLCiphers(ciphers []datatypes.Network_LBaaS_SSLCipher) *schema.Set
 	return NewStringSet(schema.HashString, c)
 }
 
-func ResourceTagsCustomizeDiff(diff *schema.ResourceDiff) error {
+// DefaultTags returns the tags configured in the provider block that apply to the given tag type:
+// default_tags for user tags and default_access_tags for access tags.
+func DefaultTags(meta interface{}, tagType string) []string {
+	sess, ok := meta.(conns.ClientSession)
+	if !ok {
+		return nil
+	}
+	switch strings.TrimSpace(tagType) {
+	case "", "user":
+		return sess.DefaultTags()
+	case "access":
+		return sess.DefaultAccessTags()
+	}
+	return nil
+}
+
+// MergeDefaultTags returns a new set with the tags of the resource and the provider default tags.
+// A default tag that only differs in case from a tag of the resource is not added again.
+func MergeDefaultTags(tags *schema.Set, defaultTags []string) *schema.Set {
+	hash := tags.F
+	if hash == nil {
+		hash = ResourceIBMVPCHash
+	}
+	merged := schema.NewSet(hash, tags.List())
+	for _, tag := range defaultTags {
+		merged.Add(tag)
+	}
+	return merged
+}
+
+// resourceDefaultTagsCustomizeDiff plans the provider default tags along with the tags of the resource,
+// so that the plan shows every tag that is attached. When the resource does not configure the tags the
+// defaults are added to the tags in state, so a tag removed from the provider defaults is not detached
+// from resources that never declared it.
+func resourceDefaultTagsCustomizeDiff(diff *schema.ResourceDiff, key string, defaultTags []string) error {
+	if len(defaultTags) == 0 {
+		return nil
+	}
+	config := diff.GetRawConfig()
+	if !config.Type().IsObjectType() || !config.Type().HasAttribute(key) {
+		return nil
+	}
+
+	var tags *schema.Set
+	if diff.NewValueKnown(key) {
+		_, n := diff.GetChange(key)
+		tags = n.(*schema.Set)
+	} else if config.GetAttr(key).IsNull() {
+		tags = new(schema.Set)
+		if diff.Id() != "" {
+			o, _ := diff.GetChange(key)
+			tags = o.(*schema.Set)
+		}
+	} else {
+		return nil
+	}
+
+	merged := MergeDefaultTags(tags, defaultTags)
+	if merged.Len() == tags.Len() {
+		return nil
+	}
+	return diff.SetNew(key, merged)
+}
+
+func ResourceTagsCustomizeDiff(diff *schema.ResourceDiff, meta interface{}) error {
+	if err := resourceDefaultTagsCustomizeDiff(diff, "tags", DefaultTags(meta, "user")); err != nil {
+		return err
+	}
 
 	if diff.Id() != "" && diff.HasChange("tags") {
 		o, n := diff.GetChange("tags")
@@ -3117,7 +3226,10 @@ func ResourceTagsCustomizeDiff(diff *schema.ResourceDiff) error {
 	}
 	return nil
 }
-func ResourcePowerUserTagsCustomizeDiff(diff *schema.ResourceDiff) error {
+func ResourcePowerUserTagsCustomizeDiff(diff *schema.ResourceDiff, meta interface{}) error {
+	if err := resourceDefaultTagsCustomizeDiff(diff, "pi_user_tags", DefaultTags(meta, "user")); err != nil {
+		return err
+	}
 
 	if diff.Id() != "" && diff.HasChange("pi_user_tags") {
 		// power tags
@@ -3146,6 +3258,10 @@ func OnlyInUpdateDiff(resources []string, diff *schema.ResourceDiff) error {
 }
 
 func ResourceValidateAccessTags(diff *schema.ResourceDiff, meta interface{}) error {
+	// The default access tags are planned first, so that they are validated like the access tags of the resource
+	if err := resourceDefaultTagsCustomizeDiff(diff, "access_tags", DefaultTags(meta, "access")); err != nil {
+		return err
+	}
 
 	if value, ok := diff.GetOkExists("access_tags"); ok {
 		tagSet := value.(*schema.Set)
@@ -1,11 +1,17 @@
 package flex
 
 import (
+	"context"
+	"fmt"
 	"testing"
 
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
 	"github.com/IBM/go-sdk-core/v5/core"
 	"github.com/go-openapi/strfmt"
 	"github.com/google/uuid"
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -84,3 +90,136 @@ func TestStringifyMap(t *testing.T) {
 	var foo interface{} = map[string]interface{}{"foo": "bar"}
 	assert.Equal(t, `{"foo":"bar"}`, Stringify(foo))
 }
+
+func TestMergeDefaultTags(t *testing.T) {
+	tags := NewStringSet(ResourceIBMVPCHash, []string{"app:web", "Env:Dev"})
+
+	merged := MergeDefaultTags(tags, []string{"env:dev", "owner:team"})
+	assert.Equal(t, 3, merged.Len())
+	assert.True(t, merged.Contains("app:web"))
+	assert.True(t, merged.Contains("Env:Dev"))
+	assert.True(t, merged.Contains("owner:team"))
+	assert.Equal(t, 2, tags.Len())
+
+	merged = MergeDefaultTags(new(schema.Set), []string{"owner:team"})
+	assert.Equal(t, []interface{}{"owner:team"}, merged.List())
+}
+
+func TestDefaultTagsWithoutSession(t *testing.T) {
+	assert.Nil(t, DefaultTags(nil, "user"))
+	assert.Nil(t, DefaultTags(nil, "access"))
+}
+
+func TestDefaultTagsCustomizeDiffExistingResource(t *testing.T) {
+	r := &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"tags": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Set:      ResourceIBMVPCHash,
+			},
+		},
+		CustomizeDiff: func(_ context.Context, diff *schema.ResourceDiff, _ interface{}) error {
+			return resourceDefaultTagsCustomizeDiff(diff, "tags", []string{"owner:team"})
+		},
+	}
+	state := &terraform.InstanceState{
+		ID: "r006-image",
+		Attributes: map[string]string{
+			"id":     "r006-image",
+			"tags.#": "1",
+			"tags.1": "app:web",
+		},
+		RawConfig: cty.ObjectVal(map[string]cty.Value{
+			"tags": cty.NullVal(cty.Set(cty.String)),
+		}),
+	}
+
+	diff, err := r.Diff(context.Background(), state, terraform.NewResourceConfigRaw(map[string]interface{}{}), nil)
+	assert.Nil(t, err)
+	if assert.NotNil(t, diff) {
+		assert.Equal(t, "2", diff.Attributes["tags.#"].New)
+	}
+}
+
+type testDefaultTagsSession struct {
+	conns.ClientSession
+}
+
+func (testDefaultTagsSession) DefaultTags() []string {
+	return []string{"owner:team"}
+}
+
+func TestDefaultTagsCustomizeDiffSecondPlanIsEmpty(t *testing.T) {
+	tests := []struct {
+		name          string
+		key           string
+		customizeDiff func(*schema.ResourceDiff, interface{}) error
+		config        []string
+	}{
+		{name: "tags", key: "tags", customizeDiff: ResourceTagsCustomizeDiff},
+		{name: "tags with resource tags", key: "tags", customizeDiff: ResourceTagsCustomizeDiff, config: []string{"app:web"}},
+		{name: "power user tags", key: "pi_user_tags", customizeDiff: ResourcePowerUserTagsCustomizeDiff},
+		{name: "power user tags with resource tags", key: "pi_user_tags", customizeDiff: ResourcePowerUserTagsCustomizeDiff, config: []string{"app:web"}},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			r := &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					tc.key: {
+						Type:     schema.TypeSet,
+						Optional: true,
+						Computed: true,
+						Elem:     &schema.Schema{Type: schema.TypeString},
+						Set:      ResourceIBMVPCHash,
+					},
+				},
+				CustomizeDiff: func(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+					return tc.customizeDiff(diff, meta)
+				},
+			}
+			rawConfig := map[string]interface{}{}
+			ctyConfig := cty.NullVal(cty.Set(cty.String))
+			if len(tc.config) > 0 {
+				values := []cty.Value{}
+				tags := []interface{}{}
+				for _, tag := range tc.config {
+					values = append(values, cty.StringVal(tag))
+					tags = append(tags, tag)
+				}
+				ctyConfig = cty.SetVal(values)
+				rawConfig[tc.key] = tags
+			}
+			config := terraform.NewResourceConfigRaw(rawConfig)
+			ctyRawConfig := cty.ObjectVal(map[string]cty.Value{tc.key: ctyConfig})
+			meta := testDefaultTagsSession{}
+
+			// The first plan creates the resource with the default tags
+			diff, err := r.Diff(context.Background(), &terraform.InstanceState{RawConfig: ctyRawConfig}, config, meta)
+			assert.Nil(t, err)
+			if !assert.NotNil(t, diff) {
+				return
+			}
+			want := len(tc.config) + 1
+			assert.Equal(t, fmt.Sprint(want), diff.Attributes[tc.key+".#"].New)
+
+			// The state after the apply has the tags that were planned, as read back from the API
+			state := &terraform.InstanceState{
+				ID: "r006-resource",
+				Attributes: map[string]string{
+					"id":          "r006-resource",
+					tc.key + ".#": fmt.Sprint(want),
+				},
+				RawConfig: ctyRawConfig,
+			}
+			for _, tag := range append(append([]string{}, tc.config...), "owner:team") {
+				state.Attributes[fmt.Sprintf("%s.%d", tc.key, ResourceIBMVPCHash(tag))] = tag
+			}
+			diff, err = r.Diff(context.Background(), state, config, meta)
+			assert.Nil(t, err)
+			assert.True(t, diff == nil || diff.Empty(), "expected an empty second plan, got %v", diff)
+		})
+	}
+}
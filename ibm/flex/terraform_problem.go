@@ -1,11 +1,16 @@
 package flex
 
 import (
+	"bytes"
+	"encoding/json"
 	"errors"
 	"fmt"
+	"os"
+	"strings"
 
 	v "github.com/IBM-Cloud/terraform-provider-ibm/version"
 	"github.com/IBM/go-sdk-core/v5/core"
+	fwdiag "github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 )
 
@@ -16,6 +21,9 @@ type TerraformProblem struct {
 
 	Resource  string
 	Operation string
+
+	// Hint is the remediation hint of the catalog that matches the problem, if any.
+	Hint *ProblemHint
 }
 
 // GetID returns a hash value computed from stable fields in the
@@ -45,10 +53,60 @@ func (e *TerraformProblem) GetConsoleOrderedMaps() *core.OrderedMaps {
 	orderedMaps.Add("resource", e.Resource)
 	orderedMaps.Add("operation", e.Operation)
 	orderedMaps.Add("component", e.Component)
+	if e.Hint != nil {
+		orderedMaps.Add("hint", e.Hint.Name)
+		orderedMaps.Add("remediation", e.Hint.Remediation)
+	}
 
 	return orderedMaps
 }
 
+// GetConsoleJSON returns the fields of the problem that are
+// relevant to a user, formatted as a JSON object with the same
+// fields as GetConsoleMessage, for tools that parse the errors.
+func (e *TerraformProblem) GetConsoleJSON() string {
+	var buf bytes.Buffer
+	buf.WriteString("{")
+	for i, item := range e.GetConsoleOrderedMaps().GetMaps() {
+		value := item.Value
+		if component, ok := value.(*core.ProblemComponent); ok && component != nil {
+			value = map[string]string{"name": component.Name, "version": component.Version}
+		}
+		key, _ := json.Marshal(fmt.Sprint(item.Key))
+		encoded, err := json.Marshal(value)
+		if err != nil {
+			encoded, _ = json.Marshal(fmt.Sprint(value))
+		}
+		if i > 0 {
+			buf.WriteString(",")
+		}
+		buf.Write(key)
+		buf.WriteString(":")
+		buf.Write(encoded)
+	}
+	buf.WriteString("}")
+	return buf.String()
+}
+
+// ErrorOutputEnv selects the format of the error details, "json" or the default "yaml".
+const ErrorOutputEnv = "IBMCLOUD_ERROR_OUTPUT"
+
+// GetConsoleOutput returns the console message in the format selected by IBMCLOUD_ERROR_OUTPUT,
+// with the remediation hint of the catalog that matches the problem. The problem itself is left
+// unchanged, the hint is only added to a copy.
+func (e *TerraformProblem) GetConsoleOutput() string {
+	problem := e
+	if e.Hint == nil {
+		withHint := *e
+		withHint.Hint = FindProblemHint(e)
+		problem = &withHint
+	}
+	if strings.EqualFold(os.Getenv(ErrorOutputEnv), "json") {
+		return problem.GetConsoleJSON()
+	}
+	return problem.GetConsoleMessage()
+}
+
 func (e *TerraformProblem) GetDebugOrderedMaps() *core.OrderedMaps {
 	orderedMaps := e.GetConsoleOrderedMaps()
 
@@ -64,7 +122,16 @@ func (e *TerraformProblem) GetDebugOrderedMaps() *core.OrderedMaps {
 // message as the summary. It is used to create a Diagnostics
 // object from a TerraformProblem in the resource/data source code.
 func (e *TerraformProblem) GetDiag() diag.Diagnostics {
-	return diag.Errorf("%s", e.GetConsoleMessage())
+	return diag.Errorf("%s", e.GetConsoleOutput())
+}
+
+// GetFrameworkDiag returns the equivalent of GetDiag for the
+// plugin framework, for use in ephemeral resources and other
+// types served by the framework provider.
+func (e *TerraformProblem) GetFrameworkDiag() fwdiag.Diagnostics {
+	return fwdiag.Diagnostics{
+		fwdiag.NewErrorDiagnostic(e.GetConsoleOutput(), ""),
+	}
 }
 
 // TerraformErrorf creates and returns a new instance of `TerraformProblem`
@@ -134,6 +134,15 @@ func TestTerraformProblemGetDiag(t *testing.T) {
 	assert.Equal(t, terraformProb.GetConsoleMessage(), diagnostic.Summary)
 }
 
+func TestTerraformProblemGetFrameworkDiag(t *testing.T) {
+	terraformProb := getPopulatedTerraformProblem()
+	diagnostics := terraformProb.GetFrameworkDiag()
+
+	assert.True(t, diagnostics.HasError())
+	assert.Len(t, diagnostics, 1)
+	assert.Equal(t, terraformProb.GetConsoleMessage(), diagnostics[0].Summary())
+}
+
 func TestTerraformErrorf(t *testing.T) {
 	causedBy := &core.SDKProblem{}
 	summary := "Update failed."
@@ -0,0 +1,76 @@
+// Copyright IBM Corp. 2025 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package flex
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// WriteOnlyPath converts a flatmap style key such as "parameters.0.api_token_wo"
+// into the cty.Path used to look up the attribute in the raw configuration.
+func WriteOnlyPath(key string) cty.Path {
+	path := cty.Path{}
+	for _, part := range strings.Split(key, ".") {
+		if index, err := strconv.Atoi(part); err == nil {
+			path = path.IndexInt(index)
+		} else {
+			path = path.GetAttr(part)
+		}
+	}
+	return path
+}
+
+// RawConfigGetter is implemented by both *schema.ResourceData and *schema.ResourceDiff.
+type RawConfigGetter interface {
+	GetRawConfig() cty.Value
+	GetRawConfigAt(valPath cty.Path) (cty.Value, diag.Diagnostics)
+}
+
+var (
+	_ RawConfigGetter = &schema.ResourceData{}
+	_ RawConfigGetter = &schema.ResourceDiff{}
+)
+
+// GetWriteOnlyString returns the configured value of a write-only string attribute.
+// Write-only values are never persisted in the plan or state, so they can only be
+// read from the raw configuration. An empty string is returned when the attribute,
+// or the block that contains it, is not set or not yet known.
+func GetWriteOnlyString(d RawConfigGetter, key string) (string, error) {
+	if d.GetRawConfig().IsNull() {
+		return "", nil
+	}
+	path := WriteOnlyPath(key)
+	// The SDK reports a missing value as an error, so the blocks that contain the attribute are
+	// checked first
+	for i, step := range path {
+		index, ok := step.(cty.IndexStep)
+		if !ok {
+			continue
+		}
+		block, diags := d.GetRawConfigAt(path[:i])
+		if diags.HasError() {
+			return "", fmt.Errorf("Error reading write-only attribute %s: %s", key, diags[0].Detail)
+		}
+		if block.IsNull() || !block.IsKnown() || index.Key.LessThan(cty.NumberIntVal(int64(block.LengthInt()))).False() {
+			return "", nil
+		}
+	}
+	value, diags := d.GetRawConfigAt(path)
+	if diags.HasError() {
+		return "", fmt.Errorf("Error reading write-only attribute %s: %s", key, diags[0].Detail)
+	}
+	if value.IsNull() || !value.IsKnown() {
+		return "", nil
+	}
+	if !value.Type().Equals(cty.String) {
+		return "", fmt.Errorf("Error reading write-only attribute %s: expected a string, got %s", key, value.Type().FriendlyName())
+	}
+	return value.AsString(), nil
+}
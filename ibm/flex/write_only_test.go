@@ -0,0 +1,80 @@
+// Copyright IBM Corp. 2025 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package flex
+
+import (
+	"testing"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWriteOnlyPath(t *testing.T) {
+	assert.Equal(t, cty.GetAttrPath("payload_wo"), WriteOnlyPath("payload_wo"))
+	assert.Equal(t, cty.GetAttrPath("parameters").IndexInt(0).GetAttr("api_token_wo"), WriteOnlyPath("parameters.0.api_token_wo"))
+}
+
+func TestGetWriteOnlyString(t *testing.T) {
+	resource := &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"payload_wo":         {Type: schema.TypeString, Optional: true, WriteOnly: true},
+			"payload_wo_version": {Type: schema.TypeInt, Optional: true},
+			"parameters": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"api_token_wo": {Type: schema.TypeString, Optional: true, WriteOnly: true},
+					},
+				},
+			},
+		},
+	}
+	parametersType := cty.List(cty.Object(map[string]cty.Type{"api_token_wo": cty.String}))
+	data := func(config cty.Value) *schema.ResourceData {
+		return resource.Data(&terraform.InstanceState{RawConfig: config})
+	}
+
+	d := data(cty.ObjectVal(map[string]cty.Value{
+		"payload_wo": cty.StringVal("secret-payload"),
+		"parameters": cty.ListVal([]cty.Value{
+			cty.ObjectVal(map[string]cty.Value{"api_token_wo": cty.StringVal("secret-token")}),
+		}),
+		"payload_wo_version": cty.NumberIntVal(1),
+	}))
+
+	payload, err := GetWriteOnlyString(d, "payload_wo")
+	assert.NoError(t, err)
+	assert.Equal(t, "secret-payload", payload)
+
+	token, err := GetWriteOnlyString(d, "parameters.0.api_token_wo")
+	assert.NoError(t, err)
+	assert.Equal(t, "secret-token", token)
+
+	_, err = GetWriteOnlyString(d, "payload_wo_version")
+	assert.Error(t, err)
+
+	_, err = GetWriteOnlyString(d, "unknown_wo")
+	assert.Error(t, err)
+
+	d = data(cty.ObjectVal(map[string]cty.Value{
+		"payload_wo":         cty.UnknownVal(cty.String),
+		"parameters":         cty.ListValEmpty(parametersType.ElementType()),
+		"payload_wo_version": cty.NullVal(cty.Number),
+	}))
+
+	payload, err = GetWriteOnlyString(d, "payload_wo")
+	assert.NoError(t, err)
+	assert.Equal(t, "", payload)
+
+	token, err = GetWriteOnlyString(d, "parameters.0.api_token_wo")
+	assert.NoError(t, err)
+	assert.Equal(t, "", token)
+
+	token, err = GetWriteOnlyString(data(cty.NullVal(d.GetRawConfig().Type())), "parameters.0.api_token_wo")
+	assert.NoError(t, err)
+	assert.Equal(t, "", token)
+}
@@ -0,0 +1,274 @@
+// Copyright IBM Corp. 2025 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/crn"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/service/iamidentity"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/service/kubernetes"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/service/secretsmanager"
+	"github.com/IBM-Cloud/terraform-provider-ibm/version"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	fwprovider "github.com/hashicorp/terraform-plugin-framework/provider"
+	fwschema "github.com/hashicorp/terraform-plugin-framework/provider/schema"
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-plugin-mux/tf5muxserver"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+var _ fwprovider.ProviderWithFunctions = &frameworkProvider{}
+var _ fwprovider.ProviderWithEphemeralResources = &frameworkProvider{}
+
+// frameworkProvider serves the parts of the provider that need
+// terraform-plugin-framework, such as provider functions and ephemeral
+// resources. It is muxed with the SDKv2 provider, so it shares the provider
+// block and the configured ClientSession of the SDKv2 provider.
+type frameworkProvider struct {
+	sdkProvider *schema.Provider
+}
+
+// ProtoV5ProviderServerFactory returns the mux server that serves the SDKv2
+// provider together with the framework provider.
+func ProtoV5ProviderServerFactory(ctx context.Context) (func() tfprotov5.ProviderServer, error) {
+	sdkProvider := Provider()
+	providers := []func() tfprotov5.ProviderServer{
+		sdkProvider.GRPCProvider,
+		FrameworkProviderServer(sdkProvider),
+	}
+
+	muxServer, err := tf5muxserver.NewMuxServer(ctx, providers...)
+	if err != nil {
+		return nil, err
+	}
+	return muxServer.ProviderServer, nil
+}
+
+// FrameworkProviderServer returns the protocol 5 server of the framework
+// provider that is muxed with the given SDKv2 provider.
+func FrameworkProviderServer(sdkProvider *schema.Provider) func() tfprotov5.ProviderServer {
+	server := providerserver.NewProtocol5(&frameworkProvider{sdkProvider: sdkProvider})
+	return func() tfprotov5.ProviderServer {
+		return frameworkProviderServer{server()}
+	}
+}
+
+// frameworkProviderServer drops the provider config warnings of the framework
+// server, as the SDKv2 server validates the same provider block and already
+// reports them, for example for deprecated arguments.
+type frameworkProviderServer struct {
+	tfprotov5.ProviderServer
+}
+
+func (s frameworkProviderServer) PrepareProviderConfig(ctx context.Context, req *tfprotov5.PrepareProviderConfigRequest) (*tfprotov5.PrepareProviderConfigResponse, error) {
+	resp, err := s.ProviderServer.PrepareProviderConfig(ctx, req)
+	if resp == nil {
+		return resp, err
+	}
+	var diags []*tfprotov5.Diagnostic
+	for _, diag := range resp.Diagnostics {
+		if diag.Severity != tfprotov5.DiagnosticSeverityWarning {
+			diags = append(diags, diag)
+		}
+	}
+	resp.Diagnostics = diags
+	return resp, err
+}
+
+func (p *frameworkProvider) Metadata(ctx context.Context, req fwprovider.MetadataRequest, resp *fwprovider.MetadataResponse) {
+	resp.TypeName = "ibm"
+	resp.Version = version.Version
+}
+
+// Schema mirrors the SDKv2 provider block, since the mux server requires every
+// server to report the same provider schema.
+func (p *frameworkProvider) Schema(ctx context.Context, req fwprovider.SchemaRequest, resp *fwprovider.SchemaResponse) {
+	sdkResp, err := p.sdkProvider.GRPCProvider().GetProviderSchema(ctx, &tfprotov5.GetProviderSchemaRequest{})
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading the SDKv2 provider schema", err.Error())
+		return
+	}
+	if sdkResp.Provider == nil || sdkResp.Provider.Block == nil {
+		return
+	}
+
+	block := sdkResp.Provider.Block
+	attributes, blocks, err := frameworkSchemaFromBlock(block, p.sdkProvider.Schema)
+	if err != nil {
+		resp.Diagnostics.AddError("Error converting the SDKv2 provider schema", err.Error())
+		return
+	}
+	resp.Schema = fwschema.Schema{
+		Description: block.Description,
+		Attributes:  attributes,
+		Blocks:      blocks,
+	}
+}
+
+// Configure hands the ClientSession of the SDKv2 provider to the framework
+// types. The mux server configures the SDKv2 provider first and stops on its
+// errors, so its meta is set by the time this runs.
+func (p *frameworkProvider) Configure(ctx context.Context, req fwprovider.ConfigureRequest, resp *fwprovider.ConfigureResponse) {
+	meta := p.sdkProvider.Meta()
+	if meta == nil {
+		return
+	}
+	resp.EphemeralResourceData = meta
+}
+
+func (p *frameworkProvider) DataSources(ctx context.Context) []func() datasource.DataSource {
+	return nil
+}
+
+func (p *frameworkProvider) Resources(ctx context.Context) []func() resource.Resource {
+	return nil
+}
+
+func (p *frameworkProvider) EphemeralResources(ctx context.Context) []func() ephemeral.EphemeralResource {
+	return []func() ephemeral.EphemeralResource{
+		// Added for IAM
+		iamidentity.EphemeralIBMIAMAccessToken,
+
+		// Added for Kubernetes Service
+		kubernetes.EphemeralIBMContainerClusterConfig,
+
+		// Added for Secrets Manager
+		secretsmanager.EphemeralIbmSmArbitrarySecret,
+		secretsmanager.EphemeralIbmSmIamCredentialsSecret,
+		secretsmanager.EphemeralIbmSmKvSecret,
+		secretsmanager.EphemeralIbmSmUsernamePasswordSecret,
+	}
+}
+
+func (p *frameworkProvider) Functions(ctx context.Context) []func() function.Function {
+	return []func() function.Function{
+		crn.NewCRNParseFunction,
+		crn.NewCRNBuildFunction,
+		crn.NewCRNIsValidFunction,
+	}
+}
+
+// frameworkSchemaFromBlock converts a protocol schema block reported by the
+// SDKv2 provider into framework attributes and blocks. sdkSchema is only used
+// for the deprecation messages, which the protocol reduces to a flag.
+func frameworkSchemaFromBlock(block *tfprotov5.SchemaBlock, sdkSchema map[string]*schema.Schema) (map[string]fwschema.Attribute, map[string]fwschema.Block, error) {
+	attributes := make(map[string]fwschema.Attribute, len(block.Attributes))
+	for _, a := range block.Attributes {
+		attribute, err := frameworkAttribute(a, deprecationMessage(a.Deprecated, sdkSchema[a.Name]))
+		if err != nil {
+			return nil, nil, fmt.Errorf("%s: %s", a.Name, err)
+		}
+		attributes[a.Name] = attribute
+	}
+
+	blocks := make(map[string]fwschema.Block, len(block.BlockTypes))
+	for _, b := range block.BlockTypes {
+		var nestedSchema map[string]*schema.Schema
+		if s, ok := sdkSchema[b.TypeName]; ok {
+			if r, ok := s.Elem.(*schema.Resource); ok {
+				nestedSchema = r.Schema
+			}
+		}
+		nestedAttributes, nestedBlocks, err := frameworkSchemaFromBlock(b.Block, nestedSchema)
+		if err != nil {
+			return nil, nil, fmt.Errorf("%s.%s", b.TypeName, err)
+		}
+		object := fwschema.NestedBlockObject{
+			Attributes: nestedAttributes,
+			Blocks:     nestedBlocks,
+		}
+		message := deprecationMessage(b.Block.Deprecated, sdkSchema[b.TypeName])
+		switch b.Nesting {
+		case tfprotov5.SchemaNestedBlockNestingModeList:
+			blocks[b.TypeName] = fwschema.ListNestedBlock{NestedObject: object, Description: b.Block.Description, DeprecationMessage: message}
+		case tfprotov5.SchemaNestedBlockNestingModeSet:
+			blocks[b.TypeName] = fwschema.SetNestedBlock{NestedObject: object, Description: b.Block.Description, DeprecationMessage: message}
+		case tfprotov5.SchemaNestedBlockNestingModeSingle:
+			blocks[b.TypeName] = fwschema.SingleNestedBlock{Attributes: nestedAttributes, Blocks: nestedBlocks, Description: b.Block.Description, DeprecationMessage: message}
+		default:
+			return nil, nil, fmt.Errorf("%s: unsupported nesting mode %s", b.TypeName, b.Nesting)
+		}
+	}
+	return attributes, blocks, nil
+}
+
+func frameworkAttribute(a *tfprotov5.SchemaAttribute, deprecation string) (fwschema.Attribute, error) {
+	switch {
+	case a.Type.Is(tftypes.String):
+		return fwschema.StringAttribute{Description: a.Description, Required: a.Required, Optional: a.Optional, Sensitive: a.Sensitive, DeprecationMessage: deprecation}, nil
+	case a.Type.Is(tftypes.Number):
+		return fwschema.NumberAttribute{Description: a.Description, Required: a.Required, Optional: a.Optional, Sensitive: a.Sensitive, DeprecationMessage: deprecation}, nil
+	case a.Type.Is(tftypes.Bool):
+		return fwschema.BoolAttribute{Description: a.Description, Required: a.Required, Optional: a.Optional, Sensitive: a.Sensitive, DeprecationMessage: deprecation}, nil
+	}
+
+	attrType, err := frameworkType(a.Type)
+	if err != nil {
+		return nil, err
+	}
+	switch t := attrType.(type) {
+	case types.ListType:
+		return fwschema.ListAttribute{ElementType: t.ElemType, Description: a.Description, Required: a.Required, Optional: a.Optional, Sensitive: a.Sensitive, DeprecationMessage: deprecation}, nil
+	case types.SetType:
+		return fwschema.SetAttribute{ElementType: t.ElemType, Description: a.Description, Required: a.Required, Optional: a.Optional, Sensitive: a.Sensitive, DeprecationMessage: deprecation}, nil
+	case types.MapType:
+		return fwschema.MapAttribute{ElementType: t.ElemType, Description: a.Description, Required: a.Required, Optional: a.Optional, Sensitive: a.Sensitive, DeprecationMessage: deprecation}, nil
+	case types.ObjectType:
+		return fwschema.ObjectAttribute{AttributeTypes: t.AttrTypes, Description: a.Description, Required: a.Required, Optional: a.Optional, Sensitive: a.Sensitive, DeprecationMessage: deprecation}, nil
+	}
+	return nil, fmt.Errorf("unsupported type %s", a.Type)
+}
+
+func frameworkType(t tftypes.Type) (attr.Type, error) {
+	switch {
+	case t.Is(tftypes.String):
+		return types.StringType, nil
+	case t.Is(tftypes.Number):
+		return types.NumberType, nil
+	case t.Is(tftypes.Bool):
+		return types.BoolType, nil
+	}
+
+	switch t := t.(type) {
+	case tftypes.List:
+		elemType, err := frameworkType(t.ElementType)
+		return types.ListType{ElemType: elemType}, err
+	case tftypes.Set:
+		elemType, err := frameworkType(t.ElementType)
+		return types.SetType{ElemType: elemType}, err
+	case tftypes.Map:
+		elemType, err := frameworkType(t.ElementType)
+		return types.MapType{ElemType: elemType}, err
+	case tftypes.Object:
+		attrTypes := make(map[string]attr.Type, len(t.AttributeTypes))
+		for name, attributeType := range t.AttributeTypes {
+			attrType, err := frameworkType(attributeType)
+			if err != nil {
+				return nil, err
+			}
+			attrTypes[name] = attrType
+		}
+		return types.ObjectType{AttrTypes: attrTypes}, nil
+	}
+	return nil, fmt.Errorf("unsupported type %s", t)
+}
+
+func deprecationMessage(deprecated bool, s *schema.Schema) string {
+	if !deprecated {
+		return ""
+	}
+	if s != nil && s.Deprecated != "" {
+		return s.Deprecated
+	}
+	return "This field is deprecated"
+}
@@ -0,0 +1,54 @@
+// Copyright IBM Corp. 2025 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+)
+
+func testMuxServer(t *testing.T) tfprotov5.ProviderServer {
+	t.Helper()
+	providerServer, err := ProtoV5ProviderServerFactory(context.Background())
+	if err != nil {
+		t.Fatalf("Error creating mux server: %s", err)
+	}
+	return providerServer()
+}
+
+func TestFrameworkProviderSchemaMatchesSDKProvider(t *testing.T) {
+	resp, err := testMuxServer(t).GetProviderSchema(context.Background(), &tfprotov5.GetProviderSchemaRequest{})
+	if err != nil {
+		t.Fatalf("Error getting provider schema: %s", err)
+	}
+	for _, diag := range resp.Diagnostics {
+		t.Errorf("Unexpected diagnostic: %s: %s", diag.Summary, diag.Detail)
+	}
+}
+
+func TestFrameworkProviderFunctions(t *testing.T) {
+	resp, err := testMuxServer(t).GetFunctions(context.Background(), &tfprotov5.GetFunctionsRequest{})
+	if err != nil {
+		t.Fatalf("Error getting functions: %s", err)
+	}
+	for _, name := range []string{"crn_parse", "crn_build", "crn_is_valid"} {
+		if _, ok := resp.Functions[name]; !ok {
+			t.Errorf("Expected function %s to be served", name)
+		}
+	}
+}
+
+func TestFrameworkProviderEphemeralResources(t *testing.T) {
+	resp, err := testMuxServer(t).GetProviderSchema(context.Background(), &tfprotov5.GetProviderSchemaRequest{})
+	if err != nil {
+		t.Fatalf("Error getting provider schema: %s", err)
+	}
+	for _, name := range []string{"ibm_iam_access_token", "ibm_sm_arbitrary_secret", "ibm_sm_iam_credentials_secret", "ibm_sm_kv_secret", "ibm_sm_username_password_secret"} {
+		if _, ok := resp.EphemeralResourceSchemas[name]; !ok {
+			t.Errorf("Expected ephemeral resource %s to be served", name)
+		}
+	}
+}
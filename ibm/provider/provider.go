@@ -14,6 +14,7 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
@@ -237,6 +238,52 @@ func Provider() *schema.Provider {
 				Description: "Path of the file that contains private and public regional endpoints mapping",
 				DefaultFunc: schema.MultiEnvDefaultFunc([]string{"IC_ENDPOINTS_FILE_PATH", "IBMCLOUD_ENDPOINTS_FILE_PATH"}, nil),
 			},
+			"default_tags": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Set:         flex.ResourceIBMVPCHash,
+				Description: "User tags that are attached to every resource that supports tags, in addition to the tags of the resource",
+			},
+			"default_access_tags": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Set:         flex.ResourceIBMVPCHash,
+				Description: "Access management tags that are attached to every resource that supports access tags, in addition to the access tags of the resource",
+			},
+			"service_limits": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: "Limits of the requests sent to the endpoints of a service",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"service": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "Name of the service, such as is or cis. The default limits apply to the services that do not have their own limits",
+						},
+						"max_in_flight": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ValidateFunc: validation.IntAtLeast(0),
+							Description:  "Maximum number of requests to the service that are waiting for a response",
+						},
+						"requests_per_second": {
+							Type:         schema.TypeFloat,
+							Optional:     true,
+							ValidateFunc: validation.FloatAtLeast(0),
+							Description:  "Maximum average rate of the requests to the service",
+						},
+						"burst": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ValidateFunc: validation.IntAtLeast(0),
+							Description:  "Number of requests that can be sent at once above requests_per_second. Defaults to requests_per_second",
+						},
+					},
+				},
+			},
 		},
 
 		DataSourcesMap: map[string]*schema.Resource{
@@ -498,6 +545,7 @@ func Provider() *schema.Provider {
 			"ibm_is_floating_ips":                    vpc.DataSourceIBMIsFloatingIps(),
 			"ibm_is_flow_log":                        vpc.DataSourceIBMIsFlowLog(),
 			"ibm_is_flow_logs":                       vpc.DataSourceIBMISFlowLogs(),
+			"ibm_is_flow_log_analysis":               vpc.DataSourceIBMIsFlowLogAnalysis(),
 			"ibm_is_image":                           vpc.DataSourceIBMISImage(),
 			"ibm_is_images":                          vpc.DataSourceIBMISImages(),
 			"ibm_is_image_export_job":                vpc.DataSourceIBMIsImageExport(),
@@ -615,9 +663,11 @@ func Provider() *schema.Provider {
 			"ibm_is_vpn_gateway":                 vpc.DataSourceIBMISVPNGateway(),
 			"ibm_is_vpn_gateways":                vpc.DataSourceIBMISVPNGateways(),
 			"ibm_is_vpc_address_prefixes":        vpc.DataSourceIbmIsVpcAddressPrefixes(),
+			"ibm_is_vpc_address_plan":            vpc.DataSourceIBMIsVPCAddressPlan(),
 			"ibm_is_vpc_address_prefix":          vpc.DataSourceIBMIsVPCAddressPrefix(),
 			"ibm_is_vpn_gateway_connection":      vpc.DataSourceIBMISVPNGatewayConnection(),
 			"ibm_is_vpn_gateway_connections":     vpc.DataSourceIBMISVPNGatewayConnections(),
+			"ibm_is_vpn_gateway_tunnels":         vpc.DataSourceIBMISVPNGatewayTunnels(),
 
 			"ibm_is_vpn_gateway_connection_local_cidrs": vpc.DataSourceIBMIsVPNGatewayConnectionLocalCidrs(),
 			"ibm_is_vpn_gateway_connection_peer_cidrs":  vpc.DataSourceIBMIsVPNGatewayConnectionPeerCidrs(),
@@ -1197,6 +1247,7 @@ func Provider() *schema.Provider {
 			"ibm_container_ingress_secret_tls":              kubernetes.ResourceIBMContainerIngressSecretTLS(),
 			"ibm_container_ingress_secret_opaque":           kubernetes.ResourceIBMContainerIngressSecretOpaque(),
 			"ibm_container_cluster":                         kubernetes.ResourceIBMContainerCluster(),
+			"ibm_container_cluster_autoscaler":              kubernetes.ResourceIBMContainerClusterAutoscaler(),
 			"ibm_container_cluster_feature":                 kubernetes.ResourceIBMContainerClusterFeature(),
 			"ibm_container_bind_service":                    kubernetes.ResourceIBMContainerBindService(),
 			"ibm_container_worker_pool":                     kubernetes.ResourceIBMContainerWorkerPool(),
@@ -1314,8 +1365,10 @@ func Provider() *schema.Provider {
 			"ibm_is_lb_listener_policy_rule":                     vpc.ResourceIBMISLBListenerPolicyRule(),
 			"ibm_is_lb_pool":                                     vpc.ResourceIBMISLBPool(),
 			"ibm_is_lb_pool_member":                              vpc.ResourceIBMISLBPoolMember(),
+			"ibm_is_lb_configuration":                            vpc.ResourceIBMISLBConfiguration(),
 			"ibm_is_network_acl":                                 vpc.ResourceIBMISNetworkACL(),
 			"ibm_is_network_acl_rule":                            vpc.ResourceIBMISNetworkACLRule(),
+			"ibm_is_network_acl_rules":                           vpc.ResourceIBMISNetworkACLRules(),
 			"ibm_is_public_gateway":                              vpc.ResourceIBMISPublicGateway(),
 			"ibm_is_private_path_service_gateway_account_policy": vpc.ResourceIBMIsPrivatePathServiceGatewayAccountPolicy(),
 			"ibm_is_private_path_service_gateway":                vpc.ResourceIBMIsPrivatePathServiceGateway(),
@@ -1324,6 +1377,7 @@ func Provider() *schema.Provider {
 			"ibm_is_private_path_service_gateway_operations":                          vpc.ResourceIBMIsPrivatePathServiceGatewayOperations(),
 			"ibm_is_security_group":                        vpc.ResourceIBMISSecurityGroup(),
 			"ibm_is_security_group_rule":                   vpc.ResourceIBMISSecurityGroupRule(),
+			"ibm_is_security_group_rules":                  vpc.ResourceIBMISSecurityGroupRules(),
 			"ibm_is_security_group_target":                 vpc.ResourceIBMISSecurityGroupTarget(),
 			"ibm_is_share":                                 vpc.ResourceIbmIsShare(),
 			"ibm_is_share_replica_operations":              vpc.ResourceIbmIsShareReplicaOperations(),
@@ -1352,6 +1406,7 @@ func Provider() *schema.Provider {
 			"ibm_is_vpc_dns_resolution_binding":            vpc.ResourceIBMIsVPCDnsResolutionBinding(),
 			"ibm_is_vpc_routing_table":                     vpc.ResourceIBMISVPCRoutingTable(),
 			"ibm_is_vpc_routing_table_route":               vpc.ResourceIBMISVPCRoutingTableRoute(),
+			"ibm_is_vpc_routing_table_routes":              vpc.ResourceIBMISVPCRoutingTableRoutes(),
 			"ibm_is_vpn_server":                            vpc.ResourceIBMIsVPNServer(),
 			"ibm_is_vpn_server_client":                     vpc.ResourceIBMIsVPNServerClient(),
 			"ibm_is_vpn_server_route":                      vpc.ResourceIBMIsVPNServerRoute(),
@@ -1752,9 +1807,21 @@ func wrapProvider(provider schema.Provider) schema.Provider {
 	}
 }
 
+// deletionProtectionResources lists the resources whose data is hard to recreate. wrapResource
+// adds an optional deletion_protection argument to them, which is enforced by wrapFunction.
+var deletionProtectionResources = map[string]bool{
+	"ibm_container_vpc_cluster": true,
+	"ibm_cos_bucket":            true,
+	"ibm_is_volume":             true,
+	"ibm_is_vpc":                true,
+	"ibm_kms_key":               true,
+	"ibm_pi_volume":             true,
+	"ibm_resource_instance":     true,
+}
+
 func wrapResource(name string, resource *schema.Resource) *schema.Resource {
 	return &schema.Resource{
-		Schema:               resource.Schema,
+		Schema:               wrapSchema(name, resource.Schema),
 		SchemaVersion:        resource.SchemaVersion,
 		MigrateState:         resource.MigrateState,
 		StateUpgraders:       resource.StateUpgraders,
@@ -1767,7 +1834,7 @@ func wrapResource(name string, resource *schema.Resource) *schema.Resource {
 		ReadWithoutTimeout:   wrapFunction(name, "read", resource.ReadWithoutTimeout, nil, false),
 		UpdateWithoutTimeout: wrapFunction(name, "update", resource.UpdateWithoutTimeout, nil, false),
 		DeleteWithoutTimeout: wrapFunction(name, "delete", resource.DeleteWithoutTimeout, nil, false),
-		CustomizeDiff:        wrapCustomizeDiff(name, resource.CustomizeDiff),
+		CustomizeDiff:        wrapCustomizeDiff(name, resource.Schema, resource.CustomizeDiff),
 		Importer:             resource.Importer,
 		DeprecationMessage:   resource.DeprecationMessage,
 		Timeouts:             resource.Timeouts,
@@ -1776,6 +1843,27 @@ func wrapResource(name string, resource *schema.Resource) *schema.Resource {
 	}
 }
 
+func wrapSchema(name string, resourceSchema map[string]*schema.Schema) map[string]*schema.Schema {
+	if !deletionProtectionResources[name] {
+		return resourceSchema
+	}
+	if _, ok := resourceSchema[flex.DeletionProtection]; ok {
+		return resourceSchema
+	}
+
+	wrappedSchema := make(map[string]*schema.Schema, len(resourceSchema)+1)
+	for key, value := range resourceSchema {
+		wrappedSchema[key] = value
+	}
+	// No default value, so that existing resources do not show a diff for the new argument
+	wrappedSchema[flex.DeletionProtection] = &schema.Schema{
+		Type:        schema.TypeBool,
+		Optional:    true,
+		Description: "Whether Terraform will be prevented from destroying the resource",
+	}
+	return wrappedSchema
+}
+
 func wrapDataSource(name string, resource *schema.Resource) *schema.Resource {
 	return &schema.Resource{
 		Schema:             resource.Schema,
@@ -1801,23 +1889,9 @@ func wrapFunction(
 ) func(context.Context, *schema.ResourceData, interface{}) diag.Diagnostics {
 	if function != nil {
 		return func(context context.Context, schema *schema.ResourceData, meta interface{}) diag.Diagnostics {
-
-			// only allow deletion if the resource is not marked as protected
-			if operationName == "delete" && schema.Get("deletion_protection") != nil {
-				// we check the value in state, not current config. Current config will always be null for a delete
-
-				if schema.Get("deletion_protection") == true {
-					log.Printf("[DEBUG] Resource has deletion protection turned on %s", resourceName)
-					var diags diag.Diagnostics
-					summary := fmt.Sprintf("Deletion protection is enabled for resource %s to prevent accidential deletion", schema.Get("name"))
-					return append(
-						diags,
-						diag.Diagnostic{
-							Severity: diag.Error,
-							Summary:  summary,
-							Detail:   "Set deletion_protection to false, apply and then destroy if deletion should proceed",
-						},
-					)
+			if operationName == "delete" {
+				if diags := checkDeletionProtection(resourceName, schema); diags != nil {
+					return diags
 				}
 			}
 
@@ -1825,6 +1899,12 @@ func wrapFunction(
 		}
 	} else if fallback != nil {
 		return func(context context.Context, schema *schema.ResourceData, meta interface{}) diag.Diagnostics {
+			if operationName == "delete" {
+				if diags := checkDeletionProtection(resourceName, schema); diags != nil {
+					return diags
+				}
+			}
+
 			return wrapError(fallback(schema, meta), resourceName, operationName, isDataSource)
 		}
 	}
@@ -1832,6 +1912,30 @@ func wrapFunction(
 	return nil
 }
 
+// checkDeletionProtection only allows deletion if the resource is not marked as protected.
+func checkDeletionProtection(resourceName string, d *schema.ResourceData) diag.Diagnostics {
+	// we check the value in state, not current config. Current config will always be null for a delete
+	if d.Get(flex.DeletionProtection) != true {
+		return nil
+	}
+
+	log.Printf("[DEBUG] Resource has deletion protection turned on %s", resourceName)
+	name := d.Id()
+	if v, ok := d.GetOk("name"); ok {
+		name = fmt.Sprint(v)
+	}
+	var diags diag.Diagnostics
+	summary := fmt.Sprintf("Deletion protection is enabled for resource %s %s to prevent accidential deletion", resourceName, name)
+	return append(
+		diags,
+		diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  summary,
+			Detail:   "Set deletion_protection to false, apply and then destroy if deletion should proceed",
+		},
+	)
+}
+
 func wrapError(err error, resourceName, operationName string, isDataSource bool) diag.Diagnostics {
 	if err == nil {
 		return nil
@@ -1853,40 +1957,73 @@ func wrapError(err error, resourceName, operationName string, isDataSource bool)
 		tfError = flex.TerraformErrorf(err, "", resourceName, operationName)
 	}
 
+	detail := tfError.GetConsoleOutput()
 	log.Printf("[DEBUG] %s", tfError.GetDebugMessage())
 	return append(
 		diags,
 		diag.Diagnostic{
 			Severity: diag.Error,
 			Summary:  tfError.Error(),
-			Detail:   tfError.GetConsoleMessage(),
+			Detail:   detail,
 		},
 	)
 }
 
-func wrapCustomizeDiff(resourceName string, function schema.CustomizeDiffFunc) schema.CustomizeDiffFunc {
+func wrapCustomizeDiff(resourceName string, resourceSchema map[string]*schema.Schema, function schema.CustomizeDiffFunc) schema.CustomizeDiffFunc {
 	if function == nil {
 		return nil
 	}
 
+	userTags := isOptionalSchema(resourceSchema, "tags") || isOptionalSchema(resourceSchema, "pi_user_tags")
+	accessTags := isOptionalSchema(resourceSchema, "access_tags")
 	return func(c context.Context, rd *schema.ResourceDiff, i interface{}) error {
+		if sess, ok := i.(conns.ClientSession); ok && (!userTags || !accessTags) {
+			i = defaultTagsSession{ClientSession: sess, userTags: userTags, accessTags: accessTags}
+		}
 		return wrapDiffErrors(function(c, rd, i), resourceName)
 	}
 }
 
+func isOptionalSchema(resourceSchema map[string]*schema.Schema, key string) bool {
+	s, ok := resourceSchema[key]
+	return ok && s.Optional
+}
+
+// defaultTagsSession hides the provider default tags from the CustomizeDiff of a resource whose tags
+// are not configurable, so that the default tags are only planned for tags the resource attaches.
+type defaultTagsSession struct {
+	conns.ClientSession
+	userTags, accessTags bool
+}
+
+func (sess defaultTagsSession) DefaultTags() []string {
+	if !sess.userTags {
+		return nil
+	}
+	return sess.ClientSession.DefaultTags()
+}
+
+func (sess defaultTagsSession) DefaultAccessTags() []string {
+	if !sess.accessTags {
+		return nil
+	}
+	return sess.ClientSession.DefaultAccessTags()
+}
+
 func wrapDiffErrors(err error, resourceName string) error {
 	if err != nil {
 		// CustomizeDiff fields often use the customizediff.All() method, which concatenates the errors
 		// returned from multiple functions using errors.Join(). Individual errors are still embedded in the
 		// error and will be extracted when the error is unwrapped by the Go core.
 		tfError := flex.TerraformErrorf(err, err.Error(), resourceName, "CustomizeDiff")
+		consoleOutput := tfError.GetConsoleOutput()
 
 		// By the time this error gets printed by the Terraform code, we've lost control of it and the
 		// message that gets printed comes from the Error() method (and we only see the Summary).
 		// Although it would be ideal to return the full TerraformError object, it is sufficient
 		// to package the console message into a new error so that the user gets the information.
 		log.Printf("[DEBUG] %s", tfError.GetDebugMessage())
-		return errors.New(tfError.GetConsoleMessage())
+		return errors.New(consoleOutput)
 	}
 
 	// Return the nil error.
@@ -2032,6 +2169,7 @@ func Validator() validate.ValidatorDict {
 				"ibm_is_lb_listener_policy":                          vpc.ResourceIBMISLBListenerPolicyValidator(),
 				"ibm_is_lb_listener":                                 vpc.ResourceIBMISLBListenerValidator(),
 				"ibm_is_lb_pool_member":                              vpc.ResourceIBMISLBPoolMemberValidator(),
+				"ibm_is_lb_configuration":                            vpc.ResourceIBMISLBConfigurationValidator(),
 				"ibm_is_lb_pool":                                     vpc.ResourceIBMISLBPoolValidator(),
 				"ibm_is_lb":                                          vpc.ResourceIBMISLBValidator(),
 				"ibm_is_network_acl":                                 vpc.ResourceIBMISNetworkACLValidator(),
@@ -2155,6 +2293,7 @@ func Validator() validate.ValidatorDict {
 				"ibm_container_ingress_instance":            kubernetes.ResourceIBMContainerIngressInstanceValidator(),
 				"ibm_container_ingress_secret_tls":          kubernetes.ResourceIBMContainerIngressSecretTLSValidator(),
 				"ibm_container_ingress_secret_opaque":       kubernetes.ResourceIBMContainerIngressSecretOpaqueValidator(),
+				"ibm_container_cluster_autoscaler":          kubernetes.ResourceIBMContainerClusterAutoscalerValidator(),
 				"ibm_container_cluster_feature":             kubernetes.ResourceIBMContainerClusterFeatureValidator(),
 
 				"ibm_iam_access_group_dynamic_rule":        iamaccessgroup.ResourceIBMIAMDynamicRuleValidator(),
@@ -2387,6 +2526,26 @@ func providerConfigure(d *schema.ResourceData) (interface{}, error) {
 		file = f.(string)
 	}
 
+	defaultTags := flex.ExpandStringList(d.Get("default_tags").(*schema.Set).List())
+	defaultAccessTags := flex.ExpandStringList(d.Get("default_access_tags").(*schema.Set).List())
+
+	serviceLimits := make(map[string]conns.ServiceLimit)
+	for _, l := range d.Get("service_limits").([]interface{}) {
+		limit, ok := l.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		service := limit["service"].(string)
+		if _, ok := serviceLimits[service]; ok {
+			return nil, fmt.Errorf("[ERROR] service_limits has more than one limit for the %s service", service)
+		}
+		serviceLimits[service] = conns.ServiceLimit{
+			MaxInFlight:       limit["max_in_flight"].(int),
+			RequestsPerSecond: limit["requests_per_second"].(float64),
+			Burst:             limit["burst"].(int),
+		}
+	}
+
 	resourceGrp := d.Get("resource_group").(string)
 	region := d.Get("region").(string)
 	zone := d.Get("zone").(string)
@@ -2423,6 +2582,9 @@ func providerConfigure(d *schema.ResourceData) (interface{}, error) {
 		PrivateEndpointType:  privateEndpointType,
 		EndpointsFile:        file,
 		IAMTrustedProfileID:  iamTrustedProfileId,
+		DefaultTags:          defaultTags,
+		DefaultAccessTags:    defaultAccessTags,
+		ServiceLimits:        serviceLimits,
 	}
 
 	return config.ClientSession()
@@ -0,0 +1,165 @@
+// Copyright IBM Corp. 2025 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package provider
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+)
+
+func TestProviderInternalValidate(t *testing.T) {
+	if err := Provider().InternalValidate(); err != nil {
+		t.Fatalf("err: %s", err)
+	}
+}
+
+func TestDeletionProtectionResources(t *testing.T) {
+	resources := Provider().ResourcesMap
+	for name := range deletionProtectionResources {
+		resource, ok := resources[name]
+		if !ok {
+			t.Errorf("Resource %s in the deletion protection allow-list does not exist", name)
+			continue
+		}
+		deletionProtection, ok := resource.Schema[flex.DeletionProtection]
+		if !ok {
+			t.Errorf("Expected resource %s to have the %s argument", name, flex.DeletionProtection)
+			continue
+		}
+		if !deletionProtection.Optional || deletionProtection.Default != nil {
+			t.Errorf("Expected %s of resource %s to be optional without a default", flex.DeletionProtection, name)
+		}
+	}
+
+	if _, ok := resources["ibm_is_subnet"].Schema[flex.DeletionProtection]; ok {
+		t.Errorf("Expected resource ibm_is_subnet not to have the %s argument", flex.DeletionProtection)
+	}
+}
+
+func TestDeletionProtectionBlocksDelete(t *testing.T) {
+	deleted := false
+	resource := wrapResource("ibm_is_vpc", &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+		},
+		DeleteContext: func(context.Context, *schema.ResourceData, interface{}) diag.Diagnostics {
+			deleted = true
+			return nil
+		},
+	})
+
+	d := schema.TestResourceDataRaw(t, resource.Schema, map[string]interface{}{
+		"name":                  "protected-vpc",
+		flex.DeletionProtection: true,
+	})
+	d.SetId("r006-vpc")
+	diags := resource.DeleteContext(context.Background(), d, nil)
+	if !diags.HasError() || deleted {
+		t.Fatalf("Expected delete of a protected resource to be blocked")
+	}
+
+	d = schema.TestResourceDataRaw(t, resource.Schema, map[string]interface{}{
+		"name": "unprotected-vpc",
+	})
+	d.SetId("r006-vpc")
+	diags = resource.DeleteContext(context.Background(), d, nil)
+	if diags.HasError() || !deleted {
+		t.Fatalf("Expected delete of an unprotected resource to proceed, got %v", diags)
+	}
+}
+
+type testDefaultTagsSession struct {
+	conns.ClientSession
+}
+
+func (testDefaultTagsSession) DefaultTags() []string {
+	return []string{"owner:team"}
+}
+
+func (testDefaultTagsSession) DefaultAccessTags() []string {
+	return []string{"project:dev"}
+}
+
+func TestDefaultTagsOnlyForOptionalTags(t *testing.T) {
+	var userTags, accessTags []string
+	customizeDiff := func(_ context.Context, _ *schema.ResourceDiff, meta interface{}) error {
+		userTags = flex.DefaultTags(meta, "user")
+		accessTags = flex.DefaultTags(meta, "access")
+		return nil
+	}
+
+	resource := wrapResource("ibm_is_image_deprecate", &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"tags": {
+				Type:     schema.TypeSet,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"access_tags": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+		},
+		CustomizeDiff: customizeDiff,
+	})
+	if err := resource.CustomizeDiff(context.Background(), nil, testDefaultTagsSession{}); err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	if len(userTags) != 0 {
+		t.Errorf("Expected no default tags for computed tags, got %v", userTags)
+	}
+	if len(accessTags) != 1 {
+		t.Errorf("Expected the default access tags for optional access tags, got %v", accessTags)
+	}
+}
+
+func TestResourceErrorHint(t *testing.T) {
+	const conflict = "[PUT /pcloud/v1/cloud-instances/{cloud_instance_id}/volumes/{volume_id}][409] pcloudCloudinstancesVolumesPutConflict"
+	resource := wrapResource("ibm_pi_volume", &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+		},
+		CreateContext: func(context.Context, *schema.ResourceData, interface{}) diag.Diagnostics {
+			err := errors.New(conflict)
+			return flex.TerraformErrorf(err, err.Error(), "ibm_pi_volume", "create").GetDiag()
+		},
+		Delete: func(*schema.ResourceData, interface{}) error {
+			return errors.New(conflict)
+		},
+	})
+	d := schema.TestResourceDataRaw(t, resource.Schema, map[string]interface{}{"name": "volume"})
+
+	t.Setenv(flex.ErrorOutputEnv, "json")
+	for _, diags := range []diag.Diagnostics{
+		resource.CreateContext(context.Background(), d, nil),
+		resource.DeleteContext(context.Background(), d, nil),
+	} {
+		if !diags.HasError() {
+			t.Fatalf("Expected an error")
+		}
+		output := diags[0].Summary
+		if diags[0].Detail != "" {
+			output = diags[0].Detail
+		}
+		if !strings.HasPrefix(output, "{") || !strings.Contains(output, `"hint":"power-conflict"`) {
+			t.Errorf("Expected the JSON output with the power-conflict hint, got %s", output)
+		}
+	}
+}
@@ -200,8 +200,13 @@ func resourceIBMCmValidationCreate(context context.Context, d *schema.ResourceDa
 	}
 
 	mk := fmt.Sprintf("%s.%s", *version.CatalogID, *version.OfferingID)
-	conns.IbmMutexKV.Lock(mk)
-	defer conns.IbmMutexKV.Unlock(mk)
+	unlock, err := conns.IbmKeyedLock.Lock(context, mk)
+	if err != nil {
+		tfErr := flex.DiscriminatedTerraformErrorf(err, fmt.Sprintf("Error waiting for the lock on %s: %s", mk, err), "ibm_cm_validation", "create", "wait-for-lock")
+		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+		return tfErr.GetDiag()
+	}
+	defer unlock()
 
 	valid := "valid"
 	if version.Validation.State == &valid && d.Get("revalidate_if_validated") != true {
@@ -1811,8 +1811,13 @@ func resourceIBMCmVersionCreate(context context.Context, d *schema.ResourceData,
 	}
 
 	mk := fmt.Sprintf("%s.%s", d.Get("catalog_id").(string), d.Get("offering_id").(string))
-	conns.IbmMutexKV.Lock(mk)
-	defer conns.IbmMutexKV.Unlock(mk)
+	unlock, err := conns.IbmKeyedLock.Lock(context, mk)
+	if err != nil {
+		tfErr := flex.DiscriminatedTerraformErrorf(err, fmt.Sprintf("Error waiting for the lock on %s: %s", mk, err), "ibm_cm_version", "create", "wait-for-lock")
+		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+		return tfErr.GetDiag()
+	}
+	defer unlock()
 
 	getOfferingOptions := &catalogmanagementv1.GetOfferingOptions{}
 	getOfferingOptions.SetCatalogIdentifier(d.Get("catalog_id").(string))
@@ -2428,8 +2433,13 @@ func resourceIBMCmVersionUpdate(context context.Context, d *schema.ResourceData,
 	}
 
 	mk := fmt.Sprintf("%s.%s", d.Get("catalog_id").(string), d.Get("offering_id").(string))
-	conns.IbmMutexKV.Lock(mk)
-	defer conns.IbmMutexKV.Unlock(mk)
+	unlock, err := conns.IbmKeyedLock.Lock(context, mk)
+	if err != nil {
+		tfErr := flex.DiscriminatedTerraformErrorf(err, fmt.Sprintf("Error waiting for the lock on %s: %s", mk, err), "ibm_cm_version", "update", "wait-for-lock")
+		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+		return tfErr.GetDiag()
+	}
+	defer unlock()
 
 	getVersionOptions := &catalogmanagementv1.GetVersionOptions{}
 	getVersionOptions.SetVersionLocID(strings.Replace(d.Id(), "/", ".", 1))
@@ -2678,8 +2688,13 @@ func resourceIBMCmVersionDelete(context context.Context, d *schema.ResourceData,
 	}
 
 	mk := fmt.Sprintf("%s.%s", d.Get("catalog_id").(string), d.Get("offering_id").(string))
-	conns.IbmMutexKV.Lock(mk)
-	defer conns.IbmMutexKV.Unlock(mk)
+	unlock, err := conns.IbmKeyedLock.Lock(context, mk)
+	if err != nil {
+		tfErr := flex.DiscriminatedTerraformErrorf(err, fmt.Sprintf("Error waiting for the lock on %s: %s", mk, err), "ibm_cm_version", "delete", "wait-for-lock")
+		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+		return tfErr.GetDiag()
+	}
+	defer unlock()
 
 	deleteVersionOptions := &catalogmanagementv1.DeleteVersionOptions{}
 
@@ -16,6 +16,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
@@ -162,6 +163,22 @@ func ResourceIBMCdToolchainToolGitlab() *schema.Resource {
 							Sensitive:        true,
 							Description:      "Personal Access Token. Required if ‘auth_type’ is set to ‘pat’, ignored otherwise.",
 						},
+						"api_token_wo": &schema.Schema{
+							Type:          schema.TypeString,
+							Optional:      true,
+							WriteOnly:     true,
+							Sensitive:     true,
+							ConflictsWith: []string{"parameters.0.api_token"},
+							RequiredWith:  []string{"parameters.0.api_token_wo_version"},
+							Description:   "The API token as a write-only parameter. The value is not stored in the Terraform state. Use `api_token_wo_version` to trigger an update of the token.",
+						},
+						"api_token_wo_version": &schema.Schema{
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ValidateFunc: validation.IntAtLeast(1),
+							RequiredWith: []string{"parameters.0.api_token_wo"},
+							Description:  "The version of `api_token_wo`. Increment this value to update the tool with the current `api_token_wo`.",
+						},
 						"toolchain_issues_enabled": &schema.Schema{
 							Type:        schema.TypeBool,
 							Optional:    true,
@@ -420,6 +437,7 @@ func resourceIBMCdToolchainToolGitlabRead(context context.Context, d *schema.Res
 		"toolchain_issues_enabled": "has_issues",
 	}
 	parametersMap := GetParametersFromRead(toolchainTool.Parameters, ResourceIBMCdToolchainToolGitlab(), remapFields)
+	SetWriteOnlyParametersFromState(d, ResourceIBMCdToolchainToolGitlab(), parametersMap)
 	if err = d.Set("parameters", []map[string]interface{}{parametersMap}); err != nil {
 		err = fmt.Errorf("Error setting parameters: %s", err)
 		return flex.DiscriminatedTerraformErrorf(err, err.Error(), "ibm_cd_toolchain_tool_gitlab", "read", "set-parameters").GetDiag()
@@ -656,7 +674,7 @@ func ResourceIBMCdToolchainToolGitlabToolModelParametersAsPatch(patch map[string
 		patch["auth_type"] = nil
 	}
 	path = "parameters.0.api_token"
-	if _, exists := d.GetOk(path); d.HasChange(path) && !exists {
+	if _, exists := d.GetOk(path); d.HasChange(path) && !exists && !d.HasChange("parameters.0.api_token_wo_version") {
 		patch["api_token"] = nil
 	}
 	path = "parameters.0.toolchain_issues_enabled"
@@ -16,6 +16,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
@@ -83,6 +84,22 @@ func ResourceIBMCdToolchainToolJenkins() *schema.Resource {
 							Sensitive:        true,
 							Description:      "The API token to use for Jenkins REST API calls so that DevOps Insights can collect data from Jenkins. You can find the API token on the configuration page of your Jenkins instance. You can use a toolchain secret reference for this parameter. For more information, see [Protecting your sensitive data in Continuous Delivery](https://cloud.ibm.com/docs/ContinuousDelivery?topic=ContinuousDelivery-cd_data_security#cd_secure_credentials).",
 						},
+						"api_token_wo": &schema.Schema{
+							Type:          schema.TypeString,
+							Optional:      true,
+							WriteOnly:     true,
+							Sensitive:     true,
+							ConflictsWith: []string{"parameters.0.api_token"},
+							RequiredWith:  []string{"parameters.0.api_token_wo_version"},
+							Description:   "The API token as a write-only parameter. The value is not stored in the Terraform state. Use `api_token_wo_version` to trigger an update of the token.",
+						},
+						"api_token_wo_version": &schema.Schema{
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ValidateFunc: validation.IntAtLeast(1),
+							RequiredWith: []string{"parameters.0.api_token_wo"},
+							Description:  "The version of `api_token_wo`. Increment this value to update the tool with the current `api_token_wo`.",
+						},
 					},
 				},
 			},
@@ -257,6 +274,7 @@ func resourceIBMCdToolchainToolJenkinsRead(context context.Context, d *schema.Re
 		}
 	}
 	parametersMap := GetParametersFromRead(toolchainTool.Parameters, ResourceIBMCdToolchainToolJenkins(), nil)
+	SetWriteOnlyParametersFromState(d, ResourceIBMCdToolchainToolJenkins(), parametersMap)
 	if err = d.Set("parameters", []map[string]interface{}{parametersMap}); err != nil {
 		err = fmt.Errorf("Error setting parameters: %s", err)
 		return flex.DiscriminatedTerraformErrorf(err, err.Error(), "ibm_cd_toolchain_tool_jenkins", "read", "set-parameters").GetDiag()
@@ -430,7 +448,7 @@ func ResourceIBMCdToolchainToolJenkinsToolModelParametersAsPatch(patch map[strin
 		patch["api_user_name"] = nil
 	}
 	path = "parameters.0.api_token"
-	if _, exists := d.GetOk(path); d.HasChange(path) && !exists {
+	if _, exists := d.GetOk(path); d.HasChange(path) && !exists && !d.HasChange("parameters.0.api_token_wo_version") {
 		patch["api_token"] = nil
 	}
 }
@@ -16,6 +16,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
@@ -82,6 +83,22 @@ func ResourceIBMCdToolchainToolJira() *schema.Resource {
 							Sensitive:        true,
 							Description:      "The api token for your JIRA account. Optional for public projects. You can use a toolchain secret reference for this parameter. For more information, see [Protecting your sensitive data in Continuous Delivery](https://cloud.ibm.com/docs/ContinuousDelivery?topic=ContinuousDelivery-cd_data_security#cd_secure_credentials).",
 						},
+						"api_token_wo": &schema.Schema{
+							Type:          schema.TypeString,
+							Optional:      true,
+							WriteOnly:     true,
+							Sensitive:     true,
+							ConflictsWith: []string{"parameters.0.api_token"},
+							RequiredWith:  []string{"parameters.0.api_token_wo_version"},
+							Description:   "The API token as a write-only parameter. The value is not stored in the Terraform state. Use `api_token_wo_version` to trigger an update of the token.",
+						},
+						"api_token_wo_version": &schema.Schema{
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ValidateFunc: validation.IntAtLeast(1),
+							RequiredWith: []string{"parameters.0.api_token_wo"},
+							Description:  "The version of `api_token_wo`. Increment this value to update the tool with the current `api_token_wo`.",
+						},
 					},
 				},
 			},
@@ -263,6 +280,7 @@ func resourceIBMCdToolchainToolJiraRead(context context.Context, d *schema.Resou
 		"api_token": "password",
 	}
 	parametersMap := GetParametersFromRead(toolchainTool.Parameters, ResourceIBMCdToolchainToolJira(), remapFields)
+	SetWriteOnlyParametersFromState(d, ResourceIBMCdToolchainToolJira(), parametersMap)
 	if err = d.Set("parameters", []map[string]interface{}{parametersMap}); err != nil {
 		err = fmt.Errorf("Error setting parameters: %s", err)
 		return flex.DiscriminatedTerraformErrorf(err, err.Error(), "ibm_cd_toolchain_tool_jira", "read", "set-parameters").GetDiag()
@@ -439,7 +457,7 @@ func ResourceIBMCdToolchainToolJiraToolModelParametersAsPatch(patch map[string]i
 		patch["enable_traceability"] = nil
 	}
 	path = "parameters.0.api_token"
-	if _, exists := d.GetOk(path); d.HasChange(path) && !exists {
+	if _, exists := d.GetOk(path); d.HasChange(path) && !exists && !d.HasChange("parameters.0.api_token_wo_version") {
 		patch["api_token"] = nil
 	}
 }
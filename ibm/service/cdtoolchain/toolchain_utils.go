@@ -1,9 +1,18 @@
 package cdtoolchain
 
 import (
+	"log"
+	"strings"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
+const (
+	writeOnlySuffix        = "_wo"
+	writeOnlyVersionSuffix = "_wo_version"
+)
+
 func GetParametersForCreate(d *schema.ResourceData, resource *schema.Resource, remapFields map[string]string) map[string]interface{} {
 	params := make(map[string]interface{})
 
@@ -11,10 +20,17 @@ func GetParametersForCreate(d *schema.ResourceData, resource *schema.Resource, r
 		srcParams := d.Get("parameters.0").(map[string]interface{})
 		parametersSchema := resource.Schema["parameters"].Elem.(*schema.Resource).Schema
 		for key, element := range parametersSchema {
-			if !element.Computed && srcParams[key] != nil {
+			if !element.WriteOnly && !element.Computed && !strings.HasSuffix(key, writeOnlyVersionSuffix) && srcParams[key] != nil {
 				params[getTargetField(key, remapFields)] = srcParams[key]
 			}
 		}
+		for key, element := range parametersSchema {
+			if element.WriteOnly {
+				if value := getWriteOnlyParameter(d, key); value != "" {
+					params[getTargetField(strings.TrimSuffix(key, writeOnlySuffix), remapFields)] = value
+				}
+			}
+		}
 	}
 
 	if _, ok := d.GetOk("initialization"); ok {
@@ -35,10 +51,18 @@ func GetParametersForUpdate(d *schema.ResourceData, resource *schema.Resource, r
 	srcParams := d.Get("parameters.0").(map[string]interface{})
 	parametersSchema := resource.Schema["parameters"].Elem.(*schema.Resource).Schema
 	for key, element := range parametersSchema {
-		if !element.Computed && srcParams[key] != nil && d.HasChange("parameters.0."+key) {
+		if !element.WriteOnly && !element.Computed && !strings.HasSuffix(key, writeOnlyVersionSuffix) && srcParams[key] != nil && d.HasChange("parameters.0."+key) {
 			params[getTargetField(key, remapFields)] = srcParams[key]
 		}
 	}
+	// Write-only parameters are sent when their version changes
+	for key, element := range parametersSchema {
+		if element.WriteOnly && d.HasChange("parameters.0."+key+"_version") {
+			if value := getWriteOnlyParameter(d, key); value != "" {
+				params[getTargetField(strings.TrimSuffix(key, writeOnlySuffix), remapFields)] = value
+			}
+		}
+	}
 	return params
 }
 
@@ -54,6 +78,30 @@ func GetParametersFromRead(readParams map[string]interface{}, resource *schema.R
 	return params
 }
 
+// SetWriteOnlyParametersFromState keeps the write-only parameter versions from the state in
+// the parameters read from the service, and drops the values returned for parameters that
+// are set through their write-only variant.
+func SetWriteOnlyParametersFromState(d *schema.ResourceData, resource *schema.Resource, params map[string]interface{}) {
+	parametersSchema := resource.Schema["parameters"].Elem.(*schema.Resource).Schema
+	for key := range parametersSchema {
+		if !strings.HasSuffix(key, writeOnlyVersionSuffix) {
+			continue
+		}
+		if version, ok := d.GetOk("parameters.0." + key); ok {
+			params[key] = version
+			delete(params, strings.TrimSuffix(key, writeOnlyVersionSuffix))
+		}
+	}
+}
+
+func getWriteOnlyParameter(d *schema.ResourceData, key string) string {
+	value, err := flex.GetWriteOnlyString(d, "parameters.0."+key)
+	if err != nil {
+		log.Printf("[WARN] %s", err)
+	}
+	return value
+}
+
 func getTargetField(field string, remapFields map[string]string) string {
 	if remapFields != nil {
 		if val, ok := remapFields[field]; ok {
@@ -0,0 +1,68 @@
+// Copyright IBM Corp. 2025 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package cdtoolchain_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/service/cdtoolchain"
+)
+
+func TestGetParametersForCreateSkipsWriteOnlyVersion(t *testing.T) {
+	r := cdtoolchain.ResourceIBMCdToolchainToolJira()
+	d := schema.TestResourceDataRaw(t, r.Schema, map[string]interface{}{
+		"parameters": []interface{}{
+			map[string]interface{}{
+				"project_key":          "key",
+				"api_url":              "https://jira.example.com",
+				"api_token_wo_version": 1,
+			},
+		},
+	})
+
+	params := cdtoolchain.GetParametersForCreate(d, r, map[string]string{"api_token": "password"})
+	assert.Equal(t, "key", params["project_key"])
+	assert.NotContains(t, params, "api_token_wo")
+	assert.NotContains(t, params, "api_token_wo_version")
+	assert.Equal(t, "", params["password"])
+}
+
+func TestSetWriteOnlyParametersFromState(t *testing.T) {
+	r := cdtoolchain.ResourceIBMCdToolchainToolJenkins()
+	d := schema.TestResourceDataRaw(t, r.Schema, map[string]interface{}{
+		"parameters": []interface{}{
+			map[string]interface{}{
+				"name":                 "jenkins",
+				"api_token_wo_version": 2,
+			},
+		},
+	})
+
+	params := map[string]interface{}{
+		"name":      "jenkins",
+		"api_token": "hash:SHA3-512:abc",
+	}
+	cdtoolchain.SetWriteOnlyParametersFromState(d, r, params)
+	assert.Equal(t, 2, params["api_token_wo_version"])
+	assert.NotContains(t, params, "api_token")
+
+	d = schema.TestResourceDataRaw(t, r.Schema, map[string]interface{}{
+		"parameters": []interface{}{
+			map[string]interface{}{
+				"name":      "jenkins",
+				"api_token": "token",
+			},
+		},
+	})
+	params = map[string]interface{}{
+		"name":      "jenkins",
+		"api_token": "hash:SHA3-512:abc",
+	}
+	cdtoolchain.SetWriteOnlyParametersFromState(d, r, params)
+	assert.NotContains(t, params, "api_token_wo_version")
+	assert.Equal(t, "hash:SHA3-512:abc", params["api_token"])
+}
@@ -4,6 +4,7 @@
 package cis
 
 import (
+	"context"
 	"fmt"
 	"reflect"
 
@@ -12,6 +13,7 @@ import (
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
 	"github.com/IBM/go-sdk-core/v5/core"
 	"github.com/IBM/networking-go-sdk/rulesetsv1"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -236,11 +238,11 @@ var CISRulesetsRulesObject = &schema.Resource{
 
 func ResourceIBMCISRulesetRule() *schema.Resource {
 	return &schema.Resource{
-		Create:   ResourceIBMCISRulesetRuleCreate,
-		Read:     ResourceIBMCISRulesetRuleRead,
-		Update:   ResourceIBMCISRulesetRuleUpdate,
-		Delete:   ResourceIBMCISRulesetRuleDelete,
-		Importer: &schema.ResourceImporter{},
+		CreateContext: ResourceIBMCISRulesetRuleCreate,
+		Read:          ResourceIBMCISRulesetRuleRead,
+		UpdateContext: ResourceIBMCISRulesetRuleUpdate,
+		DeleteContext: ResourceIBMCISRulesetRuleDelete,
+		Importer:      &schema.ResourceImporter{},
 		Schema: map[string]*schema.Schema{
 			cisID: {
 				Type:        schema.TypeString,
@@ -285,10 +287,10 @@ func ResourceIBMCISRulesetRuleValidator() *validate.ResourceValidator {
 	return &ibmCISRulesetValidator
 }
 
-func ResourceIBMCISRulesetRuleCreate(d *schema.ResourceData, meta interface{}) error {
+func ResourceIBMCISRulesetRuleCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess, err := meta.(conns.ClientSession).CisRulesetsSession()
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error while getting the CisRulesetsSession %s", err)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error while getting the CisRulesetsSession %s", err))
 	}
 	crn := d.Get(cisID).(string)
 	zoneId := d.Get(cisDomainID).(string)
@@ -296,6 +298,14 @@ func ResourceIBMCISRulesetRuleCreate(d *schema.ResourceData, meta interface{}) e
 
 	sess.Crn = core.StringPtr(crn)
 
+	// The rules of a ruleset are versioned together, so the changes to the rules of the same ruleset are serialized
+	mk := cisRulesetLockKey(crn, zoneId, rulesetId)
+	unlock, err := conns.IbmKeyedLock.Lock(context, mk)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error waiting for the lock on %s: %s", mk, err))
+	}
+	defer unlock()
+
 	if zoneId != "" {
 		sess.ZoneIdentifier = core.StringPtr(zoneId)
 		opt := sess.NewCreateZoneRulesetRuleOptions(rulesetId)
@@ -313,7 +323,7 @@ func ResourceIBMCISRulesetRuleCreate(d *schema.ResourceData, meta interface{}) e
 		if !reflect.ValueOf(rulesObject[CISRulesetsRulePosition]).IsNil() {
 			position, err = expandCISRulesetsRulesPositions(rulesObject[CISRulesetsRulePosition])
 			if err != nil {
-				return fmt.Errorf("[ERROR] Error while creating the zone Rule %s", err)
+				return diag.FromErr(fmt.Errorf("[ERROR] Error while creating the zone Rule %s", err))
 			}
 		}
 		opt.SetPosition(&position)
@@ -327,7 +337,7 @@ func ResourceIBMCISRulesetRuleCreate(d *schema.ResourceData, meta interface{}) e
 		result, resp, err := sess.CreateZoneRulesetRule(opt)
 
 		if err != nil {
-			return fmt.Errorf("[ERROR] Error while creating the zone Rule %s", resp)
+			return diag.FromErr(fmt.Errorf("[ERROR] Error while creating the zone Rule %s", resp))
 		}
 		len_rules := len(result.Result.Rules)
 
@@ -386,7 +396,7 @@ func ResourceIBMCISRulesetRuleCreate(d *schema.ResourceData, meta interface{}) e
 		if reflect.ValueOf(rulesObject[CISRulesetsRulePosition]).IsNil() {
 			position, err = expandCISRulesetsRulesPositions(rulesObject[CISRulesetsRulePosition])
 			if err != nil {
-				return fmt.Errorf("[ERROR] Error while creating the instance Rule %s", err)
+				return diag.FromErr(fmt.Errorf("[ERROR] Error while creating the instance Rule %s", err))
 			}
 		}
 		opt.SetPosition(&position)
@@ -400,7 +410,7 @@ func ResourceIBMCISRulesetRuleCreate(d *schema.ResourceData, meta interface{}) e
 		result, resp, err := sess.CreateInstanceRulesetRule(opt)
 
 		if err != nil {
-			return fmt.Errorf("[ERROR] Error while creating the instance Rule %s", resp)
+			return diag.FromErr(fmt.Errorf("[ERROR] Error while creating the instance Rule %s", resp))
 		}
 
 		len_rules := len(result.Result.Rules)
@@ -416,15 +426,22 @@ func ResourceIBMCISRulesetRuleRead(d *schema.ResourceData, meta interface{}) err
 	return nil
 }
 
-func ResourceIBMCISRulesetRuleUpdate(d *schema.ResourceData, meta interface{}) error {
+func ResourceIBMCISRulesetRuleUpdate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess, err := meta.(conns.ClientSession).CisRulesetsSession()
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error while getting the CisRulesetsSession %s", err)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error while getting the CisRulesetsSession %s", err))
 	}
 
 	ruleId, rulesetId, zoneId, crn, _ := flex.ConvertTfToCisFourVar(d.Id())
 	sess.Crn = core.StringPtr(crn)
 
+	mk := cisRulesetLockKey(crn, zoneId, rulesetId)
+	unlock, err := conns.IbmKeyedLock.Lock(context, mk)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error waiting for the lock on %s: %s", mk, err))
+	}
+	defer unlock()
+
 	if zoneId != "" {
 		sess.ZoneIdentifier = core.StringPtr(zoneId)
 
@@ -443,7 +460,7 @@ func ResourceIBMCISRulesetRuleUpdate(d *schema.ResourceData, meta interface{}) e
 		opt.SetRef(rulesetsRuleObject[CISRulesetsRuleRef].(string))
 		position, positionError := expandCISRulesetsRulesPositions(rulesetsRuleObject[CISRulesetsRulePosition])
 		if positionError != nil {
-			return fmt.Errorf("[ERROR] Error while updating the zone Ruleset %s", err)
+			return diag.FromErr(fmt.Errorf("[ERROR] Error while updating the zone Ruleset %s", err))
 		}
 		opt.SetPosition(&position)
 
@@ -454,7 +471,7 @@ func ResourceIBMCISRulesetRuleUpdate(d *schema.ResourceData, meta interface{}) e
 		_, _, err := sess.UpdateZoneRulesetRule(opt)
 
 		if err != nil {
-			return fmt.Errorf("[ERROR] Error while updating the zone Ruleset %s", err)
+			return diag.FromErr(fmt.Errorf("[ERROR] Error while updating the zone Ruleset %s", err))
 		}
 
 		d.SetId(dataSourceCISRulesetsRuleCheckID(d, ruleId))
@@ -472,7 +489,7 @@ func ResourceIBMCISRulesetRuleUpdate(d *schema.ResourceData, meta interface{}) e
 		opt.SetRef(rulesetsRuleObject[CISRulesetsRuleAction].(string))
 		position, err := expandCISRulesetsRulesPositions(rulesetsRuleObject[CISRulesetsRulePosition])
 		if err != nil {
-			return fmt.Errorf("[ERROR] Error while updating the zone Ruleset %s", err)
+			return diag.FromErr(fmt.Errorf("[ERROR] Error while updating the zone Ruleset %s", err))
 		}
 		opt.SetPosition(&position)
 
@@ -483,7 +500,7 @@ func ResourceIBMCISRulesetRuleUpdate(d *schema.ResourceData, meta interface{}) e
 		_, _, err = sess.UpdateInstanceRulesetRule(opt)
 
 		if err != nil {
-			return fmt.Errorf("[ERROR] Error while updating the zone Ruleset %s", err)
+			return diag.FromErr(fmt.Errorf("[ERROR] Error while updating the zone Ruleset %s", err))
 		}
 
 		d.SetId(dataSourceCISRulesetsRuleCheckID(d, ruleId))
@@ -491,28 +508,35 @@ func ResourceIBMCISRulesetRuleUpdate(d *schema.ResourceData, meta interface{}) e
 	return nil
 }
 
-func ResourceIBMCISRulesetRuleDelete(d *schema.ResourceData, meta interface{}) error {
+func ResourceIBMCISRulesetRuleDelete(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 
 	sess, err := meta.(conns.ClientSession).CisRulesetsSession()
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error while getting the CisRulesetsSession %s", err)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error while getting the CisRulesetsSession %s", err))
 	}
 
 	ruleId, rulesetId, zoneId, crn, _ := flex.ConvertTfToCisFourVar(d.Id())
 	sess.Crn = core.StringPtr(crn)
 
+	mk := cisRulesetLockKey(crn, zoneId, rulesetId)
+	unlock, err := conns.IbmKeyedLock.Lock(context, mk)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error waiting for the lock on %s: %s", mk, err))
+	}
+	defer unlock()
+
 	if zoneId != "" {
 		sess.ZoneIdentifier = core.StringPtr(zoneId)
 		opt := sess.NewDeleteZoneRulesetRuleOptions(rulesetId, ruleId)
 		_, res, err := sess.DeleteZoneRulesetRule(opt)
 		if err != nil {
-			return fmt.Errorf("[ERROR] Error deleting the zone ruleset rule %s:%s", err, res)
+			return diag.FromErr(fmt.Errorf("[ERROR] Error deleting the zone ruleset rule %s:%s", err, res))
 		}
 	} else {
 		opt := sess.NewDeleteInstanceRulesetRuleOptions(rulesetId, ruleId)
 		_, res, err := sess.DeleteInstanceRulesetRule(opt)
 		if err != nil {
-			return fmt.Errorf("[ERROR] Error deleting the Instance ruleset rule %s:%s", err, res)
+			return diag.FromErr(fmt.Errorf("[ERROR] Error deleting the Instance ruleset rule %s:%s", err, res))
 		}
 	}
 
@@ -520,6 +544,10 @@ func ResourceIBMCISRulesetRuleDelete(d *schema.ResourceData, meta interface{}) e
 	return nil
 }
 
+func cisRulesetLockKey(crn, zoneId, rulesetId string) string {
+	return "cis_ruleset_" + crn + ":" + zoneId + ":" + rulesetId
+}
+
 func dataSourceCISRulesetsRuleCheckID(d *schema.ResourceData, ruleId string) string {
 	return ruleId + ":" + d.Get(CISRulesetsId).(string) + ":" + d.Get(cisDomainID).(string) + ":" + d.Get(cisID).(string)
 }
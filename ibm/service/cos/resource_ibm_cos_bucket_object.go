@@ -493,6 +493,12 @@ func getCosEndpoint(bucketLocation string, endpointType string) string {
 	return ""
 }
 
+// GetS3Client returns the COS S3 client used by ibm_cos_bucket_object, for the services that read
+// the objects other services write into COS buckets.
+func GetS3Client(bxSession *bxsession.Session, bucketLocation string, endpointType string, instanceCRN string) (*s3.S3, error) {
+	return getS3Client(bxSession, bucketLocation, endpointType, instanceCRN)
+}
+
 func getS3Client(bxSession *bxsession.Session, bucketLocation string, endpointType string, instanceCRN string) (*s3.S3, error) {
 	var s3Conf *aws.Config
 	visibility := endpointType
@@ -223,6 +223,26 @@ func ResourceIBMDatabaseInstance() *schema.Resource {
 				//  return true
 				// },
 			},
+			"adminpassword_wo": {
+				Description:   "The admin user password for the instance. The value is write-only and is not stored in the Terraform state.",
+				Type:          schema.TypeString,
+				Optional:      true,
+				WriteOnly:     true,
+				Sensitive:     true,
+				ConflictsWith: []string{"adminpassword"},
+				RequiredWith:  []string{"adminpassword_wo_version"},
+				ValidateFunc: validation.All(
+					validation.StringLenBetween(15, 72),
+					DatabaseUserPasswordValidator("database"),
+				),
+			},
+			"adminpassword_wo_version": {
+				Description:  "The version of adminpassword_wo. Increment this value to update the admin password with the current adminpassword_wo.",
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+				RequiredWith: []string{"adminpassword_wo"},
+			},
 			"configuration": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -323,9 +343,9 @@ func ResourceIBMDatabaseInstance() *schema.Resource {
 							ValidateFunc: validation.StringLenBetween(4, 32),
 						},
 						"password": {
-							Description:  "User password",
+							Description:  "User password. Leave empty to set the password with users_password instead.",
 							Type:         schema.TypeString,
-							Required:     true,
+							Optional:     true,
 							Sensitive:    true,
 							ValidateFunc: validation.StringLenBetween(15, 32),
 						},
@@ -346,6 +366,35 @@ func ResourceIBMDatabaseInstance() *schema.Resource {
 					},
 				},
 			},
+			"users_password": {
+				Description:  "The passwords of the users without a password in users. The passwords are write-only and are not stored in the Terraform state.",
+				Type:         schema.TypeList,
+				Optional:     true,
+				RequiredWith: []string{"users", "users_password_wo_version"},
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Description: "The name of the user.",
+							Type:        schema.TypeString,
+							Required:    true,
+						},
+						"password_wo": {
+							Description: "The password of the user. The value is write-only and is not stored in the Terraform state.",
+							Type:        schema.TypeString,
+							Required:    true,
+							WriteOnly:   true,
+							Sensitive:   true,
+						},
+					},
+				},
+			},
+			"users_password_wo_version": {
+				Description:  "The version of the passwords in users_password. Increment this value to update the passwords of the users that take their password from users_password.",
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+				RequiredWith: []string{"users_password"},
+			},
 			"allowlist": {
 				Type:     schema.TypeSet,
 				Optional: true,
@@ -1039,7 +1088,7 @@ type CountLimit struct {
 }
 
 func resourceIBMDatabaseInstanceDiff(_ context.Context, diff *schema.ResourceDiff, meta interface{}) (err error) {
-	err = flex.ResourceTagsCustomizeDiff(diff)
+	err = flex.ResourceTagsCustomizeDiff(diff, meta)
 	if err != nil {
 		return err
 	}
@@ -1376,9 +1425,11 @@ func resourceIBMDatabaseInstanceCreate(context context.Context, d *schema.Resour
 	instanceID := *instance.ID
 	icdId := flex.EscapeUrlParm(instanceID)
 
-	if pw, ok := d.GetOk("adminpassword"); ok {
-		adminPassword := pw.(string)
-
+	adminPassword, err := getAdminPassword(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if adminPassword != "" {
 		getDeploymentInfoOptions := &clouddatabasesv5.GetDeploymentInfoOptions{
 			ID: core.StringPtr(instanceID),
 		}
@@ -1492,8 +1543,19 @@ func resourceIBMDatabaseInstanceCreate(context context.Context, d *schema.Resour
 			return diag.FromErr(fmt.Errorf("[ERROR] Error getting database client settings: %s", err))
 		}
 
+		passwords, err := getUsersWriteOnlyPasswords(d)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
 		users := expandUsers(userList.(*schema.Set).List())
 		for _, user := range users {
+			if user.Password == "" {
+				if err := user.setWriteOnlyPassword(passwords); err != nil {
+					return diag.FromErr(err)
+				}
+			}
+
 			// Note: Some db users exist after provisioning (i.e. admin, repl)
 			// so we must attempt both methods
 			err := user.Update(instanceID, d, meta)
@@ -1983,9 +2045,12 @@ func resourceIBMDatabaseInstanceUpdate(context context.Context, d *schema.Resour
 		}
 	}
 
-	if d.HasChange("adminpassword") {
+	if d.HasChange("adminpassword") || d.HasChange("adminpassword_wo_version") {
 		adminUser := d.Get("adminuser").(string)
-		password := d.Get("adminpassword").(string)
+		password, err := getAdminPassword(d)
+		if err != nil {
+			return diag.FromErr(err)
+		}
 
 		user := &clouddatabasesv5.UserUpdatePasswordSetting{
 			Password: &password,
@@ -2045,11 +2110,27 @@ func resourceIBMDatabaseInstanceUpdate(context context.Context, d *schema.Resour
 		}
 	}
 
-	if d.HasChange("users") {
+	if d.HasChange("users") || d.HasChange("users_password_wo_version") {
 		oldUsers, newUsers := d.GetChange("users")
 		userChanges := expandUserChanges(oldUsers.(*schema.Set).List(), newUsers.(*schema.Set).List())
 
+		passwords, err := getUsersWriteOnlyPasswords(d)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		rotateWriteOnlyPasswords := d.HasChange("users_password_wo_version")
+
 		for _, change := range userChanges {
+			// Users without a password take it from users_password, which is not part of the state
+			if change.New != nil && change.New.Password == "" {
+				if !change.isCreate() && !change.isUpdate() && !rotateWriteOnlyPasswords {
+					continue
+				}
+				if err = change.New.setWriteOnlyPassword(passwords); err != nil {
+					return diag.FromErr(err)
+				}
+			}
+
 			// Delete User
 			if change.isDelete() {
 				// Delete Old User
@@ -3026,13 +3107,27 @@ func validateUsersDiff(_ context.Context, diff *schema.ResourceDiff, meta interf
 	oldUsers, newUsers := diff.GetChange("users")
 	userChanges := expandUserChanges(oldUsers.(*schema.Set).List(), newUsers.(*schema.Set).List())
 
+	passwords, err := getUsersWriteOnlyPasswords(diff)
+	if err != nil {
+		return err
+	}
+	rawConfig := diff.GetRawConfig()
+	passwordsKnown := rawConfig.IsNull() || rawConfig.GetAttr("users_password").IsWhollyKnown()
+
 	for _, change := range userChanges {
 		if change.isDelete() {
 			continue
 		}
 
 		if change.isCreate() || change.isUpdate() {
-			err = change.New.ValidatePassword()
+			if change.New.Password == "" {
+				// Write-only passwords that are not known yet are validated during apply
+				if passwordsKnown {
+					err = change.New.setWriteOnlyPassword(passwords)
+				}
+			} else {
+				err = change.New.ValidatePassword()
+			}
 
 			if err != nil {
 				return err
@@ -3062,6 +3157,37 @@ func validateUsersDiff(_ context.Context, diff *schema.ResourceDiff, meta interf
 	return
 }
 
+// getAdminPassword returns the admin password from either adminpassword or the write-only adminpassword_wo argument.
+func getAdminPassword(d *schema.ResourceData) (string, error) {
+	if pw, ok := d.GetOk("adminpassword"); ok {
+		return pw.(string), nil
+	}
+	return flex.GetWriteOnlyString(d, "adminpassword_wo")
+}
+
+// getUsersWriteOnlyPasswords returns the user passwords set in the write-only password_wo of users_password.
+func getUsersWriteOnlyPasswords(d interface {
+	flex.RawConfigGetter
+	Get(string) interface{}
+}) (map[string]string, error) {
+	passwords := map[string]string{}
+	for i, v := range d.Get("users_password").([]interface{}) {
+		if v == nil {
+			continue
+		}
+		name := v.(map[string]interface{})["name"].(string)
+		if _, ok := passwords[name]; ok {
+			return nil, fmt.Errorf("[ERROR] users_password has more than one password for user %s", name)
+		}
+		password, err := flex.GetWriteOnlyString(d, fmt.Sprintf("users_password.%d.password_wo", i))
+		if err != nil {
+			return nil, err
+		}
+		passwords[name] = password
+	}
+	return passwords, nil
+}
+
 func expandUsers(_users []interface{}) []*DatabaseUser {
 	if len(_users) == 0 {
 		return nil
@@ -3259,6 +3385,16 @@ func (u *DatabaseUser) Delete(instanceID string, d *schema.ResourceData, meta in
 	return nil
 }
 
+// setWriteOnlyPassword sets the password of a user that has no password in users from users_password.
+func (u *DatabaseUser) setWriteOnlyPassword(passwords map[string]string) error {
+	password, ok := passwords[u.Username]
+	if !ok || password == "" {
+		return fmt.Errorf("[ERROR] No password set for user %s. Set either the password in users or an entry for the user in users_password", u.Username)
+	}
+	u.Password = password
+	return u.ValidatePassword()
+}
+
 func (u *DatabaseUser) isUpdatable() bool {
 	return u.Type != "ops_manager"
 }
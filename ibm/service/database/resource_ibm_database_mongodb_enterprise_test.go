@@ -139,7 +139,7 @@ func TestAccIBMMongoDBEnterpriseDatabaseInstancePITR(t *testing.T) {
 				VersionConstraint: ">=0.9.1",
 			},
 		},
-		ProviderFactories: acc.TestAccProviderFactories(),
+		ProviderFactories: acc.TestAccProviderFactories(t),
 		CheckDestroy:      testAccCheckIBMDatabaseInstanceDestroy,
 		Steps: []resource.TestStep{
 			{
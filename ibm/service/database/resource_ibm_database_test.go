@@ -110,6 +110,29 @@ func TestValidateUserPassword(t *testing.T) {
 	}
 }
 
+func TestSetWriteOnlyPassword(t *testing.T) {
+	passwords := map[string]string{
+		"admin2": "secure-Password12345",
+		"weak":   "password",
+	}
+
+	user := DatabaseUser{Username: "admin2", Type: "database"}
+	err := user.setWriteOnlyPassword(passwords)
+	assert.NilError(t, err)
+	assert.Equal(t, "secure-Password12345", user.Password)
+
+	user = DatabaseUser{Username: "weak", Type: "database"}
+	err = user.setWriteOnlyPassword(passwords)
+	assert.ErrorContains(t, err, "password must contain at least one upper case letter")
+
+	user = DatabaseUser{Username: "missing", Type: "database"}
+	err = user.setWriteOnlyPassword(passwords)
+	assert.ErrorContains(t, err, "No password set for user missing")
+
+	err = user.setWriteOnlyPassword(nil)
+	assert.ErrorContains(t, err, "No password set for user missing")
+}
+
 func TestValidateRBACRole(t *testing.T) {
 	testcases := []struct {
 		user          DatabaseUser
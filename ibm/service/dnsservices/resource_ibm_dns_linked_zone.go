@@ -125,8 +125,11 @@ func resourceIBMDNSLinkedZoneCreate(ctx context.Context, d *schema.ResourceData,
 	createLinkedZoneOptions.SetDescription(description)
 	createLinkedZoneOptions.SetLabel(label)
 	mk := "dns_linked_zone_" + instanceID
-	conns.IbmMutexKV.Lock(mk)
-	defer conns.IbmMutexKV.Unlock(mk)
+	unlock, err := conns.IbmKeyedLock.Lock(ctx, mk)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error waiting for the lock on %s: %s", mk, err))
+	}
+	defer unlock()
 
 	resource, response, err := sess.CreateLinkedZone(createLinkedZoneOptions)
 	if err != nil {
@@ -198,8 +201,11 @@ func resourceIBMDNSLinkedZoneUpdate(ctx context.Context, d *schema.ResourceData,
 		updateLinkedZoneOptions.SetLabel(label)
 
 		mk := "dns_linked_zone_" + instanceID
-		conns.IbmMutexKV.Lock(mk)
-		defer conns.IbmMutexKV.Unlock(mk)
+		unlock, err := conns.IbmKeyedLock.Lock(ctx, mk)
+		if err != nil {
+			return diag.FromErr(fmt.Errorf("[ERROR] Error waiting for the lock on %s: %s", mk, err))
+		}
+		defer unlock()
 
 		_, response, err := sess.UpdateLinkedZone(updateLinkedZoneOptions)
 
@@ -224,8 +230,11 @@ func resourceIBMDNSLinkedZoneDelete(ctx context.Context, d *schema.ResourceData,
 	deleteLinkedZoneOptions := sess.NewDeleteLinkedZoneOptions(instanceID, linkedDnsZoneID)
 
 	mk := "linked_dns_zone_" + instanceID
-	conns.IbmMutexKV.Lock(mk)
-	defer conns.IbmMutexKV.Unlock(mk)
+	unlock, err := conns.IbmKeyedLock.Lock(ctx, mk)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error waiting for the lock on %s: %s", mk, err))
+	}
+	defer unlock()
 	response, err := sess.DeleteLinkedZone(deleteLinkedZoneOptions)
 
 	if err != nil {
@@ -111,8 +111,11 @@ func resourceIBMPrivateDNSSecondaryZoneCreate(ctx context.Context, d *schema.Res
 	createSecondaryZoneOptions.SetEnabled(enabled)
 
 	mk := "private_dns_secondary_zone_" + instanceID + resolverID
-	conns.IbmMutexKV.Lock(mk)
-	defer conns.IbmMutexKV.Unlock(mk)
+	unlock, err := conns.IbmKeyedLock.Lock(ctx, mk)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error waiting for the lock on %s: %s", mk, err))
+	}
+	defer unlock()
 
 	resource, response, err := sess.CreateSecondaryZone(createSecondaryZoneOptions)
 	if err != nil {
@@ -198,8 +201,11 @@ func resourceIBMPrivateDNSSecondaryZoneUpdate(ctx context.Context, d *schema.Res
 		updateSecondaryZoneOptions.SetEnabled(enabled)
 
 		mk := "private_dns_secondary_zone_" + instanceID + resolverID
-		conns.IbmMutexKV.Lock(mk)
-		defer conns.IbmMutexKV.Unlock(mk)
+		unlock, err := conns.IbmKeyedLock.Lock(ctx, mk)
+		if err != nil {
+			return diag.FromErr(fmt.Errorf("[ERROR] Error waiting for the lock on %s: %s", mk, err))
+		}
+		defer unlock()
 
 		_, response, err := sess.UpdateSecondaryZone(updateSecondaryZoneOptions)
 
@@ -226,8 +232,11 @@ func resourceIBMPrivateDNSSecondaryZoneDelete(ctx context.Context, d *schema.Res
 	deleteSecondaryZoneOptions := sess.NewDeleteSecondaryZoneOptions(instanceID, resolverID, secondaryZoneID)
 
 	mk := "private_dns_secondary_zone_" + instanceID + resolverID
-	conns.IbmMutexKV.Lock(mk)
-	defer conns.IbmMutexKV.Unlock(mk)
+	unlock, err := conns.IbmKeyedLock.Lock(ctx, mk)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error waiting for the lock on %s: %s", mk, err))
+	}
+	defer unlock()
 	response, err := sess.DeleteSecondaryZone(deleteSecondaryZoneOptions)
 
 	if err != nil {
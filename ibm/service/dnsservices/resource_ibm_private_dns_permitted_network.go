@@ -4,12 +4,14 @@
 package dnsservices
 
 import (
+	"context"
 	"fmt"
 	"strings"
 	"time"
 
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -29,11 +31,10 @@ var allowedNetworkTypes = []string{
 
 func ResourceIBMPrivateDNSPermittedNetwork() *schema.Resource {
 	return &schema.Resource{
-		Create:   resourceIBMPrivateDNSPermittedNetworkCreate,
-		Read:     resourceIBMPrivateDNSPermittedNetworkRead,
-		Delete:   resourceIBMPrivateDNSPermittedNetworkDelete,
-		Exists:   resourceIBMPrivateDNSPermittedNetworkExists,
-		Importer: &schema.ResourceImporter{},
+		CreateContext: resourceIBMPrivateDNSPermittedNetworkCreate,
+		ReadContext:   resourceIBMPrivateDNSPermittedNetworkRead,
+		DeleteContext: resourceIBMPrivateDNSPermittedNetworkDelete,
+		Importer:      &schema.ResourceImporter{},
 
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(10 * time.Minute),
@@ -98,10 +99,10 @@ func ResourceIBMPrivateDNSPermittedNetwork() *schema.Resource {
 	}
 }
 
-func resourceIBMPrivateDNSPermittedNetworkCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMPrivateDNSPermittedNetworkCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess, err := meta.(conns.ClientSession).PrivateDNSClientSession()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	instanceID := d.Get(pdnsInstanceID).(string)
@@ -109,37 +110,57 @@ func resourceIBMPrivateDNSPermittedNetworkCreate(d *schema.ResourceData, meta in
 	vpcCRN := d.Get(pdnsVpcCRN).(string)
 	nwType := d.Get(pdnsNetworkType).(string)
 	mk := "private_dns_permitted_network_" + instanceID + zoneID
-	conns.IbmMutexKV.Lock(mk)
-	defer conns.IbmMutexKV.Unlock(mk)
+	unlock, err := conns.IbmKeyedLock.Lock(context, mk)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error waiting for the lock on %s: %s", mk, err))
+	}
+	defer unlock()
 
 	permittedNetworkCrn, err := sess.NewPermittedNetworkVpc(vpcCRN)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	createPermittedNetworkOptions := sess.NewCreatePermittedNetworkOptions(instanceID, zoneID, nwType, permittedNetworkCrn)
 
-	response, detail, err := sess.CreatePermittedNetwork(createPermittedNetworkOptions)
+	response, detail, err := sess.CreatePermittedNetworkWithContext(context, createPermittedNetworkOptions)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error creating pdns permitted network:%s\n%s", err, detail)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error creating pdns permitted network:%s\n%s", err, detail))
 	}
 
 	d.SetId(fmt.Sprintf("%s/%s/%s", instanceID, zoneID, *response.ID))
 
-	return resourceIBMPrivateDNSPermittedNetworkRead(d, meta)
+	// The read waits for a shared lock of the same key
+	unlock()
+	return resourceIBMPrivateDNSPermittedNetworkRead(context, d, meta)
 }
 
-func resourceIBMPrivateDNSPermittedNetworkRead(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMPrivateDNSPermittedNetworkRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess, err := meta.(conns.ClientSession).PrivateDNSClientSession()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	idSet := strings.Split(d.Id(), "/")
+	if len(idSet) < 3 {
+		return diag.FromErr(fmt.Errorf("[ERROR] Incorrect ID %s: Id should be a combination of InstanceID/zoneID/permittedNetworkID", d.Id()))
+	}
+
+	// Reads of the permitted networks of a zone run concurrently, but wait for a network being added or removed
+	mk := "private_dns_permitted_network_" + idSet[0] + idSet[1]
+	unlock, err := conns.IbmKeyedLock.RLock(context, mk)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error waiting for the lock on %s: %s", mk, err))
+	}
+	defer unlock()
 	getPermittedNetworkOptions := sess.NewGetPermittedNetworkOptions(idSet[0], idSet[1], idSet[2])
-	response, detail, err := sess.GetPermittedNetwork(getPermittedNetworkOptions)
+	response, detail, err := sess.GetPermittedNetworkWithContext(context, getPermittedNetworkOptions)
 
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error reading pdns permitted network:%s\n%s", err, detail)
+		if detail != nil && detail.StatusCode == 404 {
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(fmt.Errorf("[ERROR] Error reading pdns permitted network:%s\n%s", err, detail))
 	}
 
 	d.Set(pdnsInstanceID, idSet[0])
@@ -154,48 +175,26 @@ func resourceIBMPrivateDNSPermittedNetworkRead(d *schema.ResourceData, meta inte
 	return nil
 }
 
-func resourceIBMPrivateDNSPermittedNetworkDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMPrivateDNSPermittedNetworkDelete(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess, err := meta.(conns.ClientSession).PrivateDNSClientSession()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	idSet := strings.Split(d.Id(), "/")
 	mk := "private_dns_permitted_network_" + idSet[0] + idSet[1]
-	conns.IbmMutexKV.Lock(mk)
-	defer conns.IbmMutexKV.Unlock(mk)
+	unlock, err := conns.IbmKeyedLock.Lock(context, mk)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error waiting for the lock on %s: %s", mk, err))
+	}
+	defer unlock()
 	deletePermittedNetworkOptions := sess.NewDeletePermittedNetworkOptions(idSet[0], idSet[1], idSet[2])
-	_, response, err := sess.DeletePermittedNetwork(deletePermittedNetworkOptions)
+	_, response, err := sess.DeletePermittedNetworkWithContext(context, deletePermittedNetworkOptions)
 
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error deleting pdns permitted network:%s\n%s", err, response)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error deleting pdns permitted network:%s\n%s", err, response))
 	}
 
 	d.SetId("")
 	return nil
 }
-
-func resourceIBMPrivateDNSPermittedNetworkExists(d *schema.ResourceData, meta interface{}) (bool, error) {
-	sess, err := meta.(conns.ClientSession).PrivateDNSClientSession()
-	if err != nil {
-		return false, err
-	}
-
-	idSet := strings.Split(d.Id(), "/")
-	if len(idSet) < 3 {
-		return false, fmt.Errorf("[ERROR] Incorrect ID %s: Id should be a combination of InstanceID/zoneID/permittedNetworkID", d.Id())
-	}
-
-	mk := "private_dns_permitted_network_" + idSet[0] + idSet[1]
-	conns.IbmMutexKV.Lock(mk)
-	defer conns.IbmMutexKV.Unlock(mk)
-	getPermittedNetworkOptions := sess.NewGetPermittedNetworkOptions(idSet[0], idSet[1], idSet[2])
-	_, response, err := sess.GetPermittedNetwork(getPermittedNetworkOptions)
-	if err != nil {
-		if response != nil && response.StatusCode == 404 {
-			return false, nil
-		}
-		return false, err
-	}
-	return true, nil
-}
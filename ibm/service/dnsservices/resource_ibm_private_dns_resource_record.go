@@ -4,6 +4,7 @@
 package dnsservices
 
 import (
+	"context"
 	"fmt"
 	"math/rand"
 	"regexp"
@@ -11,6 +12,7 @@ import (
 	"time"
 
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -50,12 +52,11 @@ func caseDiffSuppress(_, old, new string, _ *schema.ResourceData) bool {
 
 func ResourceIBMPrivateDNSResourceRecord() *schema.Resource {
 	return &schema.Resource{
-		Create:   resourceIBMPrivateDNSResourceRecordCreate,
-		Read:     resourceIBMPrivateDNSResourceRecordRead,
-		Update:   resourceIBMPrivateDNSResourceRecordUpdate,
-		Delete:   resourceIBMPrivateDNSResourceRecordDelete,
-		Exists:   resourceIBMPrivateDNSResourceRecordExists,
-		Importer: &schema.ResourceImporter{},
+		CreateContext: resourceIBMPrivateDNSResourceRecordCreate,
+		ReadContext:   resourceIBMPrivateDNSResourceRecordRead,
+		UpdateContext: resourceIBMPrivateDNSResourceRecordUpdate,
+		DeleteContext: resourceIBMPrivateDNSResourceRecordDelete,
+		Importer:      &schema.ResourceImporter{},
 
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(10 * time.Minute),
@@ -198,10 +199,10 @@ func ResourceIBMPrivateDNSResourceRecord() *schema.Resource {
 	}
 }
 
-func resourceIBMPrivateDNSResourceRecordCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMPrivateDNSResourceRecordCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess, err := meta.(conns.ClientSession).PrivateDNSClientSession()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	var (
@@ -237,31 +238,31 @@ func resourceIBMPrivateDNSResourceRecordCreate(d *schema.ResourceData, meta inte
 	case "A":
 		resourceRecordAData, err := sess.NewResourceRecordInputRdataRdataARecord(rdata)
 		if err != nil {
-			return fmt.Errorf("[ERROR] Error creating pdns resource record A data:%s", err)
+			return diag.FromErr(fmt.Errorf("[ERROR] Error creating pdns resource record A data:%s", err))
 		}
 		createResourceRecordOptions.SetRdata(resourceRecordAData)
 	case "AAAA":
 		resourceRecordAaaaData, err := sess.NewResourceRecordInputRdataRdataAaaaRecord(rdata)
 		if err != nil {
-			return fmt.Errorf("[ERROR] Error creating pdns resource record Aaaa data:%s", err)
+			return diag.FromErr(fmt.Errorf("[ERROR] Error creating pdns resource record Aaaa data:%s", err))
 		}
 		createResourceRecordOptions.SetRdata(resourceRecordAaaaData)
 	case "CNAME":
 		resourceRecordCnameData, err := sess.NewResourceRecordInputRdataRdataCnameRecord(rdata)
 		if err != nil {
-			return fmt.Errorf("[ERROR] Error creating pdns resource record Cname data:%s", err)
+			return diag.FromErr(fmt.Errorf("[ERROR] Error creating pdns resource record Cname data:%s", err))
 		}
 		createResourceRecordOptions.SetRdata(resourceRecordCnameData)
 	case "PTR":
 		resourceRecordPtrData, err := sess.NewResourceRecordInputRdataRdataPtrRecord(rdata)
 		if err != nil {
-			return fmt.Errorf("[ERROR] Error creating pdns resource record Ptr data:%s", err)
+			return diag.FromErr(fmt.Errorf("[ERROR] Error creating pdns resource record Ptr data:%s", err))
 		}
 		createResourceRecordOptions.SetRdata(resourceRecordPtrData)
 	case "TXT":
 		resourceRecordTxtData, err := sess.NewResourceRecordInputRdataRdataTxtRecord(rdata)
 		if err != nil {
-			return fmt.Errorf("[ERROR] Error creating pdns resource record Txt data:%s", err)
+			return diag.FromErr(fmt.Errorf("[ERROR] Error creating pdns resource record Txt data:%s", err))
 		}
 		createResourceRecordOptions.SetRdata(resourceRecordTxtData)
 	case "MX":
@@ -270,7 +271,7 @@ func resourceIBMPrivateDNSResourceRecordCreate(d *schema.ResourceData, meta inte
 		}
 		resourceRecordMxData, err := sess.NewResourceRecordInputRdataRdataMxRecord(rdata, int64(preference))
 		if err != nil {
-			return fmt.Errorf("[ERROR] Error creating pdns resource record Mx data:%s", err)
+			return diag.FromErr(fmt.Errorf("[ERROR] Error creating pdns resource record Mx data:%s", err))
 		}
 		createResourceRecordOptions.SetRdata(resourceRecordMxData)
 	case "SRV":
@@ -285,7 +286,7 @@ func resourceIBMPrivateDNSResourceRecordCreate(d *schema.ResourceData, meta inte
 		}
 		resourceRecordSrvData, err := sess.NewResourceRecordInputRdataRdataSrvRecord(int64(port), int64(priority), rdata, int64(weight))
 		if err != nil {
-			return fmt.Errorf("[ERROR] Error creating pdns resource record Srv data:%s", err)
+			return diag.FromErr(fmt.Errorf("[ERROR] Error creating pdns resource record Srv data:%s", err))
 		}
 		if v, ok := d.GetOk(pdnsSrvService); ok {
 			service = v.(string)
@@ -300,28 +301,48 @@ func resourceIBMPrivateDNSResourceRecordCreate(d *schema.ResourceData, meta inte
 	rand.Seed(time.Now().UnixNano())
 	randI := fmt.Sprint(rand.Intn(50))
 	mk := "private_dns_resource_record_" + instanceID + zoneID + randI
-	conns.IbmMutexKV.Lock(mk)
-	defer conns.IbmMutexKV.Unlock(mk)
-	response, detail, err := sess.CreateResourceRecord(createResourceRecordOptions)
+	unlock, err := conns.IbmKeyedLock.Lock(context, mk)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error creating pdns resource record:%s\n%s", err, detail)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error waiting for the lock on %s: %s", mk, err))
+	}
+	defer unlock()
+	response, detail, err := sess.CreateResourceRecordWithContext(context, createResourceRecordOptions)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error creating pdns resource record:%s\n%s", err, detail))
 	}
 
 	d.SetId(fmt.Sprintf("%s/%s/%s", instanceID, zoneID, *response.ID))
 
-	return resourceIBMPrivateDNSResourceRecordRead(d, meta)
+	// The read waits for a shared lock of the same key
+	unlock()
+	return resourceIBMPrivateDNSResourceRecordRead(context, d, meta)
 }
 
-func resourceIBMPrivateDNSResourceRecordRead(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMPrivateDNSResourceRecordRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	idSet := strings.Split(d.Id(), "/")
+	if len(idSet) < 3 {
+		return diag.FromErr(fmt.Errorf("[ERROR] Incorrect ID %s: Id should be a combination of InstanceID/zoneID/recordID", d.Id()))
+	}
 	sess, err := meta.(conns.ClientSession).PrivateDNSClientSession()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
+	}
+	rand.Seed(time.Now().UnixNano())
+	randI := fmt.Sprint(rand.Intn(50))
+	mk := "private_dns_resource_record_" + idSet[0] + idSet[1] + randI
+	unlock, err := conns.IbmKeyedLock.RLock(context, mk)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error waiting for the lock on %s: %s", mk, err))
 	}
+	defer unlock()
 	getResourceRecordOptions := sess.NewGetResourceRecordOptions(idSet[0], idSet[1], idSet[2])
-	response, detail, err := sess.GetResourceRecord(getResourceRecordOptions)
+	response, detail, err := sess.GetResourceRecordWithContext(context, getResourceRecordOptions)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error reading pdns resource record:%s\n%s", err, detail)
+		if detail != nil && detail.StatusCode == 404 {
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(fmt.Errorf("[ERROR] Error reading pdns resource record:%s\n%s", err, detail))
 	}
 
 	// extract the record name by removing zone details
@@ -381,18 +402,21 @@ func resourceIBMPrivateDNSResourceRecordRead(d *schema.ResourceData, meta interf
 	return nil
 }
 
-func resourceIBMPrivateDNSResourceRecordUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMPrivateDNSResourceRecordUpdate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	idSet := strings.Split(d.Id(), "/")
 
 	sess, err := meta.(conns.ClientSession).PrivateDNSClientSession()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	rand.Seed(time.Now().UnixNano())
 	randI := fmt.Sprint(rand.Intn(50))
 	mk := "private_dns_resource_record_" + idSet[0] + idSet[1] + randI
-	conns.IbmMutexKV.Lock(mk)
-	defer conns.IbmMutexKV.Unlock(mk)
+	unlock, err := conns.IbmKeyedLock.Lock(context, mk)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error waiting for the lock on %s: %s", mk, err))
+	}
+	defer unlock()
 
 	updateResourceRecordOptions := sess.NewUpdateResourceRecordOptions(idSet[0], idSet[1], idSet[2], "", nil)
 
@@ -416,7 +440,7 @@ func resourceIBMPrivateDNSResourceRecordUpdate(d *schema.ResourceData, meta inte
 			rdata = d.Get(pdnsRdata).(string)
 			resourceRecordAData, err := sess.NewResourceRecordUpdateInputRdataRdataARecord(rdata)
 			if err != nil {
-				return fmt.Errorf("[ERROR] Error creating pdns resource record A data:%s", err)
+				return diag.FromErr(fmt.Errorf("[ERROR] Error creating pdns resource record A data:%s", err))
 			}
 			updateResourceRecordOptions.SetRdata(resourceRecordAData)
 
@@ -425,7 +449,7 @@ func resourceIBMPrivateDNSResourceRecordUpdate(d *schema.ResourceData, meta inte
 			rdata = d.Get(pdnsRdata).(string)
 			resourceRecordAaaaData, err := sess.NewResourceRecordUpdateInputRdataRdataAaaaRecord(rdata)
 			if err != nil {
-				return fmt.Errorf("[ERROR] Error creating pdns resource record Aaaa data:%s", err)
+				return diag.FromErr(fmt.Errorf("[ERROR] Error creating pdns resource record Aaaa data:%s", err))
 			}
 			updateResourceRecordOptions.SetRdata(resourceRecordAaaaData)
 
@@ -434,7 +458,7 @@ func resourceIBMPrivateDNSResourceRecordUpdate(d *schema.ResourceData, meta inte
 			rdata = d.Get(pdnsRdata).(string)
 			resourceRecordCnameData, err := sess.NewResourceRecordUpdateInputRdataRdataCnameRecord(rdata)
 			if err != nil {
-				return fmt.Errorf("[ERROR] Error creating pdns resource record Cname data:%s", err)
+				return diag.FromErr(fmt.Errorf("[ERROR] Error creating pdns resource record Cname data:%s", err))
 			}
 			updateResourceRecordOptions.SetRdata(resourceRecordCnameData)
 
@@ -446,7 +470,7 @@ func resourceIBMPrivateDNSResourceRecordUpdate(d *schema.ResourceData, meta inte
 			rdata = d.Get(pdnsRdata).(string)
 			resourceRecordTxtData, err := sess.NewResourceRecordUpdateInputRdataRdataTxtRecord(rdata)
 			if err != nil {
-				return fmt.Errorf("[ERROR] Error creating pdns resource record Txt data:%s", err)
+				return diag.FromErr(fmt.Errorf("[ERROR] Error creating pdns resource record Txt data:%s", err))
 			}
 			updateResourceRecordOptions.SetRdata(resourceRecordTxtData)
 
@@ -457,7 +481,7 @@ func resourceIBMPrivateDNSResourceRecordUpdate(d *schema.ResourceData, meta inte
 
 			resourceRecordMxData, err := sess.NewResourceRecordUpdateInputRdataRdataMxRecord(rdata, int64(preference))
 			if err != nil {
-				return fmt.Errorf("[ERROR] Error creating pdns resource record Mx data:%s", err)
+				return diag.FromErr(fmt.Errorf("[ERROR] Error creating pdns resource record Mx data:%s", err))
 			}
 			updateResourceRecordOptions.SetRdata(resourceRecordMxData)
 
@@ -470,7 +494,7 @@ func resourceIBMPrivateDNSResourceRecordUpdate(d *schema.ResourceData, meta inte
 
 			resourceRecordSrvData, err := sess.NewResourceRecordUpdateInputRdataRdataSrvRecord(int64(port), int64(priority), rdata, int64(weight))
 			if err != nil {
-				return fmt.Errorf("[ERROR] Error creating pdns resource record Srv data:%s", err)
+				return diag.FromErr(fmt.Errorf("[ERROR] Error creating pdns resource record Srv data:%s", err))
 			}
 			updateResourceRecordOptions.SetRdata(resourceRecordSrvData)
 
@@ -480,62 +504,40 @@ func resourceIBMPrivateDNSResourceRecordUpdate(d *schema.ResourceData, meta inte
 			updateResourceRecordOptions.SetProtocol(protocol)
 		}
 
-		_, detail, err := sess.UpdateResourceRecord(updateResourceRecordOptions)
+		_, detail, err := sess.UpdateResourceRecordWithContext(context, updateResourceRecordOptions)
 		if err != nil {
-			return fmt.Errorf("[ERROR] Error updating pdns resource record:%s\n%s", err, detail)
+			return diag.FromErr(fmt.Errorf("[ERROR] Error updating pdns resource record:%s\n%s", err, detail))
 		}
 	}
 
-	return resourceIBMPrivateDNSResourceRecordRead(d, meta)
+	// The read waits for a shared lock of the same key
+	unlock()
+	return resourceIBMPrivateDNSResourceRecordRead(context, d, meta)
 }
 
-func resourceIBMPrivateDNSResourceRecordDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMPrivateDNSResourceRecordDelete(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	idSet := strings.Split(d.Id(), "/")
 
 	sess, err := meta.(conns.ClientSession).PrivateDNSClientSession()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	rand.Seed(time.Now().UnixNano())
 	randI := fmt.Sprint(rand.Intn(50))
 	deleteResourceRecordOptions := sess.NewDeleteResourceRecordOptions(idSet[0], idSet[1], idSet[2])
 	mk := "private_dns_resource_record_" + idSet[0] + idSet[1] + randI
-	conns.IbmMutexKV.Lock(mk)
-	defer conns.IbmMutexKV.Unlock(mk)
-	response, err := sess.DeleteResourceRecord(deleteResourceRecordOptions)
+	unlock, err := conns.IbmKeyedLock.Lock(context, mk)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error deleting pdns resource record:%s\n%s", err, response)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error waiting for the lock on %s: %s", mk, err))
 	}
-
-	d.SetId("")
-	return nil
-}
-
-func resourceIBMPrivateDNSResourceRecordExists(d *schema.ResourceData, meta interface{}) (bool, error) {
-	sess, err := meta.(conns.ClientSession).PrivateDNSClientSession()
+	defer unlock()
+	response, err := sess.DeleteResourceRecordWithContext(context, deleteResourceRecordOptions)
 	if err != nil {
-		return false, err
+		return diag.FromErr(fmt.Errorf("[ERROR] Error deleting pdns resource record:%s\n%s", err, response))
 	}
 
-	idSet := strings.Split(d.Id(), "/")
-	if len(idSet) < 3 {
-		return false, fmt.Errorf("[ERROR] Incorrect ID %s: Id should be a combination of InstanceID/zoneID/recordID", d.Id())
-	}
-	rand.Seed(time.Now().UnixNano())
-	randI := fmt.Sprint(rand.Intn(50))
-	getResourceRecordOptions := sess.NewGetResourceRecordOptions(idSet[0], idSet[1], idSet[2])
-	mk := "private_dns_resource_record_" + idSet[0] + idSet[1] + randI
-	conns.IbmMutexKV.Lock(mk)
-	defer conns.IbmMutexKV.Unlock(mk)
-	_, response, err := sess.GetResourceRecord(getResourceRecordOptions)
-
-	if err != nil {
-		if response != nil && response.StatusCode == 404 {
-			return false, nil
-		}
-		return false, err
-	}
-	return true, nil
+	d.SetId("")
+	return nil
 }
 
 func suppressPDNSRecordNameDiff(k, old, new string, d *schema.ResourceData) bool {
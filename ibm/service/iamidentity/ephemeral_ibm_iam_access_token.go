@@ -0,0 +1,93 @@
+// Copyright IBM Corp. 2025 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package iamidentity
+
+import (
+	"context"
+	"time"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+const iamAccessTokenResourceName = "ibm_iam_access_token"
+
+var _ ephemeral.EphemeralResourceWithConfigure = &ephemeralIBMIAMAccessToken{}
+
+// ephemeralIBMIAMAccessToken exposes the provider's IAM access token like the
+// ibm_iam_auth_token data source, without storing it in the plan or state.
+type ephemeralIBMIAMAccessToken struct {
+	meta interface{}
+}
+
+type ephemeralIBMIAMAccessTokenModel struct {
+	IAMAccessToken types.String `tfsdk:"iam_access_token"`
+	ExpiresAt      types.String `tfsdk:"expires_at"`
+}
+
+func EphemeralIBMIAMAccessToken() ephemeral.EphemeralResource {
+	return &ephemeralIBMIAMAccessToken{}
+}
+
+func (r *ephemeralIBMIAMAccessToken) Metadata(ctx context.Context, req ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse) {
+	resp.TypeName = iamAccessTokenResourceName
+}
+
+func (r *ephemeralIBMIAMAccessToken) Schema(ctx context.Context, req ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Provides the IAM access token of the provider's credentials without storing it in the plan or state. The token is refreshed when the provider has a refresh token.",
+		Attributes: map[string]schema.Attribute{
+			"iam_access_token": schema.StringAttribute{
+				Computed:    true,
+				Sensitive:   true,
+				Description: "The IAM access token, including the Bearer prefix.",
+			},
+			"expires_at": schema.StringAttribute{
+				Computed:    true,
+				Description: "The time when the access token expires. The date format follows RFC 3339.",
+			},
+		},
+	}
+}
+
+func (r *ephemeralIBMIAMAccessToken) Configure(ctx context.Context, req ephemeral.ConfigureRequest, resp *ephemeral.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	r.meta = req.ProviderData
+}
+
+func (r *ephemeralIBMIAMAccessToken) Open(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+	if r.meta == nil {
+		tfErr := flex.TerraformErrorf(nil, "The provider has not been configured", "(Ephemeral) "+iamAccessTokenResourceName, "open")
+		resp.Diagnostics.Append(tfErr.GetFrameworkDiag()...)
+		return
+	}
+
+	bmxSess, err := r.meta.(conns.ClientSession).BluemixSession()
+	if err != nil {
+		tfErr := flex.TerraformErrorf(err, "", "(Ephemeral) "+iamAccessTokenResourceName, "open")
+		resp.Diagnostics.Append(tfErr.GetFrameworkDiag()...)
+		return
+	}
+
+	accessToken, expiration, err := conns.IAMAccessToken(bmxSess)
+	if err != nil {
+		tfErr := flex.TerraformErrorf(err, "Error getting the IAM access token", "(Ephemeral) "+iamAccessTokenResourceName, "open")
+		resp.Diagnostics.Append(tfErr.GetFrameworkDiag()...)
+		return
+	}
+
+	model := ephemeralIBMIAMAccessTokenModel{
+		IAMAccessToken: types.StringValue(accessToken),
+		ExpiresAt:      types.StringNull(),
+	}
+	if !expiration.IsZero() {
+		model.ExpiresAt = types.StringValue(expiration.UTC().Format(time.RFC3339))
+	}
+	resp.Diagnostics.Append(resp.Result.Set(ctx, &model)...)
+}
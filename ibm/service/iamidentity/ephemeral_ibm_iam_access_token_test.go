@@ -0,0 +1,39 @@
+// Copyright IBM Corp. 2025 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package iamidentity_test
+
+import (
+	"testing"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+// Ephemeral resources need Terraform 1.10 or later and leave nothing in the
+// state, so the opened token is checked with a postcondition.
+func TestAccIBMIAMAccessTokenEphemeralResourceBasic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acc.TestAccPreCheck(t) },
+		ProtoV5ProviderFactories: acc.TestAccProtoV5ProviderFactories(t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMIAMAccessTokenEphemeralResourceConfig(),
+			},
+		},
+	})
+}
+
+func testAccCheckIBMIAMAccessTokenEphemeralResourceConfig() string {
+	return `
+		ephemeral "ibm_iam_access_token" "token" {
+			lifecycle {
+				postcondition {
+					condition     = startswith(self.iam_access_token, "Bearer ") && self.expires_at != null
+					error_message = "The access token or its expiration is missing."
+				}
+			}
+		}
+	`
+}
@@ -12,6 +12,7 @@ import (
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
 	"github.com/IBM/platform-services-go-sdk/iamidentityv1"
 )
@@ -52,6 +53,23 @@ func ResourceIBMIAMApiKey() *schema.Resource {
 				Sensitive:   true,
 				Description: "You can optionally passthrough the API key value for this API key. If passed, NO validation of that apiKey value is done, i.e. the value can be non-URL safe. If omitted, the API key management will create an URL safe opaque API key value. The value of the API key is checked for uniqueness. Please ensure enough variations when passing in this value.",
 			},
+			"apikey_wo": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				WriteOnly:     true,
+				Sensitive:     true,
+				ConflictsWith: []string{"apikey"},
+				RequiredWith:  []string{"apikey_wo_version"},
+				Description:   "The API key value to passthrough for this API key. The value is write-only and is not stored in the Terraform state.",
+			},
+			"apikey_wo_version": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+				RequiredWith: []string{"apikey_wo"},
+				Description:  "The version of apikey_wo. Changing the version replaces the API key with one that uses the current apikey_wo.",
+			},
 			"store_value": {
 				Type:        schema.TypeBool,
 				Optional:    true,
@@ -133,6 +151,13 @@ func resourceIbmIamApiKeyCreate(context context.Context, d *schema.ResourceData,
 	if _, ok := d.GetOk("apikey"); ok {
 		createApiKeyOptions.SetApikey(d.Get("apikey").(string))
 	}
+	apikeyWriteOnly, err := flex.GetWriteOnlyString(d, "apikey_wo")
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if apikeyWriteOnly != "" {
+		createApiKeyOptions.SetApikey(apikeyWriteOnly)
+	}
 	if _, ok := d.GetOk("store_value"); ok {
 		createApiKeyOptions.SetStoreValue(d.Get("store_value").(bool))
 	}
@@ -147,7 +172,10 @@ func resourceIbmIamApiKeyCreate(context context.Context, d *schema.ResourceData,
 	}
 
 	d.SetId(*apiKey.ID)
-	d.Set("apikey", *apiKey.Apikey)
+	// The API key value is kept out of the state when it is passed through apikey_wo
+	if apikeyWriteOnly == "" {
+		d.Set("apikey", *apiKey.Apikey)
+	}
 
 	if keyfile, ok := d.GetOk("file"); ok {
 		if err := saveToFile(apiKey, keyfile.(string)); err != nil {
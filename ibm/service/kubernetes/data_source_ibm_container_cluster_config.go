@@ -4,6 +4,7 @@
 package kubernetes
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"path/filepath"
@@ -11,11 +12,13 @@ import (
 	"strings"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	homedir "github.com/mitchellh/go-homedir"
 
 	v1 "github.com/IBM-Cloud/bluemix-go/api/container/containerv1"
+	v2 "github.com/IBM-Cloud/bluemix-go/api/container/containerv2"
 	"github.com/IBM-Cloud/bluemix-go/helpers"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
@@ -23,7 +26,7 @@ import (
 
 func DataSourceIBMContainerClusterConfig() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceIBMContainerClusterConfigRead,
+		ReadContext: dataSourceIBMContainerClusterConfigRead,
 
 		Schema: map[string]*schema.Schema{
 
@@ -145,10 +148,10 @@ func DataSourceIBMContainerClusterConfigValidator() *validate.ResourceValidator
 	return &iBMContainerClusterConfigValidator
 }
 
-func dataSourceIBMContainerClusterConfigRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceIBMContainerClusterConfigRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	csClient, err := meta.(conns.ClientSession).VpcContainerAPI()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	csAPI := csClient.Clusters()
 	name := d.Get("cluster_name_id").(string)
@@ -159,13 +162,16 @@ func dataSourceIBMContainerClusterConfigRead(d *schema.ResourceData, meta interf
 	endpointType := d.Get("endpoint_type").(string)
 
 	clusterId := "Cluster_Config_" + name
-	conns.IbmMutexKV.Lock(clusterId)
-	defer conns.IbmMutexKV.Unlock(clusterId)
+	unlock, err := conns.IbmKeyedLock.Lock(context, clusterId)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error waiting for the lock on %s: %s", clusterId, err))
+	}
+	defer unlock()
 
 	if len(configDir) == 0 {
 		configDir, err = homedir.Dir()
 		if err != nil {
-			return fmt.Errorf("[ERROR] Error fetching homedir: %s", err)
+			return diag.FromErr(fmt.Errorf("[ERROR] Error fetching homedir: %s", err))
 		}
 	}
 	configDir, _ = filepath.Abs(configDir)
@@ -176,14 +182,14 @@ func dataSourceIBMContainerClusterConfigRead(d *schema.ResourceData, meta interf
 		expectedDir := v1.ComputeClusterConfigDir(configDir, name, admin)
 		configPath = filepath.Join(expectedDir, "config.yml")
 		if !helpers.FileExists(configPath) {
-			return fmt.Errorf(`[ERROR] Couldn't find the cluster config at expected path %s. Please set "download" to true to download the new config`, configPath)
+			return diag.FromErr(fmt.Errorf(`[ERROR] Couldn't find the cluster config at expected path %s. Please set "download" to true to download the new config`, configPath))
 		}
 		d.Set("config_file_path", configPath)
 
 	} else {
 		targetEnv, err := getVpcClusterTargetHeader(d)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 		if network {
 			// For the Network config we need to gather the certs so we must override the admin value
@@ -209,7 +215,7 @@ func dataSourceIBMContainerClusterConfigRead(d *schema.ResourceData, meta interf
 				calicoConfigFilePath, clusterKeyDetails, err = csAPI.StoreConfigDetail(name, configDir, admin || true, network, targetEnv, endpointType)
 			}
 			if err != nil {
-				return fmt.Errorf("[ERROR] Error downloading the cluster config [%s]: %s", name, err)
+				return diag.FromErr(fmt.Errorf("[ERROR] Error downloading the cluster config [%s]: %s", name, err))
 			}
 			d.Set("calico_config_file_path", calicoConfigFilePath)
 			d.Set("admin_key", clusterKeyDetails.AdminKey)
@@ -220,28 +226,9 @@ func dataSourceIBMContainerClusterConfigRead(d *schema.ResourceData, meta interf
 			d.Set("config_file_path", clusterKeyDetails.FilePath)
 
 		} else {
-			var clusterKeyDetails v1.ClusterKeyInfo
-			err = resource.Retry(5*time.Minute, func() *resource.RetryError {
-				var err error
-				clusterKeyDetails, err = csAPI.GetClusterConfigDetail(name, configDir, admin, targetEnv, endpointType)
-				if err != nil {
-					log.Printf("[DEBUG] Failed to fetch cluster config err %s", err)
-					if strings.Contains(err.Error(), "Could not login to openshift account runtime error:") {
-						return resource.RetryableError(err)
-					}
-					if intermittentUserLookupFailure, _ := regexp.MatchString("Error: lookup of user for \"(.+)\" failed", err.Error()); intermittentUserLookupFailure {
-						// Intermittent error resulting from synchronisation delay
-						return resource.RetryableError(err)
-					}
-					return resource.NonRetryableError(err)
-				}
-				return nil
-			})
-			if conns.IsResourceTimeoutError(err) {
-				clusterKeyDetails, err = csAPI.GetClusterConfigDetail(name, configDir, admin, targetEnv, endpointType)
-			}
+			clusterKeyDetails, err := getClusterConfigDetail(csAPI, name, configDir, admin, targetEnv, endpointType)
 			if err != nil {
-				return fmt.Errorf("[ERROR] Error downloading the cluster config [%s]: %s", name, err)
+				return diag.FromErr(fmt.Errorf("[ERROR] Error downloading the cluster config [%s]: %s", name, err))
 			}
 			d.Set("admin_key", clusterKeyDetails.AdminKey)
 			d.Set("admin_certificate", clusterKeyDetails.Admin)
@@ -256,3 +243,29 @@ func dataSourceIBMContainerClusterConfigRead(d *schema.ResourceData, meta interf
 	d.Set("config_dir", configDir)
 	return nil
 }
+
+// getClusterConfigDetail downloads the cluster config into dir, retrying the intermittent
+// login and user lookup failures that follow the creation of a cluster
+func getClusterConfigDetail(csAPI v2.Clusters, name, dir string, admin bool, targetEnv v2.ClusterTargetHeader, endpointType string) (v1.ClusterKeyInfo, error) {
+	var clusterKeyDetails v1.ClusterKeyInfo
+	err := resource.Retry(5*time.Minute, func() *resource.RetryError {
+		var err error
+		clusterKeyDetails, err = csAPI.GetClusterConfigDetail(name, dir, admin, targetEnv, endpointType)
+		if err != nil {
+			log.Printf("[DEBUG] Failed to fetch cluster config err %s", err)
+			if strings.Contains(err.Error(), "Could not login to openshift account runtime error:") {
+				return resource.RetryableError(err)
+			}
+			if intermittentUserLookupFailure, _ := regexp.MatchString("Error: lookup of user for \"(.+)\" failed", err.Error()); intermittentUserLookupFailure {
+				// Intermittent error resulting from synchronisation delay
+				return resource.RetryableError(err)
+			}
+			return resource.NonRetryableError(err)
+		}
+		return nil
+	})
+	if conns.IsResourceTimeoutError(err) {
+		clusterKeyDetails, err = csAPI.GetClusterConfigDetail(name, dir, admin, targetEnv, endpointType)
+	}
+	return clusterKeyDetails, err
+}
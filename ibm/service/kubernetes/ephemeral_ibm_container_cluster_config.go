@@ -0,0 +1,288 @@
+// Copyright IBM Corp. 2025 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package kubernetes
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	v1 "github.com/IBM-Cloud/bluemix-go/api/container/containerv1"
+	v2 "github.com/IBM-Cloud/bluemix-go/api/container/containerv2"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"gopkg.in/yaml.v3"
+)
+
+const clusterConfigResourceName = "ibm_container_cluster_config"
+
+var _ ephemeral.EphemeralResourceWithConfigure = &ephemeralIBMContainerClusterConfig{}
+
+// ephemeralIBMContainerClusterConfig returns the connection details of a classic or VPC cluster
+// like the ibm_container_cluster_config data source, without storing them in the plan or state
+// and without leaving the cluster config on disk.
+type ephemeralIBMContainerClusterConfig struct {
+	meta interface{}
+}
+
+type ephemeralIBMContainerClusterConfigModel struct {
+	ClusterNameID     types.String `tfsdk:"cluster_name_id"`
+	ResourceGroupID   types.String `tfsdk:"resource_group_id"`
+	Admin             types.Bool   `tfsdk:"admin"`
+	EndpointType      types.String `tfsdk:"endpoint_type"`
+	Host              types.String `tfsdk:"host"`
+	CACertificate     types.String `tfsdk:"ca_certificate"`
+	Token             types.String `tfsdk:"token"`
+	ClientCertificate types.String `tfsdk:"client_certificate"`
+	ClientKey         types.String `tfsdk:"client_key"`
+	ExpiresAt         types.String `tfsdk:"expires_at"`
+	Kubeconfig        types.String `tfsdk:"kubeconfig"`
+}
+
+func EphemeralIBMContainerClusterConfig() ephemeral.EphemeralResource {
+	return &ephemeralIBMContainerClusterConfig{}
+}
+
+func (r *ephemeralIBMContainerClusterConfig) Metadata(ctx context.Context, req ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse) {
+	resp.TypeName = clusterConfigResourceName
+}
+
+func (r *ephemeralIBMContainerClusterConfig) Schema(ctx context.Context, req ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Provides short-lived credentials and a kubeconfig for a classic or VPC cluster without storing them in the plan or state. The cluster config is extracted into a private directory in memory, or in the temporary directory where no memory backed file system is available, and removed before the resource is opened. A new token is requested with the provider's credentials each time the resource is opened.",
+		Attributes: map[string]schema.Attribute{
+			"cluster_name_id": schema.StringAttribute{
+				Required:    true,
+				Description: "The name/id of the cluster",
+			},
+			"resource_group_id": schema.StringAttribute{
+				Optional:    true,
+				Description: "ID of the resource group.",
+			},
+			"admin": schema.BoolAttribute{
+				Optional:    true,
+				Description: "If set to true, returns the admin client certificate and key instead of a token",
+			},
+			"endpoint_type": schema.StringAttribute{
+				Optional:    true,
+				Description: "It can specify what kind of server URL will be used for the cluster context",
+			},
+			"host": schema.StringAttribute{
+				Computed:    true,
+				Description: "The URL of the cluster API server",
+			},
+			"ca_certificate": schema.StringAttribute{
+				Computed:    true,
+				Description: "The PEM encoded CA certificate of the cluster API server. Empty for OpenShift clusters, whose API server certificate is publicly trusted",
+			},
+			"token": schema.StringAttribute{
+				Computed:    true,
+				Sensitive:   true,
+				Description: "The token to authenticate to the cluster, when admin is not set",
+			},
+			"client_certificate": schema.StringAttribute{
+				Computed:    true,
+				Sensitive:   true,
+				Description: "The PEM encoded admin client certificate, when admin is set",
+			},
+			"client_key": schema.StringAttribute{
+				Computed:    true,
+				Sensitive:   true,
+				Description: "The PEM encoded admin client key, when admin is set",
+			},
+			"expires_at": schema.StringAttribute{
+				Computed:    true,
+				Description: "The time when the token expires, if it is known. The date format follows RFC 3339.",
+			},
+			"kubeconfig": schema.StringAttribute{
+				Computed:    true,
+				Sensitive:   true,
+				Description: "A kubeconfig with the credentials inline",
+			},
+		},
+	}
+}
+
+func (r *ephemeralIBMContainerClusterConfig) Configure(ctx context.Context, req ephemeral.ConfigureRequest, resp *ephemeral.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	r.meta = req.ProviderData
+}
+
+func (r *ephemeralIBMContainerClusterConfig) Open(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+	var model ephemeralIBMContainerClusterConfigModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &model)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if r.meta == nil {
+		tfErr := flex.TerraformErrorf(nil, "The provider has not been configured", "(Ephemeral) "+clusterConfigResourceName, "open")
+		resp.Diagnostics.Append(tfErr.GetFrameworkDiag()...)
+		return
+	}
+
+	// The v2 API serves both the classic and the VPC clusters
+	csClient, err := r.meta.(conns.ClientSession).VpcContainerAPI()
+	if err != nil {
+		tfErr := flex.TerraformErrorf(err, "", "(Ephemeral) "+clusterConfigResourceName, "open")
+		resp.Diagnostics.Append(tfErr.GetFrameworkDiag()...)
+		return
+	}
+	targetEnv := v2.ClusterTargetHeader{
+		ResourceGroup: model.ResourceGroupID.ValueString(),
+	}
+	name := model.ClusterNameID.ValueString()
+	admin := model.Admin.ValueBool()
+
+	// The API only serves the cluster config as a zip archive, which is extracted into a private
+	// directory that is removed before returning
+	configDir, err := clusterConfigTempDir()
+	if err != nil {
+		tfErr := flex.TerraformErrorf(err, "Error creating a temporary directory for the cluster config", "(Ephemeral) "+clusterConfigResourceName, "open")
+		resp.Diagnostics.Append(tfErr.GetFrameworkDiag()...)
+		return
+	}
+	defer os.RemoveAll(configDir)
+
+	clusterKeyDetails, err := getClusterConfigDetail(csClient.Clusters(), name, configDir, admin, targetEnv, model.EndpointType.ValueString())
+	if err != nil {
+		tfErr := flex.TerraformErrorf(err, fmt.Sprintf("Error getting the cluster config [%s]: %s", name, err), "(Ephemeral) "+clusterConfigResourceName, "open")
+		resp.Diagnostics.Append(tfErr.GetFrameworkDiag()...)
+		return
+	}
+
+	kubeconfig, err := clusterConfigKubeconfig(name, clusterKeyDetails, admin)
+	if err != nil {
+		tfErr := flex.TerraformErrorf(err, "Error generating the kubeconfig", "(Ephemeral) "+clusterConfigResourceName, "open")
+		resp.Diagnostics.Append(tfErr.GetFrameworkDiag()...)
+		return
+	}
+
+	model.Host = types.StringValue(clusterKeyDetails.Host)
+	model.CACertificate = types.StringValue(clusterKeyDetails.ClusterCACertificate)
+	model.Token = types.StringNull()
+	model.ClientCertificate = types.StringNull()
+	model.ClientKey = types.StringNull()
+	model.ExpiresAt = types.StringNull()
+	if admin {
+		model.ClientCertificate = types.StringValue(clusterKeyDetails.Admin)
+		model.ClientKey = types.StringValue(clusterKeyDetails.AdminKey)
+	} else {
+		model.Token = types.StringValue(clusterKeyDetails.Token)
+		if expiration := clusterConfigTokenExpiration(clusterKeyDetails.Token); !expiration.IsZero() {
+			model.ExpiresAt = types.StringValue(expiration.UTC().Format(time.RFC3339))
+		}
+	}
+	model.Kubeconfig = types.StringValue(kubeconfig)
+	resp.Diagnostics.Append(resp.Result.Set(ctx, &model)...)
+}
+
+// clusterConfigMemoryDir is a file system backed by memory on Linux
+const clusterConfigMemoryDir = "/dev/shm"
+
+// clusterConfigTempDir creates the private directory the cluster config is extracted into, in memory
+// when clusterConfigMemoryDir is available so that the credentials are not written to a disk. On other
+// systems the directory is created in the temporary directory of the user.
+func clusterConfigTempDir() (string, error) {
+	if info, err := os.Stat(clusterConfigMemoryDir); err == nil && info.IsDir() {
+		if dir, err := os.MkdirTemp(clusterConfigMemoryDir, "ibm-cluster-config-"); err == nil {
+			return dir, nil
+		}
+	}
+	return os.MkdirTemp("", "ibm-cluster-config-")
+}
+
+// clusterConfigTokenExpiration returns the expiration of the IAM ID token of a Kubernetes cluster,
+// or the zero time for the opaque OAuth tokens of OpenShift clusters
+func clusterConfigTokenExpiration(token string) time.Time {
+	claims := jwt.MapClaims{}
+	if _, _, err := jwt.NewParser().ParseUnverified(strings.TrimPrefix(token, "Bearer "), claims); err != nil {
+		return time.Time{}
+	}
+	expiration, err := claims.GetExpirationTime()
+	if err != nil || expiration == nil {
+		return time.Time{}
+	}
+	return expiration.Time
+}
+
+type clusterKubeconfig struct {
+	APIVersion     string                     `yaml:"apiVersion"`
+	Kind           string                     `yaml:"kind"`
+	Clusters       []clusterKubeconfigCluster `yaml:"clusters"`
+	Users          []clusterKubeconfigUser    `yaml:"users"`
+	Contexts       []clusterKubeconfigContext `yaml:"contexts"`
+	CurrentContext string                     `yaml:"current-context"`
+}
+
+type clusterKubeconfigCluster struct {
+	Name    string `yaml:"name"`
+	Cluster struct {
+		Server                   string `yaml:"server"`
+		CertificateAuthorityData string `yaml:"certificate-authority-data,omitempty"`
+	} `yaml:"cluster"`
+}
+
+type clusterKubeconfigUser struct {
+	Name string `yaml:"name"`
+	User struct {
+		Token                 string `yaml:"token,omitempty"`
+		ClientCertificateData string `yaml:"client-certificate-data,omitempty"`
+		ClientKeyData         string `yaml:"client-key-data,omitempty"`
+	} `yaml:"user"`
+}
+
+type clusterKubeconfigContext struct {
+	Name    string `yaml:"name"`
+	Context struct {
+		Cluster string `yaml:"cluster"`
+		User    string `yaml:"user"`
+	} `yaml:"context"`
+}
+
+// clusterConfigKubeconfig returns a kubeconfig with the certificates and the token of the cluster
+// config inline, so that it can be used without the files of the downloaded cluster config
+func clusterConfigKubeconfig(name string, clusterKeyDetails v1.ClusterKeyInfo, admin bool) (string, error) {
+	cluster := clusterKubeconfigCluster{Name: name}
+	cluster.Cluster.Server = clusterKeyDetails.Host
+	if clusterKeyDetails.ClusterCACertificate != "" {
+		cluster.Cluster.CertificateAuthorityData = base64.StdEncoding.EncodeToString([]byte(clusterKeyDetails.ClusterCACertificate))
+	}
+
+	user := clusterKubeconfigUser{Name: name}
+	if admin {
+		user.Name = name + "-admin"
+		user.User.ClientCertificateData = base64.StdEncoding.EncodeToString([]byte(clusterKeyDetails.Admin))
+		user.User.ClientKeyData = base64.StdEncoding.EncodeToString([]byte(clusterKeyDetails.AdminKey))
+	} else {
+		user.User.Token = clusterKeyDetails.Token
+	}
+
+	kubeContext := clusterKubeconfigContext{Name: name}
+	kubeContext.Context.Cluster = cluster.Name
+	kubeContext.Context.User = user.Name
+
+	config := clusterKubeconfig{
+		APIVersion:     "v1",
+		Kind:           "Config",
+		Clusters:       []clusterKubeconfigCluster{cluster},
+		Users:          []clusterKubeconfigUser{user},
+		Contexts:       []clusterKubeconfigContext{kubeContext},
+		CurrentContext: name,
+	}
+	out, err := yaml.Marshal(config)
+	if err != nil {
+		return "", err
+	}
+	return string(out), nil
+}
@@ -0,0 +1,77 @@
+// Copyright IBM Corp. 2025 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package kubernetes_test
+
+import (
+	"fmt"
+	"testing"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+// Ephemeral resources need Terraform 1.10 or later and leave nothing in the
+// state, so the opened values are checked by the postconditions of the configs.
+func TestAccIBMContainerClusterConfigEphemeralResourceVpcBasic(t *testing.T) {
+	clusterName := fmt.Sprintf("tf-cluster-config-%d", acctest.RandIntRange(10, 100))
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acc.TestAccPreCheck(t) },
+		ProtoV5ProviderFactories: acc.TestAccProtoV5ProviderFactories(t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMContainerClusterConfigEphemeralResourceVpcConfig(clusterName, false),
+			},
+			{
+				Config: testAccCheckIBMContainerClusterConfigEphemeralResourceVpcConfig(clusterName, true),
+			},
+		},
+	})
+}
+
+func testAccCheckIBMContainerClusterConfigEphemeralResourceVpcConfig(clustername string, admin bool) string {
+	postcondition := `
+			postcondition {
+				condition     = self.token != null && self.expires_at != null && self.client_certificate == null && strcontains(self.kubeconfig, "token: ")
+				error_message = "The cluster config does not have a token."
+			}`
+	if admin {
+		postcondition = `
+			postcondition {
+				condition     = startswith(self.client_certificate, "-----BEGIN CERTIFICATE-----") && startswith(self.client_key, "-----BEGIN") && self.token == null && strcontains(self.kubeconfig, "client-key-data: ")
+				error_message = "The cluster config does not have the admin certificate and key."
+			}`
+	}
+	return fmt.Sprintf(`
+	resource "ibm_container_vpc_cluster" "testacc_cluster" {
+		name              = "%[1]s"
+		vpc_id            = "%[2]s"
+		flavor            = "bx2.4x16"
+		worker_count      = 1
+		resource_group_id = "%[3]s"
+		zones {
+			subnet_id = "%[4]s"
+			name      = "us-south-1"
+		}
+		wait_till = "Normal"
+	}
+
+	ephemeral "ibm_container_cluster_config" "testacc_cluster_config" {
+		cluster_name_id   = ibm_container_vpc_cluster.testacc_cluster.id
+		resource_group_id = "%[3]s"
+		admin             = %[5]t
+
+		lifecycle {
+			postcondition {
+				condition     = startswith(self.host, "https://") && startswith(self.ca_certificate, "-----BEGIN CERTIFICATE-----")
+				error_message = "The cluster config does not have the API server and its CA certificate."
+			}
+			postcondition {
+				condition     = strcontains(self.kubeconfig, self.host) && strcontains(self.kubeconfig, "certificate-authority-data: ")
+				error_message = "The kubeconfig does not have the API server and its CA certificate inline."
+			}%[6]s
+		}
+	}`, clustername, acc.IksClusterVpcID, acc.IksClusterResourceGroupID, acc.IksClusterSubnetID, admin, postcondition)
+}
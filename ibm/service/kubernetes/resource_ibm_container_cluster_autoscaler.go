@@ -0,0 +1,569 @@
+// Copyright IBM Corp. 2025 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package kubernetes
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"os"
+	"sort"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/rest"
+	"k8s.io/client-go/util/retry"
+
+	v1 "github.com/IBM-Cloud/bluemix-go/api/container/containerv1"
+	v2 "github.com/IBM-Cloud/bluemix-go/api/container/containerv2"
+	"github.com/IBM-Cloud/bluemix-go/bmxerror"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
+)
+
+const (
+	clusterAutoscalerAddOn          = "cluster-autoscaler"
+	clusterAutoscalerNamespace      = "kube-system"
+	clusterAutoscalerConfigMap      = "iks-ca-configmap"
+	clusterAutoscalerWorkerPoolsKey = "workerPoolsConfig.json"
+)
+
+// clusterAutoscalerStringOptions maps the string arguments of the resource to the keys of the
+// add-on ConfigMap
+var clusterAutoscalerStringOptions = map[string]string{
+	"expander":                         "expander",
+	"max_node_provision_time":          "maxNodeProvisionTime",
+	"scale_down_delay_after_add":       "scaleDownDelayAfterAdd",
+	"scale_down_delay_after_delete":    "scaleDownDelayAfterDelete",
+	"scale_down_unneeded_time":         "scaleDownUnneededTime",
+	"scale_down_utilization_threshold": "scaleDownUtilizationThreshold",
+	"scan_interval":                    "scanInterval",
+}
+
+// clusterAutoscalerBoolOptions maps the bool arguments of the resource to the keys of the add-on
+// ConfigMap
+var clusterAutoscalerBoolOptions = map[string]string{
+	"balance_similar_node_groups":   "balanceSimilarNodeGroups",
+	"scale_down_enabled":            "scaleDownEnabled",
+	"skip_nodes_with_local_storage": "skipNodesWithLocalStorage",
+	"skip_nodes_with_system_pods":   "skipNodesWithSystemPods",
+}
+
+// clusterAutoscalerWorkerPool is an entry of workerPoolsConfig.json in the add-on ConfigMap
+type clusterAutoscalerWorkerPool struct {
+	Name    string `json:"name"`
+	MinSize int    `json:"minSize"`
+	MaxSize int    `json:"maxSize"`
+	Enabled bool   `json:"enabled"`
+}
+
+func ResourceIBMContainerClusterAutoscaler() *schema.Resource {
+	resourceSchema := map[string]*schema.Schema{
+		"cluster": {
+			Type:        schema.TypeString,
+			Required:    true,
+			ForceNew:    true,
+			Description: "Cluster Name or ID",
+			ValidateFunc: validate.InvokeValidator(
+				"ibm_container_cluster_autoscaler",
+				"cluster"),
+		},
+		"resource_group_id": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			ForceNew:    true,
+			Computed:    true,
+			Description: "ID of the resource group.",
+		},
+		"version": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Computed:    true,
+			Description: "The version of the cluster-autoscaler add-on, omit the version if you wish to use the default version.",
+		},
+		"endpoint_type": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: "The type of server URL used to reach the cluster API server to manage the add-on ConfigMap",
+		},
+		"worker_pool": {
+			Type:        schema.TypeSet,
+			Optional:    true,
+			Description: "The worker pools that are autoscaled. The worker pools that are not listed are not autoscaled",
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"name": {
+						Type:        schema.TypeString,
+						Required:    true,
+						Description: "The name of the worker pool",
+					},
+					"min_size": {
+						Type:         schema.TypeInt,
+						Required:     true,
+						ValidateFunc: validation.IntAtLeast(0),
+						Description:  "The minimum number of worker nodes per zone of the worker pool",
+					},
+					"max_size": {
+						Type:         schema.TypeInt,
+						Required:     true,
+						ValidateFunc: validation.IntAtLeast(1),
+						Description:  "The maximum number of worker nodes per zone of the worker pool",
+					},
+					"enabled": {
+						Type:        schema.TypeBool,
+						Optional:    true,
+						Default:     true,
+						Description: "Whether the worker pool is autoscaled",
+					},
+				},
+			},
+		},
+		"options": {
+			Type:        schema.TypeMap,
+			Optional:    true,
+			Elem:        &schema.Schema{Type: schema.TypeString},
+			Description: "Other options of the add-on ConfigMap, by ConfigMap key",
+		},
+		"health_state": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "The health state of the add-on",
+		},
+		"health_status": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "The health status of the add-on",
+		},
+	}
+	for name, key := range clusterAutoscalerStringOptions {
+		resourceSchema[name] = &schema.Schema{
+			Type:        schema.TypeString,
+			Optional:    true,
+			Computed:    true,
+			Description: fmt.Sprintf("The %s option of the add-on", key),
+		}
+	}
+	for name, key := range clusterAutoscalerBoolOptions {
+		resourceSchema[name] = &schema.Schema{
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Computed:    true,
+			Description: fmt.Sprintf("The %s option of the add-on", key),
+		}
+	}
+
+	return &schema.Resource{
+		Create:   resourceIBMContainerClusterAutoscalerCreate,
+		Read:     resourceIBMContainerClusterAutoscalerRead,
+		Update:   resourceIBMContainerClusterAutoscalerUpdate,
+		Delete:   resourceIBMContainerClusterAutoscalerDelete,
+		Importer: &schema.ResourceImporter{},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(20 * time.Minute),
+			Update: schema.DefaultTimeout(20 * time.Minute),
+			Delete: schema.DefaultTimeout(20 * time.Minute),
+		},
+
+		CustomizeDiff: resourceIBMContainerClusterAutoscalerWorkerPoolDiff,
+
+		Schema: resourceSchema,
+	}
+}
+
+func ResourceIBMContainerClusterAutoscalerValidator() *validate.ResourceValidator {
+	validateSchema := make([]validate.ValidateSchema, 0)
+	validateSchema = append(validateSchema,
+		validate.ValidateSchema{
+			Identifier:                 "cluster",
+			ValidateFunctionIdentifier: validate.ValidateCloudData,
+			Type:                       validate.TypeString,
+			Required:                   true,
+			CloudDataType:              "cluster",
+			CloudDataRange:             []string{"resolved_to:id"}})
+
+	iBMContainerClusterAutoscalerValidator := validate.ResourceValidator{ResourceName: "ibm_container_cluster_autoscaler", Schema: validateSchema}
+	return &iBMContainerClusterAutoscalerValidator
+}
+
+func resourceIBMContainerClusterAutoscalerWorkerPoolDiff(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
+	for _, pool := range diff.Get("worker_pool").(*schema.Set).List() {
+		p := pool.(map[string]interface{})
+		if p["min_size"].(int) > p["max_size"].(int) {
+			return fmt.Errorf("[ERROR] min_size of worker pool %s must not be greater than max_size", p["name"].(string))
+		}
+	}
+	return nil
+}
+
+func resourceIBMContainerClusterAutoscalerCreate(d *schema.ResourceData, meta interface{}) error {
+	csClient, err := meta.(conns.ClientSession).ContainerAPI()
+	if err != nil {
+		return err
+	}
+	addOnAPI := csClient.AddOns()
+
+	targetEnv, err := getClusterTargetHeader(d, meta)
+	if err != nil {
+		return err
+	}
+	cluster := d.Get("cluster").(string)
+
+	// The add-on is disabled on delete, so an add-on that is already enabled must be imported instead
+	addOn, err := getClusterAutoscalerAddOn(addOnAPI, cluster, targetEnv)
+	if err != nil {
+		return fmt.Errorf("[ERROR] Error getting the add-ons of cluster (%s): %s", cluster, err)
+	}
+	if addOn != nil {
+		return fmt.Errorf("[ERROR] The %s add-on is already enabled on cluster (%s), import it with terraform import ibm_container_cluster_autoscaler.<name> %s", clusterAutoscalerAddOn, cluster, cluster)
+	}
+	params := v1.ConfigureAddOns{
+		AddonsList: []v1.AddOn{{
+			Name:    clusterAutoscalerAddOn,
+			Version: d.Get("version").(string),
+		}},
+		Enable: true,
+	}
+	_, err = addOnAPI.ConfigureAddons(cluster, &params, targetEnv)
+	if err != nil {
+		return fmt.Errorf("[ERROR] Error enabling the %s add-on on cluster (%s): %s", clusterAutoscalerAddOn, cluster, err)
+	}
+	_, err = waitForContainerAddOns(d, meta, cluster, schema.TimeoutCreate)
+	if err != nil {
+		return fmt.Errorf("[ERROR] Error waiting for the %s add-on to reach normal during create (%s) : %s", clusterAutoscalerAddOn, cluster, err)
+	}
+	d.SetId(cluster)
+
+	kubeClient, err := clusterKubeClient(meta, cluster, targetEnv.ResourceGroup, d.Get("endpoint_type").(string))
+	if err != nil {
+		return err
+	}
+	_, err = waitForClusterAutoscalerConfigMap(d, kubeClient, schema.TimeoutCreate)
+	if err != nil {
+		return fmt.Errorf("[ERROR] Error waiting for the %s ConfigMap of cluster (%s): %s", clusterAutoscalerConfigMap, cluster, err)
+	}
+	err = updateClusterAutoscalerConfigMap(kubeClient, func(data map[string]string) error {
+		return expandClusterAutoscalerConfig(d, data, true)
+	})
+	if err != nil {
+		return fmt.Errorf("[ERROR] Error configuring the %s add-on of cluster (%s): %s", clusterAutoscalerAddOn, cluster, err)
+	}
+
+	return resourceIBMContainerClusterAutoscalerRead(d, meta)
+}
+
+func resourceIBMContainerClusterAutoscalerRead(d *schema.ResourceData, meta interface{}) error {
+	csClient, err := meta.(conns.ClientSession).ContainerAPI()
+	if err != nil {
+		return err
+	}
+	targetEnv, err := getClusterTargetHeader(d, meta)
+	if err != nil {
+		return err
+	}
+	cluster := d.Id()
+
+	addOn, err := getClusterAutoscalerAddOn(csClient.AddOns(), cluster, targetEnv)
+	if err != nil {
+		if apiErr, ok := err.(bmxerror.RequestFailure); ok && apiErr.StatusCode() == 404 {
+			log.Printf("[WARN] Cluster (%s) is not found, removing the %s add-on from the state", cluster, clusterAutoscalerAddOn)
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("[ERROR] Error getting the add-ons of cluster (%s): %s", cluster, err)
+	}
+	if addOn == nil {
+		log.Printf("[WARN] The %s add-on is not enabled on cluster (%s), removing it from the state", clusterAutoscalerAddOn, cluster)
+		d.SetId("")
+		return nil
+	}
+
+	kubeClient, err := clusterKubeClient(meta, cluster, targetEnv.ResourceGroup, d.Get("endpoint_type").(string))
+	if err != nil {
+		return err
+	}
+	configMap, err := kubeClient.CoreV1().ConfigMaps(clusterAutoscalerNamespace).Get(context.Background(), clusterAutoscalerConfigMap, metav1.GetOptions{})
+	if err != nil {
+		return fmt.Errorf("[ERROR] Error retrieving the %s ConfigMap of cluster (%s): %s", clusterAutoscalerConfigMap, cluster, err)
+	}
+
+	d.Set("cluster", cluster)
+	d.Set("resource_group_id", targetEnv.ResourceGroup)
+	d.Set("version", addOn.Version)
+	d.Set("health_state", addOn.HealthState)
+	d.Set("health_status", addOn.HealthStatus)
+
+	workerPools, err := flattenClusterAutoscalerWorkerPools(configMap.Data[clusterAutoscalerWorkerPoolsKey])
+	if err != nil {
+		return fmt.Errorf("[ERROR] Error reading %s of the %s ConfigMap of cluster (%s): %s", clusterAutoscalerWorkerPoolsKey, clusterAutoscalerConfigMap, cluster, err)
+	}
+	d.Set("worker_pool", workerPools)
+	for name, key := range clusterAutoscalerStringOptions {
+		if value, ok := configMap.Data[key]; ok {
+			d.Set(name, value)
+		}
+	}
+	for name, key := range clusterAutoscalerBoolOptions {
+		if value, ok := configMap.Data[key]; ok {
+			d.Set(name, value == "true")
+		}
+	}
+	// Only the options in the configuration are tracked, the ConfigMap has many more keys
+	options := make(map[string]string)
+	for key := range d.Get("options").(map[string]interface{}) {
+		if value, ok := configMap.Data[key]; ok {
+			options[key] = value
+		}
+	}
+	d.Set("options", options)
+
+	return nil
+}
+
+func resourceIBMContainerClusterAutoscalerUpdate(d *schema.ResourceData, meta interface{}) error {
+	targetEnv, err := getClusterTargetHeader(d, meta)
+	if err != nil {
+		return err
+	}
+	cluster := d.Id()
+
+	if d.HasChange("version") {
+		if version := d.Get("version").(string); version != "" {
+			err = updateAddOnVersion(d, meta, map[string]interface{}{"name": clusterAutoscalerAddOn, "version": version}, cluster, targetEnv)
+			if err != nil {
+				return fmt.Errorf("[ERROR] Error updating the %s add-on on cluster (%s) to version %s: %s", clusterAutoscalerAddOn, cluster, version, err)
+			}
+			_, err = waitForContainerAddOns(d, meta, cluster, schema.TimeoutUpdate)
+			if err != nil {
+				return fmt.Errorf("[ERROR] Error waiting for the %s add-on to reach normal during update (%s) : %s", clusterAutoscalerAddOn, cluster, err)
+			}
+		}
+	}
+
+	kubeClient, err := clusterKubeClient(meta, cluster, targetEnv.ResourceGroup, d.Get("endpoint_type").(string))
+	if err != nil {
+		return err
+	}
+	if d.HasChange("version") {
+		_, err = waitForClusterAutoscalerConfigMap(d, kubeClient, schema.TimeoutUpdate)
+		if err != nil {
+			return fmt.Errorf("[ERROR] Error waiting for the %s ConfigMap of cluster (%s): %s", clusterAutoscalerConfigMap, cluster, err)
+		}
+	}
+	err = updateClusterAutoscalerConfigMap(kubeClient, func(data map[string]string) error {
+		return expandClusterAutoscalerConfig(d, data, false)
+	})
+	if err != nil {
+		return fmt.Errorf("[ERROR] Error configuring the %s add-on of cluster (%s): %s", clusterAutoscalerAddOn, cluster, err)
+	}
+
+	return resourceIBMContainerClusterAutoscalerRead(d, meta)
+}
+
+func resourceIBMContainerClusterAutoscalerDelete(d *schema.ResourceData, meta interface{}) error {
+	csClient, err := meta.(conns.ClientSession).ContainerAPI()
+	if err != nil {
+		return err
+	}
+	targetEnv, err := getClusterTargetHeader(d, meta)
+	if err != nil {
+		return err
+	}
+	cluster := d.Id()
+
+	// Autoscaling must be disabled for every worker pool before the add-on is disabled
+	kubeClient, err := clusterKubeClient(meta, cluster, targetEnv.ResourceGroup, d.Get("endpoint_type").(string))
+	if err != nil {
+		return err
+	}
+	err = updateClusterAutoscalerConfigMap(kubeClient, func(data map[string]string) error {
+		workerPools := []clusterAutoscalerWorkerPool{}
+		if config := data[clusterAutoscalerWorkerPoolsKey]; config != "" {
+			if err := json.Unmarshal([]byte(config), &workerPools); err != nil {
+				return err
+			}
+		}
+		for i := range workerPools {
+			workerPools[i].Enabled = false
+		}
+		config, err := json.Marshal(workerPools)
+		if err != nil {
+			return err
+		}
+		data[clusterAutoscalerWorkerPoolsKey] = string(config)
+		return nil
+	})
+	if err != nil && !k8serrors.IsNotFound(err) {
+		return fmt.Errorf("[ERROR] Error disabling autoscaling of the worker pools of cluster (%s): %s", cluster, err)
+	}
+
+	params := v1.ConfigureAddOns{
+		AddonsList: []v1.AddOn{{
+			Name:    clusterAutoscalerAddOn,
+			Version: d.Get("version").(string),
+		}},
+		Enable: false,
+	}
+	_, err = csClient.AddOns().ConfigureAddons(cluster, &params, targetEnv)
+	if err != nil {
+		return fmt.Errorf("[ERROR] Error disabling the %s add-on on cluster (%s): %s", clusterAutoscalerAddOn, cluster, err)
+	}
+	d.SetId("")
+	return nil
+}
+
+// getClusterAutoscalerAddOn returns the cluster-autoscaler add-on of the cluster, or nil if the
+// add-on is not enabled. The error of the API is returned as is, so that a cluster that is not
+// found can be told apart.
+func getClusterAutoscalerAddOn(addOnAPI v1.AddOns, cluster string, targetEnv v1.ClusterTargetHeader) (*v1.AddOn, error) {
+	addOns, err := addOnAPI.GetAddons(cluster, targetEnv)
+	if err != nil {
+		return nil, err
+	}
+	for _, addOn := range addOns {
+		if addOn.Name == clusterAutoscalerAddOn {
+			return &addOn, nil
+		}
+	}
+	return nil, nil
+}
+
+// expandClusterAutoscalerConfig sets the worker pools and the options of the configuration into the
+// data of the add-on ConfigMap. On create every option that is set is written, on update only the
+// options that changed, so that the options that are not set keep the value of the add-on.
+func expandClusterAutoscalerConfig(d *schema.ResourceData, data map[string]string, create bool) error {
+	if create || d.HasChange("worker_pool") {
+		workerPools := []clusterAutoscalerWorkerPool{}
+		for _, pool := range d.Get("worker_pool").(*schema.Set).List() {
+			p := pool.(map[string]interface{})
+			workerPools = append(workerPools, clusterAutoscalerWorkerPool{
+				Name:    p["name"].(string),
+				MinSize: p["min_size"].(int),
+				MaxSize: p["max_size"].(int),
+				Enabled: p["enabled"].(bool),
+			})
+		}
+		sort.Slice(workerPools, func(i, j int) bool {
+			return workerPools[i].Name < workerPools[j].Name
+		})
+		config, err := json.Marshal(workerPools)
+		if err != nil {
+			return err
+		}
+		data[clusterAutoscalerWorkerPoolsKey] = string(config)
+	}
+	for name, key := range clusterAutoscalerStringOptions {
+		if v, ok := d.GetOk(name); ok && (create || d.HasChange(name)) {
+			data[key] = v.(string)
+		}
+	}
+	for name, key := range clusterAutoscalerBoolOptions {
+		if v, ok := d.GetOkExists(name); ok && (create || d.HasChange(name)) {
+			data[key] = fmt.Sprintf("%t", v.(bool))
+		}
+	}
+	if create || d.HasChange("options") {
+		for key, value := range d.Get("options").(map[string]interface{}) {
+			data[key] = value.(string)
+		}
+	}
+	return nil
+}
+
+func flattenClusterAutoscalerWorkerPools(config string) ([]map[string]interface{}, error) {
+	workerPools := []clusterAutoscalerWorkerPool{}
+	if config != "" {
+		if err := json.Unmarshal([]byte(config), &workerPools); err != nil {
+			return nil, err
+		}
+	}
+	result := make([]map[string]interface{}, 0, len(workerPools))
+	for _, pool := range workerPools {
+		result = append(result, map[string]interface{}{
+			"name":     pool.Name,
+			"min_size": pool.MinSize,
+			"max_size": pool.MaxSize,
+			"enabled":  pool.Enabled,
+		})
+	}
+	return result, nil
+}
+
+// updateClusterAutoscalerConfigMap applies update to the data of the add-on ConfigMap, retrying when
+// the ConfigMap is changed concurrently
+func updateClusterAutoscalerConfigMap(kubeClient kubernetes.Interface, update func(data map[string]string) error) error {
+	configMaps := kubeClient.CoreV1().ConfigMaps(clusterAutoscalerNamespace)
+	return retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		configMap, err := configMaps.Get(context.Background(), clusterAutoscalerConfigMap, metav1.GetOptions{})
+		if err != nil {
+			return err
+		}
+		if configMap.Data == nil {
+			configMap.Data = make(map[string]string)
+		}
+		if err := update(configMap.Data); err != nil {
+			return err
+		}
+		_, err = configMaps.Update(context.Background(), configMap, metav1.UpdateOptions{})
+		return err
+	})
+}
+
+// waitForClusterAutoscalerConfigMap waits for the add-on to create its ConfigMap after it is enabled
+func waitForClusterAutoscalerConfigMap(d *schema.ResourceData, kubeClient kubernetes.Interface, timeout string) (interface{}, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{"pending"},
+		Target:  []string{"available"},
+		Refresh: func() (interface{}, string, error) {
+			configMap, err := kubeClient.CoreV1().ConfigMaps(clusterAutoscalerNamespace).Get(context.Background(), clusterAutoscalerConfigMap, metav1.GetOptions{})
+			if err != nil {
+				if k8serrors.IsNotFound(err) {
+					return configMap, "pending", nil
+				}
+				return nil, "", err
+			}
+			return configMap, "available", nil
+		},
+		Timeout:    d.Timeout(timeout),
+		Delay:      5 * time.Second,
+		MinTimeout: 10 * time.Second,
+	}
+	return stateConf.WaitForState()
+}
+
+// clusterKubeClient returns a client of the cluster API server authenticated with a token of the
+// provider's credentials. The cluster config is extracted into a private directory, in memory when
+// possible, that is removed before returning.
+func clusterKubeClient(meta interface{}, cluster, resourceGroup, endpointType string) (*kubernetes.Clientset, error) {
+	csClient, err := meta.(conns.ClientSession).VpcContainerAPI()
+	if err != nil {
+		return nil, err
+	}
+	configDir, err := clusterConfigTempDir()
+	if err != nil {
+		return nil, fmt.Errorf("[ERROR] Error creating a temporary directory for the cluster config: %s", err)
+	}
+	defer os.RemoveAll(configDir)
+
+	targetEnv := v2.ClusterTargetHeader{
+		ResourceGroup: resourceGroup,
+	}
+	clusterKeyDetails, err := getClusterConfigDetail(csClient.Clusters(), cluster, configDir, false, targetEnv, endpointType)
+	if err != nil {
+		return nil, fmt.Errorf("[ERROR] Error getting the cluster config [%s]: %s", cluster, err)
+	}
+	config := &rest.Config{
+		Host:        clusterKeyDetails.Host,
+		BearerToken: clusterKeyDetails.Token,
+	}
+	if clusterKeyDetails.ClusterCACertificate != "" {
+		config.TLSClientConfig.CAData = []byte(clusterKeyDetails.ClusterCACertificate)
+	}
+	return kubernetes.NewForConfig(config)
+}
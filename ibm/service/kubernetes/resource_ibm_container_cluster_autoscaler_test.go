@@ -0,0 +1,64 @@
+// Copyright IBM Corp. 2025 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package kubernetes_test
+
+import (
+	"fmt"
+	"testing"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccIBMContainerClusterAutoscaler_Basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMContainerClusterAutoscalerBasic(acc.IksClusterID, 1, 2, "random"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_container_cluster_autoscaler.autoscaler", "worker_pool.#", "1"),
+					resource.TestCheckResourceAttr("ibm_container_cluster_autoscaler.autoscaler", "expander", "random"),
+					resource.TestCheckResourceAttrSet("ibm_container_cluster_autoscaler.autoscaler", "version"),
+				),
+			},
+			{
+				Config: testAccCheckIBMContainerClusterAutoscalerBasic(acc.IksClusterID, 2, 3, "least-waste"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_container_cluster_autoscaler.autoscaler", "expander", "least-waste"),
+					resource.TestCheckResourceAttr("ibm_container_cluster_autoscaler.autoscaler", "worker_pool.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs("ibm_container_cluster_autoscaler.autoscaler", "worker_pool.*", map[string]string{
+						"name":     "default",
+						"min_size": "2",
+						"max_size": "3",
+						"enabled":  "true",
+					}),
+					resource.TestCheckResourceAttr("ibm_container_cluster_autoscaler.autoscaler", "scale_down_unneeded_time", "20m"),
+				),
+			},
+			{
+				ResourceName:            "ibm_container_cluster_autoscaler.autoscaler",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"options"},
+			},
+		},
+	})
+}
+
+func testAccCheckIBMContainerClusterAutoscalerBasic(cluster string, minSize, maxSize int, expander string) string {
+	return fmt.Sprintf(`
+	resource "ibm_container_cluster_autoscaler" "autoscaler" {
+		cluster                  = "%[1]s"
+		expander                 = "%[4]s"
+		scale_down_unneeded_time = "20m"
+		worker_pool {
+			name     = "default"
+			min_size = %[2]d
+			max_size = %[3]d
+		}
+	}`, cluster, minSize, maxSize, expander)
+}
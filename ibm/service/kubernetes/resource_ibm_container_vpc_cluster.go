@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"log"
 	"os"
+	"strconv"
 	"strings"
 	"time"
 
@@ -51,11 +52,12 @@ func ResourceIBMContainerVpcCluster() *schema.Resource {
 
 		CustomizeDiff: customdiff.Sequence(
 			func(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
-				return flex.ResourceTagsCustomizeDiff(diff)
+				return flex.ResourceTagsCustomizeDiff(diff, v)
 			},
 			func(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
 				return flex.OnlyInUpdateDiff([]string{EnableSecureByDefaultFlag}, diff)
 			},
+			resourceIBMContainerVpcClusterWorkersPendingUpdateDiff,
 		),
 
 		Schema: map[string]*schema.Schema{
@@ -189,6 +191,57 @@ func ResourceIBMContainerVpcCluster() *schema.Resource {
 				Description: "Wait for worker node to update during kube version update.",
 			},
 
+			"worker_update_strategy": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Description: "Replaces the worker nodes in batches, pool by pool, when the worker nodes are updated. An update that fails or times out is resumed by the next apply.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"max_unavailable": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Default:      1,
+							ValidateFunc: validation.IntAtLeast(1),
+							Description:  "The maximum number of worker nodes replaced at once in each zone of a worker pool",
+						},
+						"pool_order": {
+							Type:        schema.TypeList,
+							Optional:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Description: "The names of the worker pools in the order in which they are updated. The worker pools that are not listed are updated last",
+						},
+						"pause_between_batches": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Default:      0,
+							ValidateFunc: validation.IntAtLeast(0),
+							Description:  "The time in seconds to wait between two batches of worker nodes",
+						},
+						"min_available_percent": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Default:      0,
+							ValidateFunc: validation.IntBetween(0, 100),
+							Description:  "The minimum percentage of the worker nodes of a worker pool that must be in normal health while the worker nodes of the pool are replaced",
+						},
+					},
+				},
+			},
+
+			"workers_pending_update": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The number of worker nodes of an interrupted worker update that are below the version in worker_update_target_versions or not at the operating system of their worker pool. Set only if worker_update_strategy is set",
+			},
+
+			"worker_update_target_versions": {
+				Type:        schema.TypeMap,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "The version of the worker nodes of each worker pool, by worker pool ID, that the worker update in progress was started for. Set only if worker_update_strategy is set",
+			},
+
 			"service_subnet": {
 				Type:        schema.TypeString,
 				Optional:    true,
@@ -794,7 +847,7 @@ func resourceIBMContainerVpcClusterUpdate(d *schema.ResourceData, meta interface
 
 	}
 
-	if (d.HasChange("kube_version") || d.HasChange("update_all_workers") || d.HasChange("patch_version") || d.HasChange("retry_patch_version")) && !d.IsNewResource() {
+	if (d.HasChange("kube_version") || d.HasChange("update_all_workers") || d.HasChange("patch_version") || d.HasChange("retry_patch_version") || d.HasChange("workers_pending_update")) && !d.IsNewResource() {
 
 		if d.HasChange("kube_version") {
 			ClusterClient, err := meta.(conns.ClientSession).ContainerAPI()
@@ -842,7 +895,14 @@ func resourceIBMContainerVpcClusterUpdate(d *schema.ResourceData, meta interface
 		workersInfo := make(map[string]int)
 
 		updateAllWorkers := d.Get("update_all_workers").(bool)
-		if updateAllWorkers || d.HasChange("patch_version") || d.HasChange("retry_patch_version") {
+		strategy := expandVpcClusterWorkerUpdateStrategy(d)
+		if strategy != nil && (updateAllWorkers || d.HasChange("patch_version") || d.HasChange("retry_patch_version") || d.HasChange("workers_pending_update")) {
+			// patch_version is kept on failure, the workers that are still pending are replaced by the next apply
+			err = updateVpcClusterWorkersWithStrategy(d, meta, strategy, targetEnv)
+			if err != nil {
+				return err
+			}
+		} else if updateAllWorkers || d.HasChange("patch_version") || d.HasChange("retry_patch_version") {
 
 			// patchVersion := d.Get("patch_version").(string)
 			workers, err := csClient.Workers().ListWorkers(clusterID, false, targetEnv)
@@ -854,7 +914,7 @@ func resourceIBMContainerVpcClusterUpdate(d *schema.ResourceData, meta interface
 			for index, worker := range workers {
 				workersInfo[worker.ID] = index
 			}
-			workersCount := len(workers)
+			workersCount := vpcClusterActiveWorkerCount(workers)
 
 			waitForWorkerUpdate := d.Get("wait_for_worker_update").(bool)
 
@@ -986,6 +1046,18 @@ func resourceIBMContainerVpcClusterRead(d *schema.ResourceData, meta interface{}
 		d.Set("disable_public_service_endpoint", true)
 	}
 	d.Set("image_security_enforcement", cls.ImageSecurityEnabled)
+	if targets := expandVpcClusterWorkerUpdateTargets(d); expandVpcClusterWorkerUpdateStrategy(d) != nil && len(targets) > 0 {
+		// the count only drives the resumption of an interrupted update, the previous one is kept on failure
+		_, _, _, pending, err := getVpcClusterWorkersPendingUpdate(csClient, clusterID, targetEnv, targets)
+		if err != nil {
+			log.Printf(
+				"An error occured during reading of the worker nodes pending update of cluster (%s): %s", d.Id(), err)
+		} else {
+			d.Set("workers_pending_update", pending)
+		}
+	} else {
+		d.Set("workers_pending_update", 0)
+	}
 
 	tags, err := flex.GetTagsUsingCRN(meta, cls.CRN)
 	if err != nil {
@@ -1495,6 +1567,18 @@ func waitForWorkerNodetoDelete(d *schema.ResourceData, meta interface{}, targetE
 	return deleteStateConf.WaitForState()
 }
 
+// vpcClusterActiveWorkerCount returns the number of workers that are not deleted or being deleted,
+// which the replacement of a worker leaves unchanged once the new worker is created
+func vpcClusterActiveWorkerCount(workers []v2.Worker) int {
+	count := 0
+	for _, worker := range workers {
+		if worker.LifeCycle.ActualState != workerDeletePending && worker.LifeCycle.ActualState != workerDeleteState {
+			count++
+		}
+	}
+	return count
+}
+
 func waitForNewWorker(d *schema.ResourceData, meta interface{}, targetEnv v2.ClusterTargetHeader, workersCount int) (interface{}, error) {
 	csClient, err := meta.(conns.ClientSession).VpcContainerAPI()
 	if err != nil {
@@ -1510,7 +1594,7 @@ func waitForNewWorker(d *schema.ResourceData, meta interface{}, targetEnv v2.Clu
 			if err != nil {
 				return workers, "", fmt.Errorf("[ERROR] Error in retriving the list of worker nodes")
 			}
-			if len(workers) == workersCount {
+			if vpcClusterActiveWorkerCount(workers) == workersCount {
 				return workers, "created", nil
 			}
 			return workers, "creating", nil
@@ -1544,3 +1628,351 @@ func getNewWorkerID(d *schema.ResourceData, meta interface{}, targetEnv v2.Clust
 	}
 	return "", -1, fmt.Errorf("[ERROR] no new node found")
 }
+
+// resourceIBMContainerVpcClusterWorkersPendingUpdateDiff plans an update of the worker nodes when
+// worker_update_strategy is set and worker nodes are still pending an update, so that an update
+// interrupted by a failure or a timeout is resumed
+func resourceIBMContainerVpcClusterWorkersPendingUpdateDiff(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
+	if diff.Id() == "" {
+		return nil
+	}
+	if strategies, ok := diff.Get("worker_update_strategy").([]interface{}); !ok || len(strategies) == 0 {
+		return nil
+	}
+	if !diff.Get("update_all_workers").(bool) && diff.Get("patch_version").(string) == "" {
+		return nil
+	}
+	if pending, _ := diff.GetChange("workers_pending_update"); pending.(int) > 0 {
+		return diff.SetNewComputed("workers_pending_update")
+	}
+	return nil
+}
+
+// vpcClusterWorkerUpdateStrategy is the worker_update_strategy block of the cluster
+type vpcClusterWorkerUpdateStrategy struct {
+	maxUnavailable      int
+	poolOrder           []string
+	pauseBetweenBatches time.Duration
+	minAvailablePercent int
+}
+
+func expandVpcClusterWorkerUpdateStrategy(d *schema.ResourceData) *vpcClusterWorkerUpdateStrategy {
+	strategies, ok := d.Get("worker_update_strategy").([]interface{})
+	if !ok || len(strategies) == 0 {
+		return nil
+	}
+	strategy := &vpcClusterWorkerUpdateStrategy{maxUnavailable: 1}
+	if values, ok := strategies[0].(map[string]interface{}); ok {
+		if v, ok := values["max_unavailable"].(int); ok && v > 0 {
+			strategy.maxUnavailable = v
+		}
+		if v, ok := values["pool_order"].([]interface{}); ok {
+			strategy.poolOrder = flex.ExpandStringList(v)
+		}
+		if v, ok := values["pause_between_batches"].(int); ok {
+			strategy.pauseBetweenBatches = time.Duration(v) * time.Second
+		}
+		if v, ok := values["min_available_percent"].(int); ok {
+			strategy.minAvailablePercent = v
+		}
+	}
+	return strategy
+}
+
+func expandVpcClusterWorkerUpdateTargets(d *schema.ResourceData) map[string]string {
+	targets := make(map[string]string)
+	if values, ok := d.Get("worker_update_target_versions").(map[string]interface{}); ok {
+		for poolID, version := range values {
+			targets[poolID] = version.(string)
+		}
+	}
+	return targets
+}
+
+// vpcClusterWorkerUpdateTargets returns the highest target version of the workers of each worker pool,
+// by pool ID, which is the version that an update started now brings the workers to
+func vpcClusterWorkerUpdateTargets(workers []v2.Worker) map[string]string {
+	targets := make(map[string]string)
+	for _, worker := range workers {
+		if target, ok := targets[worker.PoolID]; !ok || compareVpcClusterWorkerVersions(worker.KubeVersion.Target, target) > 0 {
+			targets[worker.PoolID] = worker.KubeVersion.Target
+		}
+	}
+	return targets
+}
+
+// compareVpcClusterWorkerVersions compares two worker versions such as 1.31.2_1530, number by number,
+// and returns -1, 0 or 1 when the first version is lower than, equal to or higher than the second one
+func compareVpcClusterWorkerVersions(a, b string) int {
+	split := func(r rune) bool { return r == '.' || r == '_' }
+	aParts, bParts := strings.FieldsFunc(a, split), strings.FieldsFunc(b, split)
+	for i := 0; i < len(aParts) && i < len(bParts); i++ {
+		aNum, aErr := strconv.Atoi(aParts[i])
+		bNum, bErr := strconv.Atoi(bParts[i])
+		switch {
+		case aErr == nil && bErr == nil && aNum != bNum:
+			if aNum < bNum {
+				return -1
+			}
+			return 1
+		case (aErr != nil || bErr != nil) && aParts[i] != bParts[i]:
+			return strings.Compare(aParts[i], bParts[i])
+		}
+	}
+	switch {
+	case len(aParts) < len(bParts):
+		return -1
+	case len(aParts) > len(bParts):
+		return 1
+	}
+	return 0
+}
+
+// vpcClusterWorkerNeedsUpdate reports whether the worker is below the version that the update of its
+// worker pool was started for, or not at the operating system of its worker pool, and is not already
+// being replaced. Without a version for its worker pool, the worker needs an update when it is not at
+// its target version.
+func vpcClusterWorkerNeedsUpdate(worker v2.Worker, poolOS, targets map[string]string) bool {
+	if worker.LifeCycle.ActualState == workerDeletePending || worker.LifeCycle.ActualState == workerDeleteState {
+		return false
+	}
+	if os, ok := poolOS[worker.PoolID]; ok && os != "" && worker.LifeCycle.ActualOperatingSystem != os {
+		return true
+	}
+	if target, ok := targets[worker.PoolID]; ok {
+		return compareVpcClusterWorkerVersions(worker.KubeVersion.Actual, target) < 0
+	}
+	return worker.KubeVersion.Actual != worker.KubeVersion.Target
+}
+
+// vpcClusterWorkersPendingUpdate returns the number of workers that need to be updated
+func vpcClusterWorkersPendingUpdate(workers []v2.Worker, poolOS, targets map[string]string) int {
+	pending := 0
+	for _, worker := range workers {
+		if vpcClusterWorkerNeedsUpdate(worker, poolOS, targets) {
+			pending++
+		}
+	}
+	return pending
+}
+
+// getVpcClusterWorkersPendingUpdate returns the workers of the cluster, the operating system of each
+// worker pool by pool ID and the number of workers that need to be updated to the target versions
+func getVpcClusterWorkersPendingUpdate(csClient v2.ContainerServiceAPI, clusterID string, targetEnv v2.ClusterTargetHeader, targets map[string]string) ([]v2.Worker, []v2.GetWorkerPoolResponse, map[string]string, int, error) {
+	pools, err := csClient.WorkerPools().ListWorkerPools(clusterID, targetEnv)
+	if err != nil {
+		return nil, nil, nil, 0, fmt.Errorf("[ERROR] Error retrieving worker pools of cluster (%s): %s", clusterID, err)
+	}
+	poolOS := make(map[string]string, len(pools))
+	for _, pool := range pools {
+		poolOS[pool.ID] = pool.OperatingSystem
+	}
+	workers, err := csClient.Workers().ListWorkers(clusterID, false, targetEnv)
+	if err != nil {
+		return nil, nil, nil, 0, fmt.Errorf("[ERROR] Error retrieving workers for cluster: %s", err)
+	}
+	return workers, pools, poolOS, vpcClusterWorkersPendingUpdate(workers, poolOS, targets), nil
+}
+
+// vpcClusterWorkerPoolOrder returns the IDs of the worker pools in the order of pool_order, followed
+// by the pools that are not in pool_order in the order of the API
+func vpcClusterWorkerPoolOrder(pools []v2.GetWorkerPoolResponse, poolOrder []string) []string {
+	ordered := make([]string, 0, len(pools))
+	seen := make(map[string]bool, len(pools))
+	for _, name := range poolOrder {
+		for _, pool := range pools {
+			if (pool.PoolName == name || pool.ID == name) && !seen[pool.ID] {
+				ordered = append(ordered, pool.ID)
+				seen[pool.ID] = true
+			}
+		}
+	}
+	for _, pool := range pools {
+		if !seen[pool.ID] {
+			ordered = append(ordered, pool.ID)
+			seen[pool.ID] = true
+		}
+	}
+	return ordered
+}
+
+// vpcClusterWorkerUpdateAllowance returns the number of workers of the pool that can be replaced
+// at once without taking the number of workers in normal health below min_available_percent
+func vpcClusterWorkerUpdateAllowance(workers []v2.Worker, poolID string, minAvailablePercent int) int {
+	size, healthy := 0, 0
+	for _, worker := range workers {
+		if worker.PoolID != poolID || worker.LifeCycle.ActualState == workerDeleteState {
+			continue
+		}
+		size++
+		if worker.Health.State == workerNormal {
+			healthy++
+		}
+	}
+	required := (size*minAvailablePercent + 99) / 100
+	return healthy - required
+}
+
+// vpcClusterWorkerUpdateBatch returns the next workers of the pool to replace: at most max_unavailable
+// workers in each zone, and at most allowance workers in all
+func vpcClusterWorkerUpdateBatch(workers []v2.Worker, poolID string, poolOS, targets map[string]string, maxUnavailable, allowance int) []v2.Worker {
+	batch := make([]v2.Worker, 0)
+	perZone := make(map[string]int)
+	for _, worker := range workers {
+		if len(batch) == allowance {
+			break
+		}
+		if worker.PoolID != poolID || !vpcClusterWorkerNeedsUpdate(worker, poolOS, targets) || perZone[worker.Location] == maxUnavailable {
+			continue
+		}
+		perZone[worker.Location]++
+		batch = append(batch, worker)
+	}
+	return batch
+}
+
+// updateVpcClusterWorkersWithStrategy replaces the workers that are not at their target version or
+// operating system, pool by pool in the order of pool_order. The workers of a pool are replaced in
+// batches of at most max_unavailable workers in each zone, the zones of the pool in parallel, and
+// each batch is waited for before the next one starts. The target versions are kept until the update
+// is complete, and only the workers that are still below them are replaced, so an update interrupted
+// by a timeout is resumed by the next apply without following the later fix packs.
+func updateVpcClusterWorkersWithStrategy(d *schema.ResourceData, meta interface{}, strategy *vpcClusterWorkerUpdateStrategy, targetEnv v2.ClusterTargetHeader) error {
+	csClient, err := meta.(conns.ClientSession).VpcContainerAPI()
+	if err != nil {
+		return err
+	}
+	clusterID := d.Id()
+
+	targets := expandVpcClusterWorkerUpdateTargets(d)
+	if len(targets) == 0 || d.HasChanges("kube_version", "update_all_workers", "patch_version", "retry_patch_version") {
+		targets = nil
+	}
+	workers, pools, poolOS, pending, err := getVpcClusterWorkersPendingUpdate(csClient, clusterID, targetEnv, targets)
+	if err != nil {
+		return err
+	}
+	if targets == nil {
+		targets = vpcClusterWorkerUpdateTargets(workers)
+		pending = vpcClusterWorkersPendingUpdate(workers, poolOS, targets)
+	}
+	d.Set("worker_update_target_versions", targets)
+	log.Printf("[INFO] Updating %d worker nodes of cluster (%s), at most %d at a time in each zone of a worker pool", pending, clusterID, strategy.maxUnavailable)
+
+	// replaced are the new workers, that are expected to be at the target version and operating system
+	replaced := make(map[string]bool)
+	batches := 0
+	for _, poolID := range vpcClusterWorkerPoolOrder(pools, strategy.poolOrder) {
+		for {
+			allowance := len(workers)
+			if strategy.minAvailablePercent > 0 {
+				allowance = vpcClusterWorkerUpdateAllowance(workers, poolID, strategy.minAvailablePercent)
+				if allowance < 1 && len(vpcClusterWorkerUpdateBatch(workers, poolID, poolOS, targets, strategy.maxUnavailable, 1)) > 0 {
+					workers, err = waitForVpcClusterWorkerUpdateAllowance(d, meta, targetEnv, poolID, strategy.minAvailablePercent)
+					if err != nil {
+						return fmt.Errorf("[ERROR] Error waiting for %d%% of the worker nodes of worker pool (%s) to be normal: %s", strategy.minAvailablePercent, poolID, err)
+					}
+					allowance = vpcClusterWorkerUpdateAllowance(workers, poolID, strategy.minAvailablePercent)
+				}
+			}
+			batch := vpcClusterWorkerUpdateBatch(workers, poolID, poolOS, targets, strategy.maxUnavailable, allowance)
+			if len(batch) == 0 {
+				break
+			}
+			if batches > 0 && strategy.pauseBetweenBatches > 0 {
+				log.Printf("[INFO] Pausing %s before the next batch of worker nodes of cluster (%s)", strategy.pauseBetweenBatches, clusterID)
+				time.Sleep(strategy.pauseBetweenBatches)
+			}
+			batches++
+
+			workersInfo := make(map[string]int, len(workers))
+			for index, worker := range workers {
+				workersInfo[worker.ID] = index
+			}
+			workersCount := vpcClusterActiveWorkerCount(workers)
+			for _, worker := range batch {
+				if replaced[worker.ID] {
+					return fmt.Errorf("[ERROR] Worker node - %s was replaced but is still not at version %s of its worker pool", worker.ID, worker.KubeVersion.Target)
+				}
+				log.Printf("[INFO] Replacing worker node (%s) of worker pool (%s) in zone %s", worker.ID, worker.PoolName, worker.Location)
+				_, err := csClient.Workers().ReplaceWokerNode(clusterID, worker.ID, targetEnv)
+				// As API returns http response 204 NO CONTENT, error raised will be exempted.
+				if err != nil && !strings.Contains(err.Error(), "EmptyResponseBody") {
+					return fmt.Errorf("[ERROR] Error replacing the worker node from the cluster: %s", err)
+				}
+			}
+
+			//1. wait for the worker nodes of the batch to delete
+			for _, worker := range batch {
+				_, deleteError := waitForWorkerNodetoDelete(d, meta, targetEnv, worker.ID)
+				if deleteError != nil {
+					return fmt.Errorf("[ERROR] Worker node - %s is failed to replace: %s", worker.ID, deleteError)
+				}
+			}
+
+			//2. wait for the new worker nodes
+			_, newWorkerError := waitForNewWorker(d, meta, targetEnv, workersCount)
+			if newWorkerError != nil {
+				return fmt.Errorf("[ERROR] Failed to spawn new worker nodes: %s", newWorkerError)
+			}
+
+			//3. wait for the new worker nodes' version update and normal state
+			workers, err = csClient.Workers().ListWorkers(clusterID, false, targetEnv)
+			if err != nil {
+				return fmt.Errorf("[ERROR] Error retrieving workers for cluster: %s", err)
+			}
+			for _, worker := range workers {
+				if _, ok := workersInfo[worker.ID]; ok {
+					continue
+				}
+				replaced[worker.ID] = true
+				_, err := waitForVpcClusterWokersVersionUpdate(d, meta, targetEnv, worker.ID)
+				if err != nil {
+					return fmt.Errorf(
+						"[ERROR] Error waiting for cluster (%s) worker nodes kube version to be updated: %s", d.Id(), err)
+				}
+			}
+			workers, err = csClient.Workers().ListWorkers(clusterID, false, targetEnv)
+			if err != nil {
+				return fmt.Errorf("[ERROR] Error retrieving workers for cluster: %s", err)
+			}
+		}
+	}
+	log.Printf("[INFO] Updated the worker nodes of cluster (%s) in %d batches", clusterID, batches)
+	d.Set("worker_update_target_versions", map[string]string{})
+	return nil
+}
+
+// waitForVpcClusterWorkerUpdateAllowance waits until a worker of the pool can be replaced without
+// taking the number of workers in normal health below min_available_percent
+func waitForVpcClusterWorkerUpdateAllowance(d *schema.ResourceData, meta interface{}, targetEnv v2.ClusterTargetHeader, poolID string, minAvailablePercent int) ([]v2.Worker, error) {
+	csClient, err := meta.(conns.ClientSession).VpcContainerAPI()
+	if err != nil {
+		return nil, err
+	}
+
+	clusterID := d.Id()
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{"unavailable"},
+		Target:  []string{"available"},
+		Refresh: func() (interface{}, string, error) {
+			workers, err := csClient.Workers().ListWorkers(clusterID, false, targetEnv)
+			if err != nil {
+				return nil, "", fmt.Errorf("[ERROR] Error in retriving the list of worker nodes")
+			}
+			if vpcClusterWorkerUpdateAllowance(workers, poolID, minAvailablePercent) > 0 {
+				return workers, "available", nil
+			}
+			return workers, "unavailable", nil
+		},
+		Timeout:      d.Timeout(schema.TimeoutUpdate),
+		Delay:        10 * time.Second,
+		MinTimeout:   5 * time.Second,
+		PollInterval: 10 * time.Second,
+	}
+	workers, err := stateConf.WaitForState()
+	if err != nil {
+		return nil, err
+	}
+	return workers.([]v2.Worker), nil
+}
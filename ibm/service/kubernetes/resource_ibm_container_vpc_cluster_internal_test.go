@@ -0,0 +1,206 @@
+// Copyright IBM Corp. 2025 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package kubernetes
+
+import (
+	"reflect"
+	"testing"
+
+	v2 "github.com/IBM-Cloud/bluemix-go/api/container/containerv2"
+)
+
+func testVpcClusterWorker(id, poolID, zone, actual, state, health string) v2.Worker {
+	worker := v2.Worker{
+		ID:       id,
+		PoolID:   poolID,
+		Location: zone,
+		KubeVersion: v2.KubeDetails{
+			Actual: actual,
+			Target: "1.31.2",
+		},
+	}
+	worker.LifeCycle.ActualState = state
+	worker.Health.State = health
+	return worker
+}
+
+func testVpcClusterWorkerIDs(workers []v2.Worker) []string {
+	ids := make([]string, 0, len(workers))
+	for _, worker := range workers {
+		ids = append(ids, worker.ID)
+	}
+	return ids
+}
+
+func TestVpcClusterWorkerUpdateBatch(t *testing.T) {
+	workers := []v2.Worker{
+		testVpcClusterWorker("w1", "pool", "us-south-1", "1.30.5", "deployed", workerNormal),
+		testVpcClusterWorker("w2", "pool", "us-south-1", "1.30.5", "deployed", workerNormal),
+		testVpcClusterWorker("w3", "pool", "us-south-2", "1.30.5", "deployed", workerNormal),
+		testVpcClusterWorker("w4", "pool", "us-south-2", "1.31.2", "deployed", workerNormal),
+		testVpcClusterWorker("w5", "pool", "us-south-3", "1.30.5", workerDeletePending, workerNormal),
+		testVpcClusterWorker("w6", "other", "us-south-1", "1.30.5", "deployed", workerNormal),
+	}
+	osWorker := testVpcClusterWorker("w7", "os-pool", "us-south-1", "1.31.2", "deployed", workerNormal)
+	osWorker.LifeCycle.ActualOperatingSystem = "UBUNTU_20_64"
+	workers = append(workers, osWorker)
+	poolOS := map[string]string{"pool": "", "os-pool": "UBUNTU_24_64"}
+
+	tests := []struct {
+		name           string
+		poolID         string
+		maxUnavailable int
+		allowance      int
+		want           []string
+	}{
+		{name: "one worker in each zone", poolID: "pool", maxUnavailable: 1, allowance: len(workers), want: []string{"w1", "w3"}},
+		{name: "two workers in each zone", poolID: "pool", maxUnavailable: 2, allowance: len(workers), want: []string{"w1", "w2", "w3"}},
+		{name: "allowance", poolID: "pool", maxUnavailable: 2, allowance: 1, want: []string{"w1"}},
+		{name: "no allowance", poolID: "pool", maxUnavailable: 2, allowance: 0, want: []string{}},
+		{name: "operating system of the pool", poolID: "os-pool", maxUnavailable: 1, allowance: len(workers), want: []string{"w7"}},
+		{name: "unknown pool", poolID: "missing", maxUnavailable: 1, allowance: len(workers), want: []string{}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := testVpcClusterWorkerIDs(vpcClusterWorkerUpdateBatch(workers, tt.poolID, poolOS, nil, tt.maxUnavailable, tt.allowance))
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("vpcClusterWorkerUpdateBatch() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestVpcClusterWorkersPendingUpdate(t *testing.T) {
+	movedTarget := testVpcClusterWorker("w3", "pool", "us-south-2", "1.31.2", "deployed", workerNormal)
+	movedTarget.KubeVersion.Target = "1.31.3"
+	workers := []v2.Worker{
+		testVpcClusterWorker("w1", "pool", "us-south-1", "1.31.2", "deployed", workerNormal),
+		testVpcClusterWorker("w2", "pool", "us-south-1", "1.31.2", "deployed", workerNormal),
+		movedTarget,
+	}
+	poolOS := map[string]string{"pool": ""}
+
+	tests := []struct {
+		name    string
+		workers []v2.Worker
+		targets map[string]string
+		want    int
+	}{
+		{name: "target moved after the update", workers: workers, targets: map[string]string{"pool": "1.31.2"}, want: 0},
+		{name: "target moved without an update", workers: workers, want: 1},
+		{name: "workers below the version of the update", workers: workers, targets: map[string]string{"pool": "1.31.3"}, want: 3},
+		{name: "fix pack of the update", workers: workers, targets: map[string]string{"pool": "1.31.2_1530"}, want: 3},
+		{name: "version of another pool", workers: workers, targets: map[string]string{"other": "1.31.3"}, want: 1},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := vpcClusterWorkersPendingUpdate(tt.workers, poolOS, tt.targets); got != tt.want {
+				t.Errorf("vpcClusterWorkersPendingUpdate() = %d, want %d", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestVpcClusterWorkerUpdateTargets(t *testing.T) {
+	newer := testVpcClusterWorker("w2", "pool", "us-south-1", "1.31.2", "deployed", workerNormal)
+	newer.KubeVersion.Target = "1.31.10"
+	workers := []v2.Worker{
+		testVpcClusterWorker("w1", "pool", "us-south-1", "1.30.5", "deployed", workerNormal),
+		newer,
+		testVpcClusterWorker("w3", "other", "us-south-1", "1.30.5", "deployed", workerNormal),
+	}
+	want := map[string]string{"pool": "1.31.10", "other": "1.31.2"}
+	if got := vpcClusterWorkerUpdateTargets(workers); !reflect.DeepEqual(got, want) {
+		t.Errorf("vpcClusterWorkerUpdateTargets() = %v, want %v", got, want)
+	}
+}
+
+func TestCompareVpcClusterWorkerVersions(t *testing.T) {
+	tests := []struct {
+		a, b string
+		want int
+	}{
+		{a: "1.31.2", b: "1.31.2", want: 0},
+		{a: "1.31.2", b: "1.31.10", want: -1},
+		{a: "1.31.2_1530", b: "1.31.2_1529", want: 1},
+		{a: "1.31.2", b: "1.31.2_1530", want: -1},
+		{a: "4.15.30_openshift", b: "4.15.30_openshift", want: 0},
+		{a: "4.15.30_openshift", b: "4.16.1_openshift", want: -1},
+	}
+	for _, tt := range tests {
+		t.Run(tt.a+" "+tt.b, func(t *testing.T) {
+			if got := compareVpcClusterWorkerVersions(tt.a, tt.b); got != tt.want {
+				t.Errorf("compareVpcClusterWorkerVersions(%q, %q) = %d, want %d", tt.a, tt.b, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestVpcClusterWorkerUpdateAllowance(t *testing.T) {
+	workers := []v2.Worker{
+		testVpcClusterWorker("w1", "pool", "us-south-1", "1.30.5", "deployed", workerNormal),
+		testVpcClusterWorker("w2", "pool", "us-south-1", "1.30.5", "deployed", workerNormal),
+		testVpcClusterWorker("w3", "pool", "us-south-2", "1.30.5", "deployed", workerNormal),
+		testVpcClusterWorker("w4", "pool", "us-south-2", "1.30.5", "provisioning", "warning"),
+		testVpcClusterWorker("w5", "pool", "us-south-3", "1.30.5", workerDeleteState, workerNormal),
+		testVpcClusterWorker("w6", "other", "us-south-1", "1.30.5", "deployed", workerNormal),
+	}
+
+	tests := []struct {
+		name                string
+		minAvailablePercent int
+		want                int
+	}{
+		{name: "no minimum", minAvailablePercent: 0, want: 3},
+		{name: "half", minAvailablePercent: 50, want: 1},
+		{name: "rounded up", minAvailablePercent: 60, want: 0},
+		{name: "all", minAvailablePercent: 100, want: -1},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := vpcClusterWorkerUpdateAllowance(workers, "pool", tt.minAvailablePercent); got != tt.want {
+				t.Errorf("vpcClusterWorkerUpdateAllowance() = %d, want %d", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestVpcClusterWorkerPoolOrder(t *testing.T) {
+	pools := []v2.GetWorkerPoolResponse{
+		{ID: "id-default", PoolName: "default"},
+		{ID: "id-edge", PoolName: "edge"},
+		{ID: "id-gpu", PoolName: "gpu"},
+	}
+
+	tests := []struct {
+		name      string
+		poolOrder []string
+		want      []string
+	}{
+		{name: "no order", poolOrder: nil, want: []string{"id-default", "id-edge", "id-gpu"}},
+		{name: "by name", poolOrder: []string{"gpu", "default"}, want: []string{"id-gpu", "id-default", "id-edge"}},
+		{name: "by id", poolOrder: []string{"id-edge"}, want: []string{"id-edge", "id-default", "id-gpu"}},
+		{name: "repeated", poolOrder: []string{"edge", "id-edge"}, want: []string{"id-edge", "id-default", "id-gpu"}},
+		{name: "unknown pool", poolOrder: []string{"missing", "gpu"}, want: []string{"id-gpu", "id-default", "id-edge"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := vpcClusterWorkerPoolOrder(pools, tt.poolOrder); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("vpcClusterWorkerPoolOrder() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestVpcClusterActiveWorkerCount(t *testing.T) {
+	workers := []v2.Worker{
+		testVpcClusterWorker("w1", "pool", "us-south-1", "1.30.5", "deployed", workerNormal),
+		testVpcClusterWorker("w2", "pool", "us-south-1", "1.30.5", "provisioning", "warning"),
+		testVpcClusterWorker("w3", "pool", "us-south-2", "1.30.5", workerDeletePending, workerNormal),
+		testVpcClusterWorker("w4", "pool", "us-south-2", "1.30.5", workerDeleteState, workerNormal),
+	}
+	if got := vpcClusterActiveWorkerCount(workers); got != 2 {
+		t.Errorf("vpcClusterActiveWorkerCount() = %d, want 2", got)
+	}
+}
@@ -8,6 +8,7 @@ const (
 	Arg_AffinityInstance                     = "pi_affinity_instance"
 	Arg_AffinityPolicy                       = "pi_affinity_policy"
 	Arg_AffinityVolume                       = "pi_affinity_volume"
+	Arg_AllowStoppingForUpdate               = "pi_allow_stopping_for_update"
 	Arg_AntiAffinityInstances                = "pi_anti_affinity_instances"
 	Arg_AntiAffinityVolumes                  = "pi_anti_affinity_volumes"
 	Arg_AuxiliaryVolumeName                  = "pi_auxiliary_volume_name"
@@ -107,6 +108,7 @@ const (
 	Arg_Protocol                             = "pi_protocol"
 	Arg_Remote                               = "pi_remote"
 	Arg_Remove                               = "pi_remove"
+	Arg_ReplaceReplicants                    = "pi_replace_replicants"
 	Arg_Replicants                           = "pi_replicants"
 	Arg_ReplicationEnabled                   = "pi_replication_enabled"
 	Arg_ReplicationPolicy                    = "pi_replication_policy"
@@ -394,6 +396,7 @@ const (
 	Attr_RemoteCopyRelationshipNames                 = "remote_copy_relationship_names"
 	Attr_RemoteCopyRelationships                     = "remote_copy_relationships"
 	Attr_RemotePool                                  = "remote_pool"
+	Attr_Replicants                                  = "replicants"
 	Attr_ReplicationEnabled                          = "replication_enabled"
 	Attr_ReplicationPoolMap                          = "replication_pool_map"
 	Attr_ReplicationSites                            = "replication_sites"
@@ -403,6 +406,7 @@ const (
 	Attr_ReservedCores                               = "reserved_cores"
 	Attr_ReservedMemory                              = "reserved_memory"
 	Attr_Reset                                       = "reset"
+	Attr_ResizeMethod                                = "resize_method"
 	Attr_ResultsOnboardedVolumes                     = "results_onboarded_volumes"
 	Attr_ResultsVolumeOnboardingFailures             = "results_volume_onboarding_failures"
 	Attr_Rules                                       = "rules"
@@ -555,6 +559,7 @@ const (
 	DestinationUnreach         = "destination-unreach"
 	Detach                     = "detach"
 	DHCPVlan                   = "dhcp-vlan"
+	DLPAR                      = "dlpar"
 	Disable                    = "disable"
 	Echo                       = "echo"
 	EchoReply                  = "echo-reply"
@@ -584,6 +589,7 @@ const (
 	Private                    = "private"
 	Public                     = "public"
 	PubVlan                    = "pub-vlan"
+	Restart                    = "restart"
 	SAP                        = "SAP"
 	Shared                     = "shared"
 	Soft                       = "soft"
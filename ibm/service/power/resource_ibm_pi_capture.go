@@ -38,7 +38,7 @@ func ResourceIBMPICapture() *schema.Resource {
 		},
 		CustomizeDiff: customdiff.Sequence(
 			func(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
-				return flex.ResourcePowerUserTagsCustomizeDiff(diff)
+				return flex.ResourcePowerUserTagsCustomizeDiff(diff, v)
 			},
 		),
 
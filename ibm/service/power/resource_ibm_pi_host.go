@@ -219,7 +219,7 @@ func resourceIBMPIHostCreate(ctx context.Context, d *schema.ResourceData, meta i
 
 	host := hosts[0].(map[string]interface{})
 	tags := flex.FlattenSet(host[Attr_UserTags].(*schema.Set))
-	if hostResponse[0].Crn != "" && len(tags) > 0 {
+	if hostResponse[0].Crn != "" && (len(tags) > 0 || len(flex.DefaultTags(meta, UserTagType)) > 0) {
 		oldList, newList := d.GetChange(Arg_Host + ".0." + Attr_UserTags)
 		err := flex.UpdateGlobalTagsUsingCRN(oldList, newList, meta, string(hostResponse[0].Crn), "", UserTagType)
 		if err != nil {
@@ -6,8 +6,11 @@ package power
 import (
 	"context"
 	"encoding/base64"
+	"errors"
 	"fmt"
 	"log"
+	"sort"
+	"strconv"
 	"strings"
 	"time"
 
@@ -16,8 +19,11 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
 	"github.com/IBM-Cloud/power-go-client/clients/instance"
+	"github.com/IBM-Cloud/power-go-client/ibmpisession"
+	"github.com/IBM-Cloud/power-go-client/power/client/p_cloud_p_vm_instances"
 	"github.com/IBM-Cloud/power-go-client/power/models"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
@@ -39,7 +45,10 @@ func ResourceIBMPIInstance() *schema.Resource {
 		},
 		CustomizeDiff: customdiff.Sequence(
 			func(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
-				return flex.ResourcePowerUserTagsCustomizeDiff(diff)
+				return flex.ResourcePowerUserTagsCustomizeDiff(diff, v)
+			},
+			func(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
+				return resourceIBMPIInstanceResizeCustomizeDiff(diff)
 			},
 		),
 
@@ -63,6 +72,12 @@ func ResourceIBMPIInstance() *schema.Resource {
 				Optional:      true,
 				Type:          schema.TypeString,
 			},
+			Arg_AllowStoppingForUpdate: {
+				Default:     true,
+				Description: "Indicates whether the instance can be stopped for updates that cannot be applied while it is running. If set to false, the plan fails when such an update is required.",
+				Optional:    true,
+				Type:        schema.TypeBool,
+			},
 			Arg_AntiAffinityInstances: {
 				ConflictsWith: []string{Arg_AntiAffinityVolumes},
 				Description:   "List of pvmInstances to base storage anti-affinity policy against; required if requesting anti-affinity and pi_anti_affinity_volumes is not provided",
@@ -251,12 +266,18 @@ func ResourceIBMPIInstance() *schema.Resource {
 				Optional:      true,
 				Type:          schema.TypeFloat,
 			},
-			Arg_Replicants: {
-				Default:     1,
-				Description: "PI Instance replicas count",
-				ForceNew:    true,
+			Arg_ReplaceReplicants: {
+				Description: "Map of replicant names to arbitrary values; the replicant is deleted and created again whenever its value is added or changed",
+				Elem:        &schema.Schema{Type: schema.TypeString},
 				Optional:    true,
-				Type:        schema.TypeInt,
+				Type:        schema.TypeMap,
+			},
+			Arg_Replicants: {
+				Default:      1,
+				Description:  "PI Instance replicas count",
+				Optional:     true,
+				Type:         schema.TypeInt,
+				ValidateFunc: validation.IntAtLeast(1),
 			},
 			Arg_ReplicationPolicy: {
 				Default:      None,
@@ -478,6 +499,109 @@ func ResourceIBMPIInstance() *schema.Resource {
 				Description: "Progress of the operation",
 				Type:        schema.TypeFloat,
 			},
+			Attr_Replicants: {
+				Computed:    true,
+				Description: "The replicants of the instance.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						Attr_HealthStatus: {
+							Computed:    true,
+							Description: "The health status of the replicant.",
+							Type:        schema.TypeString,
+						},
+						Attr_InstanceID: {
+							Computed:    true,
+							Description: "The unique identifier of the replicant.",
+							Type:        schema.TypeString,
+						},
+						Attr_MaxMemory: {
+							Computed:    true,
+							Description: "The maximum amount of memory that can be allocated to the replicant without a restart.",
+							Type:        schema.TypeFloat,
+						},
+						Attr_MaxProcessors: {
+							Computed:    true,
+							Description: "The maximum number of processors that can be allocated to the replicant without a restart.",
+							Type:        schema.TypeFloat,
+						},
+						Attr_Memory: {
+							Computed:    true,
+							Description: "The amount of memory that is allocated to the replicant.",
+							Type:        schema.TypeFloat,
+						},
+						Attr_MinMemory: {
+							Computed:    true,
+							Description: "The minimum amount of memory that can be allocated to the replicant without a restart.",
+							Type:        schema.TypeFloat,
+						},
+						Attr_MinProcessors: {
+							Computed:    true,
+							Description: "The minimum number of processors that can be allocated to the replicant without a restart.",
+							Type:        schema.TypeFloat,
+						},
+						Attr_Name: {
+							Computed:    true,
+							Description: "The name of the replicant.",
+							Type:        schema.TypeString,
+						},
+						Attr_Networks: {
+							Computed:    true,
+							Description: "The network addresses of the replicant.",
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									Attr_ExternalIP: {
+										Computed:    true,
+										Description: "The external IP address of the replicant.",
+										Type:        schema.TypeString,
+									},
+									Attr_IPAddress: {
+										Computed:    true,
+										Description: "The IP address of the replicant.",
+										Type:        schema.TypeString,
+									},
+									Attr_MacAddress: {
+										Computed:    true,
+										Description: "The MAC address of the replicant.",
+										Type:        schema.TypeString,
+									},
+									Attr_NetworkID: {
+										Computed:    true,
+										Description: "The network ID.",
+										Type:        schema.TypeString,
+									},
+									Attr_NetworkName: {
+										Computed:    true,
+										Description: "The network name.",
+										Type:        schema.TypeString,
+									},
+								},
+							},
+							Type: schema.TypeList,
+						},
+						Attr_Processors: {
+							Computed:    true,
+							Description: "The number of processors that are allocated to the replicant.",
+							Type:        schema.TypeFloat,
+						},
+						Attr_ProcType: {
+							Computed:    true,
+							Description: "The processor type of the replicant.",
+							Type:        schema.TypeString,
+						},
+						Attr_Status: {
+							Computed:    true,
+							Description: "The status of the replicant.",
+							Type:        schema.TypeString,
+						},
+					},
+				},
+				Type: schema.TypeList,
+			},
+			Attr_ResizeMethod: {
+				Computed:    true,
+				Description: "The method of the last update of the instance resources, dlpar when the update is applied while the instance is running, restart when the instance is stopped for the update, or none when the resources were not updated.",
+				Type:        schema.TypeString,
+			},
 			Attr_SharedProcessorPoolID: {
 				Computed:    true,
 				Description: "Shared Processor Pool ID the instance is deployed on",
@@ -503,21 +627,18 @@ func resourceIBMPIInstanceCreate(ctx context.Context, d *schema.ResourceData, me
 	sapClient := instance.NewIBMPISAPInstanceClient(ctx, sess, cloudInstanceID)
 	imageClient := instance.NewIBMPIImageClient(ctx, sess, cloudInstanceID)
 
+	name := d.Get(Arg_InstanceName).(string)
+	replicants := d.Get(Arg_Replicants).(int)
 	var pvmList *models.PVMInstanceList
 	if _, ok := d.GetOk(Arg_SAPProfileID); ok {
-		pvmList, err = createSAPInstance(d, sapClient)
+		pvmList, err = createSAPInstance(d, sapClient, name, int64(replicants), nil)
 	} else {
-		pvmList, err = createPVMInstance(d, client, imageClient)
+		pvmList, err = createPVMInstance(d, client, imageClient, name, float64(replicants), true, nil)
 	}
 	if err != nil {
 		return diag.FromErr(err)
 	}
 
-	var instanceReadyStatus string
-	if r, ok := d.GetOk(Arg_HealthStatus); ok {
-		instanceReadyStatus = r.(string)
-	}
-
 	// id is a combination of the cloud instance id and all of the pvm instance ids
 	id := cloudInstanceID
 	for _, pvm := range *pvmList {
@@ -526,62 +647,10 @@ func resourceIBMPIInstanceCreate(ctx context.Context, d *schema.ResourceData, me
 
 	d.SetId(id)
 
-	for _, s := range *pvmList {
-		if dt, ok := d.GetOk(Arg_DeploymentType); ok && dt.(string) == DeploymentTypeVMNoStorage {
-			_, err = isWaitForPIInstanceShutoff(ctx, client, *s.PvmInstanceID, instanceReadyStatus, d.Timeout(schema.TimeoutCreate))
-			if err != nil {
-				return diag.FromErr(err)
-			}
-		} else {
-			_, err = isWaitForPIInstanceAvailable(ctx, client, *s.PvmInstanceID, instanceReadyStatus, d.Timeout(schema.TimeoutCreate))
-			if err != nil {
-				return diag.FromErr(err)
-			}
-		}
-	}
-
-	// If Storage Pool Affinity is given as false we need to update the vm instance.
-	// Default value is true which indicates that all volumes attached to the server
-	// must reside in the same storage pool.
-	storagePoolAffinity := d.Get(Arg_StoragePoolAffinity).(bool)
-	if !storagePoolAffinity {
-		for _, s := range *pvmList {
-			body := &models.PVMInstanceUpdate{
-				StoragePoolAffinity: &storagePoolAffinity,
-			}
-			// This is a synchronous process hence no need to check for health status
-			_, err = client.Update(*s.PvmInstanceID, body)
-			if err != nil {
-				return diag.FromErr(err)
-			}
-		}
-	}
-
-	// If user tags are set, make sure tags are set correctly before moving on
-	if _, ok := d.GetOk(Arg_UserTags); ok {
-		oldList, newList := d.GetChange(Arg_UserTags)
-		for _, s := range *pvmList {
-			if s.Crn != "" {
-				err := flex.UpdateGlobalTagsUsingCRN(oldList, newList, meta, string(s.Crn), "", UserTagType)
-				if err != nil {
-					log.Printf("Error on update of pi instance (%s) pi_user_tags during creation: %s", *s.PvmInstanceID, err)
-				}
-			}
-		}
-	}
-
-	// If virtual optical device provided then update cloud initialization
-	if vod, ok := d.GetOk(Arg_VirtualOpticalDevice); ok {
-		for _, s := range *pvmList {
-			body := &models.PVMInstanceUpdate{
-				CloudInitialization: &models.CloudInitialization{
-					VirtualOpticalDevice: vod.(string),
-				},
-			}
-			_, err = client.Update(*s.PvmInstanceID, body)
-			if err != nil {
-				return diag.FromErr(err)
-			}
+	for _, pvm := range *pvmList {
+		err = configurePIInstanceReplicant(ctx, d, meta, client, pvm, d.Timeout(schema.TimeoutCreate))
+		if err != nil {
+			return diag.FromErr(err)
 		}
 	}
 
@@ -600,13 +669,41 @@ func resourceIBMPIInstanceRead(ctx context.Context, d *schema.ResourceData, meta
 	}
 
 	cloudInstanceID := idArr[0]
-	instanceID := idArr[1]
-
 	client := instance.NewIBMPIInstanceClient(ctx, sess, cloudInstanceID)
-	powervmdata, err := client.Get(instanceID)
-	if err != nil {
-		return diag.FromErr(err)
+
+	// Replicants that were deleted outside of terraform are dropped from the id, so that they are
+	// created again when pi_replicants is applied
+	replicants := []*models.PVMInstance{}
+	for _, instanceID := range idArr[1:] {
+		pvm, err := client.Get(instanceID)
+		if err != nil {
+			uErr := errors.Unwrap(err)
+			switch uErr.(type) {
+			case *p_cloud_p_vm_instances.PcloudPvminstancesGetNotFound:
+				log.Printf("[DEBUG] pvm instance (%s) does not exist %v", instanceID, err)
+				continue
+			}
+			return diag.FromErr(err)
+		}
+		replicants = append(replicants, pvm)
+	}
+	if len(replicants) == 0 {
+		d.SetId("")
+		return nil
+	}
+	instanceIDs := make([]string, 0, len(replicants))
+	for _, pvm := range replicants {
+		instanceIDs = append(instanceIDs, *pvm.PvmInstanceID)
 	}
+	d.SetId(piInstanceID(cloudInstanceID, instanceIDs))
+	d.Set(Arg_Replicants, len(replicants))
+	// The method is set by the update of the instance resources and kept until the next one
+	if _, ok := d.GetOk(Attr_ResizeMethod); !ok {
+		d.Set(Attr_ResizeMethod, None)
+	}
+	d.Set(Attr_Replicants, flattenPIInstanceReplicants(replicants))
+
+	powervmdata := replicants[0]
 
 	if powervmdata.Crn != "" {
 		d.Set(Attr_CRN, powervmdata.Crn)
@@ -618,13 +715,26 @@ func resourceIBMPIInstanceRead(ctx context.Context, d *schema.ResourceData, meta
 	}
 	d.Set(Arg_Memory, powervmdata.Memory)
 	d.Set(Arg_Processors, powervmdata.Processors)
+	d.Set(Arg_ProcType, powervmdata.ProcType)
+	// A replicant that drifted from the first one is reported as a change of the instance, so that
+	// the configuration is applied to all the replicants again
+	for _, pvm := range replicants[1:] {
+		if pvm.Memory != nil && powervmdata.Memory != nil && *pvm.Memory != *powervmdata.Memory {
+			d.Set(Arg_Memory, pvm.Memory)
+		}
+		if pvm.Processors != nil && powervmdata.Processors != nil && *pvm.Processors != *powervmdata.Processors {
+			d.Set(Arg_Processors, pvm.Processors)
+		}
+		if flex.StringValue(pvm.ProcType) != flex.StringValue(powervmdata.ProcType) {
+			d.Set(Arg_ProcType, pvm.ProcType)
+		}
+	}
 	if powervmdata.Status != nil {
 		d.Set(Attr_Status, powervmdata.Status)
 	}
 	d.Set(Arg_CloudInstanceID, cloudInstanceID)
 	d.Set(Arg_ImageID, powervmdata.ImageID)
-	d.Set(Arg_InstanceName, powervmdata.ServerName)
-	d.Set(Arg_ProcType, powervmdata.ProcType)
+	d.Set(Arg_InstanceName, piInstanceReplicantBaseName(d, powervmdata, len(replicants) > 1))
 	d.Set(Arg_StoragePool, powervmdata.StoragePool)
 	d.Set(Arg_StoragePoolAffinity, powervmdata.StoragePoolAffinity)
 	d.Set(Attr_InstanceID, powervmdata.PvmInstanceID)
@@ -709,25 +819,17 @@ func resourceIBMPIInstanceRead(ctx context.Context, d *schema.ResourceData, meta
 }
 
 func resourceIBMPIInstanceUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	name := d.Get(Arg_InstanceName).(string)
-	mem := d.Get(Arg_Memory).(float64)
-	procs := d.Get(Arg_Processors).(float64)
-	processortype := d.Get(Arg_ProcType).(string)
-	assignedVirtualCores := int64(d.Get(Arg_VirtualCoresAssigned).(int))
-
-	if d.Get(Attr_HealthStatus) == Warning {
-		return diag.Errorf("the operation cannot be performed when the lpar health in the WARNING State")
-	}
-
 	sess, err := meta.(conns.ClientSession).IBMPISession()
 	if err != nil {
 		return diag.Errorf("failed to get the session from the IBM Cloud Service")
 	}
 
-	cloudInstanceID, instanceID, err := splitID(d.Id())
+	idArr, err := flex.IdParts(d.Id())
 	if err != nil {
 		return diag.FromErr(err)
 	}
+	cloudInstanceID := idArr[0]
+	instanceIDs := idArr[1:]
 
 	client := instance.NewIBMPIInstanceClient(ctx, sess, cloudInstanceID)
 
@@ -739,6 +841,71 @@ func resourceIBMPIInstanceUpdate(ctx context.Context, d *schema.ResourceData, me
 	}
 	cores_enabled := checkCloudInstanceCapability(cloudInstance, CUSTOM_VIRTUAL_CORES)
 
+	// Replicants are removed first and added last. The replicants that are created from the
+	// updated configuration are not updated again.
+	created := map[string]bool{}
+	replicants := d.Get(Arg_Replicants).(int)
+	if replicants < len(instanceIDs) {
+		instanceIDs, err = removePIInstanceReplicants(ctx, d, client, cloudInstanceID, instanceIDs, replicants)
+		if err != nil {
+			d.Set(Arg_Replicants, len(instanceIDs))
+			return diag.FromErr(err)
+		}
+	}
+	if d.HasChange(Arg_ReplaceReplicants) {
+		instanceIDs, err = replacePIInstanceReplicants(ctx, d, meta, sess, client, cloudInstanceID, instanceIDs, created)
+		if err != nil {
+			oldTriggers, _ := d.GetChange(Arg_ReplaceReplicants)
+			d.Set(Arg_ReplaceReplicants, oldTriggers)
+			return diag.FromErr(err)
+		}
+	}
+	for i, instanceID := range instanceIDs {
+		if created[instanceID] {
+			continue
+		}
+		if diags := updatePIInstanceReplicant(ctx, d, meta, sess, client, cloudInstanceID, instanceID, i == 0, cores_enabled); diags.HasError() {
+			return diags
+		}
+	}
+	if replicants > len(instanceIDs) {
+		instanceIDs, err = addPIInstanceReplicants(ctx, d, meta, sess, client, cloudInstanceID, instanceIDs, replicants)
+		if err != nil {
+			d.Set(Arg_Replicants, len(instanceIDs))
+			return diag.FromErr(err)
+		}
+	}
+
+	return resourceIBMPIInstanceRead(ctx, d, meta)
+}
+
+// updatePIInstanceReplicant applies the changes of the configuration to one replicant of the
+// instance. The virtual serial number is only applied to the first replicant, as it must be unique.
+func updatePIInstanceReplicant(ctx context.Context, d *schema.ResourceData, meta interface{}, sess *ibmpisession.IBMPISession, client *instance.IBMPIInstanceClient, cloudInstanceID, instanceID string, first, cores_enabled bool) diag.Diagnostics {
+	pvm, err := client.Get(instanceID)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if pvm.Health != nil && pvm.Health.Status == Warning {
+		return diag.Errorf("the operation cannot be performed when the lpar health in the WARNING State")
+	}
+	status := ""
+	if pvm.Status != nil {
+		status = *pvm.Status
+	}
+
+	// Keep the index that the replication scheme added to the name of the replicant
+	name := d.Get(Arg_InstanceName).(string)
+	oldName, _ := d.GetChange(Arg_InstanceName)
+	scheme := d.Get(Arg_ReplicationScheme).(string)
+	if index, ok := piInstanceReplicantIndex(flex.StringValue(pvm.ServerName), oldName.(string), scheme); ok {
+		name = piInstanceReplicantName(name, scheme, index)
+	}
+	mem := d.Get(Arg_Memory).(float64)
+	procs := d.Get(Arg_Processors).(float64)
+	processortype := d.Get(Arg_ProcType).(string)
+	assignedVirtualCores := int64(d.Get(Arg_VirtualCoresAssigned).(int))
+
 	if d.HasChanges(Arg_InstanceName, Arg_VirtualOpticalDevice) {
 		if d.HasChange(Arg_InstanceName) && d.HasChange(Arg_VirtualOpticalDevice) {
 			oldVOD, _ := d.GetChange(Arg_VirtualOpticalDevice)
@@ -769,7 +936,6 @@ func resourceIBMPIInstanceUpdate(ctx context.Context, d *schema.ResourceData, me
 
 	if d.HasChange(Arg_ProcType) {
 		// Stop the lpar
-		status := d.Get(Attr_Status).(string)
 		if strings.ToLower(status) == State_Shutoff {
 			log.Printf("the lpar is in the shutoff state. Nothing to do . Moving on ")
 		} else {
@@ -822,26 +988,27 @@ func resourceIBMPIInstanceUpdate(ctx context.Context, d *schema.ResourceData, me
 	// Start of the change for Memory and Processors
 	if d.HasChange(Arg_Memory) || d.HasChange(Arg_Processors) {
 
-		maxMemLpar := d.Get(Attr_MaxMemory).(float64)
-		maxCPULpar := d.Get(Attr_MaxProcessors).(float64)
-
-		if mem > maxMemLpar || procs > maxCPULpar {
+		requiresRestart := piInstanceResizeRequiresRestart(mem, procs, pvm.Minmem, pvm.Maxmem, pvm.Minproc, pvm.Maxproc)
+		if requiresRestart {
 			log.Printf("Will require a shutdown to perform the change")
 		} else {
-			log.Printf("maxMemLpar is set to %f", maxMemLpar)
-			log.Printf("maxCPULpar is set to %f", maxCPULpar)
+			log.Printf("the change is within the DLPAR range of memory %f-%f and processors %f-%f", pvm.Minmem, pvm.Maxmem, pvm.Minproc, pvm.Maxproc)
 		}
 
-		instanceState := d.Get(Attr_Status).(string)
+		instanceState := status
 		log.Printf("the instance state is %s", instanceState)
 
-		if (mem > maxMemLpar || procs > maxCPULpar) && strings.ToLower(instanceState) != State_Shutoff {
+		if requiresRestart && strings.ToLower(instanceState) != State_Shutoff {
 			err = performChangeAndReboot(ctx, client, d, instanceID, mem, procs)
 			if err != nil {
 				return diag.FromErr(err)
 			}
-
+			d.Set(Attr_ResizeMethod, Restart)
 		} else {
+			// A replicant that was restarted before decides the method of the whole update
+			if first || d.Get(Attr_ResizeMethod).(string) != Restart {
+				d.Set(Attr_ResizeMethod, DLPAR)
+			}
 			body := &models.PVMInstanceUpdate{
 				Memory:     mem,
 				Processors: procs,
@@ -884,7 +1051,6 @@ func resourceIBMPIInstanceUpdate(ctx context.Context, d *schema.ResourceData, me
 
 	if d.HasChange(Arg_SAPProfileID) {
 		// Stop the lpar
-		status := d.Get(Attr_Status).(string)
 		if strings.ToLower(status) == State_Shutoff {
 			log.Printf("the lpar is in the shutoff state. Nothing to do... Moving on ")
 		} else {
@@ -973,7 +1139,6 @@ func resourceIBMPIInstanceUpdate(ctx context.Context, d *schema.ResourceData, me
 		}
 	}
 	if d.HasChanges(Arg_IBMiCSS, Arg_IBMiPHA, Arg_IBMiRDSUsers) {
-		status := d.Get(Attr_Status).(string)
 		if strings.ToLower(status) == State_Active {
 			log.Printf("the lpar is in the Active state, continuing with update")
 		} else {
@@ -1004,21 +1169,20 @@ func resourceIBMPIInstanceUpdate(ctx context.Context, d *schema.ResourceData, me
 		}
 	}
 	if d.HasChange(Arg_UserTags) {
-		if crn, ok := d.GetOk(Attr_CRN); ok {
+		if pvm.Crn != "" {
 			oldList, newList := d.GetChange(Arg_UserTags)
-			err := flex.UpdateGlobalTagsUsingCRN(oldList, newList, meta, crn.(string), "", UserTagType)
+			err := flex.UpdateGlobalTagsUsingCRN(oldList, newList, meta, string(pvm.Crn), "", UserTagType)
 			if err != nil {
 				log.Printf("Error on update of pi instance (%s) pi_user_tags: %s", instanceID, err)
 			}
 		}
 	}
 
-	if d.HasChange(Arg_VirtualSerialNumber) {
+	if d.HasChange(Arg_VirtualSerialNumber) && first {
 		vsnClient := instance.NewIBMPIVSNClient(ctx, sess, cloudInstanceID)
 
 		if d.HasChange(Arg_VirtualSerialNumber + ".0." + Attr_Serial) {
 			instanceRestart := false
-			status := d.Get(Attr_Status).(string)
 			if strings.ToLower(status) != State_Shutoff {
 				err := stopLparForResourceChange(ctx, client, instanceID, d)
 				if err != nil {
@@ -1083,7 +1247,7 @@ func resourceIBMPIInstanceUpdate(ctx context.Context, d *schema.ResourceData, me
 		}
 	}
 
-	return resourceIBMPIInstanceRead(ctx, d, meta)
+	return nil
 }
 
 func resourceIBMPIInstanceDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -1100,15 +1264,7 @@ func resourceIBMPIInstanceDelete(ctx context.Context, d *schema.ResourceData, me
 	cloudInstanceID := idArr[0]
 	client := instance.NewIBMPIInstanceClient(ctx, sess, cloudInstanceID)
 	for _, instanceID := range idArr[1:] {
-		retainVSNBool := d.Get(Arg_RetainVirtualSerialNumber).(bool)
-		if _, ok := d.GetOk(Arg_VirtualSerialNumber); ok && retainVSNBool {
-			body := &models.PVMInstanceDelete{
-				RetainVSN: &retainVSNBool,
-			}
-			err = client.DeleteWithBody(instanceID, body)
-		} else {
-			err = client.Delete(instanceID)
-		}
+		err = deletePIInstance(d, client, instanceID)
 		if err != nil {
 			return diag.FromErr(err)
 		}
@@ -1125,6 +1281,304 @@ func resourceIBMPIInstanceDelete(ctx context.Context, d *schema.ResourceData, me
 	return nil
 }
 
+// configurePIInstanceReplicant waits for a new replicant to be ready and applies the configuration
+// that is not part of the create request.
+func configurePIInstanceReplicant(ctx context.Context, d *schema.ResourceData, meta interface{}, client *instance.IBMPIInstanceClient, pvm *models.PVMInstance, timeout time.Duration) error {
+	instanceID := *pvm.PvmInstanceID
+
+	var instanceReadyStatus string
+	if r, ok := d.GetOk(Arg_HealthStatus); ok {
+		instanceReadyStatus = r.(string)
+	}
+	if dt, ok := d.GetOk(Arg_DeploymentType); ok && dt.(string) == DeploymentTypeVMNoStorage {
+		_, err := isWaitForPIInstanceShutoff(ctx, client, instanceID, instanceReadyStatus, timeout)
+		if err != nil {
+			return err
+		}
+	} else {
+		_, err := isWaitForPIInstanceAvailable(ctx, client, instanceID, instanceReadyStatus, timeout)
+		if err != nil {
+			return err
+		}
+	}
+
+	// If Storage Pool Affinity is given as false we need to update the vm instance.
+	// Default value is true which indicates that all volumes attached to the server
+	// must reside in the same storage pool.
+	storagePoolAffinity := d.Get(Arg_StoragePoolAffinity).(bool)
+	if !storagePoolAffinity {
+		body := &models.PVMInstanceUpdate{
+			StoragePoolAffinity: &storagePoolAffinity,
+		}
+		// This is a synchronous process hence no need to check for health status
+		_, err := client.Update(instanceID, body)
+		if err != nil {
+			return err
+		}
+	}
+
+	// If user tags are set, make sure tags are set correctly before moving on
+	if tags, ok := d.GetOk(Arg_UserTags); ok && pvm.Crn != "" {
+		err := flex.UpdateGlobalTagsUsingCRN(nil, tags, meta, string(pvm.Crn), "", UserTagType)
+		if err != nil {
+			log.Printf("Error on update of pi instance (%s) pi_user_tags during creation: %s", instanceID, err)
+		}
+	}
+
+	// If virtual optical device provided then update cloud initialization
+	if vod, ok := d.GetOk(Arg_VirtualOpticalDevice); ok {
+		body := &models.PVMInstanceUpdate{
+			CloudInitialization: &models.CloudInitialization{
+				VirtualOpticalDevice: vod.(string),
+			},
+		}
+		_, err := client.Update(instanceID, body)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// createPIInstanceReplicant creates a single replicant with the configuration of the instance. The
+// replication policy is applied to the storage of the replicant against the peers, which are the other
+// replicants of the instance.
+func createPIInstanceReplicant(ctx context.Context, d *schema.ResourceData, meta interface{}, sess *ibmpisession.IBMPISession, cloudInstanceID, name string, first bool, peers []string) (*models.PVMInstance, error) {
+	client := instance.NewIBMPIInstanceClient(ctx, sess, cloudInstanceID)
+
+	var pvmList *models.PVMInstanceList
+	var err error
+	if _, ok := d.GetOk(Arg_SAPProfileID); ok {
+		sapClient := instance.NewIBMPISAPInstanceClient(ctx, sess, cloudInstanceID)
+		pvmList, err = createSAPInstance(d, sapClient, name, 1, peers)
+	} else {
+		imageClient := instance.NewIBMPIImageClient(ctx, sess, cloudInstanceID)
+		pvmList, err = createPVMInstance(d, client, imageClient, name, 1, first, peers)
+	}
+	if err != nil {
+		return nil, err
+	}
+	if len(*pvmList) == 0 {
+		return nil, fmt.Errorf("failed to provision the replicant %s", name)
+	}
+	pvm := (*pvmList)[0]
+	// The replicant exists once it is created, so it is returned with the error for its id to be kept
+	err = configurePIInstanceReplicant(ctx, d, meta, client, pvm, d.Timeout(schema.TimeoutUpdate))
+	return pvm, err
+}
+
+// addPIInstanceReplicants creates replicants until the instance has the requested count. The new
+// replicants are named after the replication scheme with the first indexes that are not in use.
+func addPIInstanceReplicants(ctx context.Context, d *schema.ResourceData, meta interface{}, sess *ibmpisession.IBMPISession, client *instance.IBMPIInstanceClient, cloudInstanceID string, instanceIDs []string, replicants int) ([]string, error) {
+	name := d.Get(Arg_InstanceName).(string)
+	scheme := d.Get(Arg_ReplicationScheme).(string)
+
+	names := map[string]bool{}
+	for _, instanceID := range instanceIDs {
+		pvm, err := client.Get(instanceID)
+		if err != nil {
+			return instanceIDs, err
+		}
+		names[flex.StringValue(pvm.ServerName)] = true
+	}
+	for index := 1; len(instanceIDs) < replicants; index++ {
+		replicantName := piInstanceReplicantName(name, scheme, index)
+		if names[replicantName] {
+			continue
+		}
+		pvm, err := createPIInstanceReplicant(ctx, d, meta, sess, cloudInstanceID, replicantName, len(instanceIDs) == 0, instanceIDs)
+		if pvm != nil {
+			instanceIDs = append(instanceIDs, *pvm.PvmInstanceID)
+			d.SetId(piInstanceID(cloudInstanceID, instanceIDs))
+		}
+		if err != nil {
+			return instanceIDs, err
+		}
+	}
+	return instanceIDs, nil
+}
+
+// removePIInstanceReplicants deletes the last replicants until the instance has the requested count.
+func removePIInstanceReplicants(ctx context.Context, d *schema.ResourceData, client *instance.IBMPIInstanceClient, cloudInstanceID string, instanceIDs []string, replicants int) ([]string, error) {
+	for n := len(instanceIDs); n > replicants; n-- {
+		err := deletePIInstance(d, client, instanceIDs[n-1])
+		if err != nil {
+			return instanceIDs[:n], err
+		}
+		d.SetId(piInstanceID(cloudInstanceID, instanceIDs[:n-1]))
+	}
+	for _, instanceID := range instanceIDs[replicants:] {
+		_, err := isWaitForPIInstanceDeleted(ctx, client, instanceID, d.Timeout(schema.TimeoutUpdate))
+		if err != nil {
+			return instanceIDs[:replicants], err
+		}
+	}
+	return instanceIDs[:replicants], nil
+}
+
+// replacePIInstanceReplicants deletes and creates again the replicants whose value in
+// pi_replace_replicants was added or changed. The replicants keep their name and position.
+func replacePIInstanceReplicants(ctx context.Context, d *schema.ResourceData, meta interface{}, sess *ibmpisession.IBMPISession, client *instance.IBMPIInstanceClient, cloudInstanceID string, instanceIDs []string, created map[string]bool) ([]string, error) {
+	oldRaw, newRaw := d.GetChange(Arg_ReplaceReplicants)
+	oldTriggers := oldRaw.(map[string]interface{})
+	names := []string{}
+	for name, value := range newRaw.(map[string]interface{}) {
+		if oldValue, ok := oldTriggers[name]; !ok || oldValue != value {
+			names = append(names, name)
+		}
+	}
+	if len(names) == 0 {
+		return instanceIDs, nil
+	}
+	sort.Strings(names)
+
+	positions := map[string]int{}
+	for i, instanceID := range instanceIDs {
+		pvm, err := client.Get(instanceID)
+		if err != nil {
+			return instanceIDs, err
+		}
+		positions[flex.StringValue(pvm.ServerName)] = i
+	}
+	for _, name := range names {
+		i, ok := positions[name]
+		if !ok {
+			return instanceIDs, fmt.Errorf("failed to replace the replicant %s: the instance has no replicant with this name", name)
+		}
+		log.Printf("[DEBUG] replacing the replicant %s (%s)", name, instanceIDs[i])
+		err := deletePIInstance(d, client, instanceIDs[i])
+		if err != nil {
+			return instanceIDs, err
+		}
+		_, err = isWaitForPIInstanceDeleted(ctx, client, instanceIDs[i], d.Timeout(schema.TimeoutUpdate))
+		if err != nil {
+			return instanceIDs, err
+		}
+		peers := append(append([]string{}, instanceIDs[:i]...), instanceIDs[i+1:]...)
+		pvm, err := createPIInstanceReplicant(ctx, d, meta, sess, cloudInstanceID, name, i == 0, peers)
+		if pvm != nil {
+			instanceIDs[i] = *pvm.PvmInstanceID
+			created[instanceIDs[i]] = true
+			d.SetId(piInstanceID(cloudInstanceID, instanceIDs))
+		}
+		if err != nil {
+			return instanceIDs, err
+		}
+	}
+	return instanceIDs, nil
+}
+
+// deletePIInstance deletes a replicant of the instance without waiting for it to be deleted.
+func deletePIInstance(d *schema.ResourceData, client *instance.IBMPIInstanceClient, instanceID string) error {
+	retainVSNBool := d.Get(Arg_RetainVirtualSerialNumber).(bool)
+	if _, ok := d.GetOk(Arg_VirtualSerialNumber); ok && retainVSNBool {
+		body := &models.PVMInstanceDelete{
+			RetainVSN: &retainVSNBool,
+		}
+		return client.DeleteWithBody(instanceID, body)
+	}
+	return client.Delete(instanceID)
+}
+
+// piInstanceID returns the id of the instance, which is a combination of the cloud instance id and
+// the pvm instance ids of the replicants.
+func piInstanceID(cloudInstanceID string, instanceIDs []string) string {
+	return strings.Join(append([]string{cloudInstanceID}, instanceIDs...), "/")
+}
+
+// piInstanceReplicantName returns the name of the replicant at the index, following the
+// replication scheme.
+func piInstanceReplicantName(name, scheme string, index int) string {
+	if scheme == Prefix {
+		return fmt.Sprintf("%d-%s", index, name)
+	}
+	return fmt.Sprintf("%s-%d", name, index)
+}
+
+// piInstanceReplicantIndex returns the index of a replicant that is named after the replication
+// scheme.
+func piInstanceReplicantIndex(serverName, name, scheme string) (int, bool) {
+	var index string
+	var ok bool
+	if scheme == Prefix {
+		index, ok = strings.CutSuffix(serverName, "-"+name)
+	} else {
+		index, ok = strings.CutPrefix(serverName, name+"-")
+	}
+	if !ok {
+		return 0, false
+	}
+	i, err := strconv.Atoi(index)
+	if err != nil || i < 1 {
+		return 0, false
+	}
+	return i, true
+}
+
+// piInstanceReplicantBaseName returns the name of the instance without the index that the
+// replication scheme added to the name of the replicant.
+func piInstanceReplicantBaseName(d *schema.ResourceData, pvm *models.PVMInstance, multiple bool) string {
+	serverName := flex.StringValue(pvm.ServerName)
+	name := d.Get(Arg_InstanceName).(string)
+	scheme := d.Get(Arg_ReplicationScheme).(string)
+	if name != "" {
+		if _, ok := piInstanceReplicantIndex(serverName, name, scheme); ok || serverName == name {
+			return name
+		}
+	}
+	if !multiple {
+		return serverName
+	}
+	if scheme == Prefix {
+		if index, base, ok := strings.Cut(serverName, "-"); ok {
+			if _, err := strconv.Atoi(index); err == nil {
+				return base
+			}
+		}
+	} else if i := strings.LastIndex(serverName, "-"); i > 0 {
+		if _, err := strconv.Atoi(serverName[i+1:]); err == nil {
+			return serverName[:i]
+		}
+	}
+	return serverName
+}
+
+func flattenPIInstanceReplicants(replicants []*models.PVMInstance) []map[string]interface{} {
+	result := make([]map[string]interface{}, 0, len(replicants))
+	for _, pvm := range replicants {
+		networks := []map[string]interface{}{}
+		for _, n := range pvm.Networks {
+			if n != nil {
+				networks = append(networks, map[string]interface{}{
+					Attr_ExternalIP:  n.ExternalIP,
+					Attr_IPAddress:   n.IPAddress,
+					Attr_MacAddress:  n.MacAddress,
+					Attr_NetworkID:   n.NetworkID,
+					Attr_NetworkName: n.NetworkName,
+				})
+			}
+		}
+		replicant := map[string]interface{}{
+			Attr_InstanceID:    pvm.PvmInstanceID,
+			Attr_MaxMemory:     pvm.Maxmem,
+			Attr_MaxProcessors: pvm.Maxproc,
+			Attr_Memory:        pvm.Memory,
+			Attr_MinMemory:     pvm.Minmem,
+			Attr_MinProcessors: pvm.Minproc,
+			Attr_Name:          pvm.ServerName,
+			Attr_Networks:      networks,
+			Attr_Processors:    pvm.Processors,
+			Attr_ProcType:      pvm.ProcType,
+			Attr_Status:        pvm.Status,
+		}
+		if pvm.Health != nil {
+			replicant[Attr_HealthStatus] = pvm.Health.Status
+		}
+		result = append(result, replicant)
+	}
+	return result
+}
+
 func isWaitForPIInstanceDeleted(ctx context.Context, client *instance.IBMPIInstanceClient, id string, timeout time.Duration) (interface{}, error) {
 
 	log.Printf("Waiting for  (%s) to be deleted.", id)
@@ -1429,6 +1883,9 @@ func isPIInstanceRefreshFuncOff(client *instance.IBMPIInstanceClient, id string)
 }
 
 func stopLparForResourceChange(ctx context.Context, client *instance.IBMPIInstanceClient, id string, d *schema.ResourceData) error {
+	if !d.Get(Arg_AllowStoppingForUpdate).(bool) {
+		return fmt.Errorf("the update requires the pvm instance %s to be stopped, set %s to true to allow it", id, Arg_AllowStoppingForUpdate)
+	}
 	body := &models.PVMInstanceAction{
 		//Action: flex.PtrToString("stop"),
 		Action: flex.PtrToString(Action_ImmediateShutdown),
@@ -1458,6 +1915,87 @@ func startLparAfterResourceChange(ctx context.Context, client *instance.IBMPIIns
 	return err
 }
 
+// piInstanceResizeRequiresRestart returns whether the memory and the processors are outside of the
+// range in which the lpar can be resized while it is running (DLPAR).
+func piInstanceResizeRequiresRestart(mem, procs, minMem, maxMem, minProcs, maxProcs float64) bool {
+	return mem > maxMem || procs > maxProcs || mem < minMem || procs < minProcs
+}
+
+// piInstanceReplicantsResizeRequiresRestart returns whether the memory and the processors are
+// outside of the DLPAR range of any replicant that is not stopped.
+func piInstanceReplicantsResizeRequiresRestart(replicants []interface{}, mem, procs float64) bool {
+	for _, r := range replicants {
+		replicant := r.(map[string]interface{})
+		if strings.ToLower(replicant[Attr_Status].(string)) == State_Shutoff {
+			continue
+		}
+		if piInstanceResizeRequiresRestart(mem, procs, replicant[Attr_MinMemory].(float64), replicant[Attr_MaxMemory].(float64), replicant[Attr_MinProcessors].(float64), replicant[Attr_MaxProcessors].(float64)) {
+			return true
+		}
+	}
+	return false
+}
+
+// resourceIBMPIInstanceResizeCustomizeDiff plans whether the update of the instance resources is
+// applied while the instance is running or requires it to be stopped, and fails the plan when the
+// instance is not allowed to be stopped.
+func resourceIBMPIInstanceResizeCustomizeDiff(diff *schema.ResourceDiff) error {
+	if diff.Id() == "" {
+		return nil
+	}
+	if !diff.HasChanges(Arg_Memory, Arg_Processors, Arg_ProcType, Arg_VirtualCoresAssigned, Arg_SAPProfileID, Arg_VirtualSerialNumber) {
+		return nil
+	}
+
+	restart := []string{}
+	if strings.ToLower(diff.Get(Attr_Status).(string)) != State_Shutoff {
+		if diff.HasChange(Arg_ProcType) {
+			restart = append(restart, Arg_ProcType)
+		}
+		if diff.HasChange(Arg_SAPProfileID) {
+			restart = append(restart, Arg_SAPProfileID)
+		}
+		if diff.HasChange(Arg_VirtualSerialNumber + ".0." + Attr_Serial) {
+			restart = append(restart, Arg_VirtualSerialNumber)
+		}
+	}
+	resizeKnown := true
+	if diff.HasChanges(Arg_Memory, Arg_Processors) {
+		if !diff.NewValueKnown(Arg_Memory) || !diff.NewValueKnown(Arg_Processors) {
+			// The range cannot be checked yet, the update checks it again before stopping the instance
+			resizeKnown = false
+		} else {
+			replicants := diff.Get(Attr_Replicants).([]interface{})
+			if len(replicants) == 0 {
+				replicants = []interface{}{map[string]interface{}{
+					Attr_MaxMemory:     diff.Get(Attr_MaxMemory),
+					Attr_MaxProcessors: diff.Get(Attr_MaxProcessors),
+					Attr_MinMemory:     diff.Get(Attr_MinMemory),
+					Attr_MinProcessors: diff.Get(Attr_MinProcessors),
+					Attr_Status:        diff.Get(Attr_Status),
+				}}
+			}
+			if piInstanceReplicantsResizeRequiresRestart(replicants, diff.Get(Arg_Memory).(float64), diff.Get(Arg_Processors).(float64)) {
+				restart = append(restart, Arg_Memory+"/"+Arg_Processors)
+			}
+		}
+	}
+
+	if len(restart) > 0 {
+		if !diff.Get(Arg_AllowStoppingForUpdate).(bool) {
+			return fmt.Errorf("the update of %s requires the instance to be stopped, set %s to true to allow it", strings.Join(restart, ", "), Arg_AllowStoppingForUpdate)
+		}
+		return diff.SetNew(Attr_ResizeMethod, Restart)
+	}
+	if !resizeKnown {
+		return diff.SetNewComputed(Attr_ResizeMethod)
+	}
+	if diff.HasChanges(Arg_Memory, Arg_Processors, Arg_VirtualCoresAssigned) {
+		return diff.SetNew(Attr_ResizeMethod, DLPAR)
+	}
+	return nil
+}
+
 // Stop / Modify / Start only when the lpar is off limits
 func performChangeAndReboot(ctx context.Context, client *instance.IBMPIInstanceClient, d *schema.ResourceData, id string, mem, procs float64) error {
 	/*
@@ -1558,17 +2096,12 @@ func checkCloudInstanceCapability(cloudInstance *models.CloudInstance, custom_ca
 	return false
 }
 
-func createSAPInstance(d *schema.ResourceData, sapClient *instance.IBMPISAPInstanceClient) (*models.PVMInstanceList, error) {
-	name := d.Get(Arg_InstanceName).(string)
+func createSAPInstance(d *schema.ResourceData, sapClient *instance.IBMPISAPInstanceClient, name string, replicants int64, peers []string) (*models.PVMInstanceList, error) {
 	profileID := d.Get(Arg_SAPProfileID).(string)
 	imageid := d.Get(Arg_ImageID).(string)
 
 	pvmNetworks := expandPVMNetworks(d.Get(Arg_Network).([]interface{}))
 
-	var replicants int64
-	if r, ok := d.GetOk(Arg_Replicants); ok {
-		replicants = int64(r.(int))
-	}
 	var replicationpolicy string
 	if r, ok := d.GetOk(Arg_ReplicationPolicy); ok {
 		replicationpolicy = r.(string)
@@ -1640,33 +2173,11 @@ func createSAPInstance(d *schema.ResourceData, sapClient *instance.IBMPISAPInsta
 		body.StoragePool = sp.(string)
 	}
 
-	if ap, ok := d.GetOk(Arg_AffinityPolicy); ok {
-		policy := ap.(string)
-		affinity := &models.StorageAffinity{
-			AffinityPolicy: &policy,
-		}
-
-		if policy == Affinity {
-			if av, ok := d.GetOk(Arg_AffinityVolume); ok {
-				afvol := av.(string)
-				affinity.AffinityVolume = &afvol
-			}
-			if ai, ok := d.GetOk(Arg_AffinityInstance); ok {
-				afins := ai.(string)
-				affinity.AffinityPVMInstance = &afins
-			}
-		} else {
-			if avs, ok := d.GetOk(Arg_AntiAffinityVolumes); ok {
-				afvols := flex.ExpandStringList(avs.([]interface{}))
-				affinity.AntiAffinityVolumes = afvols
-			}
-			if ais, ok := d.GetOk(Arg_AntiAffinityInstances); ok {
-				afinss := flex.ExpandStringList(ais.([]interface{}))
-				affinity.AntiAffinityPVMInstances = afinss
-			}
-		}
-		body.StorageAffinity = affinity
+	storageAffinity, err := expandPIInstanceStorageAffinity(d, peers)
+	if err != nil {
+		return nil, err
 	}
+	body.StorageAffinity = storageAffinity
 
 	if pg, ok := d.GetOk(Arg_PlacementGroupID); ok {
 		body.PlacementGroup = pg.(string)
@@ -1688,9 +2199,9 @@ func createSAPInstance(d *schema.ResourceData, sapClient *instance.IBMPISAPInsta
 	return pvmList, nil
 }
 
-func createPVMInstance(d *schema.ResourceData, client *instance.IBMPIInstanceClient, imageClient *instance.IBMPIImageClient) (*models.PVMInstanceList, error) {
-
-	name := d.Get(Arg_InstanceName).(string)
+// createPVMInstance creates the replicants of the instance. The virtual serial number is only
+// assigned when attachVSN is set, as it cannot be shared between instances.
+func createPVMInstance(d *schema.ResourceData, client *instance.IBMPIInstanceClient, imageClient *instance.IBMPIImageClient, name string, replicants float64, attachVSN bool, peers []string) (*models.PVMInstanceList, error) {
 	imageid := d.Get(Arg_ImageID).(string)
 
 	var mem, procs float64
@@ -1722,10 +2233,6 @@ func createPVMInstance(d *schema.ResourceData, client *instance.IBMPIInstanceCli
 	if v, ok := d.GetOk(Arg_VolumeIDs); ok {
 		volids = flex.ExpandStringList((v.(*schema.Set)).List())
 	}
-	var replicants float64
-	if r, ok := d.GetOk(Arg_Replicants); ok {
-		replicants = float64(r.(int))
-	}
 	var replicationpolicy string
 	if r, ok := d.GetOk(Arg_ReplicationPolicy); ok {
 		replicationpolicy = r.(string)
@@ -1788,33 +2295,11 @@ func createPVMInstance(d *schema.ResourceData, client *instance.IBMPIInstanceCli
 		body.DeploymentType = dt.(string)
 	}
 
-	if ap, ok := d.GetOk(Arg_AffinityPolicy); ok {
-		policy := ap.(string)
-		affinity := &models.StorageAffinity{
-			AffinityPolicy: &policy,
-		}
-
-		if policy == Affinity {
-			if av, ok := d.GetOk(Arg_AffinityVolume); ok {
-				afvol := av.(string)
-				affinity.AffinityVolume = &afvol
-			}
-			if ai, ok := d.GetOk(Arg_AffinityInstance); ok {
-				afins := ai.(string)
-				affinity.AffinityPVMInstance = &afins
-			}
-		} else {
-			if avs, ok := d.GetOk(Arg_AntiAffinityVolumes); ok {
-				afvols := flex.ExpandStringList(avs.([]interface{}))
-				affinity.AntiAffinityVolumes = afvols
-			}
-			if ais, ok := d.GetOk(Arg_AntiAffinityInstances); ok {
-				afinss := flex.ExpandStringList(ais.([]interface{}))
-				affinity.AntiAffinityPVMInstances = afinss
-			}
-		}
-		body.StorageAffinity = affinity
+	storageAffinity, err := expandPIInstanceStorageAffinity(d, peers)
+	if err != nil {
+		return nil, err
 	}
+	body.StorageAffinity = storageAffinity
 
 	if sc, ok := d.GetOk(Arg_StorageConnection); ok {
 		body.StorageConnection = sc.(string)
@@ -1889,7 +2374,7 @@ func createPVMInstance(d *schema.ResourceData, client *instance.IBMPIInstanceCli
 	if tags, ok := d.GetOk(Arg_UserTags); ok {
 		body.UserTags = flex.FlattenSet(tags.(*schema.Set))
 	}
-	if vsn, ok := d.GetOk(Arg_VirtualSerialNumber); ok {
+	if vsn, ok := d.GetOk(Arg_VirtualSerialNumber); ok && attachVSN {
 		vsnListType := vsn.([]interface{})
 		vsnCreateModel := vsnSetToCreateModel(vsnListType)
 		body.VirtualSerialNumber = vsnCreateModel
@@ -1907,6 +2392,62 @@ func createPVMInstance(d *schema.ResourceData, client *instance.IBMPIInstanceCli
 	return pvmList, nil
 }
 
+// expandPIInstanceStorageAffinity returns the storage affinity of a new instance. The replication
+// policy is not applied by the API to an instance that is created alone, so for a replicant that
+// is added to the instance the other replicants are set as the affinity instance or as the
+// anti-affinity instances, unless the affinity arguments already target something else. The
+// storage affinity only places the volumes of the replicant, not the replicant on a host.
+func expandPIInstanceStorageAffinity(d *schema.ResourceData, peers []string) (*models.StorageAffinity, error) {
+	var affinity *models.StorageAffinity
+	if ap, ok := d.GetOk(Arg_AffinityPolicy); ok {
+		policy := ap.(string)
+		affinity = &models.StorageAffinity{
+			AffinityPolicy: &policy,
+		}
+
+		if policy == Affinity {
+			if av, ok := d.GetOk(Arg_AffinityVolume); ok {
+				afvol := av.(string)
+				affinity.AffinityVolume = &afvol
+			}
+			if ai, ok := d.GetOk(Arg_AffinityInstance); ok {
+				afins := ai.(string)
+				affinity.AffinityPVMInstance = &afins
+			}
+		} else {
+			if avs, ok := d.GetOk(Arg_AntiAffinityVolumes); ok {
+				afvols := flex.ExpandStringList(avs.([]interface{}))
+				affinity.AntiAffinityVolumes = afvols
+			}
+			if ais, ok := d.GetOk(Arg_AntiAffinityInstances); ok {
+				afinss := flex.ExpandStringList(ais.([]interface{}))
+				affinity.AntiAffinityPVMInstances = afinss
+			}
+		}
+	}
+
+	replicationPolicy := d.Get(Arg_ReplicationPolicy).(string)
+	if len(peers) == 0 || (replicationPolicy != Affinity && replicationPolicy != AntiAffinity) {
+		return affinity, nil
+	}
+	if affinity == nil {
+		affinity = &models.StorageAffinity{
+			AffinityPolicy: &replicationPolicy,
+		}
+	}
+	if *affinity.AffinityPolicy != replicationPolicy {
+		return nil, fmt.Errorf("%s %s conflicts with %s %s, the replication policy cannot be applied to the new replicant", Arg_AffinityPolicy, *affinity.AffinityPolicy, Arg_ReplicationPolicy, replicationPolicy)
+	}
+	if replicationPolicy == Affinity {
+		if affinity.AffinityVolume == nil && affinity.AffinityPVMInstance == nil {
+			affinity.AffinityPVMInstance = &peers[0]
+		}
+	} else if len(affinity.AntiAffinityVolumes) == 0 {
+		affinity.AntiAffinityPVMInstances = append(affinity.AntiAffinityPVMInstances, peers...)
+	}
+	return affinity, nil
+}
+
 func expandDeploymentTarget(dt []interface{}) *models.DeploymentTarget {
 	dtexpanded := &models.DeploymentTarget{}
 	for _, v := range dt {
@@ -0,0 +1,173 @@
+// Copyright IBM Corp. 2025 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package power
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/IBM-Cloud/power-go-client/power/models"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestExpandPIInstanceStorageAffinity(t *testing.T) {
+	affinity := Affinity
+	antiAffinity := AntiAffinity
+	peer := "peer-1"
+	volume := "volume-1"
+
+	tests := []struct {
+		name    string
+		config  map[string]interface{}
+		peers   []string
+		want    *models.StorageAffinity
+		wantErr bool
+	}{
+		{
+			name:   "no affinity",
+			config: map[string]interface{}{},
+			peers:  []string{"peer-1"},
+			want:   nil,
+		},
+		{
+			name:   "replication policy without peers",
+			config: map[string]interface{}{Arg_ReplicationPolicy: Affinity},
+			want:   nil,
+		},
+		{
+			name:   "replication policy none",
+			config: map[string]interface{}{Arg_ReplicationPolicy: None},
+			peers:  []string{"peer-1"},
+			want:   nil,
+		},
+		{
+			name:   "affinity with peers",
+			config: map[string]interface{}{Arg_ReplicationPolicy: Affinity},
+			peers:  []string{"peer-1", "peer-2"},
+			want:   &models.StorageAffinity{AffinityPolicy: &affinity, AffinityPVMInstance: &peer},
+		},
+		{
+			name:   "anti-affinity with peers",
+			config: map[string]interface{}{Arg_ReplicationPolicy: AntiAffinity},
+			peers:  []string{"peer-1", "peer-2"},
+			want:   &models.StorageAffinity{AffinityPolicy: &antiAffinity, AntiAffinityPVMInstances: []string{"peer-1", "peer-2"}},
+		},
+		{
+			name: "anti-affinity with peers and instances",
+			config: map[string]interface{}{
+				Arg_ReplicationPolicy:     AntiAffinity,
+				Arg_AffinityPolicy:        AntiAffinity,
+				Arg_AntiAffinityInstances: []interface{}{"other"},
+			},
+			peers: []string{"peer-1"},
+			want:  &models.StorageAffinity{AffinityPolicy: &antiAffinity, AntiAffinityPVMInstances: []string{"other", "peer-1"}},
+		},
+		{
+			name: "affinity volume is kept",
+			config: map[string]interface{}{
+				Arg_ReplicationPolicy: Affinity,
+				Arg_AffinityPolicy:    Affinity,
+				Arg_AffinityVolume:    "volume-1",
+			},
+			peers: []string{"peer-1"},
+			want:  &models.StorageAffinity{AffinityPolicy: &affinity, AffinityVolume: &volume},
+		},
+		{
+			name: "conflicting affinity policy",
+			config: map[string]interface{}{
+				Arg_ReplicationPolicy: AntiAffinity,
+				Arg_AffinityPolicy:    Affinity,
+			},
+			peers:   []string{"peer-1"},
+			wantErr: true,
+		},
+		{
+			name: "conflicting affinity policy without peers",
+			config: map[string]interface{}{
+				Arg_ReplicationPolicy: AntiAffinity,
+				Arg_AffinityPolicy:    Affinity,
+			},
+			want: &models.StorageAffinity{AffinityPolicy: &affinity},
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			d := schema.TestResourceDataRaw(t, ResourceIBMPIInstance().Schema, tc.config)
+			got, err := expandPIInstanceStorageAffinity(d, tc.peers)
+			if (err != nil) != tc.wantErr {
+				t.Fatalf("expandPIInstanceStorageAffinity() error = %v, wantErr %v", err, tc.wantErr)
+			}
+			if !reflect.DeepEqual(got, tc.want) {
+				t.Errorf("expandPIInstanceStorageAffinity() = %+v, want %+v", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestPIInstanceResizeRequiresRestart(t *testing.T) {
+	tests := []struct {
+		name  string
+		mem   float64
+		procs float64
+		want  bool
+	}{
+		{name: "within the range", mem: 4, procs: 1, want: false},
+		{name: "at the minimum", mem: 2, procs: 0.25, want: false},
+		{name: "at the maximum", mem: 8, procs: 2, want: false},
+		{name: "memory above the maximum", mem: 16, procs: 1, want: true},
+		{name: "memory below the minimum", mem: 1, procs: 1, want: true},
+		{name: "processors above the maximum", mem: 4, procs: 3, want: true},
+		{name: "processors below the minimum", mem: 4, procs: 0.125, want: true},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			got := piInstanceResizeRequiresRestart(tc.mem, tc.procs, 2, 8, 0.25, 2)
+			if got != tc.want {
+				t.Errorf("piInstanceResizeRequiresRestart(%v, %v) = %v, want %v", tc.mem, tc.procs, got, tc.want)
+			}
+		})
+	}
+}
+
+func testPIInstanceReplicant(status string, maxMem, maxProcs float64) map[string]interface{} {
+	return map[string]interface{}{
+		Attr_MaxMemory:     maxMem,
+		Attr_MaxProcessors: maxProcs,
+		Attr_MinMemory:     2.0,
+		Attr_MinProcessors: 0.25,
+		Attr_Status:        status,
+	}
+}
+
+func TestPIInstanceReplicantsResizeRequiresRestart(t *testing.T) {
+	tests := []struct {
+		name       string
+		replicants []interface{}
+		want       bool
+	}{
+		{
+			name:       "all replicants within the range",
+			replicants: []interface{}{testPIInstanceReplicant("ACTIVE", 8, 2), testPIInstanceReplicant("ACTIVE", 8, 2)},
+			want:       false,
+		},
+		{
+			name:       "second replicant out of the range",
+			replicants: []interface{}{testPIInstanceReplicant("ACTIVE", 8, 2), testPIInstanceReplicant("ACTIVE", 2, 2)},
+			want:       true,
+		},
+		{
+			name:       "stopped replicant out of the range",
+			replicants: []interface{}{testPIInstanceReplicant("ACTIVE", 8, 2), testPIInstanceReplicant("SHUTOFF", 2, 2)},
+			want:       false,
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			got := piInstanceReplicantsResizeRequiresRestart(tc.replicants, 4, 1)
+			if got != tc.want {
+				t.Errorf("piInstanceReplicantsResizeRequiresRestart() = %v, want %v", got, tc.want)
+			}
+		})
+	}
+}
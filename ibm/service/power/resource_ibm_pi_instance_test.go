@@ -7,6 +7,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"regexp"
 	"strings"
 	"testing"
 	"time"
@@ -365,20 +366,42 @@ func TestAccIBMPIInstanceReplicant(t *testing.T) {
 		CheckDestroy: testAccCheckIBMPIInstanceDestroy,
 		Steps: []resource.TestStep{
 			{
-				Config: testAccCheckIBMPIInstanceReplicantConfig(name),
+				Config: testAccCheckIBMPIInstanceReplicantConfig(name, 3, ""),
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckIBMPIInstanceExists(instanceRes),
 					resource.TestCheckResourceAttr(instanceRes, "pi_replicants", "3"),
 					resource.TestCheckResourceAttr(instanceRes, "pi_replication_policy", power.Affinity),
 					resource.TestCheckResourceAttr(instanceRes, "pi_replication_scheme", "suffix"),
+					resource.TestCheckResourceAttr(instanceRes, "replicants.#", "3"),
+				),
+			},
+			{
+				Config: testAccCheckIBMPIInstanceReplicantConfig(name, 2, ""),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIBMPIInstanceExists(instanceRes),
+					resource.TestCheckResourceAttr(instanceRes, "pi_replicants", "2"),
+					resource.TestCheckResourceAttr(instanceRes, "replicants.#", "2"),
+					resource.TestCheckResourceAttr(instanceRes, "replicants.1.name", name+"-2"),
+				),
+			},
+			{
+				Config: testAccCheckIBMPIInstanceReplicantConfig(name, 2, name+"-2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIBMPIInstanceExists(instanceRes),
+					resource.TestCheckResourceAttr(instanceRes, "replicants.#", "2"),
+					resource.TestCheckResourceAttr(instanceRes, "replicants.1.name", name+"-2"),
+					resource.TestCheckResourceAttrSet(instanceRes, "replicants.1.instance_id"),
 				),
-				ExpectNonEmptyPlan: true,
 			},
 		},
 	})
 }
 
-func testAccCheckIBMPIInstanceReplicantConfig(name string) string {
+func testAccCheckIBMPIInstanceReplicantConfig(name string, replicants int, replace string) string {
+	replaceReplicants := ""
+	if replace != "" {
+		replaceReplicants = fmt.Sprintf(`pi_replace_replicants = { "%s" = "1" }`, replace)
+	}
 	return fmt.Sprintf(`
 	resource "ibm_pi_volume" "power_volume" {
 		pi_cloud_instance_id = "%[1]s"
@@ -394,7 +417,7 @@ func testAccCheckIBMPIInstanceReplicantConfig(name string) string {
 		pi_memory            = "2"
 		pi_proc_type         = "shared"
 		pi_processors        = "1"
-		pi_replicants         = 3
+		pi_replicants         = %[5]d
 		pi_replication_policy = "affinity"
 		pi_replication_scheme = "suffix"
 		pi_sys_type          = "s922"
@@ -402,8 +425,9 @@ func testAccCheckIBMPIInstanceReplicantConfig(name string) string {
 		pi_network {
 			network_id = "%[4]s"
 		  }
+		%[6]s
 	  }
-	`, acc.Pi_cloud_instance_id, name, acc.Pi_image, acc.Pi_network_name)
+	`, acc.Pi_cloud_instance_id, name, acc.Pi_image, acc.Pi_network_name, replicants, replaceReplicants)
 }
 
 func TestAccIBMPIInstanceNetwork(t *testing.T) {
@@ -669,6 +693,69 @@ func TestAccIBMPIInstanceUpdateActiveState(t *testing.T) {
 	})
 }
 
+func TestAccIBMPIInstanceUpdateWithoutStopping(t *testing.T) {
+	instanceRes := "ibm_pi_instance.power_instance"
+	name := fmt.Sprintf("tf-pi-instance-%d", acctest.RandIntRange(10, 100))
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acc.TestAccPreCheck(t) },
+		Providers:    acc.TestAccProviders,
+		CheckDestroy: testAccCheckIBMPIInstanceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMPIInstanceWithoutStoppingConfig(name, "shared", "0.25"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIBMPIInstanceExists(instanceRes),
+					resource.TestCheckResourceAttr(instanceRes, "pi_allow_stopping_for_update", "false"),
+					resource.TestCheckResourceAttr(instanceRes, "resize_method", power.None),
+				),
+			},
+			{
+				// 0.5 is within the DLPAR range of processors of a 0.25 shared instance
+				Config: testAccCheckIBMPIInstanceWithoutStoppingConfig(name, "shared", "0.5"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIBMPIInstanceExists(instanceRes),
+					resource.TestCheckResourceAttr(instanceRes, "pi_processors", "0.5"),
+					resource.TestCheckResourceAttr(instanceRes, "resize_method", power.DLPAR),
+					resource.TestCheckResourceAttr(instanceRes, "status", "ACTIVE"),
+				),
+			},
+			{
+				Config:      testAccCheckIBMPIInstanceWithoutStoppingConfig(name, "capped", "0.5"),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("requires the instance to be stopped"),
+			},
+		},
+	})
+}
+
+func testAccCheckIBMPIInstanceWithoutStoppingConfig(name, procType, processors string) string {
+	return fmt.Sprintf(`
+	data "ibm_pi_image" "power_image" {
+		pi_cloud_instance_id = "%[1]s"
+		pi_image_name        = "%[3]s"
+	}
+	data "ibm_pi_network" "power_networks" {
+		pi_cloud_instance_id = "%[1]s"
+		pi_network_name      = "%[4]s"
+	}
+	resource "ibm_pi_instance" "power_instance" {
+		pi_allow_stopping_for_update = false
+		pi_cloud_instance_id         = "%[1]s"
+		pi_health_status             = "OK"
+		pi_image_id                  = data.ibm_pi_image.power_image.id
+		pi_instance_name             = "%[2]s"
+		pi_memory                    = "2"
+		pi_proc_type                 = "%[5]s"
+		pi_processors                = "%[6]s"
+		pi_storage_pool              = data.ibm_pi_image.power_image.storage_pool
+		pi_sys_type                  = "s922"
+		pi_network {
+			network_id = data.ibm_pi_network.power_networks.id
+		}
+	}
+	`, acc.Pi_cloud_instance_id, name, acc.Pi_image, acc.Pi_network_name, procType, processors)
+}
+
 func TestAccIBMPIInstanceUpdateStoppedState(t *testing.T) {
 	instanceRes := "ibm_pi_instance.power_instance"
 	name := fmt.Sprintf("tf-pi-instance-%d", acctest.RandIntRange(10, 100))
@@ -35,7 +35,7 @@ func ResourceIBMPINetworkAddressGroup() *schema.Resource {
 		},
 		CustomizeDiff: customdiff.Sequence(
 			func(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
-				return flex.ResourcePowerUserTagsCustomizeDiff(diff)
+				return flex.ResourcePowerUserTagsCustomizeDiff(diff, v)
 			},
 		),
 		Schema: map[string]*schema.Schema{
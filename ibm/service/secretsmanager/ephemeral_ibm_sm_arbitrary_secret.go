@@ -0,0 +1,71 @@
+// Copyright IBM Corp. 2025 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package secretsmanager
+
+import (
+	"context"
+
+	"github.com/IBM/secrets-manager-go-sdk/v2/secretsmanagerv2"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ ephemeral.EphemeralResourceWithConfigure = &ephemeralIbmSmArbitrarySecret{}
+
+type ephemeralIbmSmArbitrarySecret struct {
+	ephemeralSecretResource
+}
+
+type ephemeralIbmSmArbitrarySecretModel struct {
+	ephemeralSecretArgs
+	ExpirationDate types.String `tfsdk:"expiration_date"`
+	Payload        types.String `tfsdk:"payload"`
+}
+
+func EphemeralIbmSmArbitrarySecret() ephemeral.EphemeralResource {
+	return &ephemeralIbmSmArbitrarySecret{}
+}
+
+func (r *ephemeralIbmSmArbitrarySecret) Metadata(ctx context.Context, req ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse) {
+	resp.TypeName = ArbitrarySecretResourceName
+}
+
+func (r *ephemeralIbmSmArbitrarySecret) Schema(ctx context.Context, req ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Fetches the payload of an arbitrary secret without storing it in the plan or state.",
+		Attributes: addEphemeralSecretAttributes(map[string]schema.Attribute{
+			"expiration_date": schema.StringAttribute{
+				Computed:    true,
+				Description: "The date a secret is expired. The date format follows RFC 3339.",
+			},
+			"payload": schema.StringAttribute{
+				Computed:    true,
+				Sensitive:   true,
+				Description: "The arbitrary secret's data payload.",
+			},
+		}),
+	}
+}
+
+func (r *ephemeralIbmSmArbitrarySecret) Open(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+	var model ephemeralIbmSmArbitrarySecretModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &model)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	secret, tfErr := r.getSecret(ctx, &model.ephemeralSecretArgs, ArbitrarySecretType, ArbitrarySecretResourceName)
+	if tfErr != nil {
+		resp.Diagnostics.Append(tfErr.GetFrameworkDiag()...)
+		return
+	}
+
+	arbitrarySecret := secret.(*secretsmanagerv2.ArbitrarySecret)
+	model.setSecretMetadata(arbitrarySecret.ID, arbitrarySecret.Name, arbitrarySecret.SecretGroupID, arbitrarySecret.Crn)
+	model.ExpirationDate = ephemeralDateTime(arbitrarySecret.ExpirationDate)
+	model.Payload = types.StringPointerValue(arbitrarySecret.Payload)
+
+	resp.Diagnostics.Append(resp.Result.Set(ctx, &model)...)
+}
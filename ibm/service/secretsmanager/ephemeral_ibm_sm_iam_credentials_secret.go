@@ -0,0 +1,83 @@
+// Copyright IBM Corp. 2025 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package secretsmanager
+
+import (
+	"context"
+
+	"github.com/IBM/secrets-manager-go-sdk/v2/secretsmanagerv2"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ ephemeral.EphemeralResourceWithConfigure = &ephemeralIbmSmIamCredentialsSecret{}
+
+type ephemeralIbmSmIamCredentialsSecret struct {
+	ephemeralSecretResource
+}
+
+type ephemeralIbmSmIamCredentialsSecretModel struct {
+	ephemeralSecretArgs
+	ExpirationDate types.String `tfsdk:"expiration_date"`
+	ServiceID      types.String `tfsdk:"service_id"`
+	ApiKeyID       types.String `tfsdk:"api_key_id"`
+	ApiKey         types.String `tfsdk:"api_key"`
+}
+
+func EphemeralIbmSmIamCredentialsSecret() ephemeral.EphemeralResource {
+	return &ephemeralIbmSmIamCredentialsSecret{}
+}
+
+func (r *ephemeralIbmSmIamCredentialsSecret) Metadata(ctx context.Context, req ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse) {
+	resp.TypeName = IAMCredentialsSecretResourceName
+}
+
+func (r *ephemeralIbmSmIamCredentialsSecret) Schema(ctx context.Context, req ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Fetches the API key of an IAM credentials secret without storing it in the plan or state.",
+		Attributes: addEphemeralSecretAttributes(map[string]schema.Attribute{
+			"expiration_date": schema.StringAttribute{
+				Computed:    true,
+				Description: "The date a secret is expired. The date format follows RFC 3339.",
+			},
+			"service_id": schema.StringAttribute{
+				Computed:    true,
+				Description: "The service ID under which the API key is created.",
+			},
+			"api_key_id": schema.StringAttribute{
+				Computed:    true,
+				Description: "The ID of the API key that is generated for this secret.",
+			},
+			"api_key": schema.StringAttribute{
+				Computed:    true,
+				Sensitive:   true,
+				Description: "The API key that is generated for this secret.",
+			},
+		}),
+	}
+}
+
+func (r *ephemeralIbmSmIamCredentialsSecret) Open(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+	var model ephemeralIbmSmIamCredentialsSecretModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &model)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	secret, tfErr := r.getSecret(ctx, &model.ephemeralSecretArgs, IAMCredentialsSecretType, IAMCredentialsSecretResourceName)
+	if tfErr != nil {
+		resp.Diagnostics.Append(tfErr.GetFrameworkDiag()...)
+		return
+	}
+
+	iAMCredentialsSecret := secret.(*secretsmanagerv2.IAMCredentialsSecret)
+	model.setSecretMetadata(iAMCredentialsSecret.ID, iAMCredentialsSecret.Name, iAMCredentialsSecret.SecretGroupID, iAMCredentialsSecret.Crn)
+	model.ExpirationDate = ephemeralDateTime(iAMCredentialsSecret.ExpirationDate)
+	model.ServiceID = types.StringPointerValue(iAMCredentialsSecret.ServiceID)
+	model.ApiKeyID = types.StringPointerValue(iAMCredentialsSecret.ApiKeyID)
+	model.ApiKey = types.StringPointerValue(iAMCredentialsSecret.ApiKey)
+
+	resp.Diagnostics.Append(resp.Result.Set(ctx, &model)...)
+}
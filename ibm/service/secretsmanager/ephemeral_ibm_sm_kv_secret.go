@@ -0,0 +1,69 @@
+// Copyright IBM Corp. 2025 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package secretsmanager
+
+import (
+	"context"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/IBM/secrets-manager-go-sdk/v2/secretsmanagerv2"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ ephemeral.EphemeralResourceWithConfigure = &ephemeralIbmSmKvSecret{}
+
+type ephemeralIbmSmKvSecret struct {
+	ephemeralSecretResource
+}
+
+type ephemeralIbmSmKvSecretModel struct {
+	ephemeralSecretArgs
+	Data map[string]string `tfsdk:"data"`
+}
+
+func EphemeralIbmSmKvSecret() ephemeral.EphemeralResource {
+	return &ephemeralIbmSmKvSecret{}
+}
+
+func (r *ephemeralIbmSmKvSecret) Metadata(ctx context.Context, req ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse) {
+	resp.TypeName = KvSecretResourceName
+}
+
+func (r *ephemeralIbmSmKvSecret) Schema(ctx context.Context, req ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Fetches the data of a key-value secret without storing it in the plan or state.",
+		Attributes: addEphemeralSecretAttributes(map[string]schema.Attribute{
+			"data": schema.MapAttribute{
+				ElementType: types.StringType,
+				Computed:    true,
+				Sensitive:   true,
+				Description: "The payload data of a key-value secret.",
+			},
+		}),
+	}
+}
+
+func (r *ephemeralIbmSmKvSecret) Open(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+	var model ephemeralIbmSmKvSecretModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &model)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	secret, tfErr := r.getSecret(ctx, &model.ephemeralSecretArgs, KvSecretType, KvSecretResourceName)
+	if tfErr != nil {
+		resp.Diagnostics.Append(tfErr.GetFrameworkDiag()...)
+		return
+	}
+
+	kVSecret := secret.(*secretsmanagerv2.KVSecret)
+	model.setSecretMetadata(kVSecret.ID, kVSecret.Name, kVSecret.SecretGroupID, kVSecret.Crn)
+	if kVSecret.Data != nil {
+		model.Data = flex.Flatten(kVSecret.Data)
+	}
+
+	resp.Diagnostics.Append(resp.Result.Set(ctx, &model)...)
+}
@@ -0,0 +1,131 @@
+// Copyright IBM Corp. 2025 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package secretsmanager
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/IBM/secrets-manager-go-sdk/v2/secretsmanagerv2"
+	"github.com/go-openapi/strfmt"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// ephemeralSecretArgs holds the arguments that locate a secret, which are
+// shared by the secret ephemeral resources.
+type ephemeralSecretArgs struct {
+	InstanceID      types.String `tfsdk:"instance_id"`
+	Region          types.String `tfsdk:"region"`
+	EndpointType    types.String `tfsdk:"endpoint_type"`
+	SecretID        types.String `tfsdk:"secret_id"`
+	Name            types.String `tfsdk:"name"`
+	SecretGroupName types.String `tfsdk:"secret_group_name"`
+	SecretGroupID   types.String `tfsdk:"secret_group_id"`
+	Crn             types.String `tfsdk:"crn"`
+}
+
+// ephemeralSecretResource fetches secret payloads without persisting them in
+// the plan or state. It is embedded by the ephemeral resource of each secret
+// type.
+type ephemeralSecretResource struct {
+	meta interface{}
+}
+
+func (r *ephemeralSecretResource) Configure(ctx context.Context, req ephemeral.ConfigureRequest, resp *ephemeral.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	r.meta = req.ProviderData
+}
+
+// Add the attributes shared by the secret ephemeral resources to the given schema
+func addEphemeralSecretAttributes(attributes map[string]schema.Attribute) map[string]schema.Attribute {
+	attributes["instance_id"] = schema.StringAttribute{
+		Required:    true,
+		Description: "The ID of the Secrets Manager instance.",
+	}
+	attributes["region"] = schema.StringAttribute{
+		Optional:    true,
+		Computed:    true,
+		Description: "The region of the Secrets Manager instance.",
+	}
+	attributes["endpoint_type"] = schema.StringAttribute{
+		Optional:    true,
+		Description: "public or private.",
+	}
+	attributes["secret_id"] = schema.StringAttribute{
+		Optional:    true,
+		Computed:    true,
+		Description: "The ID of the secret. Either secret_id or name and secret_group_name must be provided.",
+	}
+	attributes["name"] = schema.StringAttribute{
+		Optional:    true,
+		Computed:    true,
+		Description: "The human-readable name of your secret.",
+	}
+	attributes["secret_group_name"] = schema.StringAttribute{
+		Optional:    true,
+		Description: "The human-readable name of your secret group.",
+	}
+	attributes["secret_group_id"] = schema.StringAttribute{
+		Computed:    true,
+		Description: "A v4 UUID identifier, or `default` secret group.",
+	}
+	attributes["crn"] = schema.StringAttribute{
+		Computed:    true,
+		Description: "A CRN that uniquely identifies an IBM Cloud resource.",
+	}
+	return attributes
+}
+
+// getSecret gets the secret located by args and fills in the computed
+// arguments, so that each secret type only needs to set its payload.
+func (r *ephemeralSecretResource) getSecret(context context.Context, args *ephemeralSecretArgs, secretType string, resourceName string) (secretsmanagerv2.SecretIntf, *flex.TerraformProblem) {
+	resource := fmt.Sprintf("(Ephemeral) %s", resourceName)
+	if r.meta == nil {
+		return nil, flex.TerraformErrorf(nil, "The provider has not been configured", resource, "open")
+	}
+
+	secretsManagerClient, endpointsFile, err := getSecretsManagerSession(r.meta.(conns.ClientSession))
+	if err != nil {
+		return nil, flex.TerraformErrorf(err, "", resource, "open")
+	}
+
+	region := args.Region.ValueString()
+	if region == "" {
+		region = getRegionFromServiceURL(secretsManagerClient)
+	}
+	endpointType := args.EndpointType.ValueString()
+	if endpointType == "" {
+		endpointType = getEndpointTypeFromServiceURL(secretsManagerClient)
+	}
+	secretsManagerClient = getClientWithInstanceEndpoint(secretsManagerClient, args.InstanceID.ValueString(), region, endpointType, endpointsFile)
+
+	secretIntf, tfErr := getSecretWithClient(context, secretsManagerClient, args.SecretID.ValueString(), args.Name.ValueString(), args.SecretGroupName.ValueString(), secretType, resource, "open")
+	if tfErr != nil {
+		return nil, tfErr
+	}
+
+	args.Region = types.StringValue(region)
+	return secretIntf, nil
+}
+
+// setSecretMetadata sets the computed arguments that every secret type has.
+func (args *ephemeralSecretArgs) setSecretMetadata(id, name, secretGroupID, crn *string) {
+	args.SecretID = types.StringPointerValue(id)
+	args.Name = types.StringPointerValue(name)
+	args.SecretGroupID = types.StringPointerValue(secretGroupID)
+	args.Crn = types.StringPointerValue(crn)
+}
+
+func ephemeralDateTime(dt *strfmt.DateTime) types.String {
+	if dt == nil {
+		return types.StringNull()
+	}
+	return types.StringValue(DateTimeToRFC3339(dt))
+}
@@ -0,0 +1,77 @@
+// Copyright IBM Corp. 2025 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package secretsmanager
+
+import (
+	"context"
+
+	"github.com/IBM/secrets-manager-go-sdk/v2/secretsmanagerv2"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ ephemeral.EphemeralResourceWithConfigure = &ephemeralIbmSmUsernamePasswordSecret{}
+
+type ephemeralIbmSmUsernamePasswordSecret struct {
+	ephemeralSecretResource
+}
+
+type ephemeralIbmSmUsernamePasswordSecretModel struct {
+	ephemeralSecretArgs
+	ExpirationDate types.String `tfsdk:"expiration_date"`
+	Username       types.String `tfsdk:"username"`
+	Password       types.String `tfsdk:"password"`
+}
+
+func EphemeralIbmSmUsernamePasswordSecret() ephemeral.EphemeralResource {
+	return &ephemeralIbmSmUsernamePasswordSecret{}
+}
+
+func (r *ephemeralIbmSmUsernamePasswordSecret) Metadata(ctx context.Context, req ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse) {
+	resp.TypeName = UsernamePasswordSecretResourceName
+}
+
+func (r *ephemeralIbmSmUsernamePasswordSecret) Schema(ctx context.Context, req ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Fetches the credentials of a user credentials secret without storing them in the plan or state.",
+		Attributes: addEphemeralSecretAttributes(map[string]schema.Attribute{
+			"expiration_date": schema.StringAttribute{
+				Computed:    true,
+				Description: "The date a secret is expired. The date format follows RFC 3339.",
+			},
+			"username": schema.StringAttribute{
+				Computed:    true,
+				Description: "The username that is assigned to the secret.",
+			},
+			"password": schema.StringAttribute{
+				Computed:    true,
+				Sensitive:   true,
+				Description: "The password that is assigned to the secret.",
+			},
+		}),
+	}
+}
+
+func (r *ephemeralIbmSmUsernamePasswordSecret) Open(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+	var model ephemeralIbmSmUsernamePasswordSecretModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &model)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	secret, tfErr := r.getSecret(ctx, &model.ephemeralSecretArgs, UsernamePasswordSecretType, UsernamePasswordSecretResourceName)
+	if tfErr != nil {
+		resp.Diagnostics.Append(tfErr.GetFrameworkDiag()...)
+		return
+	}
+
+	usernamePasswordSecret := secret.(*secretsmanagerv2.UsernamePasswordSecret)
+	model.setSecretMetadata(usernamePasswordSecret.ID, usernamePasswordSecret.Name, usernamePasswordSecret.SecretGroupID, usernamePasswordSecret.Crn)
+	model.ExpirationDate = ephemeralDateTime(usernamePasswordSecret.ExpirationDate)
+	model.Username = types.StringPointerValue(usernamePasswordSecret.Username)
+	model.Password = types.StringPointerValue(usernamePasswordSecret.Password)
+
+	resp.Diagnostics.Append(resp.Result.Set(ctx, &model)...)
+}
@@ -0,0 +1,68 @@
+// Copyright IBM Corp. 2025 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package secretsmanager_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+)
+
+// Ephemeral resources need Terraform 1.10 or later and leave nothing in the
+// state, so the opened values are checked with postconditions.
+func TestAccIbmSmUsernamePasswordSecretEphemeralResourceBasic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acc.TestAccPreCheck(t) },
+		ProtoV5ProviderFactories: acc.TestAccProtoV5ProviderFactories(t),
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccCheckIbmSmUsernamePasswordSecretEphemeralResourceConfigBasic(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("ibm_sm_username_password_secret.sm_username_password_secret_instance", "secret_id"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIbmSmUsernamePasswordSecretEphemeralResourceConfigBasic() string {
+	return fmt.Sprintf(`
+		resource "ibm_sm_username_password_secret" "sm_username_password_secret_instance" {
+			name = "test_username_password_secret_ephemeral_terraform"
+			instance_id   = "%s"
+			region        = "%s"
+			username = "username"
+			password = "password"
+			secret_group_id = "default"
+		}
+
+		ephemeral "ibm_sm_username_password_secret" "sm_username_password_secret" {
+			instance_id   = "%s"
+			region = "%s"
+			secret_id = ibm_sm_username_password_secret.sm_username_password_secret_instance.secret_id
+			lifecycle {
+				postcondition {
+					condition     = self.username == "username" && self.password == "password"
+					error_message = "The credentials of the secret do not match."
+				}
+			}
+		}
+
+		ephemeral "ibm_sm_username_password_secret" "sm_username_password_secret_by_name" {
+			instance_id   = "%s"
+			region = "%s"
+			name = ibm_sm_username_password_secret.sm_username_password_secret_instance.name
+			secret_group_name = "default"
+			lifecycle {
+				postcondition {
+					condition     = self.username == "username" && self.password == "password"
+					error_message = "The credentials of the secret found by name do not match."
+				}
+			}
+		}
+	`, acc.SecretsManagerInstanceID, acc.SecretsManagerInstanceRegion, acc.SecretsManagerInstanceID, acc.SecretsManagerInstanceRegion, acc.SecretsManagerInstanceID, acc.SecretsManagerInstanceRegion)
+}
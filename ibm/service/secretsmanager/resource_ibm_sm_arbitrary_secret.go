@@ -16,6 +16,7 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
@@ -43,10 +44,27 @@ func ResourceIbmSmArbitrarySecret() *schema.Resource {
 				Description: "The secret type. Supported types are arbitrary, certificates (imported, public, and private), IAM credentials, key-value, and user credentials.",
 			},
 			"payload": &schema.Schema{
-				Type:        schema.TypeString,
-				Required:    true,
-				Sensitive:   true,
-				Description: "The arbitrary secret data payload.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				Sensitive:    true,
+				ExactlyOneOf: []string{"payload", "payload_wo"},
+				Description:  "The arbitrary secret data payload.",
+			},
+			"payload_wo": &schema.Schema{
+				Type:         schema.TypeString,
+				Optional:     true,
+				WriteOnly:    true,
+				Sensitive:    true,
+				ExactlyOneOf: []string{"payload", "payload_wo"},
+				RequiredWith: []string{"payload_wo_version"},
+				Description:  "The arbitrary secret data payload. The value is write-only and is not stored in the Terraform state.",
+			},
+			"payload_wo_version": &schema.Schema{
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+				RequiredWith: []string{"payload_wo"},
+				Description:  "The version of `payload_wo`. Increment this value to create a new secret version with the current `payload_wo`.",
 			},
 			"custom_metadata": &schema.Schema{
 				Type:        schema.TypeMap,
@@ -325,9 +343,12 @@ func resourceIbmSmArbitrarySecretRead(context context.Context, d *schema.Resourc
 		tfErr := flex.TerraformErrorf(err, fmt.Sprintf("Error setting expiration_date"), ArbitrarySecretResourceName, "read")
 		return tfErr.GetDiag()
 	}
-	if err = d.Set("payload", secret.Payload); err != nil {
-		tfErr := flex.TerraformErrorf(err, fmt.Sprintf("Error setting payload"), ArbitrarySecretResourceName, "read")
-		return tfErr.GetDiag()
+	// The payload is not stored when it is provided through the write-only payload_wo argument
+	if _, ok := d.GetOk("payload_wo_version"); !ok {
+		if err = d.Set("payload", secret.Payload); err != nil {
+			tfErr := flex.TerraformErrorf(err, fmt.Sprintf("Error setting payload"), ArbitrarySecretResourceName, "read")
+			return tfErr.GetDiag()
+		}
 	}
 
 	// Call get version metadata API to get the current version_custom_metadata
@@ -425,9 +446,14 @@ func resourceIbmSmArbitrarySecretUpdate(context context.Context, d *schema.Resou
 	}
 
 	// Apply change in payload (if changed)
-	if d.HasChange("payload") {
+	if d.HasChange("payload") || d.HasChange("payload_wo_version") {
+		payload, err := getArbitrarySecretPayload(d)
+		if err != nil {
+			tfErr := flex.TerraformErrorf(err, "", ArbitrarySecretResourceName, "update")
+			return tfErr.GetDiag()
+		}
 		versionModel := &secretsmanagerv2.ArbitrarySecretVersionPrototype{}
-		versionModel.Payload = core.StringPtr(d.Get("payload").(string))
+		versionModel.Payload = core.StringPtr(payload)
 		if _, ok := d.GetOk("version_custom_metadata"); ok {
 			versionModel.VersionCustomMetadata = d.Get("version_custom_metadata").(map[string]interface{})
 		}
@@ -510,8 +536,12 @@ func resourceIbmSmArbitrarySecretMapToArbitrarySecretPrototype(d *schema.Resourc
 	if _, ok := d.GetOk("name"); ok {
 		model.Name = core.StringPtr(d.Get("name").(string))
 	}
-	if _, ok := d.GetOk("payload"); ok {
-		model.Payload = core.StringPtr(d.Get("payload").(string))
+	payload, err := getArbitrarySecretPayload(d)
+	if err != nil {
+		return nil, err
+	}
+	if payload != "" {
+		model.Payload = core.StringPtr(payload)
 	}
 	if _, ok := d.GetOk("custom_metadata"); ok {
 		model.CustomMetadata = d.Get("custom_metadata").(map[string]interface{})
@@ -544,3 +574,11 @@ func resourceIbmSmArbitrarySecretMapToArbitrarySecretPrototype(d *schema.Resourc
 	}
 	return model, nil
 }
+
+// getArbitrarySecretPayload returns the payload from either the payload or the write-only payload_wo argument.
+func getArbitrarySecretPayload(d *schema.ResourceData) (string, error) {
+	if payload, ok := d.GetOk("payload"); ok {
+		return payload.(string), nil
+	}
+	return flex.GetWriteOnlyString(d, "payload_wo")
+}
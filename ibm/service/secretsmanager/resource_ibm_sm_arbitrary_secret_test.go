@@ -85,6 +85,36 @@ func TestAccIbmSmArbitrarySecretAllArgs(t *testing.T) {
 	})
 }
 
+func TestAccIbmSmArbitrarySecretWriteOnly(t *testing.T) {
+	resourceName := "ibm_sm_arbitrary_secret.sm_arbitrary_secret_write_only"
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acc.TestAccPreCheck(t) },
+		Providers:    acc.TestAccProviders,
+		CheckDestroy: testAccCheckIbmSmArbitrarySecretDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: arbitrarySecretConfigWriteOnly(payload, 1),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckIbmSmArbitrarySecretPayload(resourceName, payload),
+					resource.TestCheckNoResourceAttr(resourceName, "payload"),
+					resource.TestCheckNoResourceAttr(resourceName, "payload_wo"),
+					resource.TestCheckResourceAttr(resourceName, "payload_wo_version", "1"),
+					resource.TestCheckResourceAttr(resourceName, "versions_total", "1"),
+				),
+			},
+			{
+				Config: arbitrarySecretConfigWriteOnly(modifiedPayload, 2),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckIbmSmArbitrarySecretPayload(resourceName, modifiedPayload),
+					resource.TestCheckNoResourceAttr(resourceName, "payload"),
+					resource.TestCheckResourceAttr(resourceName, "payload_wo_version", "2"),
+					resource.TestCheckResourceAttr(resourceName, "versions_total", "2"),
+				),
+			},
+		},
+	})
+}
+
 var arbitrarySecretBasicConfigFormat = `
 		resource "ibm_sm_arbitrary_secret" "sm_arbitrary_secret_basic" {
 			instance_id   = "%s"
@@ -106,6 +136,15 @@ var arbitrarySecretFullConfigFormat = `
 			secret_group_id = "default"
 		}`
 
+var arbitrarySecretWriteOnlyConfigFormat = `
+		resource "ibm_sm_arbitrary_secret" "sm_arbitrary_secret_write_only" {
+			instance_id   = "%s"
+  			region        = "%s"
+			name = "%s"
+  			payload_wo = "%s"
+  			payload_wo_version = %d
+		}`
+
 func arbitrarySecretConfigBasic() string {
 	return fmt.Sprintf(arbitrarySecretBasicConfigFormat, acc.SecretsManagerInstanceID, acc.SecretsManagerInstanceRegion,
 		arbitrarySecretName, payload)
@@ -116,6 +155,11 @@ func arbitrarySecretConfigAllArgs() string {
 		arbitrarySecretName, description, label, payload, expirationDate, customMetadata)
 }
 
+func arbitrarySecretConfigWriteOnly(payload string, version int) string {
+	return fmt.Sprintf(arbitrarySecretWriteOnlyConfigFormat, acc.SecretsManagerInstanceID, acc.SecretsManagerInstanceRegion,
+		arbitrarySecretName, payload, version)
+}
+
 func testAccCheckIbmSmArbitrarySecretConfigUpdated() string {
 	return fmt.Sprintf(arbitrarySecretFullConfigFormat, acc.SecretsManagerInstanceID, acc.SecretsManagerInstanceRegion,
 		modifiedArbitrarySecretName, modifiedDescription, modifiedLabel, modifiedPayload, modifiedExpirationDate, modifiedCustomMetadata)
@@ -186,6 +230,17 @@ func testAccCheckIbmSmArbitrarySecretUpdated(n string) resource.TestCheckFunc {
 	}
 }
 
+func testAccCheckIbmSmArbitrarySecretPayload(n string, expectedPayload string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		arbitrarySecretIntf, err := getSecret(s, n)
+		if err != nil {
+			return err
+		}
+		secret := arbitrarySecretIntf.(*secretsmanagerv2.ArbitrarySecret)
+		return verifyAttr(*secret.Payload, expectedPayload, "payload")
+	}
+}
+
 func testAccCheckIbmSmArbitrarySecretDestroy(s *terraform.State) error {
 	secretsManagerClient, err := acc.TestAccProvider.Meta().(conns.ClientSession).SecretsManagerV2()
 	if err != nil {
@@ -63,27 +63,36 @@ func getRegion(originalClient *secretsmanagerv2.SecretsManagerV2, d *schema.Reso
 	if ok {
 		return d.Get("region").(string)
 	} else {
-		// extract region from base URL (provider config)
-		// base url is like that : "https://<private.>secrets-manager.<region>.<rest of domain>"
-		baseUrl := originalClient.Service.GetServiceURL()
-		u := strings.Replace(baseUrl, "private.", "", 1)
-		return strings.Split(u, ".")[1]
+		return getRegionFromServiceURL(originalClient)
 	}
 }
 
+// Extract the region from the base URL of the provider's configuration
+func getRegionFromServiceURL(originalClient *secretsmanagerv2.SecretsManagerV2) string {
+	// base url is like that : "https://<private.>secrets-manager.<region>.<rest of domain>"
+	baseUrl := originalClient.Service.GetServiceURL()
+	u := strings.Replace(baseUrl, "private.", "", 1)
+	return strings.Split(u, ".")[1]
+}
+
 // Clone the base secrets manager client and set the API endpoint per the instance
 func getEndpointType(originalClient *secretsmanagerv2.SecretsManagerV2, d *schema.ResourceData) string {
 	_, ok := d.GetOk("endpoint_type")
 	if ok {
 		return d.Get("endpoint_type").(string)
 	} else {
-		baseUrl := originalClient.Service.GetServiceURL()
+		return getEndpointTypeFromServiceURL(originalClient)
+	}
+}
 
-		if strings.Contains(baseUrl, "private.") {
-			return "private"
-		} else {
-			return "public"
-		}
+// Extract the endpoint type from the base URL of the provider's configuration
+func getEndpointTypeFromServiceURL(originalClient *secretsmanagerv2.SecretsManagerV2) string {
+	baseUrl := originalClient.Service.GetServiceURL()
+
+	if strings.Contains(baseUrl, "private.") {
+		return "private"
+	} else {
+		return "public"
 	}
 }
 
@@ -226,10 +235,20 @@ func getSecretByIdOrByName(context context.Context, d *schema.ResourceData, meta
 	secretName := d.Get("name").(string)
 	groupName := d.Get("secret_group_name").(string)
 
+	secretIntf, tfErr := getSecretWithClient(context, secretsManagerClient, secretId, secretName, groupName, secretType, fmt.Sprintf("(Data) %s", dataSourceName), "read")
+	if tfErr != nil {
+		return nil, "", "", tfErr.GetDiag()
+	}
+	return secretIntf, region, instanceId, nil
+}
+
+// Get a secret by its ID, or by its name and secret group name, using a client for the secret's instance
+func getSecretWithClient(context context.Context, secretsManagerClient *secretsmanagerv2.SecretsManagerV2, secretId, secretName, groupName, secretType, resource, operation string) (secretsmanagerv2.SecretIntf, *flex.TerraformProblem) {
 	log.Printf("[DEBUG] getSecretByIdOrByName %q %q %q %q\n", secretId, secretName, groupName, secretType)
 
 	var secretIntf secretsmanagerv2.SecretIntf
 	var response *core.DetailedResponse
+	var err error
 
 	if secretId != "" {
 		getSecretOptions := &secretsmanagerv2.GetSecretOptions{}
@@ -238,10 +257,9 @@ func getSecretByIdOrByName(context context.Context, d *schema.ResourceData, meta
 		secretIntf, response, err = secretsManagerClient.GetSecretWithContext(context, getSecretOptions)
 		if err != nil {
 			log.Printf("[DEBUG] GetSecretWithContext failed %s\n%s", err, response)
-			tfErr := flex.TerraformErrorf(err, fmt.Sprintf("GetSecretWithContext failed %s\n%s", err, response), fmt.Sprintf("(Data) %s", dataSourceName), "read")
-			return nil, "", "", tfErr.GetDiag()
+			return nil, flex.TerraformErrorf(err, fmt.Sprintf("GetSecretWithContext failed %s\n%s", err, response), resource, operation)
 		}
-		return secretIntf, region, instanceId, nil
+		return secretIntf, nil
 	}
 
 	if secretName != "" && groupName != "" {
@@ -255,14 +273,12 @@ func getSecretByIdOrByName(context context.Context, d *schema.ResourceData, meta
 		secretIntf, response, err = secretsManagerClient.GetSecretByNameTypeWithContext(context, getSecretByNameOptions)
 		if err != nil {
 			log.Printf("[DEBUG] GetSecretByNameTypeWithContext failed %s\n%s", err, response)
-			tfErr := flex.TerraformErrorf(err, fmt.Sprintf("GetSecretByNameTypeWithContext failed %s\n%s", err, response), fmt.Sprintf("(Data) %s", dataSourceName), "read")
-			return nil, "", "", tfErr.GetDiag()
+			return nil, flex.TerraformErrorf(err, fmt.Sprintf("GetSecretByNameTypeWithContext failed %s\n%s", err, response), resource, operation)
 		}
-		return secretIntf, region, instanceId, nil
+		return secretIntf, nil
 	}
 
-	tfErr := flex.TerraformErrorf(err, fmt.Sprintf("Missing required arguments. Please make sure that either \"secret_id\" or \"name\" and \"secret_group_name\" are provided\n"), fmt.Sprintf("(Data) %s", dataSourceName), "read")
-	return nil, "", "", tfErr.GetDiag()
+	return nil, flex.TerraformErrorf(err, fmt.Sprintf("Missing required arguments. Please make sure that either \"secret_id\" or \"name\" and \"secret_group_name\" are provided\n"), resource, operation)
 }
 
 func secretVersionMetadataAsPatchFunction(secretVersionMetadataPatch *secretsmanagerv2.SecretVersionMetadataPatch) (_patch map[string]interface{}, err error) {
@@ -0,0 +1,654 @@
+// Copyright IBM Corp. 2025 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package vpc
+
+import (
+	"bufio"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log"
+	"net/netip"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/service/cos"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
+	"github.com/IBM/ibm-cos-sdk-go/aws"
+	"github.com/IBM/ibm-cos-sdk-go/service/s3"
+	"github.com/IBM/vpc-go-sdk/vpcv1"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+const (
+	// flowLogObjectPrefix is the prefix of the objects written by the flow log collectors
+	flowLogObjectPrefix = "ibm_vpc_flowlogs_v1/"
+)
+
+func DataSourceIBMIsFlowLogAnalysis() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceIBMIsFlowLogAnalysisRead,
+
+		Schema: map[string]*schema.Schema{
+			"bucket_crn": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The CRN of the COS bucket the flow log collectors write to.",
+			},
+			"bucket_location": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The location of the COS bucket.",
+			},
+			"endpoint_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "public",
+				ValidateFunc: validate.ValidateAllowedStringValues([]string{"public", "private", "direct"}),
+				Description:  "The COS endpoint type: public, private or direct.",
+			},
+			"prefix": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     flowLogObjectPrefix,
+				Description: "The prefix of the flow log objects to read.",
+			},
+			"vpc": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The VPC identifier. If set, only the flow logs and the security groups of this VPC are analyzed.",
+			},
+			"security_groups": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Set:         schema.HashString,
+				Description: "The security group identifiers to report. If not set, all the security groups of the flows are reported.",
+			},
+			"start_time": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.IsRFC3339Time,
+				Description:  "The start of the time window, in RFC 3339 format. Defaults to one hour before end_time.",
+			},
+			"end_time": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.IsRFC3339Time,
+				Description:  "The end of the time window, in RFC 3339 format. Defaults to the time of the read.",
+			},
+			"peer_prefix_length": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      24,
+				ValidateFunc: validation.IntBetween(0, 32),
+				Description:  "The prefix length the IPv4 peer addresses are aggregated to. IPv6 peer addresses are aggregated to /64.",
+			},
+			"max_objects": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      1000,
+				ValidateFunc: validation.IntAtLeast(1),
+				Description:  "The maximum number of flow log objects to read.",
+			},
+			"objects_read": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The number of flow log objects read.",
+			},
+			"flows_read": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The number of flows in the time window.",
+			},
+			"truncated": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Indicates whether more than max_objects flow log objects were in the time window, and the analysis is partial.",
+			},
+			"aggregates": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The flows aggregated by security group, direction, action, protocol, port and peer CIDR, by descending number of flows.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"security_group": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The identifier of the security group of the network interface of the flows. Empty if the network interface has no known security group.",
+						},
+						"security_group_name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The name of the security group.",
+						},
+						"direction": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The direction of the flows relative to the network interface: inbound or outbound.",
+						},
+						"action": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The action taken on the flows: accepted or rejected.",
+						},
+						"protocol": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The transport protocol of the flows: tcp, udp, icmp or the IANA protocol number.",
+						},
+						"port": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "The destination port of the flows, that is the port on the network interface for inbound flows and the port on the peer for outbound flows.",
+						},
+						"peer_cidr": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The CIDR of the peer addresses of the flows.",
+						},
+						"flows": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "The number of flows.",
+						},
+						"bytes": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "The number of bytes of the flows, in both directions.",
+						},
+						"packets": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "The number of packets of the flows, in both directions.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// flowLogObject is a flow log object as written by a flow log collector
+type flowLogObject struct {
+	NetworkInterfaceID string          `json:"network_interface_id"`
+	FlowLogs           []flowLogRecord `json:"flow_logs"`
+}
+
+type flowLogRecord struct {
+	StartTime            string `json:"start_time"`
+	EndTime              string `json:"end_time"`
+	Direction            string `json:"direction"`
+	Action               string `json:"action"`
+	InitiatorIP          string `json:"initiator_ip"`
+	TargetIP             string `json:"target_ip"`
+	TargetPort           int64  `json:"target_port"`
+	TransportProtocol    int64  `json:"transport_protocol"`
+	BytesFromInitiator   int64  `json:"bytes_from_initiator"`
+	PacketsFromInitiator int64  `json:"packets_from_initiator"`
+	BytesFromTarget      int64  `json:"bytes_from_target"`
+	PacketsFromTarget    int64  `json:"packets_from_target"`
+}
+
+type flowLogAggregate struct {
+	securityGroup     string
+	securityGroupName string
+	direction         string
+	action            string
+	protocol          string
+	port              int64
+	peerCIDR          string
+	flows             int64
+	bytes             int64
+	packets           int64
+}
+
+func (a *flowLogAggregate) key() string {
+	return strings.Join([]string{a.securityGroup, a.direction, a.action, a.protocol, strconv.FormatInt(a.port, 10), a.peerCIDR}, "|")
+}
+
+type flowLogSecurityGroup struct {
+	id   string
+	name string
+}
+
+// flowLogAnalysis aggregates the flows of the flow log objects in a time window
+type flowLogAnalysis struct {
+	start, end       time.Time
+	peerPrefixLength int
+	// securityGroups are the security groups of the network interfaces, by network interface identifier
+	securityGroups map[string][]flowLogSecurityGroup
+	// reported are the security groups to report, all if empty
+	reported   map[string]bool
+	aggregates map[string]*flowLogAggregate
+	flows      int
+}
+
+// add aggregates the flows of a flow log object
+func (a *flowLogAnalysis) add(object *flowLogObject) {
+	groups := a.securityGroups[object.NetworkInterfaceID]
+	if len(groups) == 0 {
+		groups = []flowLogSecurityGroup{{}}
+	}
+	for _, record := range object.FlowLogs {
+		startTime, err := time.Parse(time.RFC3339, record.StartTime)
+		if err == nil && !startTime.Before(a.end) {
+			continue
+		}
+		endTime, err := time.Parse(time.RFC3339, record.EndTime)
+		if err == nil && endTime.Before(a.start) {
+			continue
+		}
+		a.flows++
+		peer := record.TargetIP
+		if record.Direction == "inbound" {
+			peer = record.InitiatorIP
+		}
+		for _, group := range groups {
+			if len(a.reported) > 0 && !a.reported[group.id] {
+				continue
+			}
+			aggregate := &flowLogAggregate{
+				securityGroup:     group.id,
+				securityGroupName: group.name,
+				direction:         record.Direction,
+				action:            record.Action,
+				protocol:          flowLogProtocolName(record.TransportProtocol),
+				port:              record.TargetPort,
+				peerCIDR:          flowLogPeerCIDR(peer, a.peerPrefixLength),
+			}
+			if existing, ok := a.aggregates[aggregate.key()]; ok {
+				aggregate = existing
+			} else {
+				a.aggregates[aggregate.key()] = aggregate
+			}
+			aggregate.flows++
+			aggregate.bytes += record.BytesFromInitiator + record.BytesFromTarget
+			aggregate.packets += record.PacketsFromInitiator + record.PacketsFromTarget
+		}
+	}
+}
+
+// sorted returns the aggregates by descending number of flows
+func (a *flowLogAnalysis) sorted() []*flowLogAggregate {
+	aggregates := make([]*flowLogAggregate, 0, len(a.aggregates))
+	for _, aggregate := range a.aggregates {
+		aggregates = append(aggregates, aggregate)
+	}
+	sort.Slice(aggregates, func(i, j int) bool {
+		if aggregates[i].flows != aggregates[j].flows {
+			return aggregates[i].flows > aggregates[j].flows
+		}
+		return aggregates[i].key() < aggregates[j].key()
+	})
+	return aggregates
+}
+
+func flowLogProtocolName(protocol int64) string {
+	switch protocol {
+	case 1:
+		return "icmp"
+	case 6:
+		return "tcp"
+	case 17:
+		return "udp"
+	}
+	return strconv.FormatInt(protocol, 10)
+}
+
+// flowLogPeerCIDR returns the CIDR of the address, with the prefix length for IPv4 and /64 for IPv6
+func flowLogPeerCIDR(address string, prefixLength int) string {
+	addr, err := netip.ParseAddr(address)
+	if err != nil {
+		return address
+	}
+	if !addr.Is4() {
+		prefixLength = 64
+	}
+	prefix, err := addr.Unmap().Prefix(prefixLength)
+	if err != nil {
+		return address
+	}
+	return prefix.String()
+}
+
+// flowLogObjectInWindow reports whether the period of the flow log object or folder, taken from
+// the year=, month=, day= and hour= segments of its key, overlaps the time window. The period is
+// the one of the last of these segments present in order, so a folder with only a year= segment
+// covers the whole year. The keys without a year= segment are in the window.
+func flowLogObjectInWindow(key string, start, end time.Time) bool {
+	parts := make(map[string]int)
+	for _, segment := range strings.Split(key, "/") {
+		name, value, ok := strings.Cut(segment, "=")
+		if !ok {
+			continue
+		}
+		switch name {
+		case "year", "month", "day", "hour":
+			if n, err := strconv.Atoi(value); err == nil {
+				parts[name] = n
+			}
+		}
+	}
+	year, ok := parts["year"]
+	if !ok {
+		return true
+	}
+	periodStart := time.Date(year, time.January, 1, 0, 0, 0, 0, time.UTC)
+	periodEnd := periodStart.AddDate(1, 0, 0)
+	if month, ok := parts["month"]; ok {
+		periodStart = time.Date(year, time.Month(month), 1, 0, 0, 0, 0, time.UTC)
+		periodEnd = periodStart.AddDate(0, 1, 0)
+		if day, ok := parts["day"]; ok {
+			periodStart = time.Date(year, time.Month(month), day, 0, 0, 0, 0, time.UTC)
+			periodEnd = periodStart.AddDate(0, 0, 1)
+			if hour, ok := parts["hour"]; ok {
+				periodStart = time.Date(year, time.Month(month), day, hour, 0, 0, 0, time.UTC)
+				periodEnd = periodStart.Add(time.Hour)
+			}
+		}
+	}
+	return periodStart.Before(end) && periodEnd.After(start)
+}
+
+// flowLogObjectInVPC reports whether the flow log object or folder is for the VPC, taken from the
+// vpc-id= segment of its key. The keys without a vpc-id= segment are in every VPC.
+func flowLogObjectInVPC(key, vpcID string) bool {
+	if vpcID == "" {
+		return true
+	}
+	for _, segment := range strings.Split(strings.TrimSuffix(key, "/"), "/") {
+		if id, ok := strings.CutPrefix(segment, "vpc-id="); ok {
+			return id == vpcID
+		}
+	}
+	return true
+}
+
+// listFlowLogObjects returns the keys of the flow log objects of the VPC in the time window, at most
+// maxObjects of them. The bucket is listed folder by folder, so that only the folders of the VPC and of
+// the years, months, days and hours in the window are listed, instead of all the objects ever written.
+func listFlowLogObjects(context context.Context, s3Client *s3.S3, bucketName, prefix, vpcID string, start, end time.Time, maxObjects int) ([]string, bool, error) {
+	keys := make([]string, 0)
+	truncated := false
+	var list func(prefix string) error
+	list = func(prefix string) error {
+		folders := make([]string, 0)
+		listObjectsInput := &s3.ListObjectsV2Input{
+			Bucket:    aws.String(bucketName),
+			Prefix:    aws.String(prefix),
+			Delimiter: aws.String("/"),
+		}
+		err := s3Client.ListObjectsV2PagesWithContext(context, listObjectsInput, func(page *s3.ListObjectsV2Output, lastPage bool) bool {
+			for _, folder := range page.CommonPrefixes {
+				key := aws.StringValue(folder.Prefix)
+				if flowLogObjectInVPC(key, vpcID) && flowLogObjectInWindow(key, start, end) {
+					folders = append(folders, key)
+				}
+			}
+			for _, object := range page.Contents {
+				key := aws.StringValue(object.Key)
+				if !flowLogObjectInVPC(key, vpcID) || !flowLogObjectInWindow(key, start, end) {
+					continue
+				}
+				if object.LastModified != nil && object.LastModified.Before(start) {
+					continue
+				}
+				if len(keys) == maxObjects {
+					truncated = true
+					return false
+				}
+				keys = append(keys, key)
+			}
+			return true
+		})
+		if err != nil {
+			return err
+		}
+		for _, folder := range folders {
+			if truncated {
+				return nil
+			}
+			if err = list(folder); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+	err := list(prefix)
+	return keys, truncated, err
+}
+
+// flowLogKeyNetworkInterface returns the network interface identifier of the vnic-id= segment of the key
+func flowLogKeyNetworkInterface(key string) string {
+	for _, segment := range strings.Split(key, "/") {
+		if id, ok := strings.CutPrefix(segment, "vnic-id="); ok {
+			return id
+		}
+	}
+	return ""
+}
+
+// readFlowLogObject reads a flow log object, gzip compressed or not. An object holds one JSON
+// document, or one JSON document per line.
+func readFlowLogObject(body io.Reader) ([]*flowLogObject, error) {
+	reader := bufio.NewReader(body)
+	if magic, err := reader.Peek(2); err == nil && bytes.Equal(magic, []byte{0x1f, 0x8b}) {
+		gzipReader, err := gzip.NewReader(reader)
+		if err != nil {
+			return nil, err
+		}
+		defer gzipReader.Close()
+		reader = bufio.NewReader(gzipReader)
+	}
+	objects := make([]*flowLogObject, 0, 1)
+	decoder := json.NewDecoder(reader)
+	for {
+		object := &flowLogObject{}
+		err := decoder.Decode(object)
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		objects = append(objects, object)
+	}
+	return objects, nil
+}
+
+// getFlowLogSecurityGroups returns the security groups of the network interfaces, by network interface identifier
+func getFlowLogSecurityGroups(context context.Context, sess *vpcv1.VpcV1, vpcID string) (map[string][]flowLogSecurityGroup, error) {
+	securityGroups := make([]vpcv1.SecurityGroup, 0)
+	start := ""
+	for {
+		listSecurityGroupsOptions := &vpcv1.ListSecurityGroupsOptions{}
+		if vpcID != "" {
+			listSecurityGroupsOptions.VPCID = &vpcID
+		}
+		if start != "" {
+			listSecurityGroupsOptions.Start = &start
+		}
+		collection, response, err := sess.ListSecurityGroupsWithContext(context, listSecurityGroupsOptions)
+		if err != nil {
+			return nil, fmt.Errorf("ListSecurityGroupsWithContext failed %s\n%s", err, response)
+		}
+		securityGroups = append(securityGroups, collection.SecurityGroups...)
+		start = flex.GetNext(collection.Next)
+		if start == "" {
+			break
+		}
+	}
+
+	interfaces := make(map[string][]flowLogSecurityGroup)
+	for _, securityGroup := range securityGroups {
+		group := flowLogSecurityGroup{id: *securityGroup.ID, name: *securityGroup.Name}
+		start := ""
+		for {
+			listSecurityGroupTargetsOptions := sess.NewListSecurityGroupTargetsOptions(group.id)
+			if start != "" {
+				listSecurityGroupTargetsOptions.Start = &start
+			}
+			collection, response, err := sess.ListSecurityGroupTargetsWithContext(context, listSecurityGroupTargetsOptions)
+			if err != nil {
+				return nil, fmt.Errorf("ListSecurityGroupTargetsWithContext failed for security group %s: %s\n%s", group.id, err, response)
+			}
+			for _, targetIntf := range collection.Targets {
+				if target, ok := targetIntf.(*vpcv1.SecurityGroupTargetReference); ok && target.ID != nil {
+					interfaces[*target.ID] = append(interfaces[*target.ID], group)
+				}
+			}
+			start = flex.GetNext(collection.Next)
+			if start == "" {
+				break
+			}
+		}
+	}
+	return interfaces, nil
+}
+
+func dataSourceIBMIsFlowLogAnalysisRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	sess, err := vpcClient(meta)
+	if err != nil {
+		tfErr := flex.DiscriminatedTerraformErrorf(err, err.Error(), "(Data) ibm_is_flow_log_analysis", "read", "initialize-client")
+		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+		return tfErr.GetDiag()
+	}
+	bxSession, err := meta.(conns.ClientSession).BluemixSession()
+	if err != nil {
+		tfErr := flex.DiscriminatedTerraformErrorf(err, err.Error(), "(Data) ibm_is_flow_log_analysis", "read", "initialize-cos-client")
+		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+		return tfErr.GetDiag()
+	}
+	bucketCRN := d.Get("bucket_crn").(string)
+	bucketParts := strings.Split(bucketCRN, ":bucket:")
+	if len(bucketParts) != 2 {
+		err = fmt.Errorf("bucket_crn %s is not the CRN of a COS bucket", bucketCRN)
+		return flex.TerraformErrorf(err, err.Error(), "(Data) ibm_is_flow_log_analysis", "read").GetDiag()
+	}
+	bucketName := bucketParts[1]
+	instanceCRN := fmt.Sprintf("%s::", bucketParts[0])
+	s3Client, err := cos.GetS3Client(bxSession, d.Get("bucket_location").(string), d.Get("endpoint_type").(string), instanceCRN)
+	if err != nil {
+		tfErr := flex.DiscriminatedTerraformErrorf(err, err.Error(), "(Data) ibm_is_flow_log_analysis", "read", "initialize-cos-client")
+		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+		return tfErr.GetDiag()
+	}
+
+	end := time.Now().UTC()
+	if v, ok := d.GetOk("end_time"); ok {
+		end, _ = time.Parse(time.RFC3339, v.(string))
+	}
+	start := end.Add(-time.Hour)
+	if v, ok := d.GetOk("start_time"); ok {
+		start, _ = time.Parse(time.RFC3339, v.(string))
+	}
+	if !start.Before(end) {
+		err = fmt.Errorf("start_time %s is not before end_time %s", start.Format(time.RFC3339), end.Format(time.RFC3339))
+		return flex.TerraformErrorf(err, err.Error(), "(Data) ibm_is_flow_log_analysis", "read").GetDiag()
+	}
+
+	vpcID := d.Get("vpc").(string)
+	securityGroups, err := getFlowLogSecurityGroups(context, sess, vpcID)
+	if err != nil {
+		tfErr := flex.TerraformErrorf(err, err.Error(), "(Data) ibm_is_flow_log_analysis", "read")
+		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+		return tfErr.GetDiag()
+	}
+	analysis := &flowLogAnalysis{
+		start:            start,
+		end:              end,
+		peerPrefixLength: d.Get("peer_prefix_length").(int),
+		securityGroups:   securityGroups,
+		reported:         make(map[string]bool),
+		aggregates:       make(map[string]*flowLogAggregate),
+	}
+	for _, id := range flex.ExpandStringList(d.Get("security_groups").(*schema.Set).List()) {
+		analysis.reported[id] = true
+	}
+
+	// The keys of the objects are listed first, and only the objects of the hours in the window are read
+	maxObjects := d.Get("max_objects").(int)
+	keys, truncated, err := listFlowLogObjects(context, s3Client, bucketName, d.Get("prefix").(string), vpcID, start, end, maxObjects)
+	if err != nil {
+		tfErr := flex.TerraformErrorf(err, fmt.Sprintf("failed listing the objects of COS bucket (%s): %s", bucketName, err), "(Data) ibm_is_flow_log_analysis", "read")
+		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+		return tfErr.GetDiag()
+	}
+	if truncated {
+		log.Printf("[WARN] More than %d flow log objects in COS bucket (%s) between %s and %s, the analysis is partial", maxObjects, bucketName, start.Format(time.RFC3339), end.Format(time.RFC3339))
+	}
+
+	for _, key := range keys {
+		getObjectInput := &s3.GetObjectInput{
+			Bucket: aws.String(bucketName),
+			Key:    aws.String(key),
+		}
+		out, err := s3Client.GetObjectWithContext(context, getObjectInput)
+		if err != nil {
+			tfErr := flex.TerraformErrorf(err, fmt.Sprintf("failed getting COS bucket (%s) object (%s): %s", bucketName, key, err), "(Data) ibm_is_flow_log_analysis", "read")
+			log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+			return tfErr.GetDiag()
+		}
+		objects, err := readFlowLogObject(out.Body)
+		out.Body.Close()
+		if err != nil {
+			tfErr := flex.TerraformErrorf(err, fmt.Sprintf("failed reading COS bucket (%s) object (%s) as a flow log: %s", bucketName, key, err), "(Data) ibm_is_flow_log_analysis", "read")
+			log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+			return tfErr.GetDiag()
+		}
+		for _, object := range objects {
+			if object.NetworkInterfaceID == "" {
+				object.NetworkInterfaceID = flowLogKeyNetworkInterface(key)
+			}
+			analysis.add(object)
+		}
+	}
+	log.Printf("[INFO] Analyzed %d flows in %d objects of COS bucket (%s)", analysis.flows, len(keys), bucketName)
+
+	aggregates := make([]map[string]interface{}, 0, len(analysis.aggregates))
+	for _, aggregate := range analysis.sorted() {
+		aggregates = append(aggregates, map[string]interface{}{
+			"security_group":      aggregate.securityGroup,
+			"security_group_name": aggregate.securityGroupName,
+			"direction":           aggregate.direction,
+			"action":              aggregate.action,
+			"protocol":            aggregate.protocol,
+			"port":                int(aggregate.port),
+			"peer_cidr":           aggregate.peerCIDR,
+			"flows":               int(aggregate.flows),
+			"bytes":               int(aggregate.bytes),
+			"packets":             int(aggregate.packets),
+		})
+	}
+
+	d.SetId(dataSourceIBMIsFlowLogAnalysisID(d))
+	if err = d.Set("objects_read", len(keys)); err != nil {
+		return flex.DiscriminatedTerraformErrorf(err, fmt.Sprintf("Error setting objects_read: %s", err), "(Data) ibm_is_flow_log_analysis", "read", "set-objects_read").GetDiag()
+	}
+	if err = d.Set("flows_read", analysis.flows); err != nil {
+		return flex.DiscriminatedTerraformErrorf(err, fmt.Sprintf("Error setting flows_read: %s", err), "(Data) ibm_is_flow_log_analysis", "read", "set-flows_read").GetDiag()
+	}
+	if err = d.Set("truncated", truncated); err != nil {
+		return flex.DiscriminatedTerraformErrorf(err, fmt.Sprintf("Error setting truncated: %s", err), "(Data) ibm_is_flow_log_analysis", "read", "set-truncated").GetDiag()
+	}
+	if err = d.Set("aggregates", aggregates); err != nil {
+		return flex.DiscriminatedTerraformErrorf(err, fmt.Sprintf("Error setting aggregates: %s", err), "(Data) ibm_is_flow_log_analysis", "read", "set-aggregates").GetDiag()
+	}
+	return nil
+}
+
+// dataSourceIBMIsFlowLogAnalysisID returns a reasonable ID for the flow log analysis.
+func dataSourceIBMIsFlowLogAnalysisID(d *schema.ResourceData) string {
+	return time.Now().UTC().String()
+}
@@ -0,0 +1,112 @@
+// Copyright IBM Corp. 2025 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package vpc
+
+import (
+	"bytes"
+	"compress/gzip"
+	"strings"
+	"testing"
+	"time"
+)
+
+const testFlowLogObject = `{"network_interface_id":"0717-a","flow_logs":[{"direction":"inbound","action":"accepted","initiator_ip":"10.0.0.1","target_ip":"10.0.0.2","target_port":22,"transport_protocol":6}]}`
+
+func TestReadFlowLogObject(t *testing.T) {
+	var compressed bytes.Buffer
+	writer := gzip.NewWriter(&compressed)
+	if _, err := writer.Write([]byte(testFlowLogObject)); err != nil {
+		t.Fatal(err)
+	}
+	if err := writer.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name    string
+		body    []byte
+		objects int
+		wantErr bool
+	}{
+		{name: "one document", body: []byte(testFlowLogObject), objects: 1},
+		{name: "one document per line", body: []byte(testFlowLogObject + "\n" + testFlowLogObject + "\n"), objects: 2},
+		{name: "gzip compressed", body: compressed.Bytes(), objects: 1},
+		{name: "empty", body: []byte{}, objects: 0},
+		{name: "not json", body: []byte("not a flow log"), wantErr: true},
+		{name: "truncated", body: []byte(testFlowLogObject[:20]), wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			objects, err := readFlowLogObject(bytes.NewReader(tt.body))
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("readFlowLogObject() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+			if len(objects) != tt.objects {
+				t.Fatalf("readFlowLogObject() returned %d objects, want %d", len(objects), tt.objects)
+			}
+			for _, object := range objects {
+				if object.NetworkInterfaceID != "0717-a" || len(object.FlowLogs) != 1 || object.FlowLogs[0].TargetPort != 22 {
+					t.Errorf("readFlowLogObject() = %+v", object)
+				}
+			}
+		})
+	}
+}
+
+func TestFlowLogObjectInWindow(t *testing.T) {
+	start := time.Date(2025, time.March, 10, 10, 30, 0, 0, time.UTC)
+	end := time.Date(2025, time.March, 10, 12, 0, 0, 0, time.UTC)
+	folder := "ibm_vpc_flowlogs_v1/account=a/region=us-south/vpc-id=r006-v/subnet-id=s/endpoint-type=vnics/instance-id=i/vnic-id=n/record-type=ingress/"
+
+	tests := []struct {
+		name string
+		key  string
+		want bool
+	}{
+		{name: "no time segments", key: folder, want: true},
+		{name: "year in window", key: folder + "year=2025/", want: true},
+		{name: "year before window", key: folder + "year=2024/", want: false},
+		{name: "month in window", key: folder + "year=2025/month=3/", want: true},
+		{name: "month after window", key: folder + "year=2025/month=4/", want: false},
+		{name: "day in window", key: folder + "year=2025/month=3/day=10/", want: true},
+		{name: "day before window", key: folder + "year=2025/month=3/day=9/", want: false},
+		{name: "hour overlapping the start", key: folder + "year=2025/month=3/day=10/hour=10/", want: true},
+		{name: "hour in window", key: folder + "year=2025/month=3/day=10/hour=11/stream-id=1/00000001.gz", want: true},
+		{name: "hour ending at the start", key: folder + "year=2025/month=3/day=10/hour=9/", want: false},
+		{name: "hour starting at the end", key: folder + "year=2025/month=3/day=10/hour=12/", want: false},
+		{name: "not a number", key: folder + "year=latest/", want: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := flowLogObjectInWindow(tt.key, start, end); got != tt.want {
+				t.Errorf("flowLogObjectInWindow(%q) = %v, want %v", strings.TrimPrefix(tt.key, folder), got, tt.want)
+			}
+		})
+	}
+}
+
+func TestFlowLogObjectInVPC(t *testing.T) {
+	tests := []struct {
+		name  string
+		key   string
+		vpcID string
+		want  bool
+	}{
+		{name: "no vpc", key: "ibm_vpc_flowlogs_v1/account=a/vpc-id=r006-v/", want: true},
+		{name: "same vpc", key: "ibm_vpc_flowlogs_v1/account=a/vpc-id=r006-v/", vpcID: "r006-v", want: true},
+		{name: "other vpc", key: "ibm_vpc_flowlogs_v1/account=a/vpc-id=r006-w/", vpcID: "r006-v", want: false},
+		{name: "vpc prefix of another", key: "ibm_vpc_flowlogs_v1/account=a/vpc-id=r006-vw/", vpcID: "r006-v", want: false},
+		{name: "above the vpc folders", key: "ibm_vpc_flowlogs_v1/account=a/", vpcID: "r006-v", want: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := flowLogObjectInVPC(tt.key, tt.vpcID); got != tt.want {
+				t.Errorf("flowLogObjectInVPC() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
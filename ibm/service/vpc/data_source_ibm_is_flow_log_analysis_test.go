@@ -0,0 +1,47 @@
+// Copyright IBM Corp. 2025 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package vpc_test
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccIBMISFlowLogAnalysisDataSource_basic(t *testing.T) {
+	resName := "data.ibm_is_flow_log_analysis.example"
+	endTime := time.Now().UTC().Format(time.RFC3339)
+	startTime := time.Now().UTC().Add(-24 * time.Hour).Format(time.RFC3339)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMISFlowLogAnalysisDataSourceConfig(acc.IsCosBucketCRN, startTime, endTime),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(resName, "id"),
+					resource.TestCheckResourceAttrSet(resName, "objects_read"),
+					resource.TestCheckResourceAttrSet(resName, "flows_read"),
+					resource.TestCheckResourceAttr(resName, "truncated", "false"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIBMISFlowLogAnalysisDataSourceConfig(bucketCRN, startTime, endTime string) string {
+	return fmt.Sprintf(`
+	data "ibm_is_flow_log_analysis" "example" {
+		bucket_crn      = "%s"
+		bucket_location = "us-south"
+		start_time      = "%s"
+		end_time        = "%s"
+		max_objects     = 10000
+	}`, bucketCRN, startTime, endTime)
+}
@@ -0,0 +1,740 @@
+// Copyright IBM Corp. 2025 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package vpc
+
+import (
+	"context"
+	"encoding/binary"
+	"fmt"
+	"log"
+	"net/netip"
+	"time"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
+	dl "github.com/IBM/networking-go-sdk/directlinkv1"
+	tg "github.com/IBM/networking-go-sdk/transitgatewayapisv1"
+	"github.com/IBM/vpc-go-sdk/vpcv1"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+const (
+	addressPlanSourceAddressPrefix  = "vpc_address_prefix"
+	addressPlanSourceSubnet         = "vpc_subnet"
+	addressPlanSourceTransitGateway = "transit_gateway"
+	addressPlanSourceDirectLink     = "direct_link"
+	addressPlanSourceReserved       = "reserved"
+	addressPlanSourceProposedPrefix = "address_prefixes"
+	addressPlanSourceProposedSubnet = "subnets"
+	addressPlanSourceFreeRange      = "free_range_requests"
+)
+
+func DataSourceIBMIsVPCAddressPlan() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceIBMIsVPCAddressPlanRead,
+
+		Timeouts: &schema.ResourceTimeout{
+			Read: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"vpcs": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "The VPCs whose address prefixes and subnets are checked. If not set, all the VPCs of the region are checked.",
+			},
+			"transit_gateways": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "The transit gateways whose connection prefixes are checked, from the latest complete route report of each transit gateway.",
+			},
+			"direct_link_gateways": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "The direct link gateways whose routes are checked, from the latest complete route report of each gateway.",
+			},
+			"address_prefixes": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: "The proposed address prefixes.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "The name of the proposed address prefix, used to report its conflicts.",
+						},
+						"cidr": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validate.ValidateCIDR,
+							Description:  "The IPv4 CIDR block of the proposed address prefix.",
+						},
+						"vpc": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "The VPC of the proposed address prefix.",
+						},
+					},
+				},
+			},
+			"subnets": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: "The proposed subnets.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "The name of the proposed subnet, used to report its conflicts.",
+						},
+						"cidr": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validate.ValidateCIDR,
+							Description:  "The IPv4 CIDR block of the proposed subnet.",
+						},
+						"vpc": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "The VPC of the proposed subnet. If set, the subnet must be within an address prefix of the VPC, and may overlap only the address prefixes of the VPC.",
+						},
+					},
+				},
+			},
+			"free_range_requests": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: "The free ranges to find. Requests are allocated in order, the ranges of a request are not used for the next requests.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "The name of the request.",
+						},
+						"within": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validate.ValidateCIDR,
+							Description:  "The IPv4 CIDR block to find the free ranges in.",
+						},
+						"prefix_length": {
+							Type:         schema.TypeInt,
+							Required:     true,
+							ValidateFunc: validation.IntBetween(8, 32),
+							Description:  "The prefix length of the free ranges.",
+						},
+						"count": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Default:      1,
+							ValidateFunc: validation.IntAtLeast(1),
+							Description:  "The number of free ranges to find.",
+						},
+					},
+				},
+			},
+			"valid": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Whether the proposed address prefixes and subnets have no conflicts.",
+			},
+			"conflicts": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The conflicts of the proposed address prefixes and subnets.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The name of the proposed address prefix or subnet.",
+						},
+						"cidr": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The CIDR block of the proposed address prefix or subnet.",
+						},
+						"conflicting_cidr": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The CIDR block that the proposed address prefix or subnet overlaps.",
+						},
+						"source": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Where the conflicting CIDR block comes from.",
+						},
+						"source_id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The identifier of the conflicting address prefix, subnet, transit gateway or direct link gateway, or the name of the conflicting proposed address prefix or subnet.",
+						},
+						"reason": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "A description of the conflict.",
+						},
+					},
+				},
+			},
+			"free_ranges": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The free ranges found for each free range request.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The name of the request.",
+						},
+						"cidrs": {
+							Type:        schema.TypeList,
+							Computed:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Description: "The free CIDR blocks, fewer than requested if the range is exhausted.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// addressRange is an inclusive range of IPv4 addresses
+type addressRange struct {
+	first, last uint32
+}
+
+func (r addressRange) overlaps(o addressRange) bool {
+	return r.first <= o.last && o.first <= r.last
+}
+
+func (r addressRange) contains(o addressRange) bool {
+	return r.first <= o.first && o.last <= r.last
+}
+
+func parseAddressRange(cidr string) (addressRange, int, error) {
+	prefix, err := netip.ParsePrefix(cidr)
+	if err != nil {
+		return addressRange{}, 0, err
+	}
+	if !prefix.Addr().Is4() {
+		return addressRange{}, 0, fmt.Errorf("%s is not an IPv4 CIDR block", cidr)
+	}
+	addr := prefix.Masked().Addr().As4()
+	first := binary.BigEndian.Uint32(addr[:])
+	return addressRange{first: first, last: first | uint32(uint64(1)<<(32-prefix.Bits())-1)}, prefix.Bits(), nil
+}
+
+func formatAddressRange(first uint32, bits int) string {
+	var addr [4]byte
+	binary.BigEndian.PutUint32(addr[:], first)
+	return netip.PrefixFrom(netip.AddrFrom4(addr), bits).String()
+}
+
+// addressPlanRange is a CIDR block that is in use or proposed
+type addressPlanRange struct {
+	name     string
+	cidr     string
+	vpc      string
+	source   string
+	sourceID string
+	r        addressRange
+}
+
+type addressPlanConflict struct {
+	name            string
+	cidr            string
+	conflictingCIDR string
+	source          string
+	sourceID        string
+	reason          string
+}
+
+func newAddressPlanRange(name, cidr, vpc, source, sourceID string) (*addressPlanRange, error) {
+	r, _, err := parseAddressRange(cidr)
+	if err != nil {
+		return nil, err
+	}
+	return &addressPlanRange{name: name, cidr: cidr, vpc: vpc, source: source, sourceID: sourceID, r: r}, nil
+}
+
+// definesRange reports whether the range is an address prefix that contains the
+// whole of r, so that the blocks of r can be allocated from it.
+func (a *addressPlanRange) definesRange(r addressRange) bool {
+	isPrefix := a.source == addressPlanSourceAddressPrefix || a.source == addressPlanSourceProposedPrefix
+	return isPrefix && a.r.contains(r)
+}
+
+// checkAddressPlan returns the conflicts of the proposed address prefixes and
+// subnets with the ranges in use and with each other.
+//
+// A proposed address prefix must not overlap the address prefixes of any VPC,
+// the routes of the transit gateways and direct link gateways or the reserved
+// ranges, unless it is the same prefix of the same VPC. A proposed subnet must
+// not overlap other subnets or the routes, and may overlap only the address
+// prefixes of its own VPC, which must contain it.
+func checkAddressPlan(used, prefixes, subnets []*addressPlanRange) []addressPlanConflict {
+	conflicts := make([]addressPlanConflict, 0)
+	conflict := func(proposed, other *addressPlanRange, reason string) {
+		conflicts = append(conflicts, addressPlanConflict{
+			name:            proposed.name,
+			cidr:            proposed.cidr,
+			conflictingCIDR: other.cidr,
+			source:          other.source,
+			sourceID:        other.sourceID,
+			reason:          reason,
+		})
+	}
+	sameVPC := func(a, b *addressPlanRange) bool {
+		return a.vpc != "" && a.vpc == b.vpc
+	}
+
+	for i, prefix := range prefixes {
+		for _, other := range used {
+			if other.source == addressPlanSourceSubnet || !prefix.r.overlaps(other.r) {
+				continue
+			}
+			if other.source == addressPlanSourceAddressPrefix && other.r == prefix.r && (prefix.vpc == "" || prefix.vpc == other.vpc) {
+				// the address prefix already exists
+				continue
+			}
+			conflict(prefix, other, fmt.Sprintf("address prefix %s overlaps %s %s", prefix.cidr, other.source, other.cidr))
+		}
+		for _, other := range prefixes[:i] {
+			if prefix.r.overlaps(other.r) {
+				conflict(prefix, other, fmt.Sprintf("address prefix %s overlaps proposed address prefix %s", prefix.cidr, other.name))
+			}
+		}
+	}
+
+	for i, subnet := range subnets {
+		contained := false
+		for _, other := range used {
+			if !subnet.r.overlaps(other.r) {
+				continue
+			}
+			switch other.source {
+			case addressPlanSourceAddressPrefix:
+				if subnet.vpc == "" {
+					continue
+				}
+				if sameVPC(subnet, other) {
+					contained = contained || other.r.contains(subnet.r)
+					continue
+				}
+				conflict(subnet, other, fmt.Sprintf("subnet %s overlaps address prefix %s of VPC %s", subnet.cidr, other.cidr, other.vpc))
+			case addressPlanSourceSubnet:
+				if other.r == subnet.r && (subnet.vpc == "" || sameVPC(subnet, other)) {
+					// the subnet already exists
+					continue
+				}
+				conflict(subnet, other, fmt.Sprintf("subnet %s overlaps subnet %s %s", subnet.cidr, other.sourceID, other.cidr))
+			default:
+				conflict(subnet, other, fmt.Sprintf("subnet %s overlaps %s %s", subnet.cidr, other.source, other.cidr))
+			}
+		}
+		for _, other := range prefixes {
+			if !subnet.r.overlaps(other.r) || subnet.vpc == "" || other.vpc == "" {
+				continue
+			}
+			if sameVPC(subnet, other) {
+				contained = contained || other.r.contains(subnet.r)
+				continue
+			}
+			conflict(subnet, other, fmt.Sprintf("subnet %s overlaps proposed address prefix %s of VPC %s", subnet.cidr, other.name, other.vpc))
+		}
+		for _, other := range subnets[:i] {
+			if subnet.r.overlaps(other.r) {
+				conflict(subnet, other, fmt.Sprintf("subnet %s overlaps proposed subnet %s", subnet.cidr, other.name))
+			}
+		}
+		if subnet.vpc != "" && !contained {
+			conflicts = append(conflicts, addressPlanConflict{
+				name:   subnet.name,
+				cidr:   subnet.cidr,
+				source: addressPlanSourceAddressPrefix,
+				reason: fmt.Sprintf("subnet %s is not within an address prefix of VPC %s", subnet.cidr, subnet.vpc),
+			})
+		}
+	}
+	return conflicts
+}
+
+// findFreeRanges returns up to count blocks of the prefix length within the
+// range that do not overlap the ranges in use, lowest first. Only the address
+// prefixes that contain the whole range, such as the address prefix to find
+// subnets in, are not in the way.
+func findFreeRanges(used []*addressPlanRange, within addressRange, prefixLength, count int) []string {
+	size := uint64(1) << (32 - prefixLength)
+	free := make([]string, 0, count)
+	next := (uint64(within.first) + size - 1) / size * size
+	for next+size-1 <= uint64(within.last) && len(free) < count {
+		candidate := addressRange{first: uint32(next), last: uint32(next + size - 1)}
+		overlapped, end := false, uint64(0)
+		for _, other := range used {
+			if candidate.overlaps(other.r) && !other.definesRange(within) {
+				overlapped = true
+				end = max(end, uint64(other.r.last))
+			}
+		}
+		if !overlapped {
+			free = append(free, formatAddressRange(candidate.first, prefixLength))
+			next += size
+			continue
+		}
+		// skip past the ranges in use, to the next aligned block
+		next = (end + 1 + size - 1) / size * size
+	}
+	return free
+}
+
+func dataSourceIBMIsVPCAddressPlanRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	sess, err := vpcClient(meta)
+	if err != nil {
+		tfErr := flex.DiscriminatedTerraformErrorf(err, err.Error(), "(Data) ibm_is_vpc_address_plan", "read", "initialize-client")
+		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+		return tfErr.GetDiag()
+	}
+
+	used := make([]*addressPlanRange, 0)
+	for _, cidr := range validate.ReservedAddressRanges() {
+		reserved, _ := newAddressPlanRange("", cidr, "", addressPlanSourceReserved, "")
+		used = append(used, reserved)
+	}
+
+	vpcIDs := flex.ExpandStringList(d.Get("vpcs").([]interface{}))
+	if len(vpcIDs) == 0 {
+		vpcIDs, err = listAddressPlanVPCs(context, sess)
+		if err != nil {
+			tfErr := flex.TerraformErrorf(err, fmt.Sprintf("ListVpcsWithContext failed: %s", err.Error()), "(Data) ibm_is_vpc_address_plan", "read")
+			log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+			return tfErr.GetDiag()
+		}
+	}
+	vpcRanges, err := listAddressPlanVPCRanges(context, sess, vpcIDs)
+	if err != nil {
+		tfErr := flex.TerraformErrorf(err, err.Error(), "(Data) ibm_is_vpc_address_plan", "read")
+		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+		return tfErr.GetDiag()
+	}
+	used = append(used, vpcRanges...)
+
+	// The transit gateways and direct link gateways also report the address
+	// prefixes of the VPCs they connect, these are already in use once.
+	vpcPrefixes := make(map[string]bool)
+	for _, vpcRange := range vpcRanges {
+		if vpcRange.source == addressPlanSourceAddressPrefix {
+			vpcPrefixes[vpcRange.cidr] = true
+		}
+	}
+	routeRanges := make([]*addressPlanRange, 0)
+	if transitGateways := flex.ExpandStringList(d.Get("transit_gateways").([]interface{})); len(transitGateways) > 0 {
+		tgClient, err := meta.(conns.ClientSession).TransitGatewayV1API()
+		if err != nil {
+			tfErr := flex.DiscriminatedTerraformErrorf(err, err.Error(), "(Data) ibm_is_vpc_address_plan", "read", "initialize-transit-gateway-client")
+			log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+			return tfErr.GetDiag()
+		}
+		for _, transitGateway := range transitGateways {
+			ranges, err := listAddressPlanTransitGatewayRanges(context, tgClient, transitGateway)
+			if err != nil {
+				tfErr := flex.TerraformErrorf(err, err.Error(), "(Data) ibm_is_vpc_address_plan", "read")
+				log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+				return tfErr.GetDiag()
+			}
+			routeRanges = append(routeRanges, ranges...)
+		}
+	}
+	if gateways := flex.ExpandStringList(d.Get("direct_link_gateways").([]interface{})); len(gateways) > 0 {
+		dlClient, err := meta.(conns.ClientSession).DirectlinkV1API()
+		if err != nil {
+			tfErr := flex.DiscriminatedTerraformErrorf(err, err.Error(), "(Data) ibm_is_vpc_address_plan", "read", "initialize-direct-link-client")
+			log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+			return tfErr.GetDiag()
+		}
+		for _, gateway := range gateways {
+			ranges, err := listAddressPlanDirectLinkRanges(context, dlClient, gateway)
+			if err != nil {
+				tfErr := flex.TerraformErrorf(err, err.Error(), "(Data) ibm_is_vpc_address_plan", "read")
+				log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+				return tfErr.GetDiag()
+			}
+			routeRanges = append(routeRanges, ranges...)
+		}
+	}
+	seen := make(map[string]bool)
+	for _, routeRange := range routeRanges {
+		key := routeRange.source + "/" + routeRange.sourceID + "/" + routeRange.cidr
+		if vpcPrefixes[routeRange.cidr] || seen[key] {
+			continue
+		}
+		seen[key] = true
+		used = append(used, routeRange)
+	}
+
+	prefixes, err := expandAddressPlanRanges(d.Get("address_prefixes").([]interface{}), addressPlanSourceProposedPrefix)
+	if err != nil {
+		return flex.TerraformErrorf(err, err.Error(), "(Data) ibm_is_vpc_address_plan", "read").GetDiag()
+	}
+	subnets, err := expandAddressPlanRanges(d.Get("subnets").([]interface{}), addressPlanSourceProposedSubnet)
+	if err != nil {
+		return flex.TerraformErrorf(err, err.Error(), "(Data) ibm_is_vpc_address_plan", "read").GetDiag()
+	}
+
+	conflicts := make([]map[string]interface{}, 0)
+	for _, c := range checkAddressPlan(used, prefixes, subnets) {
+		conflicts = append(conflicts, map[string]interface{}{
+			"name":             c.name,
+			"cidr":             c.cidr,
+			"conflicting_cidr": c.conflictingCIDR,
+			"source":           c.source,
+			"source_id":        c.sourceID,
+			"reason":           c.reason,
+		})
+	}
+
+	allocated := append(append(append([]*addressPlanRange{}, used...), prefixes...), subnets...)
+	freeRanges := make([]map[string]interface{}, 0)
+	for _, request := range d.Get("free_range_requests").([]interface{}) {
+		values := request.(map[string]interface{})
+		name := values["name"].(string)
+		within, bits, err := parseAddressRange(values["within"].(string))
+		if err != nil {
+			return flex.TerraformErrorf(err, fmt.Sprintf("free range request %s: %s", name, err), "(Data) ibm_is_vpc_address_plan", "read").GetDiag()
+		}
+		prefixLength := values["prefix_length"].(int)
+		if prefixLength < bits {
+			err = fmt.Errorf("free range request %s: prefix_length %d is shorter than the prefix length %d of %s", name, prefixLength, bits, values["within"].(string))
+			return flex.TerraformErrorf(err, err.Error(), "(Data) ibm_is_vpc_address_plan", "read").GetDiag()
+		}
+		cidrs := findFreeRanges(allocated, within, prefixLength, values["count"].(int))
+		if len(cidrs) < values["count"].(int) {
+			log.Printf("[WARN] Free range request %s found %d of %d free /%d ranges within %s", name, len(cidrs), values["count"].(int), prefixLength, values["within"].(string))
+		}
+		for _, cidr := range cidrs {
+			freeRange, _ := newAddressPlanRange(name, cidr, "", addressPlanSourceFreeRange, name)
+			allocated = append(allocated, freeRange)
+		}
+		freeRanges = append(freeRanges, map[string]interface{}{
+			"name":  name,
+			"cidrs": cidrs,
+		})
+	}
+
+	d.SetId(dataSourceIBMIsVPCAddressPlanID(d))
+	if err = d.Set("valid", len(conflicts) == 0); err != nil {
+		return flex.DiscriminatedTerraformErrorf(err, fmt.Sprintf("Error setting valid: %s", err), "(Data) ibm_is_vpc_address_plan", "read", "set-valid").GetDiag()
+	}
+	if err = d.Set("conflicts", conflicts); err != nil {
+		return flex.DiscriminatedTerraformErrorf(err, fmt.Sprintf("Error setting conflicts: %s", err), "(Data) ibm_is_vpc_address_plan", "read", "set-conflicts").GetDiag()
+	}
+	if err = d.Set("free_ranges", freeRanges); err != nil {
+		return flex.DiscriminatedTerraformErrorf(err, fmt.Sprintf("Error setting free_ranges: %s", err), "(Data) ibm_is_vpc_address_plan", "read", "set-free_ranges").GetDiag()
+	}
+	return nil
+}
+
+// dataSourceIBMIsVPCAddressPlanID returns a reasonable ID for the address plan.
+func dataSourceIBMIsVPCAddressPlanID(d *schema.ResourceData) string {
+	return time.Now().UTC().String()
+}
+
+func expandAddressPlanRanges(list []interface{}, source string) ([]*addressPlanRange, error) {
+	ranges := make([]*addressPlanRange, 0, len(list))
+	for _, item := range list {
+		values := item.(map[string]interface{})
+		name := values["name"].(string)
+		proposed, err := newAddressPlanRange(name, values["cidr"].(string), values["vpc"].(string), source, name)
+		if err != nil {
+			return nil, fmt.Errorf("%s %s: %s", source, name, err)
+		}
+		ranges = append(ranges, proposed)
+	}
+	return ranges, nil
+}
+
+func listAddressPlanVPCs(context context.Context, sess *vpcv1.VpcV1) ([]string, error) {
+	vpcIDs := make([]string, 0)
+	start := ""
+	for {
+		listVpcsOptions := &vpcv1.ListVpcsOptions{}
+		if start != "" {
+			listVpcsOptions.Start = &start
+		}
+		vpcs, _, err := sess.ListVpcsWithContext(context, listVpcsOptions)
+		if err != nil {
+			return nil, err
+		}
+		for _, vpc := range vpcs.Vpcs {
+			vpcIDs = append(vpcIDs, *vpc.ID)
+		}
+		start = flex.GetNext(vpcs.Next)
+		if start == "" {
+			break
+		}
+	}
+	return vpcIDs, nil
+}
+
+// listAddressPlanVPCRanges returns the IPv4 address prefixes and subnets of the VPCs
+func listAddressPlanVPCRanges(context context.Context, sess *vpcv1.VpcV1, vpcIDs []string) ([]*addressPlanRange, error) {
+	ranges := make([]*addressPlanRange, 0)
+	for _, vpcID := range vpcIDs {
+		start := ""
+		for {
+			listVpcAddressPrefixesOptions := &vpcv1.ListVPCAddressPrefixesOptions{
+				VPCID: &vpcID,
+			}
+			if start != "" {
+				listVpcAddressPrefixesOptions.Start = &start
+			}
+			addressPrefixes, _, err := sess.ListVPCAddressPrefixesWithContext(context, listVpcAddressPrefixesOptions)
+			if err != nil {
+				return nil, fmt.Errorf("ListVPCAddressPrefixesWithContext failed for VPC %s: %s", vpcID, err)
+			}
+			for _, addressPrefix := range addressPrefixes.AddressPrefixes {
+				if prefix, err := newAddressPlanRange(*addressPrefix.Name, *addressPrefix.CIDR, vpcID, addressPlanSourceAddressPrefix, *addressPrefix.ID); err == nil {
+					ranges = append(ranges, prefix)
+				}
+			}
+			start = flex.GetNext(addressPrefixes.Next)
+			if start == "" {
+				break
+			}
+		}
+
+		start = ""
+		for {
+			listSubnetsOptions := &vpcv1.ListSubnetsOptions{
+				VPCID: &vpcID,
+			}
+			if start != "" {
+				listSubnetsOptions.Start = &start
+			}
+			subnets, _, err := sess.ListSubnetsWithContext(context, listSubnetsOptions)
+			if err != nil {
+				return nil, fmt.Errorf("ListSubnetsWithContext failed for VPC %s: %s", vpcID, err)
+			}
+			for _, subnet := range subnets.Subnets {
+				if subnet.Ipv4CIDRBlock == nil {
+					continue
+				}
+				if subnetRange, err := newAddressPlanRange(*subnet.Name, *subnet.Ipv4CIDRBlock, vpcID, addressPlanSourceSubnet, *subnet.ID); err == nil {
+					ranges = append(ranges, subnetRange)
+				}
+			}
+			start = flex.GetNext(subnets.Next)
+			if start == "" {
+				break
+			}
+		}
+	}
+	return ranges, nil
+}
+
+// listAddressPlanTransitGatewayRanges returns the IPv4 connection prefixes of the
+// latest complete route report of the transit gateway
+func listAddressPlanTransitGatewayRanges(context context.Context, client *tg.TransitGatewayApisV1, transitGatewayID string) ([]*addressPlanRange, error) {
+	listTransitGatewayRouteReportsOptions := &tg.ListTransitGatewayRouteReportsOptions{
+		TransitGatewayID: &transitGatewayID,
+	}
+	reports, _, err := client.ListTransitGatewayRouteReportsWithContext(context, listTransitGatewayRouteReportsOptions)
+	if err != nil {
+		return nil, fmt.Errorf("ListTransitGatewayRouteReportsWithContext failed for transit gateway %s: %s", transitGatewayID, err)
+	}
+	var latest *tg.RouteReport
+	for i, report := range reports.RouteReports {
+		if report.Status == nil || *report.Status != tg.RouteReport_Status_Complete {
+			continue
+		}
+		if latest == nil || time.Time(*report.CreatedAt).After(time.Time(*latest.CreatedAt)) {
+			latest = &reports.RouteReports[i]
+		}
+	}
+	if latest == nil {
+		return nil, fmt.Errorf("transit gateway %s has no complete route report, create one with the ibm_tg_route_report resource", transitGatewayID)
+	}
+
+	ranges := make([]*addressPlanRange, 0)
+	for _, connection := range latest.Connections {
+		prefixes := make([]string, 0, len(connection.Routes)+len(connection.Bgps))
+		for _, route := range connection.Routes {
+			if route.Prefix != nil {
+				prefixes = append(prefixes, *route.Prefix)
+			}
+		}
+		for _, bgp := range connection.Bgps {
+			if bgp.Prefix != nil {
+				prefixes = append(prefixes, *bgp.Prefix)
+			}
+		}
+		for _, prefix := range prefixes {
+			if route, err := newAddressPlanRange(flex.StringValue(connection.Name), prefix, "", addressPlanSourceTransitGateway, transitGatewayID); err == nil {
+				ranges = append(ranges, route)
+			}
+		}
+	}
+	return ranges, nil
+}
+
+// listAddressPlanDirectLinkRanges returns the IPv4 routes of the latest complete
+// route report of the direct link gateway
+func listAddressPlanDirectLinkRanges(context context.Context, client *dl.DirectLinkV1, gatewayID string) ([]*addressPlanRange, error) {
+	listGatewayRouteReportsOptions := &dl.ListGatewayRouteReportsOptions{
+		GatewayID: &gatewayID,
+	}
+	reports, _, err := client.ListGatewayRouteReportsWithContext(context, listGatewayRouteReportsOptions)
+	if err != nil {
+		return nil, fmt.Errorf("ListGatewayRouteReportsWithContext failed for direct link gateway %s: %s", gatewayID, err)
+	}
+	var latest *dl.RouteReport
+	for i, report := range reports.RouteReports {
+		if report.Status == nil || *report.Status != dl.RouteReport_Status_Complete {
+			continue
+		}
+		if latest == nil || time.Time(*report.CreatedAt).After(time.Time(*latest.CreatedAt)) {
+			latest = &reports.RouteReports[i]
+		}
+	}
+	if latest == nil {
+		return nil, fmt.Errorf("direct link gateway %s has no complete route report, create one with the ibm_dl_route_report resource", gatewayID)
+	}
+
+	prefixes := make([]string, 0)
+	for _, route := range latest.OnPremRoutes {
+		if route.Prefix != nil {
+			prefixes = append(prefixes, *route.Prefix)
+		}
+	}
+	for _, route := range latest.GatewayRoutes {
+		if route.Prefix != nil {
+			prefixes = append(prefixes, *route.Prefix)
+		}
+	}
+	for _, connection := range latest.VirtualConnectionRoutes {
+		for _, route := range connection.Routes {
+			if route.Prefix != nil {
+				prefixes = append(prefixes, *route.Prefix)
+			}
+		}
+	}
+	ranges := make([]*addressPlanRange, 0, len(prefixes))
+	for _, prefix := range prefixes {
+		if route, err := newAddressPlanRange("", prefix, "", addressPlanSourceDirectLink, gatewayID); err == nil {
+			ranges = append(ranges, route)
+		}
+	}
+	return ranges, nil
+}
@@ -0,0 +1,234 @@
+// Copyright IBM Corp. 2025 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package vpc
+
+import (
+	"reflect"
+	"testing"
+)
+
+func testAddressPlanRange(t *testing.T, name, cidr, vpc, source, sourceID string) *addressPlanRange {
+	t.Helper()
+	r, err := newAddressPlanRange(name, cidr, vpc, source, sourceID)
+	if err != nil {
+		t.Fatalf("newAddressPlanRange(%s) failed: %s", cidr, err)
+	}
+	return r
+}
+
+func TestCheckAddressPlan(t *testing.T) {
+	used := []*addressPlanRange{
+		testAddressPlanRange(t, "", "169.254.0.0/16", "", addressPlanSourceReserved, ""),
+		testAddressPlanRange(t, "", "10.10.0.0/16", "vpc-a", addressPlanSourceAddressPrefix, "prefix-a"),
+		testAddressPlanRange(t, "", "10.20.0.0/16", "vpc-b", addressPlanSourceAddressPrefix, "prefix-b"),
+		testAddressPlanRange(t, "", "10.10.1.0/24", "vpc-a", addressPlanSourceSubnet, "subnet-a"),
+		testAddressPlanRange(t, "", "172.16.0.0/16", "", addressPlanSourceTransitGateway, "tgw"),
+	}
+
+	tests := []struct {
+		name     string
+		prefixes [][3]string
+		subnets  [][3]string
+		want     []string
+	}{
+		{
+			name:     "free address prefix",
+			prefixes: [][3]string{{"p1", "10.30.0.0/16", "vpc-a"}},
+			want:     []string{},
+		},
+		{
+			name:     "address prefix in a reserved range",
+			prefixes: [][3]string{{"p1", "169.254.10.0/24", "vpc-a"}},
+			want:     []string{"p1: address prefix 169.254.10.0/24 overlaps reserved 169.254.0.0/16"},
+		},
+		{
+			name:     "existing address prefix of the VPC",
+			prefixes: [][3]string{{"p1", "10.10.0.0/16", "vpc-a"}},
+			want:     []string{},
+		},
+		{
+			name:     "address prefix of another VPC",
+			prefixes: [][3]string{{"p1", "10.20.0.0/16", "vpc-a"}},
+			want:     []string{"p1: address prefix 10.20.0.0/16 overlaps vpc_address_prefix 10.20.0.0/16"},
+		},
+		{
+			name:     "address prefix over a transit gateway route",
+			prefixes: [][3]string{{"p1", "172.16.8.0/22", ""}},
+			want:     []string{"p1: address prefix 172.16.8.0/22 overlaps transit_gateway 172.16.0.0/16"},
+		},
+		{
+			name:     "overlapping proposed address prefixes",
+			prefixes: [][3]string{{"p1", "10.30.0.0/16", "vpc-a"}, {"p2", "10.30.128.0/17", "vpc-a"}},
+			want:     []string{"p2: address prefix 10.30.128.0/17 overlaps proposed address prefix p1"},
+		},
+		{
+			name:    "subnet within an address prefix of its VPC",
+			subnets: [][3]string{{"s1", "10.10.2.0/24", "vpc-a"}},
+			want:    []string{},
+		},
+		{
+			name:    "subnet outside of the address prefixes of its VPC",
+			subnets: [][3]string{{"s1", "10.40.0.0/24", "vpc-a"}},
+			want:    []string{"s1: subnet 10.40.0.0/24 is not within an address prefix of VPC vpc-a"},
+		},
+		{
+			name:    "subnet in an address prefix of another VPC",
+			subnets: [][3]string{{"s1", "10.20.0.0/24", "vpc-a"}},
+			want: []string{
+				"s1: subnet 10.20.0.0/24 overlaps address prefix 10.20.0.0/16 of VPC vpc-b",
+				"s1: subnet 10.20.0.0/24 is not within an address prefix of VPC vpc-a",
+			},
+		},
+		{
+			name:    "subnet over an existing subnet",
+			subnets: [][3]string{{"s1", "10.10.0.0/23", "vpc-a"}},
+			want:    []string{"s1: subnet 10.10.0.0/23 overlaps subnet subnet-a 10.10.1.0/24"},
+		},
+		{
+			name:     "subnet within a proposed address prefix",
+			prefixes: [][3]string{{"p1", "10.30.0.0/16", "vpc-a"}},
+			subnets:  [][3]string{{"s1", "10.30.1.0/24", "vpc-a"}},
+			want:     []string{},
+		},
+		{
+			name:    "subnet without a VPC",
+			subnets: [][3]string{{"s1", "10.20.5.0/24", ""}, {"s2", "172.16.1.0/24", ""}},
+			want:    []string{"s2: subnet 172.16.1.0/24 overlaps transit_gateway 172.16.0.0/16"},
+		},
+		{
+			name:    "overlapping proposed subnets",
+			subnets: [][3]string{{"s1", "10.10.4.0/24", "vpc-a"}, {"s2", "10.10.4.0/25", "vpc-a"}},
+			want:    []string{"s2: subnet 10.10.4.0/25 overlaps proposed subnet s1"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var prefixes, subnets []*addressPlanRange
+			for _, p := range tt.prefixes {
+				prefixes = append(prefixes, testAddressPlanRange(t, p[0], p[1], p[2], addressPlanSourceProposedPrefix, ""))
+			}
+			for _, s := range tt.subnets {
+				subnets = append(subnets, testAddressPlanRange(t, s[0], s[1], s[2], addressPlanSourceProposedSubnet, ""))
+			}
+			got := make([]string, 0)
+			for _, conflict := range checkAddressPlan(used, prefixes, subnets) {
+				got = append(got, conflict.name+": "+conflict.reason)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("checkAddressPlan() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestFindFreeRanges(t *testing.T) {
+	tests := []struct {
+		name         string
+		prefixes     []string
+		used         []string
+		within       string
+		prefixLength int
+		count        int
+		want         []string
+	}{
+		{
+			name:         "empty range",
+			within:       "10.0.0.0/16",
+			prefixLength: 24,
+			count:        2,
+			want:         []string{"10.0.0.0/24", "10.0.1.0/24"},
+		},
+		{
+			name:         "first block in use",
+			used:         []string{"10.0.0.0/24"},
+			within:       "10.0.0.0/16",
+			prefixLength: 24,
+			count:        2,
+			want:         []string{"10.0.1.0/24", "10.0.2.0/24"},
+		},
+		{
+			name:         "part of a block in use",
+			used:         []string{"10.0.0.128/25", "10.0.1.64/26"},
+			within:       "10.0.0.0/16",
+			prefixLength: 24,
+			count:        1,
+			want:         []string{"10.0.2.0/24"},
+		},
+		{
+			name:         "address prefix that contains the whole range",
+			prefixes:     []string{"10.0.0.0/8"},
+			within:       "10.0.0.0/16",
+			prefixLength: 24,
+			count:        1,
+			want:         []string{"10.0.0.0/24"},
+		},
+		{
+			name:         "address prefix with a subnet in use",
+			prefixes:     []string{"10.0.0.0/16"},
+			used:         []string{"10.0.0.0/24"},
+			within:       "10.0.0.0/16",
+			prefixLength: 24,
+			count:        1,
+			want:         []string{"10.0.1.0/24"},
+		},
+		{
+			name:         "subnet that covers the whole address prefix",
+			prefixes:     []string{"10.0.0.0/24"},
+			used:         []string{"10.0.0.0/24"},
+			within:       "10.0.0.0/24",
+			prefixLength: 26,
+			count:        1,
+			want:         []string{},
+		},
+		{
+			name:         "route that contains the whole range",
+			used:         []string{"10.0.0.0/8"},
+			within:       "10.0.0.0/16",
+			prefixLength: 24,
+			count:        1,
+			want:         []string{},
+		},
+		{
+			name:         "exhausted range",
+			used:         []string{"10.0.0.0/24"},
+			within:       "10.0.0.0/23",
+			prefixLength: 24,
+			count:        3,
+			want:         []string{"10.0.1.0/24"},
+		},
+		{
+			name:         "block larger than the range",
+			within:       "10.0.0.0/24",
+			prefixLength: 16,
+			count:        1,
+			want:         []string{},
+		},
+		{
+			name:         "end of the address space",
+			used:         []string{"255.255.255.0/25"},
+			within:       "255.255.255.0/24",
+			prefixLength: 25,
+			count:        2,
+			want:         []string{"255.255.255.128/25"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			used := make([]*addressPlanRange, 0, len(tt.prefixes)+len(tt.used))
+			for _, cidr := range tt.prefixes {
+				used = append(used, testAddressPlanRange(t, "", cidr, "r006-vpc", addressPlanSourceAddressPrefix, ""))
+			}
+			for _, cidr := range tt.used {
+				used = append(used, testAddressPlanRange(t, "", cidr, "", addressPlanSourceSubnet, ""))
+			}
+			within, _, err := parseAddressRange(tt.within)
+			if err != nil {
+				t.Fatalf("parseAddressRange(%s) failed: %s", tt.within, err)
+			}
+			if got := findFreeRanges(used, within, tt.prefixLength, tt.count); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("findFreeRanges() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,69 @@
+// Copyright IBM Corp. 2025 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package vpc_test
+
+import (
+	"fmt"
+	"testing"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccIBMIsVPCAddressPlanDataSourceBasic(t *testing.T) {
+	name := fmt.Sprintf("tfvpcplan-%d", acctest.RandIntRange(10, 100))
+	prefixName := fmt.Sprintf("tfaddplanprefix-%d", acctest.RandIntRange(10, 100))
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMIsVPCAddressPlanDataSourceConfigBasic(name, prefixName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.ibm_is_vpc_address_plan.is_vpc_address_plan", "id"),
+					resource.TestCheckResourceAttr("data.ibm_is_vpc_address_plan.is_vpc_address_plan", "valid", "false"),
+					resource.TestCheckResourceAttr("data.ibm_is_vpc_address_plan.is_vpc_address_plan", "conflicts.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs("data.ibm_is_vpc_address_plan.is_vpc_address_plan", "conflicts.*", map[string]string{
+						"name":   "overlapping",
+						"source": "address_prefixes",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs("data.ibm_is_vpc_address_plan.is_vpc_address_plan", "conflicts.*", map[string]string{
+						"name":   "reserved",
+						"source": "reserved",
+					}),
+					resource.TestCheckResourceAttr("data.ibm_is_vpc_address_plan.is_vpc_address_plan", "free_ranges.0.cidrs.#", "2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIBMIsVPCAddressPlanDataSourceConfigBasic(name, prefixName string) string {
+	return testAccCheckIBMISVPCAddressPrefixConfig(name, prefixName) + `
+		data "ibm_is_vpc_address_plan" "is_vpc_address_plan" {
+			vpcs = [ibm_is_vpc_address_prefix.testacc_vpc_address_prefix.vpc]
+			address_prefixes {
+				name = "existing"
+				cidr = ibm_is_vpc_address_prefix.testacc_vpc_address_prefix.cidr
+				vpc  = ibm_is_vpc.testacc_vpc.id
+			}
+			address_prefixes {
+				name = "overlapping"
+				cidr = ibm_is_vpc_address_prefix.testacc_vpc_address_prefix.cidr
+			}
+			subnets {
+				name = "reserved"
+				cidr = "169.254.10.0/24"
+			}
+			free_range_requests {
+				name          = "subnets"
+				within        = ibm_is_vpc_address_prefix.testacc_vpc_address_prefix.cidr
+				prefix_length = 28
+				count         = 2
+			}
+		}
+	`
+}
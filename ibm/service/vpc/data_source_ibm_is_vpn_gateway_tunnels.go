@@ -0,0 +1,243 @@
+// Copyright IBM Corp. 2025 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package vpc
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/IBM/vpc-go-sdk/vpcv1"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func DataSourceIBMISVPNGatewayTunnels() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceIBMISVPNGatewayTunnelsRead,
+
+		Schema: map[string]*schema.Schema{
+			isVPNGatewayID: {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The VPN gateway identifier.",
+			},
+			"healthy": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Indicates whether every connection with admin_state_up true is up and, in static route mode, has at least one tunnel up.",
+			},
+			"tunnels_up": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The number of tunnels up across all the connections.",
+			},
+			"tunnels_down": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The number of tunnels down across all the connections.",
+			},
+			isvpnGatewayConnections: {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The connections of the VPN gateway with the status of their tunnels.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The unique identifier for this VPN gateway connection.",
+						},
+						"name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The name for this VPN gateway connection.",
+						},
+						"mode": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The mode of the VPN gateway connection: policy or route.",
+						},
+						"admin_state_up": {
+							Type:        schema.TypeBool,
+							Computed:    true,
+							Description: "If set to false, the VPN gateway connection is shut down.",
+						},
+						"status": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The status of the VPN gateway connection.",
+						},
+						"status_reasons": dataSourceIBMISVPNGatewayTunnelsStatusReasonsSchema("The reasons for the current status of the connection (if any)."),
+						"peer_address": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The IP address of the peer VPN gateway, if the peer is specified by address.",
+						},
+						"peer_fqdn": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The FQDN of the peer VPN gateway, if the peer is specified by FQDN.",
+						},
+						"healthy": {
+							Type:        schema.TypeBool,
+							Computed:    true,
+							Description: "Indicates whether the connection is up and, in static route mode, has at least one tunnel up.",
+						},
+						"tunnels": {
+							Type:        schema.TypeList,
+							Computed:    true,
+							Description: "The tunnels of the connection (in static route mode).",
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"public_ip_address": {
+										Type:        schema.TypeString,
+										Computed:    true,
+										Description: "The IP address of the VPN gateway member in which the tunnel resides.",
+									},
+									"status": {
+										Type:        schema.TypeString,
+										Computed:    true,
+										Description: "The status of the tunnel.",
+									},
+									"status_reasons": dataSourceIBMISVPNGatewayTunnelsStatusReasonsSchema("The reasons for the current status of the tunnel (if any)."),
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceIBMISVPNGatewayTunnelsStatusReasonsSchema(description string) *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeList,
+		Computed:    true,
+		Description: description,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"code": {
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: "A snake case string succinctly identifying the status reason.",
+				},
+				"message": {
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: "An explanation of the status reason.",
+				},
+				"more_info": {
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: "Link to documentation about this status reason.",
+				},
+			},
+		},
+	}
+}
+
+func dataSourceIBMISVPNGatewayTunnelsRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	sess, err := vpcClient(meta)
+	if err != nil {
+		tfErr := flex.DiscriminatedTerraformErrorf(err, err.Error(), "(Data) ibm_is_vpn_gateway_tunnels", "read", "initialize-client")
+		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+		return tfErr.GetDiag()
+	}
+	vpnGatewayID := d.Get(isVPNGatewayID).(string)
+
+	allrecs := []vpcv1.VPNGatewayConnectionIntf{}
+	start := ""
+	for {
+		listVPNGatewayConnectionsOptions := sess.NewListVPNGatewayConnectionsOptions(vpnGatewayID)
+		if start != "" {
+			listVPNGatewayConnectionsOptions.Start = &start
+		}
+		collection, _, err := sess.ListVPNGatewayConnectionsWithContext(context, listVPNGatewayConnectionsOptions)
+		if err != nil {
+			tfErr := flex.TerraformErrorf(err, fmt.Sprintf("ListVPNGatewayConnectionsWithContext failed: %s", err.Error()), "(Data) ibm_is_vpn_gateway_tunnels", "read")
+			log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+			return tfErr.GetDiag()
+		}
+		allrecs = append(allrecs, collection.Connections...)
+		start = flex.GetNext(collection.Next)
+		if start == "" {
+			break
+		}
+	}
+
+	healthy := true
+	tunnelsUp, tunnelsDown := 0, 0
+	connections := make([]map[string]interface{}, 0, len(allrecs))
+	for _, vpnGatewayConnectionIntf := range allrecs {
+		health := getVPNGatewayConnectionHealth(vpnGatewayConnectionIntf)
+		if health.adminStateUp && !health.healthy() {
+			healthy = false
+		}
+		tunnels := make([]map[string]interface{}, 0, len(health.tunnels))
+		for _, tunnel := range health.tunnels {
+			tunnelMap := map[string]interface{}{
+				"status":         tunnel.Status,
+				"status_reasons": dataSourceIBMISVPNGatewayTunnelsFlattenTunnelStatusReasons(tunnel.StatusReasons),
+			}
+			if tunnel.PublicIP != nil {
+				tunnelMap["public_ip_address"] = tunnel.PublicIP.Address
+			}
+			tunnels = append(tunnels, tunnelMap)
+		}
+		up := health.tunnelsUp()
+		tunnelsUp += up
+		tunnelsDown += len(health.tunnels) - up
+		connections = append(connections, map[string]interface{}{
+			"id":             health.id,
+			"name":           health.name,
+			"mode":           health.mode,
+			"admin_state_up": health.adminStateUp,
+			"status":         health.status,
+			"status_reasons": resourceVPNGatewayConnectionFlattenLifecycleReasons(health.statusReasons),
+			"peer_address":   health.peerAddress,
+			"peer_fqdn":      health.peerFqdn,
+			"healthy":        health.healthy(),
+			"tunnels":        tunnels,
+		})
+	}
+
+	d.SetId(dataSourceIBMISVPNGatewayTunnelsID(d))
+	if err = d.Set("healthy", healthy); err != nil {
+		return flex.DiscriminatedTerraformErrorf(err, fmt.Sprintf("Error setting healthy: %s", err), "(Data) ibm_is_vpn_gateway_tunnels", "read", "set-healthy").GetDiag()
+	}
+	if err = d.Set("tunnels_up", tunnelsUp); err != nil {
+		return flex.DiscriminatedTerraformErrorf(err, fmt.Sprintf("Error setting tunnels_up: %s", err), "(Data) ibm_is_vpn_gateway_tunnels", "read", "set-tunnels_up").GetDiag()
+	}
+	if err = d.Set("tunnels_down", tunnelsDown); err != nil {
+		return flex.DiscriminatedTerraformErrorf(err, fmt.Sprintf("Error setting tunnels_down: %s", err), "(Data) ibm_is_vpn_gateway_tunnels", "read", "set-tunnels_down").GetDiag()
+	}
+	if err = d.Set(isvpnGatewayConnections, connections); err != nil {
+		return flex.DiscriminatedTerraformErrorf(err, fmt.Sprintf("Error setting connections: %s", err), "(Data) ibm_is_vpn_gateway_tunnels", "read", "set-connections").GetDiag()
+	}
+	return nil
+}
+
+func dataSourceIBMISVPNGatewayTunnelsFlattenTunnelStatusReasons(statusReasons []vpcv1.VPNGatewayConnectionTunnelStatusReason) []map[string]interface{} {
+	statusReasonsList := make([]map[string]interface{}, 0, len(statusReasons))
+	for _, statusReason := range statusReasons {
+		statusReasonMap := map[string]interface{}{
+			"code":    statusReason.Code,
+			"message": statusReason.Message,
+		}
+		if statusReason.MoreInfo != nil {
+			statusReasonMap["more_info"] = statusReason.MoreInfo
+		}
+		statusReasonsList = append(statusReasonsList, statusReasonMap)
+	}
+	return statusReasonsList
+}
+
+// dataSourceIBMISVPNGatewayTunnelsID returns a reasonable ID for the tunnel status.
+func dataSourceIBMISVPNGatewayTunnelsID(d *schema.ResourceData) string {
+	return time.Now().UTC().String()
+}
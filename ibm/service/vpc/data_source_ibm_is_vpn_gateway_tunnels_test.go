@@ -0,0 +1,71 @@
+// Copyright IBM Corp. 2025 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package vpc_test
+
+import (
+	"fmt"
+	"testing"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccIBMISVpnGatewayTunnelsDataSource_basic(t *testing.T) {
+	var vpnGatewayConnection string
+	node := "data.ibm_is_vpn_gateway_tunnels.test1"
+	vpcname := fmt.Sprintf("tfvpnuat-vpc-%d", acctest.RandIntRange(100, 200))
+	subnetname := fmt.Sprintf("tfvpnuat-subnet-%d", acctest.RandIntRange(100, 200))
+	vpngwname := fmt.Sprintf("tfvpnuat-vpngw-%d", acctest.RandIntRange(100, 200))
+	name := fmt.Sprintf("tfvpnuat-createname-%d", acctest.RandIntRange(100, 200))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMISVpnGatewayTunnelsDataSourceConfig(vpcname, subnetname, vpngwname, name),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIBMISVPNGatewayConnectionExists("ibm_is_vpn_gateway_connection.testacc_VPNGatewayConnection", vpnGatewayConnection),
+					resource.TestCheckResourceAttr(node, "connections.#", "1"),
+					resource.TestCheckResourceAttr(node, "connections.0.name", name),
+					resource.TestCheckResourceAttr(node, "connections.0.peer_address", "1.2.3.4"),
+					resource.TestCheckResourceAttr(node, "connections.0.tunnels.#", "2"),
+					resource.TestCheckResourceAttrSet(node, "connections.0.tunnels.0.status"),
+					resource.TestCheckResourceAttrSet(node, "healthy"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIBMISVpnGatewayTunnelsDataSourceConfig(vpc, subnet, vpngwname, name string) string {
+	return fmt.Sprintf(`
+	resource "ibm_is_vpc" "testacc_vpc" {
+		name = "%s"
+	}
+	resource "ibm_is_subnet" "testacc_subnet" {
+		name = "%s"
+		vpc = ibm_is_vpc.testacc_vpc.id
+		zone = "%s"
+		ipv4_cidr_block = "%s"
+	}
+	resource "ibm_is_vpn_gateway" "testacc_vpnGateway" {
+		name = "%s"
+		subnet = ibm_is_subnet.testacc_subnet.id
+		mode = "route"
+	}
+	resource "ibm_is_vpn_gateway_connection" "testacc_VPNGatewayConnection" {
+		name = "%s"
+		vpn_gateway = ibm_is_vpn_gateway.testacc_vpnGateway.id
+		preshared_key = "VPNDemoPassword"
+		peer {
+			address = "1.2.3.4"
+		}
+	}
+	data "ibm_is_vpn_gateway_tunnels" "test1" {
+		vpn_gateway = ibm_is_vpn_gateway_connection.testacc_VPNGatewayConnection.vpn_gateway
+	}`, vpc, subnet, acc.ISZoneName, acc.ISCIDR, vpngwname, name)
+}
@@ -17,6 +17,7 @@ import (
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
 	"github.com/IBM/go-sdk-core/v5/core"
 	"github.com/IBM/vpc-go-sdk/vpcv1"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -133,11 +134,11 @@ const (
 
 func ResourceIBMISInstance() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceIBMisInstanceCreate,
-		Read:   resourceIBMisInstanceRead,
-		Update: resourceIBMisInstanceUpdate,
-		Delete: resourceIBMisInstanceDelete,
-		Exists: resourceIBMisInstanceExists,
+		CreateContext: resourceIBMisInstanceCreate,
+		Read:          resourceIBMisInstanceRead,
+		UpdateContext: resourceIBMisInstanceUpdate,
+		Delete:        resourceIBMisInstanceDelete,
+		Exists:        resourceIBMisInstanceExists,
 		Importer: &schema.ResourceImporter{
 			State: func(d *schema.ResourceData, meta interface{}) (result []*schema.ResourceData, err error) {
 				log.Printf("[INFO] Instance (%s) importing", d.Id())
@@ -180,7 +181,7 @@ func ResourceIBMISInstance() *schema.Resource {
 		CustomizeDiff: customdiff.All(
 			customdiff.Sequence(
 				func(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
-					return flex.ResourceTagsCustomizeDiff(diff)
+					return flex.ResourceTagsCustomizeDiff(diff, v)
 				}),
 			customdiff.Sequence(
 				func(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
@@ -4885,7 +4886,7 @@ func instanceCreateByVolume(d *schema.ResourceData, meta interface{}, profile, n
 	return nil
 }
 
-func resourceIBMisInstanceCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMisInstanceCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 
 	profile := d.Get(isInstanceProfile).(string)
 	name := d.Get(isInstanceName).(string)
@@ -4904,32 +4905,32 @@ func resourceIBMisInstanceCreate(d *schema.ResourceData, meta interface{}) error
 		planCrn, _ := catalogOffering[isInstanceCatalogOfferingPlanCrn].(string)
 		err := instanceCreateByCatalogOffering(d, meta, profile, name, vpcID, zone, image, offeringCrn, versionCrn, planCrn)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 
 	} else if volume != "" {
 		err := instanceCreateByVolume(d, meta, profile, name, vpcID, zone)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	} else if snapshot != "" || snapshotcrn != "" {
 		err := instanceCreateBySnapshot(d, meta, profile, name, vpcID, zone)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	} else if template != "" {
 		err := instanceCreateByTemplate(d, meta, profile, name, vpcID, zone, image, template)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	} else {
 		err := instanceCreateByImage(d, meta, profile, name, vpcID, zone, image, bootProfile)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
-	return resourceIBMisInstanceUpdate(d, meta)
+	return resourceIBMisInstanceUpdate(context, d, meta)
 }
 
 func isWaitForInstanceAvailable(instanceC *vpcv1.VpcV1, id string, timeout time.Duration, d *schema.ResourceData) (interface{}, error) {
@@ -5596,21 +5597,21 @@ func instanceGet(d *schema.ResourceData, meta interface{}, id string) error {
 	return nil
 }
 
-func instanceUpdate(d *schema.ResourceData, meta interface{}) error {
+func instanceUpdate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	instanceC, err := vpcClient(meta)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	id := d.Id()
 	// network attachments
 
 	err = handleVolumePrototypesUpdate(d, instanceC)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	err = handleClusterNetworkAttachmentUpdate(d, instanceC)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if d.HasChange("network_attachments") && !d.IsNewResource() {
@@ -5643,7 +5644,7 @@ func instanceUpdate(d *schema.ResourceData, meta interface{}) error {
 						}
 						res, err := instanceC.DeleteInstanceNetworkAttachment(deleteInstanceNetworkAttachmentOptions)
 						if err != nil {
-							return fmt.Errorf("[ERROR] Error while deleting network attachment(%s) of instance(%s) \n%s: %q", nacIdStr, d.Id(), err, res)
+							return diag.FromErr(fmt.Errorf("[ERROR] Error while deleting network attachment(%s) of instance(%s) \n%s: %q", nacIdStr, d.Id(), err, res))
 						}
 					}
 				}
@@ -5664,7 +5665,7 @@ func instanceUpdate(d *schema.ResourceData, meta interface{}) error {
 				nacMap := nac.(map[string]interface{})
 				VirtualNetworkInterfaceModel, err := resourceIBMIsInstanceMapToVirtualNetworkInterfacePrototypeAttachmentContext(allowipspoofing, autodelete, enablenat, d, nacMap["virtual_network_interface"].([]interface{})[0].(map[string]interface{}))
 				if err != nil {
-					return err
+					return diag.FromErr(err)
 				}
 				nacNameStr := nacMap["name"].(string)
 				createInstanceNetworkAttachmentOptions := &vpcv1.CreateInstanceNetworkAttachmentOptions{
@@ -5674,7 +5675,7 @@ func instanceUpdate(d *schema.ResourceData, meta interface{}) error {
 				}
 				_, res, err := instanceC.CreateInstanceNetworkAttachment(createInstanceNetworkAttachmentOptions)
 				if err != nil {
-					return fmt.Errorf("[ERROR] Error while creating network attachment(%s) of instance(%s) \n%s: %q", nacNameStr, d.Id(), err, res)
+					return diag.FromErr(fmt.Errorf("[ERROR] Error while creating network attachment(%s) of instance(%s) \n%s: %q", nacNameStr, d.Id(), err, res))
 				}
 			} else {
 				log.Printf("[DEBUG] nacId is not empty")
@@ -5693,12 +5694,12 @@ func instanceUpdate(d *schema.ResourceData, meta interface{}) error {
 					}
 					instanceNetworkAttachmentPatchAsPatch, err := instanceNetworkAttachmentPatch.AsPatch()
 					if err != nil {
-						return (fmt.Errorf("[ERROR] Error encountered while apply as patch for instanceNetworkAttachmentPatchAsPatch of network attachment(%s) of instance(%s) %s", nacId, id, err))
+						return diag.FromErr((fmt.Errorf("[ERROR] Error encountered while apply as patch for instanceNetworkAttachmentPatchAsPatch of network attachment(%s) of instance(%s) %s", nacId, id, err)))
 					}
 					updateInstanceNetworkAttachmentOptions.InstanceNetworkAttachmentPatch = instanceNetworkAttachmentPatchAsPatch
 					_, res, err := instanceC.UpdateInstanceNetworkAttachment(updateInstanceNetworkAttachmentOptions)
 					if err != nil {
-						return (fmt.Errorf("[ERROR] Error encountered while updating network attachment(%s) name of instance(%s) %s/n%s", nacId, id, err, res))
+						return diag.FromErr((fmt.Errorf("[ERROR] Error encountered while updating network attachment(%s) name of instance(%s) %s/n%s", nacId, id, err, res)))
 					}
 					// output, err := json.MarshalIndent(updateInstanceNetworkAttachmentOptions, "", "    ")
 					// if err == nil {
@@ -5741,13 +5742,13 @@ func instanceUpdate(d *schema.ResourceData, meta interface{}) error {
 					}
 					virtualNetworkInterfacePatchAsPatch, err := virtualNetworkInterfacePatch.AsPatch()
 					if err != nil {
-						return fmt.Errorf("[ERROR] Error encountered while apply as patch for virtualNetworkInterfacePatch of instance(%s) vni (%s) %s", d.Id(), vniId, err)
+						return diag.FromErr(fmt.Errorf("[ERROR] Error encountered while apply as patch for virtualNetworkInterfacePatch of instance(%s) vni (%s) %s", d.Id(), vniId, err))
 					}
 					updateVirtualNetworkInterfaceOptions.VirtualNetworkInterfacePatch = virtualNetworkInterfacePatchAsPatch
 					_, response, err := instanceC.UpdateVirtualNetworkInterface(updateVirtualNetworkInterfaceOptions)
 					if err != nil {
 						log.Printf("[DEBUG] UpdateVirtualNetworkInterfaceWithContext failed %s\n%s", err, response)
-						return fmt.Errorf("UpdateVirtualNetworkInterfaceWithContext failed during instance(%s) network attachment patch %s\n%s", d.Id(), err, response)
+						return diag.FromErr(fmt.Errorf("UpdateVirtualNetworkInterfaceWithContext failed during instance(%s) network attachment patch %s\n%s", d.Id(), err, response))
 					}
 
 					if d.HasChange(ipsName) {
@@ -5782,7 +5783,7 @@ func instanceUpdate(d *schema.ResourceData, meta interface{}) error {
 									_, response, err := instanceC.AddVirtualNetworkInterfaceIP(addVirtualNetworkInterfaceIPOptions)
 									if err != nil {
 										log.Printf("[DEBUG] AddVirtualNetworkInterfaceIPWithContext failed in VirtualNetworkInterface patch during instance nac patch %s\n%s", err, response)
-										return fmt.Errorf("AddVirtualNetworkInterfaceIPWithContext failed in VirtualNetworkInterface patch during instance nac patch %s\n%s", err, response)
+										return diag.FromErr(fmt.Errorf("AddVirtualNetworkInterfaceIPWithContext failed in VirtualNetworkInterface patch during instance nac patch %s\n%s", err, response))
 									}
 								}
 							}
@@ -5796,7 +5797,7 @@ func instanceUpdate(d *schema.ResourceData, meta interface{}) error {
 									response, err := instanceC.RemoveVirtualNetworkInterfaceIP(removeVirtualNetworkInterfaceIPOptions)
 									if err != nil {
 										log.Printf("[DEBUG] RemoveVirtualNetworkInterfaceIPWithContext failed in VirtualNetworkInterface patch during instance nac patch %s\n%s", err, response)
-										return fmt.Errorf("RemoveVirtualNetworkInterfaceIPWithContext failed in VirtualNetworkInterface patch during instance nac patch %s\n%s", err, response)
+										return diag.FromErr(fmt.Errorf("RemoveVirtualNetworkInterfaceIPWithContext failed in VirtualNetworkInterface patch during instance nac patch %s\n%s", err, response))
 									}
 								}
 							}
@@ -5825,12 +5826,12 @@ func instanceUpdate(d *schema.ResourceData, meta interface{}) error {
 						}
 						reservedIpPathAsPatch, err := reservedIpPath.AsPatch()
 						if err != nil {
-							return fmt.Errorf("[ERROR] Error calling reserved ip as patch on vni patch \n%s", err)
+							return diag.FromErr(fmt.Errorf("[ERROR] Error calling reserved ip as patch on vni patch \n%s", err))
 						}
 						updateripoptions.ReservedIPPatch = reservedIpPathAsPatch
 						_, response, err := instanceC.UpdateSubnetReservedIP(updateripoptions)
 						if err != nil {
-							return fmt.Errorf("[ERROR] Error updating vni reserved ip(%s): %s\n%s", ripId, err, response)
+							return diag.FromErr(fmt.Errorf("[ERROR] Error updating vni reserved ip(%s): %s\n%s", ripId, err, response))
 						}
 					}
 					if d.HasChange(sgName) {
@@ -5847,11 +5848,11 @@ func instanceUpdate(d *schema.ResourceData, meta interface{}) error {
 								}
 								_, response, err := instanceC.CreateSecurityGroupTargetBinding(createsgnicoptions)
 								if err != nil {
-									return fmt.Errorf("[ERROR] Error while creating security group %q for virtual network interface %s\n%s: %q", add[i], vniId, err, response)
+									return diag.FromErr(fmt.Errorf("[ERROR] Error while creating security group %q for virtual network interface %s\n%s: %q", add[i], vniId, err, response))
 								}
 								_, err = isWaitForVirtualNetworkInterfaceAvailable(instanceC, vniId, d.Timeout(schema.TimeoutUpdate))
 								if err != nil {
-									return err
+									return diag.FromErr(err)
 								}
 							}
 
@@ -5864,11 +5865,11 @@ func instanceUpdate(d *schema.ResourceData, meta interface{}) error {
 								}
 								response, err := instanceC.DeleteSecurityGroupTargetBinding(deletesgnicoptions)
 								if err != nil {
-									return fmt.Errorf("[ERROR] Error while removing security group %q for virtual network interface %s\n%s: %q", remove[i], d.Id(), err, response)
+									return diag.FromErr(fmt.Errorf("[ERROR] Error while removing security group %q for virtual network interface %s\n%s: %q", remove[i], d.Id(), err, response))
 								}
 								_, err = isWaitForVirtualNetworkInterfaceAvailable(instanceC, vniId, d.Timeout(schema.TimeoutUpdate))
 								if err != nil {
-									return err
+									return diag.FromErr(err)
 								}
 							}
 						}
@@ -5898,12 +5899,12 @@ func instanceUpdate(d *schema.ResourceData, meta interface{}) error {
 			}
 			instanceNetworkAttachmentPatchAsPatch, err := instanceNetworkAttachmentPatch.AsPatch()
 			if err != nil {
-				return (fmt.Errorf("[ERROR] Error encountered while apply as patch for instanceNetworkAttachmentPatchAsPatch of pna of instance(%s) %s", id, err))
+				return diag.FromErr((fmt.Errorf("[ERROR] Error encountered while apply as patch for instanceNetworkAttachmentPatchAsPatch of pna of instance(%s) %s", id, err)))
 			}
 			updateInstanceNetworkAttachmentOptions.InstanceNetworkAttachmentPatch = instanceNetworkAttachmentPatchAsPatch
 			_, res, err := instanceC.UpdateInstanceNetworkAttachment(updateInstanceNetworkAttachmentOptions)
 			if err != nil {
-				return (fmt.Errorf("[ERROR] Error encountered while updating pna name of instance(%s) %s/n%s", id, err, res))
+				return diag.FromErr((fmt.Errorf("[ERROR] Error encountered while updating pna name of instance(%s) %s/n%s", id, err, res)))
 			}
 		}
 		if d.HasChange(nacVniName) {
@@ -5942,13 +5943,13 @@ func instanceUpdate(d *schema.ResourceData, meta interface{}) error {
 			}
 			virtualNetworkInterfacePatchAsPatch, err := virtualNetworkInterfacePatch.AsPatch()
 			if err != nil {
-				return fmt.Errorf("[ERROR] Error encountered while apply as patch for virtualNetworkInterfacePatch of instance(%s) vni (%s) %s", d.Id(), vniId, err)
+				return diag.FromErr(fmt.Errorf("[ERROR] Error encountered while apply as patch for virtualNetworkInterfacePatch of instance(%s) vni (%s) %s", d.Id(), vniId, err))
 			}
 			updateVirtualNetworkInterfaceOptions.VirtualNetworkInterfacePatch = virtualNetworkInterfacePatchAsPatch
 			_, response, err := instanceC.UpdateVirtualNetworkInterface(updateVirtualNetworkInterfaceOptions)
 			if err != nil {
 				log.Printf("[DEBUG] UpdateVirtualNetworkInterfaceWithContext failed %s\n%s", err, response)
-				return fmt.Errorf("UpdateVirtualNetworkInterfaceWithContext failed during instance(%s) network attachment patch %s\n%s", d.Id(), err, response)
+				return diag.FromErr(fmt.Errorf("UpdateVirtualNetworkInterfaceWithContext failed during instance(%s) network attachment patch %s\n%s", d.Id(), err, response))
 			}
 
 			if d.HasChange(ipsName) {
@@ -5983,7 +5984,7 @@ func instanceUpdate(d *schema.ResourceData, meta interface{}) error {
 							_, response, err := instanceC.AddVirtualNetworkInterfaceIP(addVirtualNetworkInterfaceIPOptions)
 							if err != nil {
 								log.Printf("[DEBUG] AddVirtualNetworkInterfaceIPWithContext failed in VirtualNetworkInterface patch during instance nac patch %s\n%s", err, response)
-								return fmt.Errorf("AddVirtualNetworkInterfaceIPWithContext failed in VirtualNetworkInterface patch during instance nac patch %s\n%s", err, response)
+								return diag.FromErr(fmt.Errorf("AddVirtualNetworkInterfaceIPWithContext failed in VirtualNetworkInterface patch during instance nac patch %s\n%s", err, response))
 							}
 						}
 					}
@@ -5997,7 +5998,7 @@ func instanceUpdate(d *schema.ResourceData, meta interface{}) error {
 							response, err := instanceC.RemoveVirtualNetworkInterfaceIP(removeVirtualNetworkInterfaceIPOptions)
 							if err != nil {
 								log.Printf("[DEBUG] RemoveVirtualNetworkInterfaceIPWithContext failed in VirtualNetworkInterface patch during instance nac patch %s\n%s", err, response)
-								return fmt.Errorf("RemoveVirtualNetworkInterfaceIPWithContext failed in VirtualNetworkInterface patch during instance nac patch %s\n%s", err, response)
+								return diag.FromErr(fmt.Errorf("RemoveVirtualNetworkInterfaceIPWithContext failed in VirtualNetworkInterface patch during instance nac patch %s\n%s", err, response))
 							}
 						}
 					}
@@ -6026,12 +6027,12 @@ func instanceUpdate(d *schema.ResourceData, meta interface{}) error {
 				}
 				reservedIpPathAsPatch, err := reservedIpPath.AsPatch()
 				if err != nil {
-					return fmt.Errorf("[ERROR] Error calling reserved ip as patch on vni patch \n%s", err)
+					return diag.FromErr(fmt.Errorf("[ERROR] Error calling reserved ip as patch on vni patch \n%s", err))
 				}
 				updateripoptions.ReservedIPPatch = reservedIpPathAsPatch
 				_, response, err := instanceC.UpdateSubnetReservedIP(updateripoptions)
 				if err != nil {
-					return fmt.Errorf("[ERROR] Error updating vni reserved ip(%s): %s\n%s", ripId, err, response)
+					return diag.FromErr(fmt.Errorf("[ERROR] Error updating vni reserved ip(%s): %s\n%s", ripId, err, response))
 				}
 			}
 			if d.HasChange(sgName) {
@@ -6048,11 +6049,11 @@ func instanceUpdate(d *schema.ResourceData, meta interface{}) error {
 						}
 						_, response, err := instanceC.CreateSecurityGroupTargetBinding(createsgnicoptions)
 						if err != nil {
-							return fmt.Errorf("[ERROR] Error while creating security group %q for virtual network interface %s\n%s: %q", add[i], vniId, err, response)
+							return diag.FromErr(fmt.Errorf("[ERROR] Error while creating security group %q for virtual network interface %s\n%s: %q", add[i], vniId, err, response))
 						}
 						_, err = isWaitForVirtualNetworkInterfaceAvailable(instanceC, vniId, d.Timeout(schema.TimeoutUpdate))
 						if err != nil {
-							return err
+							return diag.FromErr(err)
 						}
 					}
 
@@ -6065,11 +6066,11 @@ func instanceUpdate(d *schema.ResourceData, meta interface{}) error {
 						}
 						response, err := instanceC.DeleteSecurityGroupTargetBinding(deletesgnicoptions)
 						if err != nil {
-							return fmt.Errorf("[ERROR] Error while removing security group %q for virtual network interface %s\n%s: %q", remove[i], d.Id(), err, response)
+							return diag.FromErr(fmt.Errorf("[ERROR] Error while removing security group %q for virtual network interface %s\n%s: %q", remove[i], d.Id(), err, response))
 						}
 						_, err = isWaitForVirtualNetworkInterfaceAvailable(instanceC, vniId, d.Timeout(schema.TimeoutUpdate))
 						if err != nil {
-							return err
+							return diag.FromErr(err)
 						}
 					}
 				}
@@ -6079,72 +6080,6 @@ func instanceUpdate(d *schema.ResourceData, meta interface{}) error {
 
 	}
 
-	resPol := "reservation_affinity.0.policy"
-	resPool := "reservation_affinity.0.pool"
-
-	if (d.HasChange(resPol) || d.HasChange(resPool)) && !d.IsNewResource() {
-		if resAffinity, ok := d.GetOk(isReservationAffinity); ok {
-			getinsOptions := &vpcv1.GetInstanceOptions{
-				ID: &id,
-			}
-			_, response, err := instanceC.GetInstance(getinsOptions)
-			if err != nil {
-				return fmt.Errorf("[ERROR] Error getting instance (%s): %s\n%s", id, err, response)
-			}
-			eTag := response.Headers.Get("ETag")
-
-			resAff := resAffinity.([]interface{})[0].(map[string]interface{})
-			var resAffinityPatch = &vpcv1.InstanceReservationAffinityPatch{}
-			policy, ok := resAff["policy"]
-			policyStr := policy.(string)
-			idStr := ""
-			if policyStr != "" && ok {
-				resAffinityPatch.Policy = &policyStr
-			}
-			if d.HasChange(resPool) {
-				poolIntf, okPool := resAff[isReservationAffinityPool]
-				if okPool && poolIntf != nil && poolIntf.([]interface{}) != nil && len(poolIntf.([]interface{})) > 0 {
-					pool := poolIntf.([]interface{})[0].(map[string]interface{})
-					id, okId := pool["id"]
-					if okId {
-						idStr, ok = id.(string)
-						if idStr != "" && ok {
-							var resAffPool = make([]vpcv1.ReservationIdentityIntf, 1)
-							resAffPool[0] = &vpcv1.ReservationIdentity{
-								ID: &idStr,
-							}
-							resAffinityPatch.Pool = resAffPool
-						}
-					}
-
-				}
-			}
-
-			instancePatchModel := &vpcv1.InstancePatch{
-				ReservationAffinity: resAffinityPatch,
-			}
-			mpatch, err := instancePatchModel.AsPatch()
-			if err != nil {
-				return fmt.Errorf("[ERROR] Error calling asPatch with reservation affinity: %s", err)
-			}
-			//Detaching the reservation from the reserved instance
-			if policyStr == "disabled" && idStr == "" {
-				resAffMap := mpatch["reservation_affinity"].(map[string]interface{})
-				resAffMap["pool"] = nil
-				mpatch["reservation_affinity"] = resAffMap
-			}
-			param := &vpcv1.UpdateInstanceOptions{
-				InstancePatch: mpatch,
-				ID:            &id,
-			}
-			param.IfMatch = &eTag
-			_, _, err = instanceC.UpdateInstance(param)
-			if err != nil {
-				return err
-			}
-		}
-	}
-
 	bootVolSize := "boot_volume.0.size"
 	bootIopsSize := "boot_volume.0.iops"
 	bootVolBandwidth := "boot_volume.0.bandwidth"
@@ -6162,7 +6097,7 @@ func instanceUpdate(d *schema.ResourceData, meta interface{}) error {
 		volPatchModelAsPatch, err := volPatchModel.AsPatch()
 
 		if err != nil {
-			return (fmt.Errorf("[ERROR] Error encountered while apply as patch for boot volume bandwidth of instance %s", err))
+			return diag.FromErr((fmt.Errorf("[ERROR] Error encountered while apply as patch for boot volume bandwidth of instance %s", err)))
 		}
 
 		updateVolumeOptions.VolumePatch = volPatchModelAsPatch
@@ -6170,19 +6105,19 @@ func instanceUpdate(d *schema.ResourceData, meta interface{}) error {
 		vol, res, err := instanceC.UpdateVolume(updateVolumeOptions)
 
 		if vol == nil || err != nil {
-			return (fmt.Errorf("[ERROR] Error encountered while expanding boot volume bandwidth of instance %s/n%s", err, res))
+			return diag.FromErr((fmt.Errorf("[ERROR] Error encountered while expanding boot volume bandwidth of instance %s/n%s", err, res)))
 		}
 
 		_, err = isWaitForVolumeAvailable(instanceC, volId, d.Timeout(schema.TimeoutUpdate))
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
 	if d.HasChange(bootVolSize) && !d.IsNewResource() {
 		old, new := d.GetChange(bootVolSize)
 		if new.(int) < old.(int) {
-			return fmt.Errorf("[ERROR] Error while updating boot volume size of the instance, only expansion is possible")
+			return diag.FromErr(fmt.Errorf("[ERROR] Error while updating boot volume size of the instance, only expansion is possible"))
 		}
 		bootVol := int64(new.(int))
 		volId := d.Get("boot_volume.0.volume_id").(string)
@@ -6195,7 +6130,7 @@ func instanceUpdate(d *schema.ResourceData, meta interface{}) error {
 		volPatchModelAsPatch, err := volPatchModel.AsPatch()
 
 		if err != nil {
-			return (fmt.Errorf("[ERROR] Error encountered while apply as patch for boot volume of instance %s", err))
+			return diag.FromErr((fmt.Errorf("[ERROR] Error encountered while apply as patch for boot volume of instance %s", err)))
 		}
 
 		updateVolumeOptions.VolumePatch = volPatchModelAsPatch
@@ -6203,12 +6138,12 @@ func instanceUpdate(d *schema.ResourceData, meta interface{}) error {
 		vol, res, err := instanceC.UpdateVolume(updateVolumeOptions)
 
 		if vol == nil || err != nil {
-			return (fmt.Errorf("[ERROR] Error encountered while expanding boot volume of instance %s/n%s", err, res))
+			return diag.FromErr((fmt.Errorf("[ERROR] Error encountered while expanding boot volume of instance %s/n%s", err, res)))
 		}
 
 		_, err = isWaitForVolumeAvailable(instanceC, volId, d.Timeout(schema.TimeoutUpdate))
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 	if d.HasChange(bootIopsSize) && !d.IsNewResource() {
@@ -6225,7 +6160,7 @@ func instanceUpdate(d *schema.ResourceData, meta interface{}) error {
 		volPatchModelAsPatch, err := volPatchModel.AsPatch()
 
 		if err != nil {
-			return (fmt.Errorf("[ERROR] Error encountered while apply as patch for boot iops of instance %s", err))
+			return diag.FromErr((fmt.Errorf("[ERROR] Error encountered while apply as patch for boot iops of instance %s", err)))
 		}
 
 		updateVolumeOptions.VolumePatch = volPatchModelAsPatch
@@ -6233,12 +6168,12 @@ func instanceUpdate(d *schema.ResourceData, meta interface{}) error {
 		vol, res, err := instanceC.UpdateVolume(updateVolumeOptions)
 
 		if vol == nil || err != nil {
-			return (fmt.Errorf("[ERROR] Error encountered while expanding boot iops of instance %s/n%s", err, res))
+			return diag.FromErr((fmt.Errorf("[ERROR] Error encountered while expanding boot iops of instance %s/n%s", err, res)))
 		}
 
 		_, err = isWaitForVolumeAvailable(instanceC, volId, d.Timeout(schema.TimeoutUpdate))
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 	bootVolTags := "boot_volume.0.tags"
@@ -6260,25 +6195,25 @@ func instanceUpdate(d *schema.ResourceData, meta interface{}) error {
 				volumePatchModel.UserTags = userTagsArray
 				volumePatch, err := volumePatchModel.AsPatch()
 				if err != nil {
-					return fmt.Errorf("[ERROR] Error encountered while apply as patch for boot volume of instance %s", err)
+					return diag.FromErr(fmt.Errorf("[ERROR] Error encountered while apply as patch for boot volume of instance %s", err))
 				}
 				optionsget := &vpcv1.GetVolumeOptions{
 					ID: &volId,
 				}
 				_, response, err := instanceC.GetVolume(optionsget)
 				if err != nil {
-					return fmt.Errorf("[ERROR] Error getting Boot Volume (%s): %s\n%s", id, err, response)
+					return diag.FromErr(fmt.Errorf("[ERROR] Error getting Boot Volume (%s): %s\n%s", id, err, response))
 				}
 				eTag := response.Headers.Get("ETag")
 				updateVolumeOptions.IfMatch = &eTag
 				updateVolumeOptions.VolumePatch = volumePatch
 				vol, res, err := instanceC.UpdateVolume(updateVolumeOptions)
 				if vol == nil || err != nil {
-					return (fmt.Errorf("[ERROR] Error encountered while applying tags for boot volume of instance %s/n%s", err, res))
+					return diag.FromErr((fmt.Errorf("[ERROR] Error encountered while applying tags for boot volume of instance %s/n%s", err, res)))
 				}
 				_, err = isWaitForVolumeAvailable(instanceC, volId, d.Timeout(schema.TimeoutCreate))
 				if err != nil {
-					return err
+					return diag.FromErr(err)
 				}
 			}
 		}
@@ -6296,7 +6231,7 @@ func instanceUpdate(d *schema.ResourceData, meta interface{}) error {
 		volPatchModelAsPatch, err := volPatchModel.AsPatch()
 
 		if err != nil {
-			return (fmt.Errorf("[ERROR] Error encountered while apply as patch for boot volume name update of instance %s", err))
+			return diag.FromErr((fmt.Errorf("[ERROR] Error encountered while apply as patch for boot volume name update of instance %s", err)))
 		}
 
 		updateVolumeOptions.VolumePatch = volPatchModelAsPatch
@@ -6304,7 +6239,7 @@ func instanceUpdate(d *schema.ResourceData, meta interface{}) error {
 		vol, res, err := instanceC.UpdateVolume(updateVolumeOptions)
 
 		if vol == nil || err != nil {
-			return (fmt.Errorf("[ERROR] Error encountered while updating name of boot volume of instance %s/n%s", err, res))
+			return diag.FromErr((fmt.Errorf("[ERROR] Error encountered while updating name of boot volume of instance %s/n%s", err, res)))
 		}
 	}
 	bootVolAutoDel := "boot_volume.0.auto_delete_volume"
@@ -6314,7 +6249,7 @@ func instanceUpdate(d *schema.ResourceData, meta interface{}) error {
 		}
 		vols, _, err := instanceC.ListInstanceVolumeAttachments(listvolattoptions)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 
 		auto_delete := d.Get(bootVolAutoDel).(bool)
@@ -6330,91 +6265,18 @@ func instanceUpdate(d *schema.ResourceData, meta interface{}) error {
 				}
 				volAttNamePatchModelAsPatch, err := volAttNamePatchModel.AsPatch()
 				if err != nil || volAttNamePatchModelAsPatch == nil {
-					return fmt.Errorf("[ERROR] Error Instance volume attachment (%s) as patch : %s", id, err)
+					return diag.FromErr(fmt.Errorf("[ERROR] Error Instance volume attachment (%s) as patch : %s", id, err))
 				}
 				updateInstanceVolAttOptions.VolumeAttachmentPatch = volAttNamePatchModelAsPatch
 
 				instanceVolAttUpdate, response, err := instanceC.UpdateInstanceVolumeAttachment(updateInstanceVolAttOptions)
 				if err != nil || instanceVolAttUpdate == nil {
 					log.Printf("[DEBUG] Instance volume attachment updation err %s\n%s", err, response)
-					return err
+					return diag.FromErr(err)
 				}
 			}
 		}
 	}
-	if d.HasChange(isPlacementTargetDedicatedHost) || d.HasChange(isPlacementTargetDedicatedHostGroup) && !d.IsNewResource() {
-		dedicatedHost := d.Get(isPlacementTargetDedicatedHost).(string)
-		dedicatedHostGroup := d.Get(isPlacementTargetDedicatedHostGroup).(string)
-		actiontype := "stop"
-
-		if dedicatedHost == "" && dedicatedHostGroup == "" {
-			return fmt.Errorf("[ERROR] Error: Instances cannot be moved from private to public hosts")
-		}
-
-		createinsactoptions := &vpcv1.CreateInstanceActionOptions{
-			InstanceID: &id,
-			Type:       &actiontype,
-		}
-		_, response, err := instanceC.CreateInstanceAction(createinsactoptions)
-		if err != nil {
-			if response != nil && response.StatusCode == 404 {
-				return nil
-			}
-			return fmt.Errorf("[ERROR] Error Creating Instance Action: %s\n%s", err, response)
-		}
-		_, err = isWaitForInstanceActionStop(instanceC, d.Timeout(schema.TimeoutUpdate), id, d)
-		if err != nil {
-			return err
-		}
-
-		updateOptions := &vpcv1.UpdateInstanceOptions{
-			ID: &id,
-		}
-
-		instancePatchModel := &vpcv1.InstancePatch{}
-
-		if dedicatedHost != "" {
-			placementTarget := &vpcv1.InstancePlacementTargetPatch{
-				ID: &dedicatedHost,
-			}
-			instancePatchModel.PlacementTarget = placementTarget
-		} else if dedicatedHostGroup != "" {
-			placementTarget := &vpcv1.InstancePlacementTargetPatch{
-				ID: &dedicatedHostGroup,
-			}
-			instancePatchModel.PlacementTarget = placementTarget
-		}
-
-		instancePatch, err := instancePatchModel.AsPatch()
-		if err != nil {
-			return fmt.Errorf("[ERROR] Error calling asPatch with total volume bandwidth for InstancePatch: %s", err)
-		}
-
-		updateOptions.InstancePatch = instancePatch
-
-		_, _, err = instanceC.UpdateInstance(updateOptions)
-		if err != nil {
-			return err
-		}
-
-		actiontype = "start"
-		createinsactoptions = &vpcv1.CreateInstanceActionOptions{
-			InstanceID: &id,
-			Type:       &actiontype,
-		}
-		_, response, err = instanceC.CreateInstanceAction(createinsactoptions)
-		if err != nil {
-			if response != nil && response.StatusCode == 404 {
-				return nil
-			}
-			return fmt.Errorf("[ERROR] Error Creating Instance Action: %s\n%s", err, response)
-		}
-		_, err = isWaitForInstanceActionStart(instanceC, d.Timeout(schema.TimeoutUpdate), id, d)
-		if err != nil {
-			return err
-		}
-	}
-
 	if d.HasChange(isInstanceAction) && !d.IsNewResource() {
 
 		actiontype := d.Get(isInstanceAction).(string)
@@ -6424,14 +6286,14 @@ func instanceUpdate(d *schema.ResourceData, meta interface{}) error {
 			}
 			instance, response, err := instanceC.GetInstance(getinsOptions)
 			if err != nil {
-				return fmt.Errorf("[ERROR] Error Getting Instance (%s): %s\n%s", id, err, response)
+				return diag.FromErr(fmt.Errorf("[ERROR] Error Getting Instance (%s): %s\n%s", id, err, response))
 			}
 			if (actiontype == "stop" || actiontype == "reboot") && *instance.Status != isInstanceStatusRunning {
 				d.Set(isInstanceAction, nil)
-				return fmt.Errorf("[ERROR] Error with stop/reboot action: Cannot invoke stop/reboot action while instance is not in running state")
+				return diag.FromErr(fmt.Errorf("[ERROR] Error with stop/reboot action: Cannot invoke stop/reboot action while instance is not in running state"))
 			} else if actiontype == "start" && *instance.Status != isInstanceActionStatusStopped {
 				d.Set(isInstanceAction, nil)
-				return fmt.Errorf("[ERROR] Error with start action: Cannot invoke start action while instance is not in stopped state")
+				return diag.FromErr(fmt.Errorf("[ERROR] Error with start action: Cannot invoke start action while instance is not in stopped state"))
 			}
 			createinsactoptions := &vpcv1.CreateInstanceActionOptions{
 				InstanceID: &id,
@@ -6443,22 +6305,39 @@ func instanceUpdate(d *schema.ResourceData, meta interface{}) error {
 			}
 			_, response, err = instanceC.CreateInstanceAction(createinsactoptions)
 			if err != nil {
-				return fmt.Errorf("[ERROR] Error Creating Instance Action: %s\n%s", err, response)
+				return diag.FromErr(fmt.Errorf("[ERROR] Error Creating Instance Action: %s\n%s", err, response))
 			}
 			if actiontype == "stop" {
 				_, err = isWaitForInstanceActionStop(instanceC, d.Timeout(schema.TimeoutUpdate), id, d)
 				if err != nil {
-					return err
+					return diag.FromErr(err)
 				}
 			} else if actiontype == "start" || actiontype == "reboot" {
 				_, err = isWaitForInstanceActionStart(instanceC, d.Timeout(schema.TimeoutUpdate), id, d)
 				if err != nil {
-					return err
+					return diag.FromErr(err)
 				}
 			}
 
 		}
 	}
+	// Changes of the instance itself are sent in one patch, with at most one
+	// stop and start of the instance.
+	var diags diag.Diagnostics
+	if !d.IsNewResource() {
+		restartedFor, err := instanceUpdatePatch(d, instanceC, id)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		if len(restartedFor) > 0 {
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Warning,
+				Summary:  fmt.Sprintf("Instance %s was restarted", id),
+				Detail:   fmt.Sprintf("The instance was stopped and started again to update %s, which can only be changed while the instance is stopped.", strings.Join(restartedFor, ", ")),
+			})
+		}
+	}
+
 	if d.HasChange(isInstanceVolumes) {
 		old, new := d.GetChange(isInstanceVolumes)
 		oldaddons := old.([]interface{})
@@ -6490,11 +6369,11 @@ func instanceUpdate(d *schema.ResourceData, meta interface{}) error {
 				}
 				vol, _, err := instanceC.CreateInstanceVolumeAttachment(createvolattoptions)
 				if err != nil {
-					return fmt.Errorf("[ERROR] Error while attaching volume %q for instance %s: %q", add[i], d.Id(), err)
+					return diag.FromErr(fmt.Errorf("[ERROR] Error while attaching volume %q for instance %s: %q", add[i], d.Id(), err))
 				}
 				_, err = isWaitForInstanceVolumeAttached(instanceC, d, id, *vol.ID)
 				if err != nil {
-					return err
+					return diag.FromErr(err)
 				}
 			}
 
@@ -6506,7 +6385,7 @@ func instanceUpdate(d *schema.ResourceData, meta interface{}) error {
 				}
 				vols, _, err := instanceC.ListInstanceVolumeAttachments(listvolattoptions)
 				if err != nil {
-					return err
+					return diag.FromErr(err)
 				}
 				for _, vol := range vols.VolumeAttachments {
 					if *vol.Volume.ID == remove[i] {
@@ -6516,11 +6395,11 @@ func instanceUpdate(d *schema.ResourceData, meta interface{}) error {
 						}
 						_, err := instanceC.DeleteInstanceVolumeAttachment(delvolattoptions)
 						if err != nil {
-							return fmt.Errorf("[ERROR] Error while removing volume %q for instance %s: %q", remove[i], d.Id(), err)
+							return diag.FromErr(fmt.Errorf("[ERROR] Error while removing volume %q for instance %s: %q", remove[i], d.Id(), err))
 						}
 						_, err = isWaitForInstanceVolumeDetached(instanceC, d, d.Id(), *vol.ID)
 						if err != nil {
-							return err
+							return diag.FromErr(err)
 						}
 						break
 					}
@@ -6544,11 +6423,11 @@ func instanceUpdate(d *schema.ResourceData, meta interface{}) error {
 				}
 				_, response, err := instanceC.CreateSecurityGroupTargetBinding(createsgnicoptions)
 				if err != nil {
-					return fmt.Errorf("[ERROR] Error while creating security group %q for primary network interface of instance %s\n%s: %q", add[i], d.Id(), err, response)
+					return diag.FromErr(fmt.Errorf("[ERROR] Error while creating security group %q for primary network interface of instance %s\n%s: %q", add[i], d.Id(), err, response))
 				}
 				_, err = isWaitForInstanceAvailable(instanceC, d.Id(), d.Timeout(schema.TimeoutUpdate), d)
 				if err != nil {
-					return err
+					return diag.FromErr(err)
 				}
 			}
 
@@ -6562,11 +6441,11 @@ func instanceUpdate(d *schema.ResourceData, meta interface{}) error {
 				}
 				response, err := instanceC.DeleteSecurityGroupTargetBinding(deletesgnicoptions)
 				if err != nil {
-					return fmt.Errorf("[ERROR] Error while removing security group %q for primary network interface of instance %s\n%s: %q", remove[i], d.Id(), err, response)
+					return diag.FromErr(fmt.Errorf("[ERROR] Error while removing security group %q for primary network interface of instance %s\n%s: %q", remove[i], d.Id(), err, response))
 				}
 				_, err = isWaitForInstanceAvailable(instanceC, d.Id(), d.Timeout(schema.TimeoutUpdate), d)
 				if err != nil {
-					return err
+					return diag.FromErr(err)
 				}
 			}
 		}
@@ -6590,12 +6469,12 @@ func instanceUpdate(d *schema.ResourceData, meta interface{}) error {
 		}
 		reservedIpPathAsPatch, err := reservedIpPath.AsPatch()
 		if err != nil {
-			return fmt.Errorf("[ERROR] Error calling reserved ip as patch \n%s", err)
+			return diag.FromErr(fmt.Errorf("[ERROR] Error calling reserved ip as patch \n%s", err))
 		}
 		updateripoptions.ReservedIPPatch = reservedIpPathAsPatch
 		_, response, err := instanceC.UpdateSubnetReservedIP(updateripoptions)
 		if err != nil {
-			return fmt.Errorf("[ERROR] Error updating instance network interface reserved ip(%s): %s\n%s", ripId, err, response)
+			return diag.FromErr(fmt.Errorf("[ERROR] Error updating instance network interface reserved ip(%s): %s\n%s", ripId, err, response))
 		}
 	}
 
@@ -6614,17 +6493,17 @@ func instanceUpdate(d *schema.ResourceData, meta interface{}) error {
 		}
 		networkInterfacePatch, err := networkInterfacePatchModel.AsPatch()
 		if err != nil {
-			return fmt.Errorf("[ERROR] Error calling asPatch for NetworkInterfacePatch: %s", err)
+			return diag.FromErr(fmt.Errorf("[ERROR] Error calling asPatch for NetworkInterfacePatch: %s", err))
 		}
 		updatepnicfoptions.NetworkInterfacePatch = networkInterfacePatch
 
 		_, response, err := instanceC.UpdateInstanceNetworkInterface(updatepnicfoptions)
 		if err != nil {
-			return fmt.Errorf("[ERROR] Error while updating name %s for primary network interface of instance %s\n%s: %q", newName, d.Id(), err, response)
+			return diag.FromErr(fmt.Errorf("[ERROR] Error while updating name %s for primary network interface of instance %s\n%s: %q", newName, d.Id(), err, response))
 		}
 		_, err = isWaitForInstanceAvailable(instanceC, d.Id(), d.Timeout(schema.TimeoutUpdate), d)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
@@ -6656,12 +6535,12 @@ func instanceUpdate(d *schema.ResourceData, meta interface{}) error {
 				}
 				reservedIpPathAsPatch, err := reservedIpPath.AsPatch()
 				if err != nil {
-					return fmt.Errorf("[ERROR] Error calling reserved ip as patch \n%s", err)
+					return diag.FromErr(fmt.Errorf("[ERROR] Error calling reserved ip as patch \n%s", err))
 				}
 				updateripoptions.ReservedIPPatch = reservedIpPathAsPatch
 				_, response, err := instanceC.UpdateSubnetReservedIP(updateripoptions)
 				if err != nil {
-					return fmt.Errorf("[ERROR] Error updating instance network interface reserved ip(%s): %s\n%s", ripId, err, response)
+					return diag.FromErr(fmt.Errorf("[ERROR] Error updating instance network interface reserved ip(%s): %s\n%s", ripId, err, response))
 				}
 			}
 
@@ -6681,11 +6560,11 @@ func instanceUpdate(d *schema.ResourceData, meta interface{}) error {
 						}
 						_, response, err := instanceC.CreateSecurityGroupTargetBinding(createsgnicoptions)
 						if err != nil {
-							return fmt.Errorf("[ERROR] Error while creating security group %q for network interface of instance %s\n%s: %q", add[i], d.Id(), err, response)
+							return diag.FromErr(fmt.Errorf("[ERROR] Error while creating security group %q for network interface of instance %s\n%s: %q", add[i], d.Id(), err, response))
 						}
 						_, err = isWaitForInstanceAvailable(instanceC, d.Id(), d.Timeout(schema.TimeoutUpdate), d)
 						if err != nil {
-							return err
+							return diag.FromErr(err)
 						}
 					}
 
@@ -6700,11 +6579,11 @@ func instanceUpdate(d *schema.ResourceData, meta interface{}) error {
 						}
 						response, err := instanceC.DeleteSecurityGroupTargetBinding(deletesgnicoptions)
 						if err != nil {
-							return fmt.Errorf("[ERROR] Error while removing security group %q for network interface of instance %s\n%s: %q", remove[i], d.Id(), err, response)
+							return diag.FromErr(fmt.Errorf("[ERROR] Error while removing security group %q for network interface of instance %s\n%s: %q", remove[i], d.Id(), err, response))
 						}
 						_, err = isWaitForInstanceAvailable(instanceC, d.Id(), d.Timeout(schema.TimeoutUpdate), d)
 						if err != nil {
-							return err
+							return diag.FromErr(err)
 						}
 					}
 				}
@@ -6727,247 +6606,227 @@ func instanceUpdate(d *schema.ResourceData, meta interface{}) error {
 				}
 				networkInterfacePatch, err := instancePatchModel.AsPatch()
 				if err != nil {
-					return fmt.Errorf("[ERROR] Error calling asPatch for NetworkInterfacePatch: %s", err)
+					return diag.FromErr(fmt.Errorf("[ERROR] Error calling asPatch for NetworkInterfacePatch: %s", err))
 				}
 				updatepnicfoptions.NetworkInterfacePatch = networkInterfacePatch
 
 				_, response, err := instanceC.UpdateInstanceNetworkInterface(updatepnicfoptions)
 				if err != nil {
-					return fmt.Errorf("[ERROR] Error while updating name %s for network interface of instance %s\n%s: %q", newName, d.Id(), err, response)
+					return diag.FromErr(fmt.Errorf("[ERROR] Error while updating name %s for network interface of instance %s\n%s: %q", newName, d.Id(), err, response))
 				}
 				if err != nil {
-					return err
+					return diag.FromErr(err)
 				}
 			}
 		}
 
 	}
 
-	if (d.HasChange(isInstanceName) || d.HasChange("confidential_compute_mode") || d.HasChange("enable_secure_boot")) && !d.IsNewResource() {
-		restartNeeded := false
-		serverstopped := false
-		name := d.Get(isInstanceName).(string)
-		updnetoptions := &vpcv1.UpdateInstanceOptions{
-			ID: &id,
-		}
-		instancePatchModel := &vpcv1.InstancePatch{}
-		if d.HasChange("confidential_compute_mode") {
-			instancePatchModel.ConfidentialComputeMode = core.StringPtr(d.Get("confidential_compute_mode").(string))
-			restartNeeded = true
-		}
-		if _, ok := d.GetOkExists("enable_secure_boot"); ok && d.HasChange("enable_secure_boot") {
-			instancePatchModel.EnableSecureBoot = core.BoolPtr(d.Get("enable_secure_boot").(bool))
-		}
-		if d.HasChange("name") {
-			instancePatchModel.Name = &name
-		}
-
-		instancePatch, err := instancePatchModel.AsPatch()
+	getinsOptions := &vpcv1.GetInstanceOptions{
+		ID: &id,
+	}
+	instance, response, err := instanceC.GetInstance(getinsOptions)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error Getting Instance: %s\n%s", err, response))
+	}
+	if d.HasChange(isInstanceTags) {
+		oldList, newList := d.GetChange(isInstanceTags)
+		err = flex.UpdateTagsUsingCRN(oldList, newList, meta, *instance.CRN)
 		if err != nil {
-			return fmt.Errorf("[ERROR] Error calling asPatch for InstancePatch: %s", err)
-		}
-		updnetoptions.InstancePatch = instancePatch
-		if restartNeeded {
-			getinsOptions := &vpcv1.GetInstanceOptions{
-				ID: &id,
-			}
-			instance, response, err := instanceC.GetInstance(getinsOptions)
-			if err != nil {
-				if response != nil && response.StatusCode == 404 {
-					d.SetId("")
-					return nil
-				}
-				return fmt.Errorf("[ERROR] Error Getting Instance (%s): %s\n%s", id, err, response)
-			}
-
-			if instance != nil && *instance.Status == "running" {
-				actiontype := "stop"
-				createinsactoptions := &vpcv1.CreateInstanceActionOptions{
-					InstanceID: &id,
-					Type:       &actiontype,
-				}
-				_, response, err = instanceC.CreateInstanceAction(createinsactoptions)
-				if err != nil {
-					if response != nil && response.StatusCode == 404 {
-						return nil
-					}
-					return fmt.Errorf("[ERROR] Error Creating Instance Action: %s\n%s", err, response)
-				}
-				_, err = isWaitForInstanceActionStop(instanceC, d.Timeout(schema.TimeoutUpdate), id, d)
-				if err != nil {
-					return err
-				}
-				serverstopped = true
-			}
+			log.Printf(
+				"[ERROR] Error on update of resource Instance (%s) tags: %s", d.Id(), err)
 		}
-		_, _, err = instanceC.UpdateInstance(updnetoptions)
+	}
+	if d.HasChange(isInstanceAccessTags) {
+		oldList, newList := d.GetChange(isInstanceAccessTags)
+		err = flex.UpdateGlobalTagsUsingCRN(oldList, newList, meta, *instance.CRN, "", isInstanceAccessTagType)
 		if err != nil {
-			return err
-		}
-		if serverstopped {
-			actiontype := "start"
-			createinsactoptions := &vpcv1.CreateInstanceActionOptions{
-				InstanceID: &id,
-				Type:       &actiontype,
-			}
-			_, response, err := instanceC.CreateInstanceAction(createinsactoptions)
-			if err != nil {
-				if response != nil && response.StatusCode == 404 {
-					return nil
-				}
-				return fmt.Errorf("[ERROR] Error Creating Instance Action: %s\n%s", err, response)
-			}
-			_, err = isWaitForInstanceAvailable(instanceC, d.Id(), d.Timeout(schema.TimeoutUpdate), d)
-			if err != nil {
-				return err
-			}
+			log.Printf(
+				"[ERROR] Error on update of resource Instance (%s) access tags: %s", d.Id(), err)
 		}
 	}
+	return diags
+}
 
-	if d.HasChange(isInstanceMetadataServiceEnabled) && !d.IsNewResource() {
-		enabled := d.Get(isInstanceMetadataServiceEnabled).(bool)
-		updatedoptions := &vpcv1.UpdateInstanceOptions{
-			ID: &id,
-		}
-		instancePatchModel := &vpcv1.InstancePatch{
-			MetadataService: &vpcv1.InstanceMetadataServicePatch{
-				Enabled: &enabled,
-			},
-		}
-		instancePatch, err := instancePatchModel.AsPatch()
-		if err != nil {
-			return fmt.Errorf("[ERROR] Error calling asPatch for InstancePatch: %s", err)
-		}
-		updatedoptions.InstancePatch = instancePatch
+// instancePatchPlan is the single patch of the changed attributes of an instance
+type instancePatchPlan struct {
+	patch *vpcv1.InstancePatch
+	// changes are the attributes in the patch, restartFor are the ones among them
+	// that can only be changed while the instance is stopped.
+	changes    []string
+	restartFor []string
+	// detachReservationPool sends a null reservation pool, which detaches the
+	// instance from its reservation. ifMatch sends the ETag of the instance.
+	detachReservationPool bool
+	ifMatch               bool
+}
 
-		_, _, err = instanceC.UpdateInstance(updatedoptions)
-		if err != nil {
-			return err
-		}
+func (plan *instancePatchPlan) add(attribute string, restart bool) {
+	plan.changes = append(plan.changes, attribute)
+	if restart {
+		plan.restartFor = append(plan.restartFor, attribute)
 	}
+}
 
-	if d.HasChange(isInstanceMetadataService) && !d.IsNewResource() {
-		metadataServiceIntf := d.Get(isInstanceMetadataService)
-		updatedoptions := &vpcv1.UpdateInstanceOptions{
-			ID: &id,
+// expandInstancePatchPlan collects the pending changes of the instance in one patch
+func expandInstancePatchPlan(d *schema.ResourceData) (*instancePatchPlan, error) {
+	plan := &instancePatchPlan{
+		patch: &vpcv1.InstancePatch{},
+	}
+	patch := plan.patch
+
+	if d.HasChange(isInstanceName) {
+		patch.Name = core.StringPtr(d.Get(isInstanceName).(string))
+		plan.add(isInstanceName, false)
+	}
+	if d.HasChange("confidential_compute_mode") {
+		patch.ConfidentialComputeMode = core.StringPtr(d.Get("confidential_compute_mode").(string))
+		plan.add("confidential_compute_mode", true)
+	}
+	if _, ok := d.GetOkExists("enable_secure_boot"); ok && d.HasChange("enable_secure_boot") {
+		patch.EnableSecureBoot = core.BoolPtr(d.Get("enable_secure_boot").(bool))
+		plan.add("enable_secure_boot", false)
+	}
+	if d.HasChange(isInstanceProfile) {
+		patch.Profile = &vpcv1.InstancePatchProfile{
+			Name: core.StringPtr(d.Get(isInstanceProfile).(string)),
 		}
+		plan.add(isInstanceProfile, true)
+	}
+	if d.HasChange(isInstanceTotalVolumeBandwidth) {
+		patch.TotalVolumeBandwidth = core.Int64Ptr(int64(d.Get(isInstanceTotalVolumeBandwidth).(int)))
+		plan.add(isInstanceTotalVolumeBandwidth, false)
+	}
+	if d.HasChange(isInstanceAvailablePolicyHostFailure) {
+		patch.AvailabilityPolicy = &vpcv1.InstanceAvailabilityPolicyPatch{
+			HostFailure: core.StringPtr(d.Get(isInstanceAvailablePolicyHostFailure).(string)),
+		}
+		plan.add(isInstanceAvailablePolicyHostFailure, false)
+	}
+
+	if d.HasChange(isInstanceMetadataServiceEnabled) || d.HasChange(isInstanceMetadataService) {
 		metadataServicePatchModel := &vpcv1.InstanceMetadataServicePatch{}
-		instancePatchModel := &vpcv1.InstancePatch{}
-		metadataServiceMap := metadataServiceIntf.([]interface{})[0].(map[string]interface{})
-		if d.HasChange(isInstanceMetadataService + ".0." + isInstanceMetadataServiceEnabled1) {
-			enabledIntf, ok := metadataServiceMap[isInstanceMetadataServiceEnabled1]
-			if ok {
-				enabled := enabledIntf.(bool)
-				metadataServicePatchModel.Enabled = &enabled
-			}
+		if d.HasChange(isInstanceMetadataServiceEnabled) {
+			metadataServicePatchModel.Enabled = core.BoolPtr(d.Get(isInstanceMetadataServiceEnabled).(bool))
+			plan.add(isInstanceMetadataServiceEnabled, false)
 		}
-		if d.HasChange(isInstanceMetadataService + ".0." + isInstanceMetadataServiceProtocol) {
-			protocolIntf, ok := metadataServiceMap[isInstanceMetadataServiceProtocol]
-			if ok {
-				protocol := protocolIntf.(string)
-				metadataServicePatchModel.Protocol = &protocol
+		if metadataService, ok := d.Get(isInstanceMetadataService).([]interface{}); ok && len(metadataService) > 0 && metadataService[0] != nil && d.HasChange(isInstanceMetadataService) {
+			metadataServiceMap := metadataService[0].(map[string]interface{})
+			if d.HasChange(isInstanceMetadataService + ".0." + isInstanceMetadataServiceEnabled1) {
+				if enabled, ok := metadataServiceMap[isInstanceMetadataServiceEnabled1].(bool); ok {
+					metadataServicePatchModel.Enabled = &enabled
+				}
 			}
-		}
-		if d.HasChange(isInstanceMetadataService + ".0." + isInstanceMetadataServiceRespHopLimit) {
-			respHopLimitIntf, ok := metadataServiceMap[isInstanceMetadataServiceRespHopLimit]
-			if ok {
-				respHopLimit := int64(respHopLimitIntf.(int))
-				metadataServicePatchModel.ResponseHopLimit = &respHopLimit
+			if d.HasChange(isInstanceMetadataService + ".0." + isInstanceMetadataServiceProtocol) {
+				if protocol, ok := metadataServiceMap[isInstanceMetadataServiceProtocol].(string); ok {
+					metadataServicePatchModel.Protocol = &protocol
+				}
 			}
+			if d.HasChange(isInstanceMetadataService + ".0." + isInstanceMetadataServiceRespHopLimit) {
+				if respHopLimit, ok := metadataServiceMap[isInstanceMetadataServiceRespHopLimit].(int); ok {
+					metadataServicePatchModel.ResponseHopLimit = core.Int64Ptr(int64(respHopLimit))
+				}
+			}
+			plan.add(isInstanceMetadataService, false)
 		}
-		instancePatchModel.MetadataService = metadataServicePatchModel
-
-		instancePatch, err := instancePatchModel.AsPatch()
-		if err != nil {
-			return fmt.Errorf("[ERROR] Error calling asPatch for InstancePatch: %s", err)
-		}
-		updatedoptions.InstancePatch = instancePatch
-
-		_, _, err = instanceC.UpdateInstance(updatedoptions)
-		if err != nil {
-			return err
-		}
+		patch.MetadataService = metadataServicePatchModel
 	}
 
-	if d.HasChange(isInstanceAvailablePolicyHostFailure) && !d.IsNewResource() {
-
-		updatedoptions := &vpcv1.UpdateInstanceOptions{
-			ID: &id,
-		}
-		availablePolicyHostFailure := d.Get(isInstanceAvailablePolicyHostFailure).(string)
-		instancePatchModel := &vpcv1.InstancePatch{
-			AvailabilityPolicy: &vpcv1.InstanceAvailabilityPolicyPatch{
-				HostFailure: &availablePolicyHostFailure,
-			},
+	if d.HasChange(isPlacementTargetDedicatedHost) || d.HasChange(isPlacementTargetDedicatedHostGroup) {
+		dedicatedHost := d.Get(isPlacementTargetDedicatedHost).(string)
+		dedicatedHostGroup := d.Get(isPlacementTargetDedicatedHostGroup).(string)
+		if dedicatedHost == "" && dedicatedHostGroup == "" {
+			return nil, fmt.Errorf("[ERROR] Error: Instances cannot be moved from private to public hosts")
 		}
-		instancePatch, err := instancePatchModel.AsPatch()
-		if err != nil {
-			return fmt.Errorf("[ERROR] Error calling asPatch for InstancePatch: %s", err)
+		placementTarget := &vpcv1.InstancePlacementTargetPatch{
+			ID: &dedicatedHost,
 		}
-		updatedoptions.InstancePatch = instancePatch
-
-		_, _, err = instanceC.UpdateInstance(updatedoptions)
-		if err != nil {
-			return err
+		if dedicatedHost == "" {
+			placementTarget.ID = &dedicatedHostGroup
 		}
-	}
-
-	if d.HasChange(isInstanceProfile) && !d.IsNewResource() {
-
-		getinsOptions := &vpcv1.GetInstanceOptions{
-			ID: &id,
+		patch.PlacementTarget = placementTarget
+		if d.HasChange(isPlacementTargetDedicatedHost) {
+			plan.add(isPlacementTargetDedicatedHost, true)
 		}
-		instance, response, err := instanceC.GetInstance(getinsOptions)
-		if err != nil {
-			if response != nil && response.StatusCode == 404 {
-				d.SetId("")
-				return nil
-			}
-			return fmt.Errorf("[ERROR] Error Getting Instance (%s): %s\n%s", id, err, response)
+		if d.HasChange(isPlacementTargetDedicatedHostGroup) {
+			plan.add(isPlacementTargetDedicatedHostGroup, true)
 		}
+	}
 
-		if instance != nil && *instance.Status == "running" {
-			actiontype := "stop"
-			createinsactoptions := &vpcv1.CreateInstanceActionOptions{
-				InstanceID: &id,
-				Type:       &actiontype,
+	resPol := "reservation_affinity.0.policy"
+	resPool := "reservation_affinity.0.pool"
+	if d.HasChange(resPol) || d.HasChange(resPool) {
+		if resAffinity, ok := d.GetOk(isReservationAffinity); ok {
+			resAff := resAffinity.([]interface{})[0].(map[string]interface{})
+			var resAffinityPatch = &vpcv1.InstanceReservationAffinityPatch{}
+			policyStr, _ := resAff["policy"].(string)
+			idStr := ""
+			if policyStr != "" {
+				resAffinityPatch.Policy = &policyStr
 			}
-			_, response, err = instanceC.CreateInstanceAction(createinsactoptions)
-			if err != nil {
-				if response != nil && response.StatusCode == 404 {
-					return nil
+			if d.HasChange(resPool) {
+				if pools, ok := resAff[isReservationAffinityPool].([]interface{}); ok && len(pools) > 0 && pools[0] != nil {
+					pool := pools[0].(map[string]interface{})
+					if idStr, _ = pool["id"].(string); idStr != "" {
+						resAffinityPatch.Pool = []vpcv1.ReservationIdentityIntf{
+							&vpcv1.ReservationIdentity{
+								ID: &idStr,
+							},
+						}
+					}
 				}
-				return fmt.Errorf("[ERROR] Error Creating Instance Action: %s\n%s", err, response)
 			}
-			_, err = isWaitForInstanceActionStop(instanceC, d.Timeout(schema.TimeoutUpdate), id, d)
-			if err != nil {
-				return err
-			}
-		}
-
-		updnetoptions := &vpcv1.UpdateInstanceOptions{
-			ID: &id,
+			patch.ReservationAffinity = resAffinityPatch
+			//Detaching the reservation from the reserved instance
+			plan.detachReservationPool = policyStr == "disabled" && idStr == ""
+			plan.ifMatch = true
+			plan.add(isReservationAffinity, false)
 		}
+	}
+	return plan, nil
+}
 
-		instanceProfile := d.Get(isInstanceProfile).(string)
-		profile := &vpcv1.InstancePatchProfile{
-			Name: &instanceProfile,
-		}
-		instancePatchModel := &vpcv1.InstancePatch{
-			Profile: profile,
-		}
-		instancePatch, err := instancePatchModel.AsPatch()
-		if err != nil {
-			return fmt.Errorf("[ERROR] Error calling asPatch for InstancePatch: %s", err)
-		}
-		updnetoptions.InstancePatch = instancePatch
+// instanceUpdatePatch sends the pending changes of the instance in one patch. If
+// some of them can only be changed while the instance is stopped and the
+// instance is running, it is stopped once before the patch and started again
+// after it. It returns the attributes that the instance was restarted for.
+func instanceUpdatePatch(d *schema.ResourceData, instanceC *vpcv1.VpcV1, id string) ([]string, error) {
+	plan, err := expandInstancePatchPlan(d)
+	if err != nil {
+		return nil, err
+	}
+	if len(plan.changes) == 0 {
+		return nil, nil
+	}
+	instancePatch, err := plan.patch.AsPatch()
+	if err != nil {
+		return nil, fmt.Errorf("[ERROR] Error calling asPatch for InstancePatch: %s", err)
+	}
+	if plan.detachReservationPool {
+		resAffMap := instancePatch["reservation_affinity"].(map[string]interface{})
+		resAffMap["pool"] = nil
+	}
+	updateOptions := &vpcv1.UpdateInstanceOptions{
+		ID:            &id,
+		InstancePatch: instancePatch,
+	}
 
-		_, response, err = instanceC.UpdateInstance(updnetoptions)
-		if err != nil {
-			return fmt.Errorf("[ERROR] Error in UpdateInstancePatch: %s\n%s", err, response)
+	getinsOptions := &vpcv1.GetInstanceOptions{
+		ID: &id,
+	}
+	instance, response, err := instanceC.GetInstance(getinsOptions)
+	if err != nil {
+		if response != nil && response.StatusCode == 404 {
+			d.SetId("")
+			return nil, nil
 		}
+		return nil, fmt.Errorf("[ERROR] Error Getting Instance (%s): %s\n%s", id, err, response)
+	}
 
-		actiontype := "start"
+	stopped := false
+	if len(plan.restartFor) > 0 && instance.Status != nil && *instance.Status == isInstanceStatusRunning {
+		log.Printf("[INFO] Stopping instance (%s) to update %s", id, strings.Join(plan.restartFor, ", "))
+		actiontype := "stop"
 		createinsactoptions := &vpcv1.CreateInstanceActionOptions{
 			InstanceID: &id,
 			Type:       &actiontype,
@@ -6975,71 +6834,86 @@ func instanceUpdate(d *schema.ResourceData, meta interface{}) error {
 		_, response, err = instanceC.CreateInstanceAction(createinsactoptions)
 		if err != nil {
 			if response != nil && response.StatusCode == 404 {
-				return nil
+				return nil, nil
 			}
-			return fmt.Errorf("[ERROR] Error Creating Instance Action: %s\n%s", err, response)
+			return nil, fmt.Errorf("[ERROR] Error stopping instance (%s) to update %s: %s\n%s", id, strings.Join(plan.restartFor, ", "), err, response)
 		}
-		_, err = isWaitForInstanceAvailable(instanceC, d.Id(), d.Timeout(schema.TimeoutUpdate), d)
+		_, err = isWaitForInstanceActionStop(instanceC, d.Timeout(schema.TimeoutUpdate), id, d)
 		if err != nil {
-			return err
+			return nil, err
 		}
+		stopped = true
 
-	}
-	if d.HasChange(isInstanceTotalVolumeBandwidth) && !d.IsNewResource() {
-		totalVolBandwidth := int64(d.Get(isInstanceTotalVolumeBandwidth).(int))
-		updnetoptions := &vpcv1.UpdateInstanceOptions{
-			ID: &id,
+		// Stopping the instance changes its ETag
+		if plan.ifMatch {
+			_, response, err = instanceC.GetInstance(getinsOptions)
+			if err != nil {
+				err = fmt.Errorf("[ERROR] Error Getting Instance (%s): %s\n%s", id, err, response)
+				return nil, instanceStartAfterFailedUpdate(d, instanceC, id, plan.restartFor, err)
+			}
 		}
+	}
+	if plan.ifMatch {
+		updateOptions.IfMatch = core.StringPtr(response.Headers.Get("ETag"))
+	}
 
-		instancePatchModel := &vpcv1.InstancePatch{
-			TotalVolumeBandwidth: &totalVolBandwidth,
-		}
-		instancePatch, err := instancePatchModel.AsPatch()
-		if err != nil {
-			return fmt.Errorf("[ERROR] Error calling asPatch with total volume bandwidth for InstancePatch: %s", err)
+	log.Printf("[DEBUG] Updating instance (%s): %s", id, strings.Join(plan.changes, ", "))
+	_, response, err = instanceC.UpdateInstance(updateOptions)
+	if err != nil {
+		err = fmt.Errorf("[ERROR] Error updating instance (%s) %s: %s\n%s", id, strings.Join(plan.changes, ", "), err, response)
+		if stopped {
+			return nil, instanceStartAfterFailedUpdate(d, instanceC, id, plan.restartFor, err)
 		}
-		updnetoptions.InstancePatch = instancePatch
+		return nil, err
+	}
+	if stopped {
+		return plan.restartFor, instanceStartAfterUpdate(d, instanceC, id, plan.restartFor)
+	}
+	return nil, nil
+}
 
-		_, _, err = instanceC.UpdateInstance(updnetoptions)
-		if err != nil {
-			return err
-		}
+// instanceStartAfterFailedUpdate starts the instance that was stopped for an
+// update that failed with err, so that it is not left stopped, and returns err.
+func instanceStartAfterFailedUpdate(d *schema.ResourceData, instanceC *vpcv1.VpcV1, id string, restartFor []string, err error) error {
+	if startErr := instanceStartAfterUpdate(d, instanceC, id, restartFor); startErr != nil {
+		log.Printf("[ERROR] %s", startErr)
 	}
+	return err
+}
 
-	getinsOptions := &vpcv1.GetInstanceOptions{
-		ID: &id,
+func instanceStartAfterUpdate(d *schema.ResourceData, instanceC *vpcv1.VpcV1, id string, restartFor []string) error {
+	actiontype := "start"
+	createinsactoptions := &vpcv1.CreateInstanceActionOptions{
+		InstanceID: &id,
+		Type:       &actiontype,
 	}
-	instance, response, err := instanceC.GetInstance(getinsOptions)
+	_, response, err := instanceC.CreateInstanceAction(createinsactoptions)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error Getting Instance: %s\n%s", err, response)
-	}
-	if d.HasChange(isInstanceTags) {
-		oldList, newList := d.GetChange(isInstanceTags)
-		err = flex.UpdateTagsUsingCRN(oldList, newList, meta, *instance.CRN)
-		if err != nil {
-			log.Printf(
-				"[ERROR] Error on update of resource Instance (%s) tags: %s", d.Id(), err)
+		if response != nil && response.StatusCode == 404 {
+			return nil
 		}
+		return fmt.Errorf("[ERROR] Error starting instance (%s) after updating %s: %s\n%s", id, strings.Join(restartFor, ", "), err, response)
 	}
-	if d.HasChange(isInstanceAccessTags) {
-		oldList, newList := d.GetChange(isInstanceAccessTags)
-		err = flex.UpdateGlobalTagsUsingCRN(oldList, newList, meta, *instance.CRN, "", isInstanceAccessTagType)
-		if err != nil {
-			log.Printf(
-				"[ERROR] Error on update of resource Instance (%s) access tags: %s", d.Id(), err)
-		}
+	_, err = isWaitForInstanceAvailable(instanceC, id, d.Timeout(schema.TimeoutUpdate), d)
+	if err != nil {
+		return err
 	}
+	log.Printf("[INFO] Started instance (%s) after updating %s", id, strings.Join(restartFor, ", "))
 	return nil
 }
 
-func resourceIBMisInstanceUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMisInstanceUpdate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 
-	err := instanceUpdate(d, meta)
-	if err != nil {
-		return err
+	diags := instanceUpdate(context, d, meta)
+	if diags.HasError() {
+		return diags
 	}
 
-	return resourceIBMisInstanceRead(d, meta)
+	err := resourceIBMisInstanceRead(d, meta)
+	if err != nil {
+		return append(diags, diag.FromErr(err)...)
+	}
+	return diags
 }
 
 func instanceDelete(d *schema.ResourceData, meta interface{}, id string) error {
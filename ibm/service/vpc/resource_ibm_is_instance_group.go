@@ -7,12 +7,14 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"net/url"
 	"os"
 	"strings"
 	"time"
 
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
+	"github.com/IBM/go-sdk-core/v5/core"
 	"github.com/IBM/vpc-go-sdk/vpcv1"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
@@ -29,6 +31,11 @@ const (
 	isInstanceGroupAccessTags    = "access_tags"
 	isInstanceGroupUserTagType   = "user"
 	isInstanceGroupAccessTagType = "access"
+
+	isInstanceGroupRollingUpdate               = "rolling_update"
+	isInstanceGroupOutdatedMemberships         = "outdated_memberships"
+	isInstanceGroupRollingUpdateOnFailurePause = "pause"
+	isInstanceGroupRollingUpdateOnFailureAbort = "abort"
 )
 
 func ResourceIBMISInstanceGroup() *schema.Resource {
@@ -43,7 +50,7 @@ func ResourceIBMISInstanceGroup() *schema.Resource {
 		CustomizeDiff: customdiff.All(
 			customdiff.Sequence(
 				func(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
-					return flex.ResourceTagsCustomizeDiff(diff)
+					return flex.ResourceTagsCustomizeDiff(diff, v)
 				},
 			),
 			customdiff.Sequence(
@@ -51,6 +58,11 @@ func ResourceIBMISInstanceGroup() *schema.Resource {
 					return flex.ResourceValidateAccessTags(diff, v)
 				},
 			),
+			customdiff.Sequence(
+				func(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
+					return resourceIBMISInstanceGroupRollingUpdateCustomizeDiff(diff)
+				},
+			),
 		),
 
 		Timeouts: &schema.ResourceTimeout{
@@ -165,6 +177,52 @@ func ResourceIBMISInstanceGroup() *schema.Resource {
 				Set:         flex.ResourceIBMVPCHash,
 				Description: "List of access management tags",
 			},
+
+			isInstanceGroupRollingUpdate: {
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Description: "Replace the memberships of the instance group in batches when the instance template changes",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"max_surge": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Default:      1,
+							ValidateFunc: validate.InvokeValidator("ibm_is_instance_group", "max_surge"),
+							Description:  "The number of memberships created above the instance count in each batch",
+						},
+						"max_unavailable": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Default:      0,
+							ValidateFunc: validate.InvokeValidator("ibm_is_instance_group", "max_unavailable"),
+							Description:  "The number of memberships that can be missing from the instance count in each batch",
+						},
+						"health_timeout": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Default:      600,
+							ValidateFunc: validate.InvokeValidator("ibm_is_instance_group", "health_timeout"),
+							Description:  "The number of seconds to wait for the new memberships of a batch to be healthy, and in service in the load balancer pool",
+						},
+						"on_failure": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Default:      isInstanceGroupRollingUpdateOnFailurePause,
+							ValidateFunc: validate.InvokeValidator("ibm_is_instance_group", "on_failure"),
+							Description:  "What to do when the new memberships of a batch are not healthy in time: pause keeps them and resumes on the next apply, abort deletes them and restores the previous instance template",
+						},
+					},
+				},
+			},
+
+			isInstanceGroupOutdatedMemberships: {
+				Type:        schema.TypeList,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Computed:    true,
+				Description: "The memberships that do not use the instance template of the instance group, when rolling_update is set",
+			},
 		},
 	}
 }
@@ -214,6 +272,35 @@ func ResourceIBMISInstanceGroupValidator() *validate.ResourceValidator {
 			MinValueLength:             1,
 			MaxValueLength:             128})
 
+	validateSchema = append(validateSchema,
+		validate.ValidateSchema{
+			Identifier:                 "max_surge",
+			ValidateFunctionIdentifier: validate.IntBetween,
+			Type:                       validate.TypeInt,
+			MinValue:                   "0",
+			MaxValue:                   "1000"})
+	validateSchema = append(validateSchema,
+		validate.ValidateSchema{
+			Identifier:                 "max_unavailable",
+			ValidateFunctionIdentifier: validate.IntBetween,
+			Type:                       validate.TypeInt,
+			MinValue:                   "0",
+			MaxValue:                   "1000"})
+	validateSchema = append(validateSchema,
+		validate.ValidateSchema{
+			Identifier:                 "health_timeout",
+			ValidateFunctionIdentifier: validate.IntBetween,
+			Type:                       validate.TypeInt,
+			MinValue:                   "30",
+			MaxValue:                   "7200"})
+	validateSchema = append(validateSchema,
+		validate.ValidateSchema{
+			Identifier:                 "on_failure",
+			ValidateFunctionIdentifier: validate.ValidateAllowedStringValue,
+			Type:                       validate.TypeString,
+			Optional:                   true,
+			AllowedValues:              "abort, pause"})
+
 	ibmISInstanceGroupResourceValidator := validate.ResourceValidator{ResourceName: "ibm_is_instance_group", Schema: validateSchema}
 	return &ibmISInstanceGroupResourceValidator
 }
@@ -391,6 +478,15 @@ func resourceIBMISInstanceGroupUpdate(d *schema.ResourceData, meta interface{})
 			return healthError
 		}
 	}
+
+	// replace the memberships that use a previous instance template, also when a
+	// previous rolling update was paused
+	if _, ok := d.GetOk(isInstanceGroupRollingUpdate); ok {
+		err = instanceGroupRollingUpdate(d, meta, sess)
+		if err != nil {
+			return err
+		}
+	}
 	return resourceIBMISInstanceGroupRead(d, meta)
 }
 
@@ -444,6 +540,18 @@ func resourceIBMISInstanceGroupRead(d *schema.ResourceData, meta interface{}) er
 			"Error on get of instance group (%s) tags: %s", d.Id(), err)
 	}
 	d.Set("tags", tags)
+
+	outdated := make([]string, 0)
+	if _, ok := d.GetOk(isInstanceGroupRollingUpdate); ok {
+		memberships, err := listInstanceGroupMemberships(sess, instanceGroupID)
+		if err != nil {
+			return err
+		}
+		for _, membership := range outdatedInstanceGroupMemberships(memberships, *instanceGroup.InstanceTemplate.ID) {
+			outdated = append(outdated, *membership.ID)
+		}
+	}
+	d.Set(isInstanceGroupOutdatedMemberships, outdated)
 	return nil
 }
 
@@ -613,3 +721,395 @@ func waitForInstanceGroupDelete(d *schema.ResourceData, meta interface{}) (inter
 	return healthStateConf.WaitForState()
 
 }
+
+func resourceIBMISInstanceGroupRollingUpdateCustomizeDiff(diff *schema.ResourceDiff) error {
+	rollingUpdate, ok := diff.GetOk(isInstanceGroupRollingUpdate)
+	if !ok {
+		return nil
+	}
+	if ru := rollingUpdate.([]interface{}); len(ru) > 0 && ru[0] != nil {
+		values := ru[0].(map[string]interface{})
+		if values["max_surge"].(int) == 0 && values["max_unavailable"].(int) == 0 {
+			return fmt.Errorf("[ERROR] rolling_update: at least one of max_surge and max_unavailable must be greater than 0")
+		}
+	}
+	// the memberships left on a previous instance template by a paused rolling
+	// update are replaced on the next apply
+	if diff.Id() != "" && (diff.HasChange("instance_template") || len(diff.Get(isInstanceGroupOutdatedMemberships).([]interface{})) > 0) {
+		return diff.SetNewComputed(isInstanceGroupOutdatedMemberships)
+	}
+	return nil
+}
+
+func listInstanceGroupMemberships(sess *vpcv1.VpcV1, instanceGroupID string) ([]vpcv1.InstanceGroupMembership, error) {
+	memberships := []vpcv1.InstanceGroupMembership{}
+	start := ""
+	for {
+		listInstanceGroupMembershipsOptions := vpcv1.ListInstanceGroupMembershipsOptions{
+			InstanceGroupID: &instanceGroupID,
+		}
+		if start != "" {
+			listInstanceGroupMembershipsOptions.Start = &start
+		}
+		collection, response, err := sess.ListInstanceGroupMemberships(&listInstanceGroupMembershipsOptions)
+		if err != nil {
+			return nil, fmt.Errorf("[ERROR] Error Getting InstanceGroup Memberships: %s\n%s", err, response)
+		}
+		memberships = append(memberships, collection.Memberships...)
+		start = flex.GetNext(collection.Next)
+		if start == "" {
+			break
+		}
+	}
+	return memberships, nil
+}
+
+// outdatedInstanceGroupMemberships returns the memberships that use another
+// instance template than the given one, and are not being deleted
+func outdatedInstanceGroupMemberships(memberships []vpcv1.InstanceGroupMembership, instanceTemplate string) []vpcv1.InstanceGroupMembership {
+	outdated := []vpcv1.InstanceGroupMembership{}
+	for _, membership := range memberships {
+		if *membership.Status == vpcv1.InstanceGroupMembershipStatusDeletingConst {
+			continue
+		}
+		if membership.InstanceTemplate == nil || *membership.InstanceTemplate.ID != instanceTemplate {
+			outdated = append(outdated, membership)
+		}
+	}
+	return outdated
+}
+
+// instanceGroupRollingUpdate replaces the memberships that do not use the
+// instance template of the instance group, in batches of max_surge plus
+// max_unavailable memberships. Each batch first scales the instance group up
+// by max_surge, then deletes the outdated memberships of the batch and scales
+// back to the instance count, so that at most max_unavailable memberships are
+// missing. The next batch starts once the new memberships are healthy and, if
+// the instance group has a load balancer pool, their pool members are healthy.
+func instanceGroupRollingUpdate(d *schema.ResourceData, meta interface{}, sess *vpcv1.VpcV1) error {
+	rollingUpdate := d.Get(isInstanceGroupRollingUpdate).([]interface{})
+	if len(rollingUpdate) == 0 || rollingUpdate[0] == nil {
+		return nil
+	}
+	values := rollingUpdate[0].(map[string]interface{})
+	maxSurge := values["max_surge"].(int)
+	maxUnavailable := values["max_unavailable"].(int)
+	healthTimeout := time.Duration(values["health_timeout"].(int)) * time.Second
+	onFailure := values["on_failure"].(string)
+
+	instanceGroupID := d.Id()
+	oldTemplate, newTemplate := d.GetChange("instance_template")
+	instanceTemplate := newTemplate.(string)
+	lbID := d.Get("load_balancer").(string)
+	lbPoolID := d.Get("load_balancer_pool").(string)
+
+	getInstanceGroupOptions := vpcv1.GetInstanceGroupOptions{ID: &instanceGroupID}
+	instanceGroup, response, err := sess.GetInstanceGroup(&getInstanceGroupOptions)
+	if err != nil || instanceGroup == nil {
+		return fmt.Errorf("[ERROR] Error Getting InstanceGroup: %s\n%s", err, response)
+	}
+	membershipCount := *instanceGroup.MembershipCount
+	if lbPoolID != "" && lbID == "" && instanceGroup.LoadBalancerPool != nil {
+		lbID, err = loadBalancerIDFromPoolHref(*instanceGroup.LoadBalancerPool.Href)
+		if err != nil {
+			return fmt.Errorf("[ERROR] Error Getting the load balancer of InstanceGroup (%s): %s", instanceGroupID, err)
+		}
+	}
+
+	// fail pauses or aborts the rolling update after the memberships created for
+	// the batch did not get in service, remaining are the outdated memberships
+	// that are left. The memberships created above the instance count are deleted
+	// when the rolling update pauses, so that the instance count is kept.
+	fail := func(created []string, surged bool, remaining []vpcv1.InstanceGroupMembership, err error) error {
+		if surged && onFailure != isInstanceGroupRollingUpdateOnFailureAbort {
+			if deleteErr := deleteInstanceGroupMemberships(d, sess, created); deleteErr != nil {
+				return fmt.Errorf("[ERROR] Instance group (%s) rolling update failed: %s, and could not be paused: %s", instanceGroupID, err, deleteErr)
+			}
+			if countErr := updateInstanceGroupMembershipCount(sess, meta, instanceGroupID, membershipCount, d.Timeout(schema.TimeoutUpdate)); countErr != nil {
+				return fmt.Errorf("[ERROR] Instance group (%s) rolling update failed: %s, and could not be paused: %s", instanceGroupID, err, countErr)
+			}
+		}
+		if onFailure == isInstanceGroupRollingUpdateOnFailureAbort {
+			abortErr := abortInstanceGroupRollingUpdate(d, meta, sess, created, oldTemplate.(string), membershipCount)
+			if abortErr != nil {
+				return fmt.Errorf("[ERROR] Instance group (%s) rolling update failed: %s, and could not be aborted: %s", instanceGroupID, err, abortErr)
+			}
+			return fmt.Errorf("[ERROR] Instance group (%s) rolling update aborted, the instance template was restored to %s: %s", instanceGroupID, oldTemplate.(string), err)
+		}
+		paused := make([]string, 0, len(remaining))
+		for _, membership := range remaining {
+			paused = append(paused, *membership.ID)
+		}
+		d.Set(isInstanceGroupOutdatedMemberships, paused)
+		return fmt.Errorf("[ERROR] Instance group (%s) rolling update paused with %d memberships left on a previous instance template, the next apply resumes it: %s", instanceGroupID, len(remaining), err)
+	}
+
+	for {
+		memberships, err := listInstanceGroupMemberships(sess, instanceGroupID)
+		if err != nil {
+			return err
+		}
+		outdated := outdatedInstanceGroupMemberships(memberships, instanceTemplate)
+		if len(outdated) == 0 {
+			return nil
+		}
+		batch := outdated[:min(maxSurge+maxUnavailable, len(outdated))]
+		surge := min(maxSurge, len(batch))
+		log.Printf("[INFO] Instance group (%s) rolling update: replacing %d of %d memberships that do not use instance template %s", instanceGroupID, len(batch), len(outdated), instanceTemplate)
+
+		existing := make(map[string]bool, len(memberships))
+		for _, membership := range memberships {
+			existing[*membership.ID] = true
+		}
+
+		// The surge memberships must be in service before the batch is deleted
+		if surge > 0 {
+			err = updateInstanceGroupMembershipCount(sess, meta, instanceGroupID, membershipCount+int64(surge), d.Timeout(schema.TimeoutUpdate))
+			if err != nil {
+				return err
+			}
+			surged, err := newInstanceGroupMemberships(sess, instanceGroupID, existing)
+			if err != nil {
+				return err
+			}
+			_, err = waitForInstanceGroupMembershipsInService(sess, instanceGroupID, lbID, lbPoolID, surged, healthTimeout)
+			if err != nil {
+				return fail(surged, true, outdated, err)
+			}
+			for _, id := range surged {
+				existing[id] = true
+			}
+		}
+		for _, membership := range batch {
+			err = deleteInstanceGroupMembershipAndInstance(sess, instanceGroupID, membership, d.Timeout(schema.TimeoutUpdate))
+			if err != nil {
+				return err
+			}
+		}
+		err = updateInstanceGroupMembershipCount(sess, meta, instanceGroupID, membershipCount, d.Timeout(schema.TimeoutUpdate))
+		if err != nil {
+			return err
+		}
+
+		created, err := newInstanceGroupMemberships(sess, instanceGroupID, existing)
+		if err != nil {
+			return err
+		}
+		_, err = waitForInstanceGroupMembershipsInService(sess, instanceGroupID, lbID, lbPoolID, created, healthTimeout)
+		if err != nil {
+			return fail(created, false, outdated[len(batch):], err)
+		}
+	}
+}
+
+// newInstanceGroupMemberships returns the IDs of the memberships of the instance
+// group that are not in existing and are not being deleted
+func newInstanceGroupMemberships(sess *vpcv1.VpcV1, instanceGroupID string, existing map[string]bool) ([]string, error) {
+	memberships, err := listInstanceGroupMemberships(sess, instanceGroupID)
+	if err != nil {
+		return nil, err
+	}
+	created := []string{}
+	for _, membership := range memberships {
+		if !existing[*membership.ID] && *membership.Status != vpcv1.InstanceGroupMembershipStatusDeletingConst {
+			created = append(created, *membership.ID)
+		}
+	}
+	return created, nil
+}
+
+// loadBalancerIDFromPoolHref returns the ID of the load balancer from the href
+// of one of its pools, which ends with load_balancers/{id}/pools/{pool_id}
+func loadBalancerIDFromPoolHref(href string) (string, error) {
+	poolURL, err := url.Parse(href)
+	if err != nil {
+		return "", err
+	}
+	parts := strings.Split(strings.Trim(poolURL.Path, "/"), "/")
+	for i := 0; i+2 < len(parts); i++ {
+		if parts[i] == "load_balancers" && parts[i+1] != "" && parts[i+2] == "pools" {
+			return parts[i+1], nil
+		}
+	}
+	return "", fmt.Errorf("%s is not the href of a load balancer pool", href)
+}
+
+func updateInstanceGroupMembershipCount(sess *vpcv1.VpcV1, meta interface{}, instanceGroupID string, membershipCount int64, timeout time.Duration) error {
+	getInstanceGroupOptions := vpcv1.GetInstanceGroupOptions{ID: &instanceGroupID}
+	instanceGroup, response, err := sess.GetInstanceGroup(&getInstanceGroupOptions)
+	if err != nil || instanceGroup == nil {
+		return fmt.Errorf("[ERROR] Error Getting InstanceGroup: %s\n%s", err, response)
+	}
+	if *instanceGroup.MembershipCount == membershipCount {
+		return nil
+	}
+	instanceGroupPatchModel := vpcv1.InstanceGroupPatch{
+		MembershipCount: &membershipCount,
+	}
+	instanceGroupPatch, err := instanceGroupPatchModel.AsPatch()
+	if err != nil {
+		return fmt.Errorf("[ERROR] Error calling asPatch for InstanceGroupPatch: %s", err)
+	}
+	instanceGroupUpdateOptions := vpcv1.UpdateInstanceGroupOptions{
+		ID:                 &instanceGroupID,
+		InstanceGroupPatch: instanceGroupPatch,
+	}
+	_, response, err = sess.UpdateInstanceGroup(&instanceGroupUpdateOptions)
+	if err != nil {
+		return fmt.Errorf("[ERROR] Error Updating InstanceGroup membership count to %d: %s\n%s", membershipCount, err, response)
+	}
+	_, err = waitForHealthyInstanceGroup(instanceGroupID, meta, timeout)
+	return err
+}
+
+// deleteInstanceGroupMembershipAndInstance deletes the membership together with its
+// instance, and waits for the membership to be deleted
+func deleteInstanceGroupMembershipAndInstance(sess *vpcv1.VpcV1, instanceGroupID string, membership vpcv1.InstanceGroupMembership, timeout time.Duration) error {
+	if membership.DeleteInstanceOnMembershipDelete == nil || !*membership.DeleteInstanceOnMembershipDelete {
+		instanceGroupMembershipPatchModel := &vpcv1.InstanceGroupMembershipPatch{
+			DeleteInstanceOnMembershipDelete: core.BoolPtr(true),
+		}
+		instanceGroupMembershipPatch, err := instanceGroupMembershipPatchModel.AsPatch()
+		if err != nil {
+			return fmt.Errorf("[ERROR] Error calling asPatch for InstanceGroupMembershipPatch: %s", err)
+		}
+		updateInstanceGroupMembershipOptions := sess.NewUpdateInstanceGroupMembershipOptions(instanceGroupID, *membership.ID, instanceGroupMembershipPatch)
+		_, response, err := sess.UpdateInstanceGroupMembership(updateInstanceGroupMembershipOptions)
+		if err != nil {
+			return fmt.Errorf("[ERROR] Error updating InstanceGroup Membership %s: %s\n%s", *membership.ID, err, response)
+		}
+	}
+
+	deleteInstanceGroupMembershipOptions := sess.NewDeleteInstanceGroupMembershipOptions(instanceGroupID, *membership.ID)
+	response, err := sess.DeleteInstanceGroupMembership(deleteInstanceGroupMembershipOptions)
+	if err != nil {
+		if response != nil && response.StatusCode == 404 {
+			return nil
+		}
+		return fmt.Errorf("[ERROR] Error deleting InstanceGroup Membership %s: %s\n%s", *membership.ID, err, response)
+	}
+
+	getInstanceGroupMembershipOptions := sess.NewGetInstanceGroupMembershipOptions(instanceGroupID, *membership.ID)
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{vpcv1.InstanceGroupMembershipStatusDeletingConst},
+		Target:  []string{"deleted"},
+		Refresh: func() (interface{}, string, error) {
+			instanceGroupMembership, response, err := sess.GetInstanceGroupMembership(getInstanceGroupMembershipOptions)
+			if err != nil {
+				if response != nil && response.StatusCode == 404 {
+					return response, "deleted", nil
+				}
+				return nil, "", fmt.Errorf("[ERROR] Error Getting InstanceGroup Membership %s: %s\n%s", *membership.ID, err, response)
+			}
+			return instanceGroupMembership, vpcv1.InstanceGroupMembershipStatusDeletingConst, nil
+		},
+		Timeout:    timeout,
+		Delay:      10 * time.Second,
+		MinTimeout: 5 * time.Second,
+	}
+	_, err = stateConf.WaitForState()
+	return err
+}
+
+// waitForInstanceGroupMembershipsInService waits for the memberships to be healthy
+// and, if the instance group has a load balancer pool, for their pool members to
+// be healthy. A failed membership ends the wait.
+func waitForInstanceGroupMembershipsInService(sess *vpcv1.VpcV1, instanceGroupID, lbID, lbPoolID string, membershipIDs []string, timeout time.Duration) (interface{}, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{vpcv1.InstanceGroupMembershipStatusPendingConst},
+		Target:  []string{vpcv1.InstanceGroupMembershipStatusHealthyConst},
+		Refresh: func() (interface{}, string, error) {
+			for _, membershipID := range membershipIDs {
+				getInstanceGroupMembershipOptions := sess.NewGetInstanceGroupMembershipOptions(instanceGroupID, membershipID)
+				membership, response, err := sess.GetInstanceGroupMembership(getInstanceGroupMembershipOptions)
+				if err != nil {
+					return nil, "", fmt.Errorf("[ERROR] Error Getting InstanceGroup Membership %s: %s\n%s", membershipID, err, response)
+				}
+				if *membership.Status == vpcv1.InstanceGroupMembershipStatusFailedConst {
+					return membership, "", fmt.Errorf("membership %s (%s) failed", membershipID, *membership.Name)
+				}
+				if *membership.Status != vpcv1.InstanceGroupMembershipStatusHealthyConst {
+					log.Printf("[DEBUG] Instance group membership %s is %s", membershipID, *membership.Status)
+					return membership, vpcv1.InstanceGroupMembershipStatusPendingConst, nil
+				}
+				if lbPoolID == "" {
+					continue
+				}
+				if membership.PoolMember == nil {
+					log.Printf("[DEBUG] Instance group membership %s is not a member of load balancer pool %s yet", membershipID, lbPoolID)
+					return membership, vpcv1.InstanceGroupMembershipStatusPendingConst, nil
+				}
+				getLoadBalancerPoolMemberOptions := sess.NewGetLoadBalancerPoolMemberOptions(lbID, lbPoolID, *membership.PoolMember.ID)
+				member, response, err := sess.GetLoadBalancerPoolMember(getLoadBalancerPoolMemberOptions)
+				if err != nil {
+					return nil, "", fmt.Errorf("[ERROR] Error Getting Load Balancer Pool Member %s: %s\n%s", *membership.PoolMember.ID, err, response)
+				}
+				if *member.Health != vpcv1.LoadBalancerPoolMemberHealthOkConst {
+					log.Printf("[DEBUG] Load balancer pool member %s of instance group membership %s is %s", *member.ID, membershipID, *member.Health)
+					return member, vpcv1.InstanceGroupMembershipStatusPendingConst, nil
+				}
+			}
+			return membershipIDs, vpcv1.InstanceGroupMembershipStatusHealthyConst, nil
+		},
+		Timeout:      timeout,
+		Delay:        10 * time.Second,
+		MinTimeout:   5 * time.Second,
+		PollInterval: 10 * time.Second,
+	}
+	return stateConf.WaitForState()
+}
+
+// deleteInstanceGroupMemberships deletes the memberships with their instances
+func deleteInstanceGroupMemberships(d *schema.ResourceData, sess *vpcv1.VpcV1, membershipIDs []string) error {
+	instanceGroupID := d.Id()
+	for _, membershipID := range membershipIDs {
+		getInstanceGroupMembershipOptions := sess.NewGetInstanceGroupMembershipOptions(instanceGroupID, membershipID)
+		membership, response, err := sess.GetInstanceGroupMembership(getInstanceGroupMembershipOptions)
+		if err != nil {
+			if response != nil && response.StatusCode == 404 {
+				continue
+			}
+			return fmt.Errorf("[ERROR] Error Getting InstanceGroup Membership %s: %s\n%s", membershipID, err, response)
+		}
+		err = deleteInstanceGroupMembershipAndInstance(sess, instanceGroupID, *membership, d.Timeout(schema.TimeoutUpdate))
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// abortInstanceGroupRollingUpdate deletes the new memberships of the failed batch,
+// restores the previous instance template and scales back to the instance count,
+// so that the deleted memberships of the batch are created again from the
+// previous instance template.
+func abortInstanceGroupRollingUpdate(d *schema.ResourceData, meta interface{}, sess *vpcv1.VpcV1, created []string, oldTemplate string, membershipCount int64) error {
+	instanceGroupID := d.Id()
+	if err := deleteInstanceGroupMemberships(d, sess, created); err != nil {
+		return err
+	}
+
+	if oldTemplate != "" && oldTemplate != d.Get("instance_template").(string) {
+		instanceGroupPatchModel := vpcv1.InstanceGroupPatch{
+			InstanceTemplate: &vpcv1.InstanceTemplateIdentity{
+				ID: &oldTemplate,
+			},
+		}
+		instanceGroupPatch, err := instanceGroupPatchModel.AsPatch()
+		if err != nil {
+			return fmt.Errorf("[ERROR] Error calling asPatch for InstanceGroupPatch: %s", err)
+		}
+		instanceGroupUpdateOptions := vpcv1.UpdateInstanceGroupOptions{
+			ID:                 &instanceGroupID,
+			InstanceGroupPatch: instanceGroupPatch,
+		}
+		_, response, err := sess.UpdateInstanceGroup(&instanceGroupUpdateOptions)
+		if err != nil {
+			return fmt.Errorf("[ERROR] Error restoring InstanceGroup instance template: %s\n%s", err, response)
+		}
+		// the state keeps the instance template of the instance group, the next
+		// apply starts the rolling update again
+		d.Set("instance_template", oldTemplate)
+	}
+	return updateInstanceGroupMembershipCount(sess, meta, instanceGroupID, membershipCount, d.Timeout(schema.TimeoutUpdate))
+}
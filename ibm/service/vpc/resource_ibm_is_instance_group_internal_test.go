@@ -0,0 +1,49 @@
+// Copyright IBM Corp. 2025 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package vpc
+
+import (
+	"testing"
+)
+
+func TestLoadBalancerIDFromPoolHref(t *testing.T) {
+	tests := []struct {
+		href    string
+		want    string
+		wantErr bool
+	}{
+		{
+			href: "https://us-south.iaas.cloud.ibm.com/v1/load_balancers/r006-lb/pools/r006-pool",
+			want: "r006-lb",
+		},
+		{
+			href: "https://us-south.private.iaas.cloud.ibm.com/v1/load_balancers/r006-lb/pools/r006-pool?version=2025-01-01",
+			want: "r006-lb",
+		},
+		{
+			href:    "https://us-south.iaas.cloud.ibm.com/v1/load_balancers/r006-lb",
+			wantErr: true,
+		},
+		{
+			href:    "https://us-south.iaas.cloud.ibm.com/v1/instance_groups/r006-group/pools/r006-pool",
+			wantErr: true,
+		},
+		{
+			href:    "",
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		got, err := loadBalancerIDFromPoolHref(tt.href)
+		if tt.wantErr {
+			if err == nil {
+				t.Errorf("loadBalancerIDFromPoolHref(%q) = %s, want an error", tt.href, got)
+			}
+			continue
+		}
+		if err != nil || got != tt.want {
+			t.Errorf("loadBalancerIDFromPoolHref(%q) = %s, %v, want %s", tt.href, got, err, tt.want)
+		}
+	}
+}
@@ -0,0 +1,142 @@
+// Copyright IBM Corp. 2025 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package vpc
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/IBM/vpc-go-sdk/vpcv1"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+// testInstanceUpdateData returns the data of an update of an instance from the
+// attributes of the state to the attributes of the configuration
+func testInstanceUpdateData(t *testing.T, state map[string]string, config map[string]interface{}) *schema.ResourceData {
+	t.Helper()
+	instanceSchema := schema.InternalMap(ResourceIBMISInstance().Schema)
+	instanceState := &terraform.InstanceState{
+		ID:         "0717-instance",
+		Attributes: state,
+	}
+	diff, err := instanceSchema.Diff(context.Background(), instanceState, terraform.NewResourceConfigRaw(config), nil, nil, false)
+	if err != nil {
+		t.Fatalf("Diff failed: %s", err)
+	}
+	d, err := instanceSchema.Data(instanceState, diff)
+	if err != nil {
+		t.Fatalf("Data failed: %s", err)
+	}
+	return d
+}
+
+func TestExpandInstancePatchPlan(t *testing.T) {
+	state := map[string]string{
+		"id":                     "0717-instance",
+		"name":                   "instance",
+		"profile":                "bx2-2x8",
+		"vpc":                    "r006-vpc",
+		"zone":                   "us-south-1",
+		"image":                  "r006-image",
+		"total_volume_bandwidth": "1000",
+		"dedicated_host":         "0717-host",
+	}
+	config := func(changes map[string]interface{}) map[string]interface{} {
+		values := map[string]interface{}{
+			"name":                   "instance",
+			"profile":                "bx2-2x8",
+			"vpc":                    "r006-vpc",
+			"zone":                   "us-south-1",
+			"image":                  "r006-image",
+			"total_volume_bandwidth": 1000,
+			"dedicated_host":         "0717-host",
+		}
+		for name, value := range changes {
+			values[name] = value
+		}
+		return values
+	}
+
+	tests := []struct {
+		name           string
+		config         map[string]interface{}
+		wantChanges    []string
+		wantRestartFor []string
+		wantErr        bool
+	}{
+		{
+			name:   "no changes",
+			config: config(nil),
+		},
+		{
+			name:        "name",
+			config:      config(map[string]interface{}{"name": "renamed"}),
+			wantChanges: []string{"name"},
+		},
+		{
+			name:           "profile",
+			config:         config(map[string]interface{}{"profile": "bx2-4x16"}),
+			wantChanges:    []string{"profile"},
+			wantRestartFor: []string{"profile"},
+		},
+		{
+			name: "name, profile and bandwidth",
+			config: config(map[string]interface{}{
+				"name":                   "renamed",
+				"profile":                "bx2-4x16",
+				"total_volume_bandwidth": 2000,
+			}),
+			wantChanges:    []string{"name", "profile", "total_volume_bandwidth"},
+			wantRestartFor: []string{"profile"},
+		},
+		{
+			name:           "dedicated host",
+			config:         config(map[string]interface{}{"dedicated_host": "0717-other-host"}),
+			wantChanges:    []string{"dedicated_host"},
+			wantRestartFor: []string{"dedicated_host"},
+		},
+		{
+			name:    "dedicated host removed",
+			config:  config(map[string]interface{}{"dedicated_host": ""}),
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			plan, err := expandInstancePatchPlan(testInstanceUpdateData(t, state, tt.config))
+			if tt.wantErr {
+				if err == nil {
+					t.Fatalf("expandInstancePatchPlan() = %v, want an error", plan.changes)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("expandInstancePatchPlan() failed: %s", err)
+			}
+			if !reflect.DeepEqual(plan.changes, tt.wantChanges) {
+				t.Errorf("expandInstancePatchPlan() changes = %v, want %v", plan.changes, tt.wantChanges)
+			}
+			if !reflect.DeepEqual(plan.restartFor, tt.wantRestartFor) {
+				t.Errorf("expandInstancePatchPlan() restartFor = %v, want %v", plan.restartFor, tt.wantRestartFor)
+			}
+		})
+	}
+
+	plan, err := expandInstancePatchPlan(testInstanceUpdateData(t, state, config(map[string]interface{}{"name": "renamed", "profile": "bx2-4x16"})))
+	if err != nil {
+		t.Fatalf("expandInstancePatchPlan() failed: %s", err)
+	}
+	patch := plan.patch
+	if patch.Name == nil || *patch.Name != "renamed" {
+		t.Errorf("expandInstancePatchPlan() patch name = %v, want renamed", patch.Name)
+	}
+	if patch.Profile == nil || *patch.Profile.(*vpcv1.InstancePatchProfile).Name != "bx2-4x16" {
+		t.Errorf("expandInstancePatchPlan() patch profile = %v, want bx2-4x16", patch.Profile)
+	}
+	if patch.TotalVolumeBandwidth != nil || patch.PlacementTarget != nil {
+		t.Errorf("expandInstancePatchPlan() patch has attributes that did not change")
+	}
+}
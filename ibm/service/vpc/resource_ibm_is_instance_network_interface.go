@@ -338,8 +338,11 @@ func resourceIBMIsInstanceNetworkInterfaceCreate(context context.Context, d *sch
 	}
 
 	isNICKey := "instance_key_" + instance_id
-	conns.IbmMutexKV.Lock(isNICKey)
-	defer conns.IbmMutexKV.Unlock(isNICKey)
+	unlock, err := conns.IbmKeyedLock.Lock(context, isNICKey)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error waiting for the lock on %s: %s", isNICKey, err))
+	}
+	defer unlock()
 
 	networkInterface, response, err := vpcClient.CreateInstanceNetworkInterfaceWithContext(context, createInstanceNetworkInterfaceOptions)
 	if err != nil {
@@ -622,8 +625,11 @@ func resourceIBMIsInstanceNetworkInterfaceUpdate(context context.Context, d *sch
 	}
 	if hasChange {
 		isNICKey := "instance_key_" + instance_id
-		conns.IbmMutexKV.Lock(isNICKey)
-		defer conns.IbmMutexKV.Unlock(isNICKey)
+		unlock, err := conns.IbmKeyedLock.Lock(context, isNICKey)
+		if err != nil {
+			return diag.FromErr(fmt.Errorf("[ERROR] Error waiting for the lock on %s: %s", isNICKey, err))
+		}
+		defer unlock()
 		updateInstanceNetworkInterfaceOptions.NetworkInterfacePatch, _ = patchVals.AsPatch()
 		_, response, err := vpcClient.UpdateInstanceNetworkInterfaceWithContext(context, updateInstanceNetworkInterfaceOptions)
 		if err != nil {
@@ -711,8 +717,11 @@ func resourceIBMIsInstanceNetworkInterfaceDelete(context context.Context, d *sch
 	instance_id := parts[0]
 	network_intf_id := parts[1]
 	isNICKey := "instance_key_" + instance_id
-	conns.IbmMutexKV.Lock(isNICKey)
-	defer conns.IbmMutexKV.Unlock(isNICKey)
+	unlock, err := conns.IbmKeyedLock.Lock(context, isNICKey)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error waiting for the lock on %s: %s", isNICKey, err))
+	}
+	defer unlock()
 
 	deleteInstanceNetworkInterfaceOptions.SetInstanceID(instance_id)
 	deleteInstanceNetworkInterfaceOptions.SetID(network_intf_id)
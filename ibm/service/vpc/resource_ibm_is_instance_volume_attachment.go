@@ -14,7 +14,9 @@ import (
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
+	"github.com/IBM/go-sdk-core/v5/core"
 	"github.com/IBM/vpc-go-sdk/vpcv1"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
@@ -38,12 +40,12 @@ const (
 
 func ResourceIBMISInstanceVolumeAttachment() *schema.Resource {
 	return &schema.Resource{
-		Create:   resourceIBMisInstanceVolumeAttachmentCreate,
-		Read:     resourceIBMisInstanceVolumeAttachmentRead,
-		Update:   resourceIBMisInstanceVolumeAttachmentUpdate,
-		Delete:   resourceIBMisInstanceVolumeAttachmentDelete,
-		Exists:   resourceIBMisInstanceVolumeAttachmentExists,
-		Importer: &schema.ResourceImporter{},
+		CreateContext: resourceIBMisInstanceVolumeAttachmentCreate,
+		Read:          resourceIBMisInstanceVolumeAttachmentRead,
+		Update:        resourceIBMisInstanceVolumeAttachmentUpdate,
+		DeleteContext: resourceIBMisInstanceVolumeAttachmentDelete,
+		Exists:        resourceIBMisInstanceVolumeAttachmentExists,
+		Importer:      &schema.ResourceImporter{},
 
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(10 * time.Minute),
@@ -57,7 +59,7 @@ func ResourceIBMISInstanceVolumeAttachment() *schema.Resource {
 				}),
 			customdiff.Sequence(
 				func(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
-					return flex.ResourceTagsCustomizeDiff(diff)
+					return flex.ResourceTagsCustomizeDiff(diff, v)
 				}),
 		),
 		Schema: map[string]*schema.Schema{
@@ -285,7 +287,7 @@ func ResourceIBMISInstanceVolumeAttachmentValidator() *validate.ResourceValidato
 	return &ibmISInstanceVolumeAttachmentValidator
 }
 
-func instanceVolAttachmentCreate(d *schema.ResourceData, meta interface{}, instanceId string) error {
+func instanceVolAttachmentCreate(context context.Context, d *schema.ResourceData, meta interface{}, instanceId string) error {
 	sess, err := vpcClient(meta)
 	if err != nil {
 		return err
@@ -415,10 +417,13 @@ func instanceVolAttachmentCreate(d *schema.ResourceData, meta interface{}, insta
 	}
 
 	isInstanceKey := "instance_key_" + instanceId
-	conns.IbmMutexKV.Lock(isInstanceKey)
-	defer conns.IbmMutexKV.Unlock(isInstanceKey)
+	unlock, err := conns.IbmKeyedLock.Lock(context, isInstanceKey)
+	if err != nil {
+		return fmt.Errorf("[ERROR] Error waiting for the lock on %s: %s", isInstanceKey, err)
+	}
+	defer unlock()
 
-	instanceVolAtt, response, err := sess.CreateInstanceVolumeAttachment(instanceVolAttproto)
+	instanceVolAtt, response, err := sess.CreateInstanceVolumeAttachmentWithContext(context, instanceVolAttproto)
 	if err != nil {
 		log.Printf("[DEBUG] Instance volume attachment create err %s\n%s", err, response)
 		return fmt.Errorf("[ERROR] Error while attaching volume for instance %s: %q", instanceId, err)
@@ -443,13 +448,13 @@ func instanceVolAttachmentCreate(d *schema.ResourceData, meta interface{}, insta
 	return nil
 }
 
-func resourceIBMisInstanceVolumeAttachmentCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMisInstanceVolumeAttachmentCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	instanceId := d.Get(isInstanceId).(string)
-	err := instanceVolAttachmentCreate(d, meta, instanceId)
+	err := instanceVolAttachmentCreate(context, d, meta, instanceId)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
-	return resourceIBMisInstanceVolumeAttachmentRead(d, meta)
+	return diag.FromErr(resourceIBMisInstanceVolumeAttachmentRead(d, meta))
 }
 
 func resourceIBMisInstanceVolumeAttachmentRead(d *schema.ResourceData, meta interface{}) error {
@@ -477,10 +482,20 @@ func instanceVolumeAttachmentGet(d *schema.ResourceData, meta interface{}, insta
 	volumeAtt, response, err := instanceC.GetInstanceVolumeAttachment(getinsVolAttOptions)
 	if err != nil {
 		if response != nil && response.StatusCode == 404 {
-			d.SetId("")
-			return nil
+			// the volume is attached again, or an ibm_is_volume replaced using a snapshot is attached in its place
+			volumeAtt, response, err = instanceVolAttGetByVolume(instanceC, instanceId, d.Get(isInstanceVolAttVol).(string))
+			if err != nil {
+				return err
+			}
+			if volumeAtt == nil {
+				d.SetId("")
+				return nil
+			}
+			id = *volumeAtt.ID
+			d.SetId(makeTerraformVolAttID(instanceId, id))
+		} else {
+			return fmt.Errorf("[ERROR] Error getting Instance volume attachment : %s\n%s", err, response)
 		}
-		return fmt.Errorf("[ERROR] Error getting Instance volume attachment : %s\n%s", err, response)
 	}
 	d.Set(isInstanceId, instanceId)
 
@@ -816,7 +831,7 @@ func resourceIBMisInstanceVolumeAttachmentUpdate(d *schema.ResourceData, meta in
 	return resourceIBMisInstanceVolumeAttachmentRead(d, meta)
 }
 
-func instanceVolAttDelete(d *schema.ResourceData, meta interface{}, instanceId, id, volId string, volDelete bool) error {
+func instanceVolAttDelete(context context.Context, d *schema.ResourceData, meta interface{}, instanceId, id, volId string, volDelete bool) error {
 	instanceC, err := vpcClient(meta)
 	if err != nil {
 		return err
@@ -828,10 +843,13 @@ func instanceVolAttDelete(d *schema.ResourceData, meta interface{}, instanceId,
 	}
 
 	isInstanceKey := "instance_key_" + instanceId
-	conns.IbmMutexKV.Lock(isInstanceKey)
-	defer conns.IbmMutexKV.Unlock(isInstanceKey)
+	unlock, err := conns.IbmKeyedLock.Lock(context, isInstanceKey)
+	if err != nil {
+		return fmt.Errorf("[ERROR] Error waiting for the lock on %s: %s", isInstanceKey, err)
+	}
+	defer unlock()
 
-	_, err = instanceC.DeleteInstanceVolumeAttachment(deleteInstanceVolAttOptions)
+	_, err = instanceC.DeleteInstanceVolumeAttachmentWithContext(context, deleteInstanceVolAttOptions)
 	if err != nil {
 		return fmt.Errorf("[ERROR] Error while deleting volume attachment (%s) from instance (%s) : %q", id, instanceId, err)
 	}
@@ -856,10 +874,10 @@ func instanceVolAttDelete(d *schema.ResourceData, meta interface{}, instanceId,
 	return nil
 }
 
-func resourceIBMisInstanceVolumeAttachmentDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMisInstanceVolumeAttachmentDelete(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	instanceId, id, err := parseVolAttTerraformID(d.Id())
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	volDelete := false
@@ -871,9 +889,9 @@ func resourceIBMisInstanceVolumeAttachmentDelete(d *schema.ResourceData, meta in
 		volId = volIdOk.(string)
 	}
 
-	err = instanceVolAttDelete(d, meta, instanceId, id, volId, volDelete)
+	err = instanceVolAttDelete(context, d, meta, instanceId, id, volId, volDelete)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	d.SetId("")
 	return nil
@@ -901,13 +919,94 @@ func instanceVolAttExists(d *schema.ResourceData, meta interface{}, instanceId,
 	_, response, err := instanceC.GetInstanceVolumeAttachment(getinsvolattOptions)
 	if err != nil {
 		if response != nil && response.StatusCode == 404 {
-			return false, nil
+			volumeAtt, _, err := instanceVolAttGetByVolume(instanceC, instanceId, d.Get(isInstanceVolAttVol).(string))
+			if err != nil {
+				return false, err
+			}
+			return volumeAtt != nil, nil
 		}
 		return false, fmt.Errorf("[ERROR] Error getting Instance volume attachment: %s\n%s", err, response)
 	}
 	return true, nil
 }
 
+// instanceVolAttGetByVolume returns the volume attachment of the instance for the volume with the given id, or
+// for the volume that replaced it when the volume was replaced by an ibm_is_volume using a snapshot of it. It
+// returns nil if the instance has no such attachment.
+func instanceVolAttGetByVolume(instanceC *vpcv1.VpcV1, instanceId, volumeId string) (*vpcv1.VolumeAttachment, *core.DetailedResponse, error) {
+	if volumeId == "" {
+		return nil, nil, nil
+	}
+	listinsVolAttOptions := &vpcv1.ListInstanceVolumeAttachmentsOptions{
+		InstanceID: &instanceId,
+	}
+	volumeAtts, response, err := instanceC.ListInstanceVolumeAttachments(listinsVolAttOptions)
+	if err != nil {
+		if response != nil && response.StatusCode == 404 {
+			return nil, nil, nil
+		}
+		return nil, response, fmt.Errorf("[ERROR] Error listing Instance volume attachments : %s\n%s", err, response)
+	}
+	var found *vpcv1.VolumeAttachment
+	for i, volumeAtt := range volumeAtts.VolumeAttachments {
+		if volumeAtt.Volume != nil && *volumeAtt.Volume.ID == volumeId {
+			found = &volumeAtts.VolumeAttachments[i]
+			break
+		}
+	}
+	if found == nil {
+		for i, volumeAtt := range volumeAtts.VolumeAttachments {
+			if volumeAtt.Volume == nil {
+				continue
+			}
+			replaced, err := instanceVolReplaces(instanceC, *volumeAtt.Volume.ID, volumeId)
+			if err != nil {
+				return nil, nil, err
+			}
+			if replaced {
+				found = &volumeAtts.VolumeAttachments[i]
+				break
+			}
+		}
+	}
+	if found == nil {
+		return nil, nil, nil
+	}
+	getinsVolAttOptions := &vpcv1.GetInstanceVolumeAttachmentOptions{
+		InstanceID: &instanceId,
+		ID:         found.ID,
+	}
+	volumeAtt, response, err := instanceC.GetInstanceVolumeAttachment(getinsVolAttOptions)
+	if err != nil {
+		return nil, response, fmt.Errorf("[ERROR] Error getting Instance volume attachment : %s\n%s", err, response)
+	}
+	return volumeAtt, response, nil
+}
+
+// instanceVolReplaces reports whether the volume volumeId was restored from a snapshot of the volume
+// replacedId, as done by an ibm_is_volume with replace_using_snapshot.
+func instanceVolReplaces(instanceC *vpcv1.VpcV1, volumeId, replacedId string) (bool, error) {
+	vol, response, err := instanceC.GetVolume(&vpcv1.GetVolumeOptions{
+		ID: &volumeId,
+	})
+	if err != nil {
+		return false, fmt.Errorf("[ERROR] Error getting Volume (%s): %s\n%s", volumeId, err, response)
+	}
+	if vol.SourceSnapshot == nil || vol.SourceSnapshot.ID == nil {
+		return false, nil
+	}
+	snapshot, response, err := instanceC.GetSnapshot(&vpcv1.GetSnapshotOptions{
+		ID: vol.SourceSnapshot.ID,
+	})
+	if err != nil {
+		if response != nil && response.StatusCode == 404 {
+			return false, nil
+		}
+		return false, fmt.Errorf("[ERROR] Error getting Snapshot (%s): %s\n%s", *vol.SourceSnapshot.ID, err, response)
+	}
+	return snapshot.SourceVolume != nil && *snapshot.SourceVolume.ID == replacedId, nil
+}
+
 func makeTerraformVolAttID(id1, id2 string) string {
 	// Include both instance id and volume attachment to create a unique Terraform id.  As a bonus,
 	// we can extract the instance id as needed for API calls such as READ.
@@ -0,0 +1,1805 @@
+// Copyright IBM Corp. 2025 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package vpc
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/vpc-go-sdk/vpcv1"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const (
+	isLBConfigurationPools      = "pools"
+	isLBConfigurationMembers    = "members"
+	isLBConfigurationListeners  = "listeners"
+	isLBConfigurationPolicies   = "policies"
+	isLBConfigurationTargetPool = "target_pool"
+
+	// isLBPoolMemberWeightDefault is the weight of a pool member that does not set one
+	isLBPoolMemberWeightDefault = 50
+)
+
+func ResourceIBMISLBConfiguration() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceIBMISLBConfigurationCreate,
+		ReadContext:   resourceIBMISLBConfigurationRead,
+		UpdateContext: resourceIBMISLBConfigurationUpdate,
+		DeleteContext: resourceIBMISLBConfigurationDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceIBMISLBConfigurationImport,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			isLBID: {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The load balancer identifier.",
+			},
+			isLBConfigurationPools: {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: "The complete list of pools of the load balancer. Pools of the load balancer that are not in the list are deleted.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The unique identifier for this load balancer pool.",
+						},
+						isLBPoolName: {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validate.InvokeValidator("ibm_is_lb_configuration", isLBPoolName),
+							Description:  "The name for this load balancer pool, unique within the load balancer.",
+						},
+						isLBPoolAlgorithm: {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validate.InvokeValidator("ibm_is_lb_configuration", isLBPoolAlgorithm),
+							Description:  "The load balancing algorithm.",
+						},
+						isLBPoolProtocol: {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validate.InvokeValidator("ibm_is_lb_configuration", isLBPoolProtocol),
+							Description:  "The protocol used for this load balancer pool.",
+						},
+						isLBPoolHealthDelay: {
+							Type:        schema.TypeInt,
+							Required:    true,
+							Description: "The health check interval in seconds.",
+						},
+						isLBPoolHealthRetries: {
+							Type:        schema.TypeInt,
+							Required:    true,
+							Description: "The health check max retries.",
+						},
+						isLBPoolHealthTimeout: {
+							Type:        schema.TypeInt,
+							Required:    true,
+							Description: "The health check timeout in seconds.",
+						},
+						isLBPoolHealthType: {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validate.InvokeValidator("ibm_is_lb_configuration", isLBPoolHealthType),
+							Description:  "The protocol type of the health check.",
+						},
+						isLBPoolHealthMonitorURL: {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Computed:    true,
+							Description: "The health check URL path. Applicable only if the health_type is http or https.",
+						},
+						isLBPoolHealthMonitorPort: {
+							Type:        schema.TypeInt,
+							Optional:    true,
+							Computed:    true,
+							Description: "The health check port. If not specified, the port of each member is used.",
+						},
+						isLBPoolProxyProtocol: {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Default:      "disabled",
+							ValidateFunc: validate.InvokeValidator("ibm_is_lb_configuration", isLBPoolProxyProtocol),
+							Description:  "The PROXY protocol setting for this pool.",
+						},
+						isLBPoolSessPersistenceType: {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validate.InvokeValidator("ibm_is_lb_configuration", isLBPoolSessPersistenceType),
+							Description:  "The session persistence type.",
+						},
+						isLBPoolSessPersistenceAppCookieName: {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "The session persistence cookie name. Applicable only if the session_persistence_type is app_cookie.",
+						},
+						isLBPoolProvisioningStatus: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The provisioning status of this pool.",
+						},
+						isLBConfigurationMembers: {
+							Type:        schema.TypeList,
+							Optional:    true,
+							Description: "The complete list of members of the pool. A change to the members replaces all the members of the pool at once.",
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"id": {
+										Type:        schema.TypeString,
+										Computed:    true,
+										Description: "The unique identifier for this load balancer pool member.",
+									},
+									isLBPoolMemberPort: {
+										Type:        schema.TypeInt,
+										Required:    true,
+										Description: "The port the member receives load balancer traffic on.",
+									},
+									isLBPoolMemberTargetAddress: {
+										Type:        schema.TypeString,
+										Optional:    true,
+										Description: "The IP address of the member.",
+									},
+									isLBPoolMemberTargetID: {
+										Type:        schema.TypeString,
+										Optional:    true,
+										Description: "The unique identifier of the virtual server instance or application load balancer of the member.",
+									},
+									isLBPoolMemberWeight: {
+										Type:         schema.TypeInt,
+										Optional:     true,
+										Default:      isLBPoolMemberWeightDefault,
+										ValidateFunc: validate.InvokeValidator("ibm_is_lb_configuration", isLBPoolMemberWeight),
+										Description:  "The weight of the member.",
+									},
+									isLBPoolMemberHealth: {
+										Type:        schema.TypeString,
+										Computed:    true,
+										Description: "The health of the member.",
+									},
+									isLBPoolMemberProvisioningStatus: {
+										Type:        schema.TypeString,
+										Computed:    true,
+										Description: "The provisioning status of the member.",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			isLBConfigurationListeners: {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: "The complete list of listeners of the load balancer. Listeners of the load balancer that are not in the list are deleted.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The unique identifier for this load balancer listener.",
+						},
+						isLBListenerPort: {
+							Type:         schema.TypeInt,
+							Required:     true,
+							ValidateFunc: validate.InvokeValidator("ibm_is_lb_configuration", isLBListenerPort),
+							Description:  "The listener port number, unique within the load balancer.",
+						},
+						isLBListenerProtocol: {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validate.InvokeValidator("ibm_is_lb_configuration", isLBListenerProtocol),
+							Description:  "The listener protocol.",
+						},
+						isLBListenerDefaultPool: {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "The name of the pool in pools that is used by default for requests to the listener.",
+						},
+						isLBListenerCertificateInstance: {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "The CRN of the certificate instance. Required for the https protocol.",
+						},
+						isLBListenerConnectionLimit: {
+							Type:        schema.TypeInt,
+							Optional:    true,
+							Computed:    true,
+							Description: "The connection limit of the listener.",
+						},
+						isLBListenerIdleConnectionTimeout: {
+							Type:        schema.TypeInt,
+							Optional:    true,
+							Computed:    true,
+							Description: "The idle connection timeout of the listener in seconds.",
+						},
+						isLBListenerAcceptProxyProtocol: {
+							Type:        schema.TypeBool,
+							Optional:    true,
+							Default:     false,
+							Description: "If set to true, this listener will accept and forward PROXY protocol information.",
+						},
+						isLBPoolProvisioningStatus: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The provisioning status of this listener.",
+						},
+						isLBConfigurationPolicies: {
+							Type:        schema.TypeList,
+							Optional:    true,
+							Description: "The complete list of policies of the listener. A policy is identified by its name. A policy that changed is updated in place with its rules, and a policy whose action changed is replaced by a new policy.",
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"id": {
+										Type:        schema.TypeString,
+										Computed:    true,
+										Description: "The unique identifier for this listener policy.",
+									},
+									isLBListenerPolicyName: {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validate.InvokeValidator("ibm_is_lb_configuration", isLBListenerPolicyName),
+										Description:  "The name for this policy, unique within the listener.",
+									},
+									isLBListenerPolicyAction: {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validate.InvokeValidator("ibm_is_lb_configuration", isLBListenerPolicyAction),
+										Description:  "The policy action.",
+									},
+									isLBListenerPolicyPriority: {
+										Type:         schema.TypeInt,
+										Required:     true,
+										ValidateFunc: validate.InvokeValidator("ibm_is_lb_configuration", isLBListenerPolicyPriority),
+										Description:  "The priority of the policy, unique within the listener.",
+									},
+									isLBConfigurationTargetPool: {
+										Type:        schema.TypeString,
+										Optional:    true,
+										Description: "The name of the pool in pools that requests are forwarded to. Applicable only if the action is forward_to_pool.",
+									},
+									isLBListenerPolicyTargetURL: {
+										Type:        schema.TypeString,
+										Optional:    true,
+										Description: "The redirect target URL. Applicable only if the action is redirect.",
+									},
+									isLBListenerPolicyTargetHTTPStatusCode: {
+										Type:        schema.TypeInt,
+										Optional:    true,
+										Description: "The HTTP status code of the redirect response. Applicable only if the action is redirect.",
+									},
+									isLBListenerPolicyRules: {
+										Type:        schema.TypeList,
+										Optional:    true,
+										Description: "The rules of the policy.",
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												isLBListenerPolicyRuleCondition: {
+													Type:         schema.TypeString,
+													Required:     true,
+													ValidateFunc: validate.InvokeValidator("ibm_is_lb_configuration", isLBListenerPolicyRuleCondition),
+													Description:  "The condition of the rule.",
+												},
+												isLBListenerPolicyRuleType: {
+													Type:         schema.TypeString,
+													Required:     true,
+													ValidateFunc: validate.InvokeValidator("ibm_is_lb_configuration", isLBListenerPolicyRuleType),
+													Description:  "The type of the rule.",
+												},
+												isLBListenerPolicyRuleValue: {
+													Type:        schema.TypeString,
+													Required:    true,
+													Description: "The value to be matched for the rule condition.",
+												},
+												isLBListenerPolicyRuleField: {
+													Type:        schema.TypeString,
+													Optional:    true,
+													Description: "The field. Applicable only to the header, query and body rule types.",
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func ResourceIBMISLBConfigurationValidator() *validate.ResourceValidator {
+	validateSchema := make([]validate.ValidateSchema, 0)
+	validateSchema = append(validateSchema,
+		validate.ValidateSchema{
+			Identifier:                 isLBPoolName,
+			ValidateFunctionIdentifier: validate.ValidateRegexpLen,
+			Type:                       validate.TypeString,
+			Required:                   true,
+			Regexp:                     `^([a-z]|[a-z][-a-z0-9]*[a-z0-9])$`,
+			MinValueLength:             1,
+			MaxValueLength:             63})
+	validateSchema = append(validateSchema,
+		validate.ValidateSchema{
+			Identifier:                 isLBPoolAlgorithm,
+			ValidateFunctionIdentifier: validate.ValidateAllowedStringValue,
+			Type:                       validate.TypeString,
+			Required:                   true,
+			AllowedValues:              "round_robin, weighted_round_robin, least_connections"})
+	validateSchema = append(validateSchema,
+		validate.ValidateSchema{
+			Identifier:                 isLBPoolProtocol,
+			ValidateFunctionIdentifier: validate.ValidateAllowedStringValue,
+			Type:                       validate.TypeString,
+			Required:                   true,
+			AllowedValues:              "http, tcp, https, udp"})
+	validateSchema = append(validateSchema,
+		validate.ValidateSchema{
+			Identifier:                 isLBPoolHealthType,
+			ValidateFunctionIdentifier: validate.ValidateAllowedStringValue,
+			Type:                       validate.TypeString,
+			Required:                   true,
+			AllowedValues:              "http, tcp, https"})
+	validateSchema = append(validateSchema,
+		validate.ValidateSchema{
+			Identifier:                 isLBPoolProxyProtocol,
+			ValidateFunctionIdentifier: validate.ValidateAllowedStringValue,
+			Type:                       validate.TypeString,
+			Optional:                   true,
+			AllowedValues:              "disabled, v1, v2"})
+	validateSchema = append(validateSchema,
+		validate.ValidateSchema{
+			Identifier:                 isLBPoolSessPersistenceType,
+			ValidateFunctionIdentifier: validate.ValidateAllowedStringValue,
+			Type:                       validate.TypeString,
+			Optional:                   true,
+			AllowedValues:              "source_ip, app_cookie, http_cookie"})
+	validateSchema = append(validateSchema,
+		validate.ValidateSchema{
+			Identifier:                 isLBPoolMemberWeight,
+			ValidateFunctionIdentifier: validate.IntBetween,
+			Type:                       validate.TypeInt,
+			Optional:                   true,
+			MinValue:                   "0",
+			MaxValue:                   "100"})
+	validateSchema = append(validateSchema,
+		validate.ValidateSchema{
+			Identifier:                 isLBListenerPort,
+			ValidateFunctionIdentifier: validate.IntBetween,
+			Type:                       validate.TypeInt,
+			Required:                   true,
+			MinValue:                   "1",
+			MaxValue:                   "65535"})
+	validateSchema = append(validateSchema,
+		validate.ValidateSchema{
+			Identifier:                 isLBListenerProtocol,
+			ValidateFunctionIdentifier: validate.ValidateAllowedStringValue,
+			Type:                       validate.TypeString,
+			Required:                   true,
+			AllowedValues:              "http, tcp, https, udp"})
+	validateSchema = append(validateSchema,
+		validate.ValidateSchema{
+			Identifier:                 isLBListenerPolicyName,
+			ValidateFunctionIdentifier: validate.ValidateRegexpLen,
+			Type:                       validate.TypeString,
+			Required:                   true,
+			Regexp:                     `^([a-z]|[a-z][-a-z0-9]*[a-z0-9])$`,
+			MinValueLength:             1,
+			MaxValueLength:             63})
+	validateSchema = append(validateSchema,
+		validate.ValidateSchema{
+			Identifier:                 isLBListenerPolicyAction,
+			ValidateFunctionIdentifier: validate.ValidateAllowedStringValue,
+			Type:                       validate.TypeString,
+			Required:                   true,
+			AllowedValues:              "forward_to_pool, redirect, reject"})
+	validateSchema = append(validateSchema,
+		validate.ValidateSchema{
+			Identifier:                 isLBListenerPolicyPriority,
+			ValidateFunctionIdentifier: validate.IntBetween,
+			Type:                       validate.TypeInt,
+			Required:                   true,
+			MinValue:                   "1",
+			MaxValue:                   "10"})
+	validateSchema = append(validateSchema,
+		validate.ValidateSchema{
+			Identifier:                 isLBListenerPolicyRuleCondition,
+			ValidateFunctionIdentifier: validate.ValidateAllowedStringValue,
+			Type:                       validate.TypeString,
+			Required:                   true,
+			AllowedValues:              "contains, equals, matches_regex"})
+	validateSchema = append(validateSchema,
+		validate.ValidateSchema{
+			Identifier:                 isLBListenerPolicyRuleType,
+			ValidateFunctionIdentifier: validate.ValidateAllowedStringValue,
+			Type:                       validate.TypeString,
+			Required:                   true,
+			AllowedValues:              "body, header, hostname, path, query, sni_hostname"})
+
+	ibmISLBConfigurationResourceValidator := validate.ResourceValidator{ResourceName: "ibm_is_lb_configuration", Schema: validateSchema}
+	return &ibmISLBConfigurationResourceValidator
+}
+
+type lbMemberSpec struct {
+	id                 string
+	port               int64
+	targetAddress      string
+	targetID           string
+	weight             int64
+	health             string
+	provisioningStatus string
+}
+
+func (m *lbMemberSpec) key() string {
+	return fmt.Sprintf("%s%s:%d/%d", m.targetAddress, m.targetID, m.port, m.weight)
+}
+
+func (m *lbMemberSpec) prototype() vpcv1.LoadBalancerPoolMemberPrototype {
+	target := &vpcv1.LoadBalancerPoolMemberTargetPrototype{}
+	if m.targetAddress != "" {
+		target.Address = core.StringPtr(m.targetAddress)
+	} else {
+		target.ID = core.StringPtr(m.targetID)
+	}
+	return vpcv1.LoadBalancerPoolMemberPrototype{
+		Port:   core.Int64Ptr(m.port),
+		Target: target,
+		Weight: core.Int64Ptr(m.weight),
+	}
+}
+
+type lbPoolSpec struct {
+	id                     string
+	name                   string
+	algorithm              string
+	protocol               string
+	healthDelay            int64
+	healthRetries          int64
+	healthTimeout          int64
+	healthType             string
+	healthMonitorURL       string
+	healthMonitorPort      int64
+	proxyProtocol          string
+	sessionPersistenceType string
+	sessionPersistenceName string
+	provisioningStatus     string
+	members                []*lbMemberSpec
+}
+
+func (p *lbPoolSpec) String() string {
+	return fmt.Sprintf("%s (%s %s)", p.name, p.protocol, p.algorithm)
+}
+
+// settingsDiffer reports whether the pool settings other than the members differ from the
+// actual pool. The health check URL and port are only compared when they are set.
+func (p *lbPoolSpec) settingsDiffer(actual *lbPoolSpec) bool {
+	return p.algorithm != actual.algorithm || p.protocol != actual.protocol ||
+		p.healthDelay != actual.healthDelay || p.healthRetries != actual.healthRetries ||
+		p.healthTimeout != actual.healthTimeout || p.healthType != actual.healthType ||
+		(p.healthMonitorURL != "" && p.healthMonitorURL != actual.healthMonitorURL) ||
+		(p.healthMonitorPort != 0 && p.healthMonitorPort != actual.healthMonitorPort) ||
+		p.proxyProtocol != actual.proxyProtocol || p.sessionPersistenceType != actual.sessionPersistenceType ||
+		(p.sessionPersistenceType == "app_cookie" && p.sessionPersistenceName != actual.sessionPersistenceName)
+}
+
+// membersKey returns the members of the pool in a form that does not depend on their order
+func (p *lbPoolSpec) membersKey() string {
+	keys := make([]string, 0, len(p.members))
+	for _, member := range p.members {
+		keys = append(keys, member.key())
+	}
+	sort.Strings(keys)
+	return strings.Join(keys, ",")
+}
+
+func (p *lbPoolSpec) memberPrototypes() []vpcv1.LoadBalancerPoolMemberPrototype {
+	members := make([]vpcv1.LoadBalancerPoolMemberPrototype, 0, len(p.members))
+	for _, member := range p.members {
+		members = append(members, member.prototype())
+	}
+	return members
+}
+
+func (p *lbPoolSpec) prototype(lbID string) *vpcv1.CreateLoadBalancerPoolOptions {
+	healthMonitor := &vpcv1.LoadBalancerPoolHealthMonitorPrototype{
+		Delay:      core.Int64Ptr(p.healthDelay),
+		MaxRetries: core.Int64Ptr(p.healthRetries),
+		Timeout:    core.Int64Ptr(p.healthTimeout),
+		Type:       core.StringPtr(p.healthType),
+	}
+	if p.healthMonitorURL != "" {
+		healthMonitor.URLPath = core.StringPtr(p.healthMonitorURL)
+	}
+	if p.healthMonitorPort != 0 {
+		healthMonitor.Port = core.Int64Ptr(p.healthMonitorPort)
+	}
+	createLoadBalancerPoolOptions := &vpcv1.CreateLoadBalancerPoolOptions{
+		LoadBalancerID: &lbID,
+		Name:           core.StringPtr(p.name),
+		Algorithm:      core.StringPtr(p.algorithm),
+		Protocol:       core.StringPtr(p.protocol),
+		HealthMonitor:  healthMonitor,
+		ProxyProtocol:  core.StringPtr(p.proxyProtocol),
+		Members:        p.memberPrototypes(),
+	}
+	if p.sessionPersistenceType != "" {
+		createLoadBalancerPoolOptions.SessionPersistence = &vpcv1.LoadBalancerPoolSessionPersistencePrototype{
+			Type: core.StringPtr(p.sessionPersistenceType),
+		}
+		if p.sessionPersistenceName != "" {
+			createLoadBalancerPoolOptions.SessionPersistence.CookieName = core.StringPtr(p.sessionPersistenceName)
+		}
+	}
+	return createLoadBalancerPoolOptions
+}
+
+func (p *lbPoolSpec) patch() (map[string]interface{}, error) {
+	healthMonitor := &vpcv1.LoadBalancerPoolHealthMonitorPatch{
+		Delay:      core.Int64Ptr(p.healthDelay),
+		MaxRetries: core.Int64Ptr(p.healthRetries),
+		Timeout:    core.Int64Ptr(p.healthTimeout),
+		Type:       core.StringPtr(p.healthType),
+	}
+	if p.healthMonitorURL != "" {
+		healthMonitor.URLPath = core.StringPtr(p.healthMonitorURL)
+	}
+	if p.healthMonitorPort != 0 {
+		healthMonitor.Port = core.Int64Ptr(p.healthMonitorPort)
+	}
+	loadBalancerPoolPatchModel := &vpcv1.LoadBalancerPoolPatch{
+		Algorithm:     core.StringPtr(p.algorithm),
+		Protocol:      core.StringPtr(p.protocol),
+		HealthMonitor: healthMonitor,
+		ProxyProtocol: core.StringPtr(p.proxyProtocol),
+	}
+	if p.sessionPersistenceType != "" {
+		loadBalancerPoolPatchModel.SessionPersistence = &vpcv1.LoadBalancerPoolSessionPersistencePatch{
+			Type: core.StringPtr(p.sessionPersistenceType),
+		}
+		if p.sessionPersistenceName != "" {
+			loadBalancerPoolPatchModel.SessionPersistence.CookieName = core.StringPtr(p.sessionPersistenceName)
+		}
+	}
+	loadBalancerPoolPatch, err := loadBalancerPoolPatchModel.AsPatch()
+	if err != nil {
+		return nil, err
+	}
+	if p.sessionPersistenceType == "" {
+		loadBalancerPoolPatch["session_persistence"] = nil
+	}
+	return loadBalancerPoolPatch, nil
+}
+
+func (p *lbPoolSpec) flatten() map[string]interface{} {
+	members := make([]interface{}, 0, len(p.members))
+	for _, member := range p.members {
+		members = append(members, map[string]interface{}{
+			"id":                             member.id,
+			isLBPoolMemberPort:               int(member.port),
+			isLBPoolMemberTargetAddress:      member.targetAddress,
+			isLBPoolMemberTargetID:           member.targetID,
+			isLBPoolMemberWeight:             int(member.weight),
+			isLBPoolMemberHealth:             member.health,
+			isLBPoolMemberProvisioningStatus: member.provisioningStatus,
+		})
+	}
+	return map[string]interface{}{
+		"id":                                 p.id,
+		isLBPoolName:                         p.name,
+		isLBPoolAlgorithm:                    p.algorithm,
+		isLBPoolProtocol:                     p.protocol,
+		isLBPoolHealthDelay:                  int(p.healthDelay),
+		isLBPoolHealthRetries:                int(p.healthRetries),
+		isLBPoolHealthTimeout:                int(p.healthTimeout),
+		isLBPoolHealthType:                   p.healthType,
+		isLBPoolHealthMonitorURL:             p.healthMonitorURL,
+		isLBPoolHealthMonitorPort:            int(p.healthMonitorPort),
+		isLBPoolProxyProtocol:                p.proxyProtocol,
+		isLBPoolSessPersistenceType:          p.sessionPersistenceType,
+		isLBPoolSessPersistenceAppCookieName: p.sessionPersistenceName,
+		isLBPoolProvisioningStatus:           p.provisioningStatus,
+		isLBConfigurationMembers:             members,
+	}
+}
+
+func expandLBPoolSpec(pool map[string]interface{}) *lbPoolSpec {
+	spec := &lbPoolSpec{}
+	spec.name, _ = pool[isLBPoolName].(string)
+	spec.algorithm, _ = pool[isLBPoolAlgorithm].(string)
+	spec.protocol, _ = pool[isLBPoolProtocol].(string)
+	if v, ok := pool[isLBPoolHealthDelay].(int); ok {
+		spec.healthDelay = int64(v)
+	}
+	if v, ok := pool[isLBPoolHealthRetries].(int); ok {
+		spec.healthRetries = int64(v)
+	}
+	if v, ok := pool[isLBPoolHealthTimeout].(int); ok {
+		spec.healthTimeout = int64(v)
+	}
+	spec.healthType, _ = pool[isLBPoolHealthType].(string)
+	spec.healthMonitorURL, _ = pool[isLBPoolHealthMonitorURL].(string)
+	if v, ok := pool[isLBPoolHealthMonitorPort].(int); ok {
+		spec.healthMonitorPort = int64(v)
+	}
+	spec.proxyProtocol, _ = pool[isLBPoolProxyProtocol].(string)
+	spec.sessionPersistenceType, _ = pool[isLBPoolSessPersistenceType].(string)
+	spec.sessionPersistenceName, _ = pool[isLBPoolSessPersistenceAppCookieName].(string)
+	members, _ := pool[isLBConfigurationMembers].([]interface{})
+	for _, m := range members {
+		values, _ := m.(map[string]interface{})
+		member := &lbMemberSpec{weight: isLBPoolMemberWeightDefault}
+		if v, ok := values[isLBPoolMemberPort].(int); ok {
+			member.port = int64(v)
+		}
+		member.targetAddress, _ = values[isLBPoolMemberTargetAddress].(string)
+		member.targetID, _ = values[isLBPoolMemberTargetID].(string)
+		if v, ok := values[isLBPoolMemberWeight].(int); ok {
+			member.weight = int64(v)
+		}
+		spec.members = append(spec.members, member)
+	}
+	return spec
+}
+
+func lbPoolSpecFromPool(pool vpcv1.LoadBalancerPool, members []vpcv1.LoadBalancerPoolMember) *lbPoolSpec {
+	spec := &lbPoolSpec{
+		id:                 *pool.ID,
+		name:               *pool.Name,
+		algorithm:          *pool.Algorithm,
+		protocol:           *pool.Protocol,
+		proxyProtocol:      *pool.ProxyProtocol,
+		provisioningStatus: *pool.ProvisioningStatus,
+	}
+	if healthMonitor, ok := pool.HealthMonitor.(*vpcv1.LoadBalancerPoolHealthMonitor); ok {
+		spec.healthDelay = *healthMonitor.Delay
+		spec.healthRetries = *healthMonitor.MaxRetries
+		spec.healthTimeout = *healthMonitor.Timeout
+		spec.healthType = *healthMonitor.Type
+		if healthMonitor.URLPath != nil {
+			spec.healthMonitorURL = *healthMonitor.URLPath
+		}
+		if healthMonitor.Port != nil {
+			spec.healthMonitorPort = *healthMonitor.Port
+		}
+	}
+	if pool.SessionPersistence != nil {
+		spec.sessionPersistenceType = *pool.SessionPersistence.Type
+		if *pool.SessionPersistence.Type == "app_cookie" && pool.SessionPersistence.CookieName != nil {
+			spec.sessionPersistenceName = *pool.SessionPersistence.CookieName
+		}
+	}
+	for _, member := range members {
+		memberSpec := &lbMemberSpec{
+			id:                 *member.ID,
+			port:               *member.Port,
+			weight:             isLBPoolMemberWeightDefault,
+			health:             *member.Health,
+			provisioningStatus: *member.ProvisioningStatus,
+		}
+		if member.Weight != nil {
+			memberSpec.weight = *member.Weight
+		}
+		if target, ok := member.Target.(*vpcv1.LoadBalancerPoolMemberTarget); ok {
+			if target.Address != nil {
+				memberSpec.targetAddress = *target.Address
+			} else if target.ID != nil {
+				memberSpec.targetID = *target.ID
+			}
+		}
+		spec.members = append(spec.members, memberSpec)
+	}
+	return spec
+}
+
+type lbPolicyRuleSpec struct {
+	id        string
+	condition string
+	ruleType  string
+	value     string
+	field     string
+}
+
+func (r lbPolicyRuleSpec) key() string {
+	return strings.Join([]string{r.ruleType, r.field, r.condition, r.value}, "|")
+}
+
+type lbPolicySpec struct {
+	id             string
+	name           string
+	action         string
+	priority       int64
+	targetPool     string
+	targetURL      string
+	httpStatusCode int64
+	rules          []lbPolicyRuleSpec
+}
+
+func (p *lbPolicySpec) key() string {
+	rules := make([]string, 0, len(p.rules))
+	for _, rule := range p.rules {
+		rules = append(rules, rule.key())
+	}
+	sort.Strings(rules)
+	return fmt.Sprintf("%s|%d|%s|%s|%d|%s", p.action, p.priority, p.targetPool, p.targetURL, p.httpStatusCode, strings.Join(rules, ","))
+}
+
+// prototype returns the prototype of the policy, with the target pool resolved by name in poolIDs
+func (p *lbPolicySpec) prototype(poolIDs map[string]string) vpcv1.LoadBalancerListenerPolicyPrototype {
+	policyPrototype := vpcv1.LoadBalancerListenerPolicyPrototype{
+		Name:     core.StringPtr(p.name),
+		Action:   core.StringPtr(p.action),
+		Priority: core.Int64Ptr(p.priority),
+	}
+	switch p.action {
+	case "forward_to_pool":
+		policyPrototype.Target = &vpcv1.LoadBalancerListenerPolicyTargetPrototypeLoadBalancerPoolIdentity{
+			ID: core.StringPtr(poolIDs[p.targetPool]),
+		}
+	case "redirect":
+		policyPrototype.Target = &vpcv1.LoadBalancerListenerPolicyTargetPrototypeLoadBalancerListenerPolicyRedirectURLPrototype{
+			HTTPStatusCode: core.Int64Ptr(p.httpStatusCode),
+			URL:            core.StringPtr(p.targetURL),
+		}
+	}
+	for _, rule := range p.rules {
+		rulePrototype := vpcv1.LoadBalancerListenerPolicyRulePrototype{
+			Condition: core.StringPtr(rule.condition),
+			Type:      core.StringPtr(rule.ruleType),
+			Value:     core.StringPtr(rule.value),
+		}
+		if rule.field != "" {
+			rulePrototype.Field = core.StringPtr(rule.field)
+		}
+		policyPrototype.Rules = append(policyPrototype.Rules, rulePrototype)
+	}
+	return policyPrototype
+}
+
+// patch returns the patch that changes the priority and target of the current policy to the ones of the
+// policy, or nil when they are the same. The action of a policy can't be changed.
+func (p *lbPolicySpec) patch(current *lbPolicySpec, poolIDs map[string]string) (map[string]interface{}, error) {
+	if p.priority == current.priority && p.targetPool == current.targetPool && p.targetURL == current.targetURL && p.httpStatusCode == current.httpStatusCode {
+		return nil, nil
+	}
+	loadBalancerListenerPolicyPatchModel := &vpcv1.LoadBalancerListenerPolicyPatch{
+		Priority: core.Int64Ptr(p.priority),
+	}
+	switch p.action {
+	case "forward_to_pool":
+		loadBalancerListenerPolicyPatchModel.Target = &vpcv1.LoadBalancerListenerPolicyTargetPatchLoadBalancerPoolIdentity{
+			ID: core.StringPtr(poolIDs[p.targetPool]),
+		}
+	case "redirect":
+		loadBalancerListenerPolicyPatchModel.Target = &vpcv1.LoadBalancerListenerPolicyTargetPatchLoadBalancerListenerPolicyRedirectURLPatch{
+			HTTPStatusCode: core.Int64Ptr(p.httpStatusCode),
+			URL:            core.StringPtr(p.targetURL),
+		}
+	}
+	return loadBalancerListenerPolicyPatchModel.AsPatch()
+}
+
+// lbPolicyMaxPriority is the lowest priority of a listener policy, the priorities start at 1
+const lbPolicyMaxPriority = 10
+
+// lbPolicyPark moves a policy out of the way of the configured policies, to the name and priority when set
+type lbPolicyPark struct {
+	policy   *lbPolicySpec
+	name     string
+	priority int64
+}
+
+type lbPolicyUpdate struct {
+	current *lbPolicySpec
+	desired *lbPolicySpec
+}
+
+// lbPolicyPlan is the order of the calls that make the policies of a listener match the configuration.
+// The names and the priorities of the policies are unique within the listener, so a policy that keeps
+// its name but gets another priority or action first moves out of the way of the configured policies.
+type lbPolicyPlan struct {
+	deletes  []*lbPolicySpec
+	parks    []lbPolicyPark
+	updates  []lbPolicyUpdate
+	creates  []*lbPolicySpec
+	replaced []*lbPolicySpec
+}
+
+// lbPolicyParkedName returns the name of a policy while its replacement is created
+func lbPolicyParkedName(name string) string {
+	suffix := "-replaced"
+	if len(name)+len(suffix) > 63 {
+		name = strings.TrimRight(name[:63-len(suffix)], "-")
+	}
+	return name + suffix
+}
+
+// planLBPolicies plans the changes of the current policies of a listener to the desired ones. The policies
+// that are not configured are deleted first. A policy whose action changed is replaced: it is renamed,
+// moved to a free priority if its priority is configured, and deleted after its replacement is created.
+// The other changed policies are updated in place, after the policies on their priorities moved to a free
+// priority. A policy that can't be moved, because all the priorities are in use, is deleted before it
+// is created again.
+func planLBPolicies(current, desired []*lbPolicySpec) lbPolicyPlan {
+	var plan lbPolicyPlan
+	desiredByName := make(map[string]*lbPolicySpec, len(desired))
+	wanted := make(map[int64]string, len(desired))
+	for _, policy := range desired {
+		desiredByName[policy.name] = policy
+		wanted[policy.priority] = policy.name
+	}
+	currentNames := make(map[string]bool, len(current))
+	held := make(map[int64]bool, len(current))
+	for _, policy := range current {
+		currentNames[policy.name] = true
+		if desiredByName[policy.name] == nil {
+			plan.deletes = append(plan.deletes, policy)
+			continue
+		}
+		held[policy.priority] = true
+	}
+	free := func() int64 {
+		for priority := int64(1); priority <= lbPolicyMaxPriority; priority++ {
+			if !held[priority] && wanted[priority] == "" {
+				held[priority] = true
+				return priority
+			}
+		}
+		return 0
+	}
+	for _, policy := range current {
+		want := desiredByName[policy.name]
+		if want == nil || want.key() == policy.key() {
+			continue
+		}
+		inPlace := want.action == policy.action
+		park := lbPolicyPark{policy: policy}
+		if !inPlace {
+			park.name = lbPolicyParkedName(policy.name)
+		}
+		if owner := wanted[policy.priority]; owner != "" && (!inPlace || owner != policy.name) {
+			park.priority = free()
+			if park.priority == 0 {
+				plan.deletes = append(plan.deletes, policy)
+				plan.creates = append(plan.creates, want)
+				delete(held, policy.priority)
+				continue
+			}
+			delete(held, policy.priority)
+		}
+		if park.name != "" || park.priority != 0 {
+			plan.parks = append(plan.parks, park)
+		}
+		if inPlace {
+			plan.updates = append(plan.updates, lbPolicyUpdate{current: policy, desired: want})
+			continue
+		}
+		plan.creates = append(plan.creates, want)
+		plan.replaced = append(plan.replaced, policy)
+	}
+	for _, policy := range desired {
+		if !currentNames[policy.name] {
+			plan.creates = append(plan.creates, policy)
+		}
+	}
+	return plan
+}
+
+type lbListenerSpec struct {
+	id                    string
+	port                  int64
+	protocol              string
+	defaultPool           string
+	certificateInstance   string
+	connectionLimit       int64
+	idleConnectionTimeout int64
+	acceptProxyProtocol   bool
+	provisioningStatus    string
+	policies              []*lbPolicySpec
+}
+
+func (l *lbListenerSpec) String() string {
+	return fmt.Sprintf("%s/%d", l.protocol, l.port)
+}
+
+// settingsDiffer reports whether the listener settings other than the policies differ from the
+// actual listener. The connection limit and idle connection timeout are only compared when they are set.
+func (l *lbListenerSpec) settingsDiffer(actual *lbListenerSpec) bool {
+	return l.protocol != actual.protocol || l.defaultPool != actual.defaultPool ||
+		l.certificateInstance != actual.certificateInstance || l.acceptProxyProtocol != actual.acceptProxyProtocol ||
+		(l.connectionLimit != 0 && l.connectionLimit != actual.connectionLimit) ||
+		(l.idleConnectionTimeout != 0 && l.idleConnectionTimeout != actual.idleConnectionTimeout)
+}
+
+func (l *lbListenerSpec) prototype(lbID string, poolIDs map[string]string) *vpcv1.CreateLoadBalancerListenerOptions {
+	createLoadBalancerListenerOptions := &vpcv1.CreateLoadBalancerListenerOptions{
+		LoadBalancerID:      &lbID,
+		Port:                core.Int64Ptr(l.port),
+		Protocol:            core.StringPtr(l.protocol),
+		AcceptProxyProtocol: core.BoolPtr(l.acceptProxyProtocol),
+	}
+	if l.defaultPool != "" {
+		createLoadBalancerListenerOptions.DefaultPool = &vpcv1.LoadBalancerPoolIdentity{
+			ID: core.StringPtr(poolIDs[l.defaultPool]),
+		}
+	}
+	if l.certificateInstance != "" {
+		createLoadBalancerListenerOptions.CertificateInstance = &vpcv1.CertificateInstanceIdentity{
+			CRN: core.StringPtr(l.certificateInstance),
+		}
+	}
+	if l.connectionLimit != 0 {
+		createLoadBalancerListenerOptions.ConnectionLimit = core.Int64Ptr(l.connectionLimit)
+	}
+	if l.idleConnectionTimeout != 0 {
+		createLoadBalancerListenerOptions.IdleConnectionTimeout = core.Int64Ptr(l.idleConnectionTimeout)
+	}
+	for _, policy := range l.policies {
+		createLoadBalancerListenerOptions.Policies = append(createLoadBalancerListenerOptions.Policies, policy.prototype(poolIDs))
+	}
+	return createLoadBalancerListenerOptions
+}
+
+func (l *lbListenerSpec) patch(poolIDs map[string]string) (map[string]interface{}, error) {
+	loadBalancerListenerPatchModel := &vpcv1.LoadBalancerListenerPatch{
+		Protocol:            core.StringPtr(l.protocol),
+		AcceptProxyProtocol: core.BoolPtr(l.acceptProxyProtocol),
+	}
+	if l.defaultPool != "" {
+		loadBalancerListenerPatchModel.DefaultPool = &vpcv1.LoadBalancerListenerDefaultPoolPatch{
+			ID: core.StringPtr(poolIDs[l.defaultPool]),
+		}
+	}
+	if l.certificateInstance != "" {
+		loadBalancerListenerPatchModel.CertificateInstance = &vpcv1.CertificateInstanceIdentity{
+			CRN: core.StringPtr(l.certificateInstance),
+		}
+	}
+	if l.connectionLimit != 0 {
+		loadBalancerListenerPatchModel.ConnectionLimit = core.Int64Ptr(l.connectionLimit)
+	}
+	if l.idleConnectionTimeout != 0 {
+		loadBalancerListenerPatchModel.IdleConnectionTimeout = core.Int64Ptr(l.idleConnectionTimeout)
+	}
+	loadBalancerListenerPatch, err := loadBalancerListenerPatchModel.AsPatch()
+	if err != nil {
+		return nil, err
+	}
+	if l.defaultPool == "" {
+		loadBalancerListenerPatch["default_pool"] = nil
+	}
+	return loadBalancerListenerPatch, nil
+}
+
+func (l *lbListenerSpec) flatten() map[string]interface{} {
+	policies := make([]interface{}, 0, len(l.policies))
+	for _, policy := range l.policies {
+		rules := make([]interface{}, 0, len(policy.rules))
+		for _, rule := range policy.rules {
+			rules = append(rules, map[string]interface{}{
+				isLBListenerPolicyRuleCondition: rule.condition,
+				isLBListenerPolicyRuleType:      rule.ruleType,
+				isLBListenerPolicyRuleValue:     rule.value,
+				isLBListenerPolicyRuleField:     rule.field,
+			})
+		}
+		policies = append(policies, map[string]interface{}{
+			"id":                                   policy.id,
+			isLBListenerPolicyName:                 policy.name,
+			isLBListenerPolicyAction:               policy.action,
+			isLBListenerPolicyPriority:             int(policy.priority),
+			isLBConfigurationTargetPool:            policy.targetPool,
+			isLBListenerPolicyTargetURL:            policy.targetURL,
+			isLBListenerPolicyTargetHTTPStatusCode: int(policy.httpStatusCode),
+			isLBListenerPolicyRules:                rules,
+		})
+	}
+	return map[string]interface{}{
+		"id":                              l.id,
+		isLBListenerPort:                  int(l.port),
+		isLBListenerProtocol:              l.protocol,
+		isLBListenerDefaultPool:           l.defaultPool,
+		isLBListenerCertificateInstance:   l.certificateInstance,
+		isLBListenerConnectionLimit:       int(l.connectionLimit),
+		isLBListenerIdleConnectionTimeout: int(l.idleConnectionTimeout),
+		isLBListenerAcceptProxyProtocol:   l.acceptProxyProtocol,
+		isLBPoolProvisioningStatus:        l.provisioningStatus,
+		isLBConfigurationPolicies:         policies,
+	}
+}
+
+func expandLBListenerSpec(listener map[string]interface{}) *lbListenerSpec {
+	spec := &lbListenerSpec{}
+	if v, ok := listener[isLBListenerPort].(int); ok {
+		spec.port = int64(v)
+	}
+	spec.protocol, _ = listener[isLBListenerProtocol].(string)
+	spec.defaultPool, _ = listener[isLBListenerDefaultPool].(string)
+	spec.certificateInstance, _ = listener[isLBListenerCertificateInstance].(string)
+	if v, ok := listener[isLBListenerConnectionLimit].(int); ok {
+		spec.connectionLimit = int64(v)
+	}
+	if v, ok := listener[isLBListenerIdleConnectionTimeout].(int); ok {
+		spec.idleConnectionTimeout = int64(v)
+	}
+	spec.acceptProxyProtocol, _ = listener[isLBListenerAcceptProxyProtocol].(bool)
+	policies, _ := listener[isLBConfigurationPolicies].([]interface{})
+	for _, p := range policies {
+		values, _ := p.(map[string]interface{})
+		policy := &lbPolicySpec{}
+		policy.name, _ = values[isLBListenerPolicyName].(string)
+		policy.action, _ = values[isLBListenerPolicyAction].(string)
+		if v, ok := values[isLBListenerPolicyPriority].(int); ok {
+			policy.priority = int64(v)
+		}
+		policy.targetPool, _ = values[isLBConfigurationTargetPool].(string)
+		policy.targetURL, _ = values[isLBListenerPolicyTargetURL].(string)
+		if v, ok := values[isLBListenerPolicyTargetHTTPStatusCode].(int); ok {
+			policy.httpStatusCode = int64(v)
+		}
+		rules, _ := values[isLBListenerPolicyRules].([]interface{})
+		for _, r := range rules {
+			ruleValues, _ := r.(map[string]interface{})
+			rule := lbPolicyRuleSpec{}
+			rule.condition, _ = ruleValues[isLBListenerPolicyRuleCondition].(string)
+			rule.ruleType, _ = ruleValues[isLBListenerPolicyRuleType].(string)
+			rule.value, _ = ruleValues[isLBListenerPolicyRuleValue].(string)
+			rule.field, _ = ruleValues[isLBListenerPolicyRuleField].(string)
+			policy.rules = append(policy.rules, rule)
+		}
+		spec.policies = append(spec.policies, policy)
+	}
+	return spec
+}
+
+// expandLBConfiguration returns the configured pools and listeners, and checks that the names
+// of the pools and the ports of the listeners are unique, and that the pools referenced by the
+// listeners and policies are configured.
+func expandLBConfiguration(d *schema.ResourceData) ([]*lbPoolSpec, []*lbListenerSpec, error) {
+	pools := make([]*lbPoolSpec, 0)
+	poolNames := make(map[string]bool)
+	for _, p := range d.Get(isLBConfigurationPools).([]interface{}) {
+		values, _ := p.(map[string]interface{})
+		pool := expandLBPoolSpec(values)
+		if poolNames[pool.name] {
+			return nil, nil, fmt.Errorf("pool name %s is used more than once, names must be unique within the load balancer", pool.name)
+		}
+		poolNames[pool.name] = true
+		for _, member := range pool.members {
+			if (member.targetAddress == "") == (member.targetID == "") {
+				return nil, nil, fmt.Errorf("member of pool %s on port %d must set exactly one of %s and %s", pool.name, member.port, isLBPoolMemberTargetAddress, isLBPoolMemberTargetID)
+			}
+		}
+		pools = append(pools, pool)
+	}
+	listeners := make([]*lbListenerSpec, 0)
+	ports := make(map[int64]bool)
+	for _, l := range d.Get(isLBConfigurationListeners).([]interface{}) {
+		values, _ := l.(map[string]interface{})
+		listener := expandLBListenerSpec(values)
+		if ports[listener.port] {
+			return nil, nil, fmt.Errorf("listener port %d is used more than once, ports must be unique within the load balancer", listener.port)
+		}
+		ports[listener.port] = true
+		if listener.defaultPool != "" && !poolNames[listener.defaultPool] {
+			return nil, nil, fmt.Errorf("default_pool %s of listener %s is not in pools", listener.defaultPool, listener)
+		}
+		policyNames := make(map[string]bool)
+		for _, policy := range listener.policies {
+			if policyNames[policy.name] {
+				return nil, nil, fmt.Errorf("policy name %s is used more than once in listener %s, names must be unique within the listener", policy.name, listener)
+			}
+			policyNames[policy.name] = true
+			if policy.action == "forward_to_pool" && !poolNames[policy.targetPool] {
+				return nil, nil, fmt.Errorf("target_pool %q of policy %s of listener %s is not in pools", policy.targetPool, policy.name, listener)
+			}
+		}
+		listeners = append(listeners, listener)
+	}
+	return pools, listeners, nil
+}
+
+// getLBConfigurationPools returns the pools of the load balancer with their members
+func getLBConfigurationPools(context context.Context, sess *vpcv1.VpcV1, lbID string) ([]*lbPoolSpec, *core.DetailedResponse, error) {
+	listLoadBalancerPoolsOptions := &vpcv1.ListLoadBalancerPoolsOptions{
+		LoadBalancerID: &lbID,
+	}
+	poolCollection, response, err := sess.ListLoadBalancerPoolsWithContext(context, listLoadBalancerPoolsOptions)
+	if err != nil {
+		return nil, response, err
+	}
+	pools := make([]*lbPoolSpec, 0, len(poolCollection.Pools))
+	for _, pool := range poolCollection.Pools {
+		listLoadBalancerPoolMembersOptions := &vpcv1.ListLoadBalancerPoolMembersOptions{
+			LoadBalancerID: &lbID,
+			PoolID:         pool.ID,
+		}
+		memberCollection, response, err := sess.ListLoadBalancerPoolMembersWithContext(context, listLoadBalancerPoolMembersOptions)
+		if err != nil {
+			return nil, response, err
+		}
+		pools = append(pools, lbPoolSpecFromPool(pool, memberCollection.Members))
+	}
+	return pools, response, nil
+}
+
+// getLBConfigurationListeners returns the listeners of the load balancer with their policies
+// and rules. The pools are referenced by their name in poolNames.
+func getLBConfigurationListeners(context context.Context, sess *vpcv1.VpcV1, lbID string, poolNames map[string]string) ([]*lbListenerSpec, *core.DetailedResponse, error) {
+	listLoadBalancerListenersOptions := &vpcv1.ListLoadBalancerListenersOptions{
+		LoadBalancerID: &lbID,
+	}
+	listenerCollection, response, err := sess.ListLoadBalancerListenersWithContext(context, listLoadBalancerListenersOptions)
+	if err != nil {
+		return nil, response, err
+	}
+	listeners := make([]*lbListenerSpec, 0, len(listenerCollection.Listeners))
+	for _, listener := range listenerCollection.Listeners {
+		spec := &lbListenerSpec{
+			id:                 *listener.ID,
+			port:               *listener.Port,
+			protocol:           *listener.Protocol,
+			provisioningStatus: *listener.ProvisioningStatus,
+		}
+		if listener.AcceptProxyProtocol != nil {
+			spec.acceptProxyProtocol = *listener.AcceptProxyProtocol
+		}
+		if listener.DefaultPool != nil {
+			spec.defaultPool = poolNames[*listener.DefaultPool.ID]
+		}
+		if listener.CertificateInstance != nil {
+			spec.certificateInstance = *listener.CertificateInstance.CRN
+		}
+		if listener.ConnectionLimit != nil {
+			spec.connectionLimit = *listener.ConnectionLimit
+		}
+		if listener.IdleConnectionTimeout != nil {
+			spec.idleConnectionTimeout = *listener.IdleConnectionTimeout
+		}
+
+		listLoadBalancerListenerPoliciesOptions := &vpcv1.ListLoadBalancerListenerPoliciesOptions{
+			LoadBalancerID: &lbID,
+			ListenerID:     listener.ID,
+		}
+		policyCollection, response, err := sess.ListLoadBalancerListenerPoliciesWithContext(context, listLoadBalancerListenerPoliciesOptions)
+		if err != nil {
+			return nil, response, err
+		}
+		for _, policy := range policyCollection.Policies {
+			policySpec := &lbPolicySpec{
+				id:       *policy.ID,
+				name:     *policy.Name,
+				action:   *policy.Action,
+				priority: *policy.Priority,
+			}
+			switch target := policy.Target.(type) {
+			case *vpcv1.LoadBalancerListenerPolicyTargetLoadBalancerPoolReference:
+				policySpec.targetPool = poolNames[*target.ID]
+			case *vpcv1.LoadBalancerListenerPolicyTargetLoadBalancerListenerPolicyRedirectURL:
+				policySpec.targetURL = *target.URL
+				policySpec.httpStatusCode = *target.HTTPStatusCode
+			case *vpcv1.LoadBalancerListenerPolicyTarget:
+				if target.ID != nil {
+					policySpec.targetPool = poolNames[*target.ID]
+				}
+				if target.URL != nil {
+					policySpec.targetURL = *target.URL
+				}
+				if target.HTTPStatusCode != nil {
+					policySpec.httpStatusCode = *target.HTTPStatusCode
+				}
+			}
+			listLoadBalancerListenerPolicyRulesOptions := &vpcv1.ListLoadBalancerListenerPolicyRulesOptions{
+				LoadBalancerID: &lbID,
+				ListenerID:     listener.ID,
+				PolicyID:       policy.ID,
+			}
+			ruleCollection, response, err := sess.ListLoadBalancerListenerPolicyRulesWithContext(context, listLoadBalancerListenerPolicyRulesOptions)
+			if err != nil {
+				return nil, response, err
+			}
+			for _, rule := range ruleCollection.Rules {
+				ruleSpec := lbPolicyRuleSpec{
+					id:        *rule.ID,
+					condition: *rule.Condition,
+					ruleType:  *rule.Type,
+					value:     *rule.Value,
+				}
+				if rule.Field != nil {
+					ruleSpec.field = *rule.Field
+				}
+				policySpec.rules = append(policySpec.rules, ruleSpec)
+			}
+			spec.policies = append(spec.policies, policySpec)
+		}
+		listeners = append(listeners, spec)
+	}
+	return listeners, response, nil
+}
+
+// applyLBConfiguration makes the pools and listeners of the load balancer match the configuration.
+// Every call puts the load balancer in update_pending, so the calls are batched: the members of a
+// pool are replaced with a single call, a new pool is created with its members and a new listener
+// with its policies, and the load balancer is waited for once after each call. The listeners that
+// are not configured are deleted first and the pools that are not configured last, so that no pool
+// is deleted while a listener or policy still uses it.
+func applyLBConfiguration(context context.Context, d *schema.ResourceData, meta interface{}, lbID, operation string) diag.Diagnostics {
+	sess, err := vpcClient(meta)
+	if err != nil {
+		tfErr := flex.DiscriminatedTerraformErrorf(err, err.Error(), "ibm_is_lb_configuration", operation, "initialize-client")
+		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+		return tfErr.GetDiag()
+	}
+	desiredPools, desiredListeners, err := expandLBConfiguration(d)
+	if err != nil {
+		tfErr := flex.TerraformErrorf(err, err.Error(), "ibm_is_lb_configuration", operation)
+		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+		return tfErr.GetDiag()
+	}
+	timeout := d.Timeout(schema.TimeoutUpdate)
+	if operation == "create" {
+		timeout = d.Timeout(schema.TimeoutCreate)
+	}
+
+	isLBKey := "load_balancer_key_" + lbID
+	unlock, err := conns.IbmKeyedLock.Lock(context, isLBKey)
+	if err != nil {
+		tfErr := flex.DiscriminatedTerraformErrorf(err, fmt.Sprintf("Error waiting for the lock on %s: %s", isLBKey, err), "ibm_is_lb_configuration", operation, "wait-for-lock")
+		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+		return tfErr.GetDiag()
+	}
+	defer unlock()
+
+	waitForLB := func() diag.Diagnostics {
+		_, err := isWaitForLBAvailable(sess, lbID, timeout)
+		if err != nil {
+			tfErr := flex.TerraformErrorf(err, fmt.Sprintf("Error checking for load balancer (%s) is active: %s", lbID, err), "ibm_is_lb_configuration", operation)
+			log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+			return tfErr.GetDiag()
+		}
+		return nil
+	}
+	apiError := func(err error, format string, args ...interface{}) diag.Diagnostics {
+		tfErr := flex.TerraformErrorf(err, fmt.Sprintf(format, args...)+": "+err.Error(), "ibm_is_lb_configuration", operation)
+		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+		return tfErr.GetDiag()
+	}
+
+	if diags := waitForLB(); diags != nil {
+		return diags
+	}
+	actualPools, _, err := getLBConfigurationPools(context, sess, lbID)
+	if err != nil {
+		return apiError(err, "ListLoadBalancerPoolsWithContext failed")
+	}
+	poolNames := make(map[string]string, len(actualPools))
+	for _, pool := range actualPools {
+		poolNames[pool.id] = pool.name
+	}
+	actualListeners, _, err := getLBConfigurationListeners(context, sess, lbID, poolNames)
+	if err != nil {
+		return apiError(err, "ListLoadBalancerListenersWithContext failed")
+	}
+	calls := 0
+
+	// listeners that are not configured
+	desiredPorts := make(map[int64]*lbListenerSpec, len(desiredListeners))
+	for _, listener := range desiredListeners {
+		desiredPorts[listener.port] = listener
+	}
+	for _, listener := range actualListeners {
+		if desiredPorts[listener.port] != nil {
+			continue
+		}
+		deleteLoadBalancerListenerOptions := &vpcv1.DeleteLoadBalancerListenerOptions{
+			LoadBalancerID: &lbID,
+			ID:             &listener.id,
+		}
+		response, err := sess.DeleteLoadBalancerListenerWithContext(context, deleteLoadBalancerListenerOptions)
+		if err != nil && (response == nil || response.StatusCode != 404) {
+			return apiError(err, "DeleteLoadBalancerListenerWithContext failed for listener %s", listener)
+		}
+		calls++
+		if diags := waitForLB(); diags != nil {
+			return diags
+		}
+	}
+
+	// pools and their members
+	actualPoolsByName := make(map[string]*lbPoolSpec, len(actualPools))
+	poolIDs := make(map[string]string, len(actualPools))
+	for _, pool := range actualPools {
+		actualPoolsByName[pool.name] = pool
+		poolIDs[pool.name] = pool.id
+	}
+	for _, pool := range desiredPools {
+		current, ok := actualPoolsByName[pool.name]
+		if !ok {
+			created, _, err := sess.CreateLoadBalancerPoolWithContext(context, pool.prototype(lbID))
+			if err != nil {
+				return apiError(err, "CreateLoadBalancerPoolWithContext failed for pool %s", pool)
+			}
+			poolIDs[pool.name] = *created.ID
+			calls++
+			if diags := waitForLB(); diags != nil {
+				return diags
+			}
+			continue
+		}
+		if pool.settingsDiffer(current) {
+			loadBalancerPoolPatch, err := pool.patch()
+			if err != nil {
+				return apiError(err, "loadBalancerPoolPatchModel.AsPatch() failed")
+			}
+			updateLoadBalancerPoolOptions := &vpcv1.UpdateLoadBalancerPoolOptions{
+				LoadBalancerID:        &lbID,
+				ID:                    &current.id,
+				LoadBalancerPoolPatch: loadBalancerPoolPatch,
+			}
+			_, _, err = sess.UpdateLoadBalancerPoolWithContext(context, updateLoadBalancerPoolOptions)
+			if err != nil {
+				return apiError(err, "UpdateLoadBalancerPoolWithContext failed for pool %s", pool)
+			}
+			calls++
+			if diags := waitForLB(); diags != nil {
+				return diags
+			}
+		}
+		if pool.membersKey() != current.membersKey() {
+			log.Printf("[DEBUG] Load balancer %s: replacing the %d members of pool %s with %d members", lbID, len(current.members), pool.name, len(pool.members))
+			replaceLoadBalancerPoolMembersOptions := &vpcv1.ReplaceLoadBalancerPoolMembersOptions{
+				LoadBalancerID: &lbID,
+				PoolID:         &current.id,
+				Members:        pool.memberPrototypes(),
+			}
+			_, _, err = sess.ReplaceLoadBalancerPoolMembersWithContext(context, replaceLoadBalancerPoolMembersOptions)
+			if err != nil {
+				return apiError(err, "ReplaceLoadBalancerPoolMembersWithContext failed for pool %s", pool)
+			}
+			calls++
+			if diags := waitForLB(); diags != nil {
+				return diags
+			}
+		}
+	}
+
+	// listeners and their policies
+	actualListenersByPort := make(map[int64]*lbListenerSpec, len(actualListeners))
+	for _, listener := range actualListeners {
+		actualListenersByPort[listener.port] = listener
+	}
+	for _, listener := range desiredListeners {
+		current, ok := actualListenersByPort[listener.port]
+		if !ok {
+			_, _, err := sess.CreateLoadBalancerListenerWithContext(context, listener.prototype(lbID, poolIDs))
+			if err != nil {
+				return apiError(err, "CreateLoadBalancerListenerWithContext failed for listener %s", listener)
+			}
+			calls++
+			if diags := waitForLB(); diags != nil {
+				return diags
+			}
+			continue
+		}
+		if listener.settingsDiffer(current) {
+			loadBalancerListenerPatch, err := listener.patch(poolIDs)
+			if err != nil {
+				return apiError(err, "loadBalancerListenerPatchModel.AsPatch() failed")
+			}
+			updateLoadBalancerListenerOptions := &vpcv1.UpdateLoadBalancerListenerOptions{
+				LoadBalancerID:            &lbID,
+				ID:                        &current.id,
+				LoadBalancerListenerPatch: loadBalancerListenerPatch,
+			}
+			_, _, err = sess.UpdateLoadBalancerListenerWithContext(context, updateLoadBalancerListenerOptions)
+			if err != nil {
+				return apiError(err, "UpdateLoadBalancerListenerWithContext failed for listener %s", listener)
+			}
+			calls++
+			if diags := waitForLB(); diags != nil {
+				return diags
+			}
+		}
+
+		policyOptions := func(policy *lbPolicySpec) *vpcv1.UpdateLoadBalancerListenerPolicyOptions {
+			return &vpcv1.UpdateLoadBalancerListenerPolicyOptions{
+				LoadBalancerID: &lbID,
+				ListenerID:     &current.id,
+				ID:             &policy.id,
+			}
+		}
+		deletePolicy := func(policy *lbPolicySpec) diag.Diagnostics {
+			deleteLoadBalancerListenerPolicyOptions := &vpcv1.DeleteLoadBalancerListenerPolicyOptions{
+				LoadBalancerID: &lbID,
+				ListenerID:     &current.id,
+				ID:             &policy.id,
+			}
+			response, err := sess.DeleteLoadBalancerListenerPolicyWithContext(context, deleteLoadBalancerListenerPolicyOptions)
+			if err != nil && (response == nil || response.StatusCode != 404) {
+				return apiError(err, "DeleteLoadBalancerListenerPolicyWithContext failed for policy %s of listener %s", policy.name, listener)
+			}
+			calls++
+			return waitForLB()
+		}
+		plan := planLBPolicies(current.policies, listener.policies)
+		for _, policy := range plan.deletes {
+			if diags := deletePolicy(policy); diags != nil {
+				return diags
+			}
+		}
+		for _, park := range plan.parks {
+			loadBalancerListenerPolicyPatchModel := &vpcv1.LoadBalancerListenerPolicyPatch{}
+			if park.name != "" {
+				loadBalancerListenerPolicyPatchModel.Name = core.StringPtr(park.name)
+			}
+			if park.priority != 0 {
+				loadBalancerListenerPolicyPatchModel.Priority = core.Int64Ptr(park.priority)
+			}
+			updateLoadBalancerListenerPolicyOptions := policyOptions(park.policy)
+			updateLoadBalancerListenerPolicyOptions.LoadBalancerListenerPolicyPatch, err = loadBalancerListenerPolicyPatchModel.AsPatch()
+			if err != nil {
+				return apiError(err, "loadBalancerListenerPolicyPatchModel.AsPatch() failed")
+			}
+			_, _, err = sess.UpdateLoadBalancerListenerPolicyWithContext(context, updateLoadBalancerListenerPolicyOptions)
+			if err != nil {
+				return apiError(err, "UpdateLoadBalancerListenerPolicyWithContext failed for policy %s of listener %s", park.policy.name, listener)
+			}
+			calls++
+			if diags := waitForLB(); diags != nil {
+				return diags
+			}
+		}
+		for _, update := range plan.updates {
+			loadBalancerListenerPolicyPatch, err := update.desired.patch(update.current, poolIDs)
+			if err != nil {
+				return apiError(err, "loadBalancerListenerPolicyPatchModel.AsPatch() failed")
+			}
+			if loadBalancerListenerPolicyPatch != nil {
+				updateLoadBalancerListenerPolicyOptions := policyOptions(update.current)
+				updateLoadBalancerListenerPolicyOptions.LoadBalancerListenerPolicyPatch = loadBalancerListenerPolicyPatch
+				_, _, err = sess.UpdateLoadBalancerListenerPolicyWithContext(context, updateLoadBalancerListenerPolicyOptions)
+				if err != nil {
+					return apiError(err, "UpdateLoadBalancerListenerPolicyWithContext failed for policy %s of listener %s", update.desired.name, listener)
+				}
+				calls++
+				if diags := waitForLB(); diags != nil {
+					return diags
+				}
+			}
+			// the rules are created before the rules that are not configured are deleted, so that
+			// the policy never matches more requests than configured
+			currentRules := make(map[string][]lbPolicyRuleSpec, len(update.current.rules))
+			for _, rule := range update.current.rules {
+				currentRules[rule.key()] = append(currentRules[rule.key()], rule)
+			}
+			for _, rule := range update.desired.rules {
+				if existing := currentRules[rule.key()]; len(existing) > 0 {
+					currentRules[rule.key()] = existing[1:]
+					continue
+				}
+				createLoadBalancerListenerPolicyRuleOptions := &vpcv1.CreateLoadBalancerListenerPolicyRuleOptions{
+					LoadBalancerID: &lbID,
+					ListenerID:     &current.id,
+					PolicyID:       &update.current.id,
+					Condition:      core.StringPtr(rule.condition),
+					Type:           core.StringPtr(rule.ruleType),
+					Value:          core.StringPtr(rule.value),
+				}
+				if rule.field != "" {
+					createLoadBalancerListenerPolicyRuleOptions.Field = core.StringPtr(rule.field)
+				}
+				_, _, err = sess.CreateLoadBalancerListenerPolicyRuleWithContext(context, createLoadBalancerListenerPolicyRuleOptions)
+				if err != nil {
+					return apiError(err, "CreateLoadBalancerListenerPolicyRuleWithContext failed for policy %s of listener %s", update.desired.name, listener)
+				}
+				calls++
+				if diags := waitForLB(); diags != nil {
+					return diags
+				}
+			}
+			for _, rule := range update.current.rules {
+				if remaining := currentRules[rule.key()]; len(remaining) == 0 || remaining[0].id != rule.id {
+					continue
+				}
+				currentRules[rule.key()] = currentRules[rule.key()][1:]
+				deleteLoadBalancerListenerPolicyRuleOptions := &vpcv1.DeleteLoadBalancerListenerPolicyRuleOptions{
+					LoadBalancerID: &lbID,
+					ListenerID:     &current.id,
+					PolicyID:       &update.current.id,
+					ID:             core.StringPtr(rule.id),
+				}
+				response, err := sess.DeleteLoadBalancerListenerPolicyRuleWithContext(context, deleteLoadBalancerListenerPolicyRuleOptions)
+				if err != nil && (response == nil || response.StatusCode != 404) {
+					return apiError(err, "DeleteLoadBalancerListenerPolicyRuleWithContext failed for policy %s of listener %s", update.desired.name, listener)
+				}
+				calls++
+				if diags := waitForLB(); diags != nil {
+					return diags
+				}
+			}
+		}
+		for _, policy := range plan.creates {
+			policyPrototype := policy.prototype(poolIDs)
+			createLoadBalancerListenerPolicyOptions := &vpcv1.CreateLoadBalancerListenerPolicyOptions{
+				LoadBalancerID: &lbID,
+				ListenerID:     &current.id,
+				Name:           policyPrototype.Name,
+				Action:         policyPrototype.Action,
+				Priority:       policyPrototype.Priority,
+				Target:         policyPrototype.Target,
+				Rules:          policyPrototype.Rules,
+			}
+			_, _, err := sess.CreateLoadBalancerListenerPolicyWithContext(context, createLoadBalancerListenerPolicyOptions)
+			if err != nil {
+				return apiError(err, "CreateLoadBalancerListenerPolicyWithContext failed for policy %s of listener %s", policy.name, listener)
+			}
+			calls++
+			if diags := waitForLB(); diags != nil {
+				return diags
+			}
+		}
+		for _, policy := range plan.replaced {
+			if diags := deletePolicy(policy); diags != nil {
+				return diags
+			}
+		}
+	}
+
+	// pools that are not configured
+	desiredPoolNames := make(map[string]bool, len(desiredPools))
+	for _, pool := range desiredPools {
+		desiredPoolNames[pool.name] = true
+	}
+	for _, pool := range actualPools {
+		if desiredPoolNames[pool.name] {
+			continue
+		}
+		deleteLoadBalancerPoolOptions := &vpcv1.DeleteLoadBalancerPoolOptions{
+			LoadBalancerID: &lbID,
+			ID:             &pool.id,
+		}
+		response, err := sess.DeleteLoadBalancerPoolWithContext(context, deleteLoadBalancerPoolOptions)
+		if err != nil && (response == nil || response.StatusCode != 404) {
+			return apiError(err, "DeleteLoadBalancerPoolWithContext failed for pool %s", pool)
+		}
+		calls++
+		if diags := waitForLB(); diags != nil {
+			return diags
+		}
+	}
+	log.Printf("[DEBUG] Load balancer %s: configuration applied with %d calls", lbID, calls)
+	return nil
+}
+
+func resourceIBMISLBConfigurationCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	lbID := d.Get(isLBID).(string)
+	if diags := applyLBConfiguration(context, d, meta, lbID, "create"); diags != nil {
+		return diags
+	}
+	d.SetId(lbID)
+	return resourceIBMISLBConfigurationRead(context, d, meta)
+}
+
+func resourceIBMISLBConfigurationRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	sess, err := vpcClient(meta)
+	if err != nil {
+		tfErr := flex.DiscriminatedTerraformErrorf(err, err.Error(), "ibm_is_lb_configuration", "read", "initialize-client")
+		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+		return tfErr.GetDiag()
+	}
+	lbID := d.Id()
+	actualPools, response, err := getLBConfigurationPools(context, sess, lbID)
+	if err != nil {
+		if response != nil && response.StatusCode == 404 {
+			d.SetId("")
+			return nil
+		}
+		tfErr := flex.TerraformErrorf(err, fmt.Sprintf("ListLoadBalancerPoolsWithContext failed: %s", err.Error()), "ibm_is_lb_configuration", "read")
+		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+		return tfErr.GetDiag()
+	}
+	poolNames := make(map[string]string, len(actualPools))
+	for _, pool := range actualPools {
+		poolNames[pool.id] = pool.name
+	}
+	actualListeners, _, err := getLBConfigurationListeners(context, sess, lbID, poolNames)
+	if err != nil {
+		tfErr := flex.TerraformErrorf(err, fmt.Sprintf("ListLoadBalancerListenersWithContext failed: %s", err.Error()), "ibm_is_lb_configuration", "read")
+		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+		return tfErr.GetDiag()
+	}
+
+	// Report the pools and listeners in the configured order, with the members of a pool in the
+	// configured order when they match, followed by the ones that are not configured.
+	poolOrder := make(map[string]int)
+	memberOrder := make(map[string]map[string]int)
+	for i, p := range d.Get(isLBConfigurationPools).([]interface{}) {
+		values, _ := p.(map[string]interface{})
+		pool := expandLBPoolSpec(values)
+		poolOrder[pool.name] = i
+		memberOrder[pool.name] = make(map[string]int, len(pool.members))
+		for j, member := range pool.members {
+			memberOrder[pool.name][member.key()] = j
+		}
+	}
+	listenerOrder := make(map[int64]int)
+	policyOrder := make(map[int64]map[string]int)
+	for i, l := range d.Get(isLBConfigurationListeners).([]interface{}) {
+		values, _ := l.(map[string]interface{})
+		listener := expandLBListenerSpec(values)
+		listenerOrder[listener.port] = i
+		policyOrder[listener.port] = make(map[string]int, len(listener.policies))
+		for j, policy := range listener.policies {
+			policyOrder[listener.port][policy.name] = j
+		}
+	}
+	configuredFirst := func(order map[string]int, i, j string) bool {
+		oi, iok := order[i]
+		oj, jok := order[j]
+		if iok != jok {
+			return iok
+		}
+		if iok {
+			return oi < oj
+		}
+		return i < j
+	}
+	sort.SliceStable(actualPools, func(i, j int) bool {
+		return configuredFirst(poolOrder, actualPools[i].name, actualPools[j].name)
+	})
+	pools := make([]interface{}, 0, len(actualPools))
+	for _, pool := range actualPools {
+		members := pool.members
+		sort.SliceStable(members, func(i, j int) bool {
+			return configuredFirst(memberOrder[pool.name], members[i].key(), members[j].key())
+		})
+		pools = append(pools, pool.flatten())
+	}
+	sort.SliceStable(actualListeners, func(i, j int) bool {
+		oi, iok := listenerOrder[actualListeners[i].port]
+		oj, jok := listenerOrder[actualListeners[j].port]
+		if iok != jok {
+			return iok
+		}
+		if iok {
+			return oi < oj
+		}
+		return actualListeners[i].port < actualListeners[j].port
+	})
+	listeners := make([]interface{}, 0, len(actualListeners))
+	for _, listener := range actualListeners {
+		policies := listener.policies
+		sort.SliceStable(policies, func(i, j int) bool {
+			return configuredFirst(policyOrder[listener.port], policies[i].name, policies[j].name)
+		})
+		listeners = append(listeners, listener.flatten())
+	}
+
+	if err = d.Set(isLBID, lbID); err != nil {
+		return flex.DiscriminatedTerraformErrorf(err, fmt.Sprintf("Error setting lb: %s", err), "ibm_is_lb_configuration", "read", "set-lb").GetDiag()
+	}
+	if err = d.Set(isLBConfigurationPools, pools); err != nil {
+		return flex.DiscriminatedTerraformErrorf(err, fmt.Sprintf("Error setting pools: %s", err), "ibm_is_lb_configuration", "read", "set-pools").GetDiag()
+	}
+	if err = d.Set(isLBConfigurationListeners, listeners); err != nil {
+		return flex.DiscriminatedTerraformErrorf(err, fmt.Sprintf("Error setting listeners: %s", err), "ibm_is_lb_configuration", "read", "set-listeners").GetDiag()
+	}
+	return nil
+}
+
+func resourceIBMISLBConfigurationUpdate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if d.HasChange(isLBConfigurationPools) || d.HasChange(isLBConfigurationListeners) {
+		if diags := applyLBConfiguration(context, d, meta, d.Id(), "update"); diags != nil {
+			return diags
+		}
+	}
+	return resourceIBMISLBConfigurationRead(context, d, meta)
+}
+
+// resourceIBMISLBConfigurationDelete deletes the listeners and then the pools in the state.
+// The listeners and pools that were added outside of Terraform after the last refresh are kept.
+func resourceIBMISLBConfigurationDelete(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	sess, err := vpcClient(meta)
+	if err != nil {
+		tfErr := flex.DiscriminatedTerraformErrorf(err, err.Error(), "ibm_is_lb_configuration", "delete", "initialize-client")
+		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+		return tfErr.GetDiag()
+	}
+	lbID := d.Id()
+
+	isLBKey := "load_balancer_key_" + lbID
+	unlock, err := conns.IbmKeyedLock.Lock(context, isLBKey)
+	if err != nil {
+		tfErr := flex.DiscriminatedTerraformErrorf(err, fmt.Sprintf("Error waiting for the lock on %s: %s", isLBKey, err), "ibm_is_lb_configuration", "delete", "wait-for-lock")
+		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+		return tfErr.GetDiag()
+	}
+	defer unlock()
+
+	waitForLB := func() diag.Diagnostics {
+		_, err := isWaitForLBAvailable(sess, lbID, d.Timeout(schema.TimeoutDelete))
+		if err != nil {
+			tfErr := flex.TerraformErrorf(err, fmt.Sprintf("Error checking for load balancer (%s) is active: %s", lbID, err), "ibm_is_lb_configuration", "delete")
+			log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+			return tfErr.GetDiag()
+		}
+		return nil
+	}
+	if diags := waitForLB(); diags != nil {
+		return diags
+	}
+	for _, l := range d.Get(isLBConfigurationListeners).([]interface{}) {
+		values, _ := l.(map[string]interface{})
+		listenerID, _ := values["id"].(string)
+		if listenerID == "" {
+			continue
+		}
+		deleteLoadBalancerListenerOptions := &vpcv1.DeleteLoadBalancerListenerOptions{
+			LoadBalancerID: &lbID,
+			ID:             &listenerID,
+		}
+		response, err := sess.DeleteLoadBalancerListenerWithContext(context, deleteLoadBalancerListenerOptions)
+		if err != nil {
+			if response != nil && response.StatusCode == 404 {
+				continue
+			}
+			tfErr := flex.TerraformErrorf(err, fmt.Sprintf("DeleteLoadBalancerListenerWithContext failed for listener %s: %s", listenerID, err.Error()), "ibm_is_lb_configuration", "delete")
+			log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+			return tfErr.GetDiag()
+		}
+		if diags := waitForLB(); diags != nil {
+			return diags
+		}
+	}
+	for _, p := range d.Get(isLBConfigurationPools).([]interface{}) {
+		values, _ := p.(map[string]interface{})
+		poolID, _ := values["id"].(string)
+		if poolID == "" {
+			continue
+		}
+		deleteLoadBalancerPoolOptions := &vpcv1.DeleteLoadBalancerPoolOptions{
+			LoadBalancerID: &lbID,
+			ID:             &poolID,
+		}
+		response, err := sess.DeleteLoadBalancerPoolWithContext(context, deleteLoadBalancerPoolOptions)
+		if err != nil {
+			if response != nil && response.StatusCode == 404 {
+				continue
+			}
+			tfErr := flex.TerraformErrorf(err, fmt.Sprintf("DeleteLoadBalancerPoolWithContext failed for pool %s: %s", poolID, err.Error()), "ibm_is_lb_configuration", "delete")
+			log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+			return tfErr.GetDiag()
+		}
+		if diags := waitForLB(); diags != nil {
+			return diags
+		}
+	}
+	d.SetId("")
+	return nil
+}
+
+// resourceIBMISLBConfigurationImport imports all the pools and listeners of a load balancer by its ID
+func resourceIBMISLBConfigurationImport(context context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	d.Set(isLBID, d.Id())
+	return []*schema.ResourceData{d}, nil
+}
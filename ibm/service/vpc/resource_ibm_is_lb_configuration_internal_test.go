@@ -0,0 +1,140 @@
+// Copyright IBM Corp. 2025 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package vpc
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+)
+
+func testLBPolicySpec(name, action string, priority int64) *lbPolicySpec {
+	policy := &lbPolicySpec{
+		id:       "id-" + name,
+		name:     name,
+		action:   action,
+		priority: priority,
+	}
+	if action == "forward_to_pool" {
+		policy.targetPool = "pool"
+	}
+	return policy
+}
+
+func describeLBPolicyPlan(plan lbPolicyPlan) []string {
+	described := []string{}
+	for _, policy := range plan.deletes {
+		described = append(described, "delete "+policy.id)
+	}
+	for _, park := range plan.parks {
+		described = append(described, fmt.Sprintf("park %s as %q at %d", park.policy.id, park.name, park.priority))
+	}
+	for _, update := range plan.updates {
+		described = append(described, fmt.Sprintf("update %s to %d", update.current.id, update.desired.priority))
+	}
+	for _, policy := range plan.creates {
+		described = append(described, fmt.Sprintf("create %s at %d", policy.name, policy.priority))
+	}
+	for _, policy := range plan.replaced {
+		described = append(described, "delete replaced "+policy.id)
+	}
+	return described
+}
+
+func TestPlanLBPolicies(t *testing.T) {
+	withTarget := testLBPolicySpec("a", "forward_to_pool", 1)
+	withTarget.targetPool = "other"
+	withRule := testLBPolicySpec("a", "forward_to_pool", 1)
+	withRule.rules = []lbPolicyRuleSpec{{condition: "equals", ruleType: "header", field: "x", value: "y"}}
+
+	tests := []struct {
+		name    string
+		current []*lbPolicySpec
+		desired []*lbPolicySpec
+		want    []string
+	}{
+		{
+			name:    "no changes",
+			current: []*lbPolicySpec{testLBPolicySpec("a", "forward_to_pool", 1), testLBPolicySpec("b", "reject", 2)},
+			desired: []*lbPolicySpec{testLBPolicySpec("a", "forward_to_pool", 1), testLBPolicySpec("b", "reject", 2)},
+			want:    []string{},
+		},
+		{
+			name:    "removed and added",
+			current: []*lbPolicySpec{testLBPolicySpec("a", "forward_to_pool", 1), testLBPolicySpec("b", "reject", 2)},
+			desired: []*lbPolicySpec{testLBPolicySpec("a", "forward_to_pool", 1), testLBPolicySpec("c", "reject", 2)},
+			want:    []string{"delete id-b", "create c at 2"},
+		},
+		{
+			name:    "target changed in place",
+			current: []*lbPolicySpec{testLBPolicySpec("a", "forward_to_pool", 1)},
+			desired: []*lbPolicySpec{withTarget},
+			want:    []string{"update id-a to 1"},
+		},
+		{
+			name:    "rules changed in place",
+			current: []*lbPolicySpec{testLBPolicySpec("a", "forward_to_pool", 1)},
+			desired: []*lbPolicySpec{withRule},
+			want:    []string{"update id-a to 1"},
+		},
+		{
+			name:    "priority moved to a free priority",
+			current: []*lbPolicySpec{testLBPolicySpec("a", "reject", 1)},
+			desired: []*lbPolicySpec{testLBPolicySpec("a", "reject", 3)},
+			want:    []string{"update id-a to 3"},
+		},
+		{
+			name:    "priorities swapped",
+			current: []*lbPolicySpec{testLBPolicySpec("a", "reject", 1), testLBPolicySpec("b", "reject", 2)},
+			desired: []*lbPolicySpec{testLBPolicySpec("a", "reject", 2), testLBPolicySpec("b", "reject", 1)},
+			want:    []string{`park id-a as "" at 3`, `park id-b as "" at 4`, "update id-a to 2", "update id-b to 1"},
+		},
+		{
+			name:    "action changed on the same priority",
+			current: []*lbPolicySpec{testLBPolicySpec("a", "reject", 1)},
+			desired: []*lbPolicySpec{testLBPolicySpec("a", "forward_to_pool", 1)},
+			want:    []string{`park id-a as "a-replaced" at 2`, "create a at 1", "delete replaced id-a"},
+		},
+		{
+			name:    "action changed on another priority",
+			current: []*lbPolicySpec{testLBPolicySpec("a", "reject", 1)},
+			desired: []*lbPolicySpec{testLBPolicySpec("a", "forward_to_pool", 2)},
+			want:    []string{`park id-a as "a-replaced" at 0`, "create a at 2", "delete replaced id-a"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := describeLBPolicyPlan(planLBPolicies(tt.current, tt.desired))
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("planLBPolicies() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestPlanLBPoliciesWithoutFreePriority(t *testing.T) {
+	current := make([]*lbPolicySpec, 0, lbPolicyMaxPriority)
+	desired := make([]*lbPolicySpec, 0, lbPolicyMaxPriority)
+	for priority := int64(1); priority <= lbPolicyMaxPriority; priority++ {
+		name := fmt.Sprintf("p%d", priority)
+		current = append(current, testLBPolicySpec(name, "reject", priority))
+		desired = append(desired, testLBPolicySpec(name, "reject", priority))
+	}
+	desired[0] = testLBPolicySpec("p1", "forward_to_pool", 1)
+
+	want := []string{"delete id-p1", "create p1 at 1"}
+	if got := describeLBPolicyPlan(planLBPolicies(current, desired)); !reflect.DeepEqual(got, want) {
+		t.Errorf("planLBPolicies() = %q, want %q", got, want)
+	}
+}
+
+func TestLBPolicyParkedName(t *testing.T) {
+	if got := lbPolicyParkedName("policy"); got != "policy-replaced" {
+		t.Errorf("lbPolicyParkedName() = %q, want %q", got, "policy-replaced")
+	}
+	long := "a234567890123456789012345678901234567890123456789012345-7890123"
+	if got := lbPolicyParkedName(long); len(got) != 63 || got != long[:54]+"-replaced" {
+		t.Errorf("lbPolicyParkedName() = %q, want 63 characters", got)
+	}
+}
@@ -0,0 +1,118 @@
+// Copyright IBM Corp. 2025 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package vpc_test
+
+import (
+	"fmt"
+	"testing"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccIBMISLBConfiguration_basic(t *testing.T) {
+	vpcname := fmt.Sprintf("tflbcfg-vpc-%d", acctest.RandIntRange(10, 100))
+	subnetname := fmt.Sprintf("tflbcfg-subnet-%d", acctest.RandIntRange(10, 100))
+	name := fmt.Sprintf("tflbcfg%d", acctest.RandIntRange(10, 100))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMISLBConfigurationConfig(vpcname, subnetname, acc.ISZoneName, acc.ISCIDR, name, `"10.0.0.1", "10.0.0.2"`, "round_robin"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_is_lb_configuration.testacc_lb_cfg", "pools.#", "2"),
+					resource.TestCheckResourceAttr("ibm_is_lb_configuration.testacc_lb_cfg", "pools.0.members.#", "2"),
+					resource.TestCheckResourceAttrSet("ibm_is_lb_configuration.testacc_lb_cfg", "pools.0.members.0.health"),
+					resource.TestCheckResourceAttr("ibm_is_lb_configuration.testacc_lb_cfg", "listeners.#", "1"),
+					resource.TestCheckResourceAttr("ibm_is_lb_configuration.testacc_lb_cfg", "listeners.0.default_pool", "web"),
+					resource.TestCheckResourceAttr("ibm_is_lb_configuration.testacc_lb_cfg", "listeners.0.policies.0.target_pool", "api"),
+				),
+			},
+			{
+				Config: testAccCheckIBMISLBConfigurationConfig(vpcname, subnetname, acc.ISZoneName, acc.ISCIDR, name, `"10.0.0.1", "10.0.0.3", "10.0.0.4"`, "least_connections"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_is_lb_configuration.testacc_lb_cfg", "pools.0.algorithm", "least_connections"),
+					resource.TestCheckResourceAttr("ibm_is_lb_configuration.testacc_lb_cfg", "pools.0.members.#", "3"),
+					resource.TestCheckResourceAttr("ibm_is_lb_configuration.testacc_lb_cfg", "pools.0.members.2.target_address", "10.0.0.4"),
+				),
+			},
+			{
+				ResourceName:      "ibm_is_lb_configuration.testacc_lb_cfg",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckIBMISLBConfigurationConfig(vpcname, subnetname, zone, cidr, name, addresses, algorithm string) string {
+	return fmt.Sprintf(`
+	resource "ibm_is_vpc" "testacc_vpc" {
+		name = "%s"
+	}
+
+	resource "ibm_is_subnet" "testacc_subnet" {
+		name = "%s"
+		vpc = ibm_is_vpc.testacc_vpc.id
+		zone = "%s"
+		ipv4_cidr_block = "%s"
+	}
+	resource "ibm_is_lb" "testacc_LB" {
+		name = "%s"
+		subnets = [ibm_is_subnet.testacc_subnet.id]
+	}
+	resource "ibm_is_lb_configuration" "testacc_lb_cfg" {
+		lb = ibm_is_lb.testacc_LB.id
+		pools {
+			name           = "web"
+			algorithm      = "%s"
+			protocol       = "http"
+			health_delay   = 5
+			health_retries = 2
+			health_timeout = 2
+			health_type    = "http"
+			health_monitor_url = "/"
+			dynamic "members" {
+				for_each = [%s]
+				content {
+					port           = 8080
+					target_address = members.value
+				}
+			}
+		}
+		pools {
+			name           = "api"
+			algorithm      = "round_robin"
+			protocol       = "http"
+			health_delay   = 5
+			health_retries = 2
+			health_timeout = 2
+			health_type    = "tcp"
+			members {
+				port           = 9090
+				target_address = "10.0.0.10"
+			}
+		}
+		listeners {
+			port         = 80
+			protocol     = "http"
+			default_pool = "web"
+			policies {
+				name        = "api"
+				action      = "forward_to_pool"
+				priority    = 1
+				target_pool = "api"
+				rules {
+					condition = "contains"
+					type      = "path"
+					value     = "/api"
+				}
+			}
+		}
+	}`, vpcname, subnetname, zone, cidr, name, algorithm, addresses)
+}
@@ -318,12 +318,15 @@ func resourceIBMISLBListenerCreate(context context.Context, d *schema.ResourceDa
 	}
 
 	isLBKey := "load_balancer_key_" + lbID
-	conns.IbmMutexKV.Lock(isLBKey)
-	defer conns.IbmMutexKV.Unlock(isLBKey)
-
-	err := lbListenerCreate(d, meta, lbID, protocol, defPool, certificateCRN, listener, uri, port, portMin, portMax, connLimit, httpStatusCode)
+	unlock, err := conns.IbmKeyedLock.Lock(context, isLBKey)
 	if err != nil {
-		return err
+		return diag.FromErr(fmt.Errorf("[ERROR] Error waiting for the lock on %s: %s", isLBKey, err))
+	}
+	defer unlock()
+
+	diagErr := lbListenerCreate(d, meta, lbID, protocol, defPool, certificateCRN, listener, uri, port, portMin, portMax, connLimit, httpStatusCode)
+	if diagErr != nil {
+		return diagErr
 	}
 
 	return resourceIBMISLBListenerRead(context, d, meta)
@@ -582,7 +585,7 @@ func resourceIBMISLBListenerUpdate(context context.Context, d *schema.ResourceDa
 	lbID := parts[0]
 	lbListenerID := parts[1]
 
-	diagEerr := lbListenerUpdate(d, meta, lbID, lbListenerID)
+	diagEerr := lbListenerUpdate(context, d, meta, lbID, lbListenerID)
 	if diagEerr != nil {
 		return diagEerr
 	}
@@ -590,7 +593,7 @@ func resourceIBMISLBListenerUpdate(context context.Context, d *schema.ResourceDa
 	return resourceIBMISLBListenerRead(context, d, meta)
 }
 
-func lbListenerUpdate(d *schema.ResourceData, meta interface{}, lbID, lbListenerID string) diag.Diagnostics {
+func lbListenerUpdate(context context.Context, d *schema.ResourceData, meta interface{}, lbID, lbListenerID string) diag.Diagnostics {
 	sess, err := vpcClient(meta)
 	if err != nil {
 		diag.FromErr(err)
@@ -728,8 +731,11 @@ func lbListenerUpdate(d *schema.ResourceData, meta interface{}, lbID, lbListener
 		updateLoadBalancerListenerOptions.LoadBalancerListenerPatch = loadBalancerListenerPatch
 
 		isLBKey := "load_balancer_key_" + lbID
-		conns.IbmMutexKV.Lock(isLBKey)
-		defer conns.IbmMutexKV.Unlock(isLBKey)
+		unlock, err := conns.IbmKeyedLock.Lock(context, isLBKey)
+		if err != nil {
+			return diag.FromErr(fmt.Errorf("[ERROR] Error waiting for the lock on %s: %s", isLBKey, err))
+		}
+		defer unlock()
 
 		_, err = isWaitForLBAvailable(sess, lbID, d.Timeout(schema.TimeoutUpdate))
 		if err != nil {
@@ -767,8 +773,11 @@ func resourceIBMISLBListenerDelete(context context.Context, d *schema.ResourceDa
 	lbListenerID := parts[1]
 
 	isLBKey := "load_balancer_key_" + lbID
-	conns.IbmMutexKV.Lock(isLBKey)
-	defer conns.IbmMutexKV.Unlock(isLBKey)
+	unlock, err := conns.IbmKeyedLock.Lock(context, isLBKey)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error waiting for the lock on %s: %s", isLBKey, err))
+	}
+	defer unlock()
 
 	diagEerr := lbListenerDelete(d, meta, lbID, lbListenerID)
 	if diagEerr != nil {
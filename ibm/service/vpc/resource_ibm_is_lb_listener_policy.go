@@ -416,7 +416,7 @@ func resourceIBMISLBListenerPolicyCreate(context context.Context, d *schema.Reso
 		name = n.(string)
 	}
 
-	errDiag := lbListenerPolicyCreate(d, meta, lbID, listenerID, action, name, priority)
+	errDiag := lbListenerPolicyCreate(context, d, meta, lbID, listenerID, action, name, priority)
 	if errDiag != nil {
 		return errDiag
 	}
@@ -450,7 +450,7 @@ func getPoolID(id string) (string, error) {
 
 }
 
-func lbListenerPolicyCreate(d *schema.ResourceData, meta interface{}, lbID, listenerID, action, name string, priority int64) diag.Diagnostics {
+func lbListenerPolicyCreate(context context.Context, d *schema.ResourceData, meta interface{}, lbID, listenerID, action, name string, priority int64) diag.Diagnostics {
 
 	sess, err := vpcClient(meta)
 	if err != nil {
@@ -599,8 +599,11 @@ func lbListenerPolicyCreate(d *schema.ResourceData, meta interface{}, lbID, list
 	}
 
 	isLBKey := "load_balancer_key_" + lbID
-	conns.IbmMutexKV.Lock(isLBKey)
-	defer conns.IbmMutexKV.Unlock(isLBKey)
+	unlock, err := conns.IbmKeyedLock.Lock(context, isLBKey)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error waiting for the lock on %s: %s", isLBKey, err))
+	}
+	defer unlock()
 
 	_, err = isWaitForLbAvailable(sess, lbID, d.Timeout(schema.TimeoutCreate))
 	if err != nil {
@@ -776,7 +779,7 @@ func resourceIBMISLBListenerPolicyUpdate(context context.Context, d *schema.Reso
 	listenerID := parts[1]
 	policyID := parts[2]
 
-	diagErr := lbListenerPolicyUpdate(d, meta, lbID, listenerID, policyID)
+	diagErr := lbListenerPolicyUpdate(context, d, meta, lbID, listenerID, policyID)
 	if diagErr != nil {
 		return diagErr
 	}
@@ -784,7 +787,7 @@ func resourceIBMISLBListenerPolicyUpdate(context context.Context, d *schema.Reso
 	return resourceIBMISLBListenerPolicyRead(context, d, meta)
 }
 
-func lbListenerPolicyUpdate(d *schema.ResourceData, meta interface{}, lbID, listenerID, ID string) diag.Diagnostics {
+func lbListenerPolicyUpdate(context context.Context, d *schema.ResourceData, meta interface{}, lbID, listenerID, ID string) diag.Diagnostics {
 	sess, err := vpcClient(meta)
 	if err != nil {
 		return diag.FromErr(err)
@@ -920,8 +923,11 @@ func lbListenerPolicyUpdate(d *schema.ResourceData, meta interface{}, lbID, list
 		}
 		updatePolicyOptions.LoadBalancerListenerPolicyPatch = loadBalancerListenerPolicyPatch
 		isLBKey := "load_balancer_key_" + lbID
-		conns.IbmMutexKV.Lock(isLBKey)
-		defer conns.IbmMutexKV.Unlock(isLBKey)
+		unlock, err := conns.IbmKeyedLock.Lock(context, isLBKey)
+		if err != nil {
+			return diag.FromErr(fmt.Errorf("[ERROR] Error waiting for the lock on %s: %s", isLBKey, err))
+		}
+		defer unlock()
 
 		_, err = isWaitForLbAvailable(sess, lbID, d.Timeout(schema.TimeoutCreate))
 		if err != nil {
@@ -954,8 +960,11 @@ func resourceIBMISLBListenerPolicyDelete(context context.Context, d *schema.Reso
 	policyID := parts[2]
 
 	isLBKey := "load_balancer_key_" + lbID
-	conns.IbmMutexKV.Lock(isLBKey)
-	defer conns.IbmMutexKV.Unlock(isLBKey)
+	unlock, err := conns.IbmKeyedLock.Lock(context, isLBKey)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error waiting for the lock on %s: %s", isLBKey, err))
+	}
+	defer unlock()
 
 	err = lbListenerPolicyDelete(d, meta, lbID, listenerID, policyID)
 	if err != nil {
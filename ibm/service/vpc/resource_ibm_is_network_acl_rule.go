@@ -11,8 +11,10 @@ import (
 	"strings"
 	"time"
 
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 
 	"github.com/IBM/vpc-go-sdk/vpcv1"
@@ -27,11 +29,11 @@ const (
 
 func ResourceIBMISNetworkACLRule() *schema.Resource {
 	return &schema.Resource{
-		Create:   resourceIBMISNetworkACLRuleCreate,
-		Read:     resourceIBMISNetworkACLRuleRead,
-		Update:   resourceIBMISNetworkACLRuleUpdate,
-		Delete:   resourceIBMISNetworkACLRuleDelete,
-		Importer: &schema.ResourceImporter{},
+		CreateContext: resourceIBMISNetworkACLRuleCreate,
+		Read:          resourceIBMISNetworkACLRuleRead,
+		UpdateContext: resourceIBMISNetworkACLRuleUpdate,
+		DeleteContext: resourceIBMISNetworkACLRuleDelete,
+		Importer:      &schema.ResourceImporter{},
 
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(10 * time.Minute),
@@ -40,7 +42,7 @@ func ResourceIBMISNetworkACLRule() *schema.Resource {
 
 		CustomizeDiff: customdiff.Sequence(
 			func(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
-				return flex.ResourceTagsCustomizeDiff(diff)
+				return flex.ResourceTagsCustomizeDiff(diff, v)
 			},
 		),
 
@@ -328,14 +330,22 @@ func ResourceIBMISNetworkACLRuleValidator() *validate.ResourceValidator {
 	return &ibmISNetworkACLRuleResourceValidator
 }
 
-func resourceIBMISNetworkACLRuleCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMISNetworkACLRuleCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	nwACLID := d.Get(isNwACLID).(string)
 
-	err := nwaclRuleCreate(d, meta, nwACLID)
+	// The rules of a network ACL are ordered, so the changes to the rules of the same ACL are serialized
+	isNetworkACLRulesKey := "network_acl_rules_key_" + nwACLID
+	unlock, err := conns.IbmKeyedLock.Lock(context, isNetworkACLRulesKey)
 	if err != nil {
-		return err
+		return diag.FromErr(fmt.Errorf("[ERROR] Error waiting for the lock on %s: %s", isNetworkACLRulesKey, err))
+	}
+	defer unlock()
+
+	err = nwaclRuleCreate(d, meta, nwACLID)
+	if err != nil {
+		return diag.FromErr(err)
 	}
-	return resourceIBMISNetworkACLRuleRead(d, meta)
+	return diag.FromErr(resourceIBMISNetworkACLRuleRead(d, meta))
 
 }
 
@@ -576,15 +586,25 @@ func nwaclRuleGet(d *schema.ResourceData, meta interface{}, nwACLID string, nwac
 	return nil
 }
 
-func resourceIBMISNetworkACLRuleUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMISNetworkACLRuleUpdate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	id := d.Id()
 	nwACLId, ruleId, err := parseNwACLTerraformID(id)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	isNetworkACLRulesKey := "network_acl_rules_key_" + nwACLId
+	unlock, err := conns.IbmKeyedLock.Lock(context, isNetworkACLRulesKey)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error waiting for the lock on %s: %s", isNetworkACLRulesKey, err))
+	}
+	defer unlock()
 
 	err = nwaclRuleUpdate(d, meta, ruleId, nwACLId)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
-	return resourceIBMISNetworkACLRuleRead(d, meta)
+	return diag.FromErr(resourceIBMISNetworkACLRuleRead(d, meta))
 }
 
 func nwaclRuleUpdate(d *schema.ResourceData, meta interface{}, id, nwACLId string) error {
@@ -758,15 +778,22 @@ func nwaclRuleUpdate(d *schema.ResourceData, meta interface{}, id, nwACLId strin
 	return nil
 }
 
-func resourceIBMISNetworkACLRuleDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMISNetworkACLRuleDelete(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	nwACLID, ruleId, err := parseNwACLTerraformID(d.Id())
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
+	isNetworkACLRulesKey := "network_acl_rules_key_" + nwACLID
+	unlock, err := conns.IbmKeyedLock.Lock(context, isNetworkACLRulesKey)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error waiting for the lock on %s: %s", isNetworkACLRulesKey, err))
+	}
+	defer unlock()
+
 	err = nwaclRuleDelete(d, meta, ruleId, nwACLID)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	d.SetId("")
 	return nil
@@ -0,0 +1,827 @@
+// Copyright IBM Corp. 2025 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package vpc
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/vpc-go-sdk/vpcv1"
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func ResourceIBMISNetworkACLRules() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceIBMISNetworkACLRulesCreate,
+		ReadContext:   resourceIBMISNetworkACLRulesRead,
+		UpdateContext: resourceIBMISNetworkACLRulesUpdate,
+		DeleteContext: resourceIBMISNetworkACLRulesDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceIBMISNetworkACLRulesImport,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			isNwACLID: {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Network ACL id",
+			},
+
+			isNetworkACLRules: {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: "The complete, ordered list of rules of the network ACL. Rules are evaluated in the order of the list, rules of the network ACL that are not in the list are removed",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						isNetworkACLRuleID: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The network acl rule id.",
+						},
+						isNetworkACLRuleName: {
+							Type:         schema.TypeString,
+							Required:     true,
+							Description:  "The user-defined name for this rule. Rules are matched with the rules of the network ACL by name",
+							ValidateFunc: validate.InvokeValidator("ibm_is_network_acl_rule", isNetworkACLRuleName),
+						},
+						isNetworkACLRuleAction: {
+							Type:         schema.TypeString,
+							Required:     true,
+							Description:  "Whether to allow or deny matching traffic",
+							ValidateFunc: validate.InvokeValidator("ibm_is_network_acl_rule", isNetworkACLRuleAction),
+						},
+						isNetworkACLRuleSource: {
+							Type:         schema.TypeString,
+							Required:     true,
+							Description:  "The source CIDR block. The CIDR block 0.0.0.0/0 applies to all addresses.",
+							ValidateFunc: validate.InvokeValidator("ibm_is_network_acl_rule", isNetworkACLRuleSource),
+						},
+						isNetworkACLRuleDestination: {
+							Type:         schema.TypeString,
+							Required:     true,
+							Description:  "The destination CIDR block. The CIDR block 0.0.0.0/0 applies to all addresses.",
+							ValidateFunc: validate.InvokeValidator("ibm_is_network_acl_rule", isNetworkACLRuleDestination),
+						},
+						isNetworkACLRuleDirection: {
+							Type:         schema.TypeString,
+							Required:     true,
+							Description:  "Direction of traffic to enforce, either inbound or outbound",
+							ValidateFunc: validate.InvokeValidator("ibm_is_network_acl_rule", isNetworkACLRuleDirection),
+						},
+						isNetworkACLRuleICMP: {
+							Type:     schema.TypeList,
+							MaxItems: 1,
+							Optional: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									isNetworkACLRuleICMPCode: {
+										Type:         schema.TypeInt,
+										Optional:     true,
+										ValidateFunc: validate.InvokeValidator("ibm_is_network_acl_rule", isNetworkACLRuleICMPCode),
+										Description:  "The ICMP traffic code to allow. Valid values from 0 to 255.",
+									},
+									isNetworkACLRuleICMPType: {
+										Type:         schema.TypeInt,
+										Optional:     true,
+										ValidateFunc: validate.InvokeValidator("ibm_is_network_acl_rule", isNetworkACLRuleICMPType),
+										Description:  "The ICMP traffic type to allow. Valid values from 0 to 254.",
+									},
+								},
+							},
+						},
+						isNetworkACLRuleTCP: {
+							Type:     schema.TypeList,
+							MaxItems: 1,
+							Optional: true,
+							Elem:     makeIBMISNetworkACLRulesPortsSchema(),
+						},
+						isNetworkACLRuleUDP: {
+							Type:     schema.TypeList,
+							MaxItems: 1,
+							Optional: true,
+							Elem:     makeIBMISNetworkACLRulesPortsSchema(),
+						},
+					},
+				},
+			},
+
+			flex.RelatedCRN: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The crn of the network ACL",
+			},
+		},
+	}
+}
+
+func makeIBMISNetworkACLRulesPortsSchema() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			isNetworkACLRulePortMax: {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      65535,
+				ValidateFunc: validate.InvokeValidator("ibm_is_network_acl_rule", isNetworkACLRulePortMax),
+				Description:  "The highest port in the range of ports to be matched",
+			},
+			isNetworkACLRulePortMin: {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      1,
+				ValidateFunc: validate.InvokeValidator("ibm_is_network_acl_rule", isNetworkACLRulePortMin),
+				Description:  "The lowest port in the range of ports to be matched",
+			},
+			isNetworkACLRuleSourcePortMax: {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      65535,
+				ValidateFunc: validate.InvokeValidator("ibm_is_network_acl_rule", isNetworkACLRuleSourcePortMax),
+				Description:  "The highest port in the range of ports to be matched",
+			},
+			isNetworkACLRuleSourcePortMin: {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      1,
+				ValidateFunc: validate.InvokeValidator("ibm_is_network_acl_rule", isNetworkACLRuleSourcePortMin),
+				Description:  "The lowest port in the range of ports to be matched",
+			},
+		},
+	}
+}
+
+// networkACLRuleSpec is the normalized form of a network ACL rule, used to match
+// the rules of the configuration with the rules of the network ACL.
+type networkACLRuleSpec struct {
+	id            string
+	name          string
+	action        string
+	direction     string
+	source        string
+	destination   string
+	protocol      string
+	icmpType      *int64
+	icmpCode      *int64
+	portMin       int64
+	portMax       int64
+	sourcePortMin int64
+	sourcePortMax int64
+}
+
+// key identifies what the rule matches and does, regardless of its name and position
+func (r *networkACLRuleSpec) key() string {
+	icmpType, icmpCode := "any", "any"
+	if r.icmpType != nil {
+		icmpType = fmt.Sprint(*r.icmpType)
+	}
+	if r.icmpCode != nil {
+		icmpCode = fmt.Sprint(*r.icmpCode)
+	}
+	return strings.Join([]string{
+		r.action, r.direction, r.source, r.destination, r.protocol, icmpType, icmpCode,
+		fmt.Sprint(r.portMin), fmt.Sprint(r.portMax), fmt.Sprint(r.sourcePortMin), fmt.Sprint(r.sourcePortMax),
+	}, "|")
+}
+
+func (r *networkACLRuleSpec) String() string {
+	s := fmt.Sprintf("%s (%s %s %s from %s to %s", r.name, r.action, r.direction, r.protocol, r.source, r.destination)
+	if r.protocol == isNetworkACLRuleTCP || r.protocol == isNetworkACLRuleUDP {
+		s += fmt.Sprintf(" ports %d-%d", r.portMin, r.portMax)
+	}
+	return s + ")"
+}
+
+// expandNetworkACLRuleSpec normalizes a rule of the rules list. The icmp type and
+// code that are not set must be left out of the rule, see networkACLRulesConfig.
+func expandNetworkACLRuleSpec(rule map[string]interface{}) (*networkACLRuleSpec, error) {
+	spec := &networkACLRuleSpec{protocol: "all"}
+	spec.name, _ = rule[isNetworkACLRuleName].(string)
+	spec.action, _ = rule[isNetworkACLRuleAction].(string)
+	spec.direction, _ = rule[isNetworkACLRuleDirection].(string)
+	spec.source, _ = rule[isNetworkACLRuleSource].(string)
+	spec.destination, _ = rule[isNetworkACLRuleDestination].(string)
+
+	protocols := 0
+	if icmp, ok := rule[isNetworkACLRuleICMP].([]interface{}); ok && len(icmp) > 0 {
+		protocols++
+		spec.protocol = isNetworkACLRuleICMP
+		if icmp[0] != nil {
+			values := icmp[0].(map[string]interface{})
+			if v, ok := values[isNetworkACLRuleICMPType].(int); ok {
+				icmpType := int64(v)
+				spec.icmpType = &icmpType
+			}
+			if v, ok := values[isNetworkACLRuleICMPCode].(int); ok {
+				if spec.icmpType == nil {
+					return nil, fmt.Errorf("rule %s: icmp code requires icmp type", spec.name)
+				}
+				icmpCode := int64(v)
+				spec.icmpCode = &icmpCode
+			}
+		}
+	}
+	for _, protocol := range []string{isNetworkACLRuleTCP, isNetworkACLRuleUDP} {
+		if ports, ok := rule[protocol].([]interface{}); ok && len(ports) > 0 {
+			protocols++
+			spec.protocol = protocol
+			spec.portMin, spec.portMax, spec.sourcePortMin, spec.sourcePortMax = 1, 65535, 1, 65535
+			if ports[0] != nil {
+				values := ports[0].(map[string]interface{})
+				if v, ok := values[isNetworkACLRulePortMin].(int); ok {
+					spec.portMin = int64(v)
+				}
+				if v, ok := values[isNetworkACLRulePortMax].(int); ok {
+					spec.portMax = int64(v)
+				}
+				if v, ok := values[isNetworkACLRuleSourcePortMin].(int); ok {
+					spec.sourcePortMin = int64(v)
+				}
+				if v, ok := values[isNetworkACLRuleSourcePortMax].(int); ok {
+					spec.sourcePortMax = int64(v)
+				}
+			}
+			if spec.portMin > spec.portMax || spec.sourcePortMin > spec.sourcePortMax {
+				return nil, fmt.Errorf("rule %s: %s port_min is greater than port_max", spec.name, protocol)
+			}
+		}
+	}
+	if protocols > 1 {
+		return nil, fmt.Errorf("rule %s: only one of icmp|tcp|udp can be defined per rule", spec.name)
+	}
+	return spec, nil
+}
+
+func expandNetworkACLRuleSpecs(rules []interface{}) ([]*networkACLRuleSpec, error) {
+	specs := make([]*networkACLRuleSpec, 0, len(rules))
+	names := make(map[string]bool, len(rules))
+	for _, rule := range rules {
+		values, _ := rule.(map[string]interface{})
+		spec, err := expandNetworkACLRuleSpec(values)
+		if err != nil {
+			return nil, err
+		}
+		if names[spec.name] {
+			return nil, fmt.Errorf("rule name %s is used more than once, names must be unique within the network ACL", spec.name)
+		}
+		names[spec.name] = true
+		specs = append(specs, spec)
+	}
+	return specs, nil
+}
+
+// networkACLRulesConfig returns the rules list with the attributes that are not set
+// left out, so that an icmp type or code of 0 is told apart from one that is not set.
+// The configuration is not available on refresh, where the rules of the state are used.
+func networkACLRulesConfig(d *schema.ResourceData) []interface{} {
+	for _, value := range []cty.Value{d.GetRawConfig(), d.GetRawState()} {
+		if !value.IsNull() && value.IsKnown() {
+			rules, _ := securityGroupRuleConfigValue(value.GetAttr(isNetworkACLRules)).([]interface{})
+			return rules
+		}
+	}
+	return d.Get(isNetworkACLRules).([]interface{})
+}
+
+// networkACLRuleSpecFromRule normalizes a rule of the network ACL
+func networkACLRuleSpecFromRule(rule vpcv1.NetworkACLRuleItemIntf) *networkACLRuleSpec {
+	spec := &networkACLRuleSpec{}
+	switch rule := rule.(type) {
+	case *vpcv1.NetworkACLRuleItemNetworkACLRuleProtocolAll:
+		spec.id, spec.name, spec.action, spec.direction = *rule.ID, *rule.Name, *rule.Action, *rule.Direction
+		spec.source, spec.destination, spec.protocol = *rule.Source, *rule.Destination, *rule.Protocol
+	case *vpcv1.NetworkACLRuleItemNetworkACLRuleProtocolIcmp:
+		spec.id, spec.name, spec.action, spec.direction = *rule.ID, *rule.Name, *rule.Action, *rule.Direction
+		spec.source, spec.destination, spec.protocol = *rule.Source, *rule.Destination, *rule.Protocol
+		spec.icmpType, spec.icmpCode = rule.Type, rule.Code
+	case *vpcv1.NetworkACLRuleItemNetworkACLRuleProtocolTcpudp:
+		spec.id, spec.name, spec.action, spec.direction = *rule.ID, *rule.Name, *rule.Action, *rule.Direction
+		spec.source, spec.destination, spec.protocol = *rule.Source, *rule.Destination, *rule.Protocol
+		spec.portMin = int64(checkNetworkACLNil(rule.DestinationPortMin))
+		spec.portMax = int64(checkNetworkACLNil(rule.DestinationPortMax))
+		spec.sourcePortMin = int64(checkNetworkACLNil(rule.SourcePortMin))
+		spec.sourcePortMax = int64(checkNetworkACLNil(rule.SourcePortMax))
+	default:
+		return nil
+	}
+	return spec
+}
+
+// flatten returns the rule as an element of the rules list
+func (r *networkACLRuleSpec) flatten() map[string]interface{} {
+	rule := map[string]interface{}{
+		isNetworkACLRuleID:          r.id,
+		isNetworkACLRuleName:        r.name,
+		isNetworkACLRuleAction:      r.action,
+		isNetworkACLRuleDirection:   r.direction,
+		isNetworkACLRuleSource:      r.source,
+		isNetworkACLRuleDestination: r.destination,
+	}
+	switch r.protocol {
+	case isNetworkACLRuleICMP:
+		icmp := map[string]interface{}{}
+		if r.icmpType != nil {
+			icmp[isNetworkACLRuleICMPType] = int(*r.icmpType)
+		}
+		if r.icmpCode != nil {
+			icmp[isNetworkACLRuleICMPCode] = int(*r.icmpCode)
+		}
+		rule[isNetworkACLRuleICMP] = []interface{}{icmp}
+	case isNetworkACLRuleTCP, isNetworkACLRuleUDP:
+		rule[r.protocol] = []interface{}{map[string]interface{}{
+			isNetworkACLRulePortMin:       int(r.portMin),
+			isNetworkACLRulePortMax:       int(r.portMax),
+			isNetworkACLRuleSourcePortMin: int(r.sourcePortMin),
+			isNetworkACLRuleSourcePortMax: int(r.sourcePortMax),
+		}}
+	}
+	return rule
+}
+
+// prototype returns the prototype that creates the rule immediately before the
+// rule with the before ID, or as the last rule if before is empty.
+func (r *networkACLRuleSpec) prototype(before string) *vpcv1.NetworkACLRulePrototype {
+	prototype := &vpcv1.NetworkACLRulePrototype{
+		Name:        core.StringPtr(r.name),
+		Action:      core.StringPtr(r.action),
+		Direction:   core.StringPtr(r.direction),
+		Source:      core.StringPtr(r.source),
+		Destination: core.StringPtr(r.destination),
+		Protocol:    core.StringPtr(r.protocol),
+	}
+	if before != "" {
+		prototype.Before = &vpcv1.NetworkACLRuleBeforePrototype{
+			ID: core.StringPtr(before),
+		}
+	}
+	switch r.protocol {
+	case isNetworkACLRuleICMP:
+		prototype.Type = r.icmpType
+		prototype.Code = r.icmpCode
+	case isNetworkACLRuleTCP, isNetworkACLRuleUDP:
+		prototype.DestinationPortMin = core.Int64Ptr(r.portMin)
+		prototype.DestinationPortMax = core.Int64Ptr(r.portMax)
+		prototype.SourcePortMin = core.Int64Ptr(r.sourcePortMin)
+		prototype.SourcePortMax = core.Int64Ptr(r.sourcePortMax)
+	}
+	return prototype
+}
+
+// replaces reports whether the rule must be deleted and created again to become
+// r, because the protocol of a rule and unsetting the icmp type or code of a rule
+// cannot be patched.
+func (r *networkACLRuleSpec) replaces(actual *networkACLRuleSpec) bool {
+	if r.protocol != actual.protocol {
+		return true
+	}
+	return (r.icmpType == nil) != (actual.icmpType == nil) || (r.icmpCode == nil) != (actual.icmpCode == nil)
+}
+
+// patch returns the patch of the fields of the actual rule that differ from r
+func (r *networkACLRuleSpec) patch(actual *networkACLRuleSpec) *vpcv1.NetworkACLRulePatch {
+	patch := &vpcv1.NetworkACLRulePatch{}
+	if r.action != actual.action {
+		patch.Action = core.StringPtr(r.action)
+	}
+	if r.direction != actual.direction {
+		patch.Direction = core.StringPtr(r.direction)
+	}
+	if r.source != actual.source {
+		patch.Source = core.StringPtr(r.source)
+	}
+	if r.destination != actual.destination {
+		patch.Destination = core.StringPtr(r.destination)
+	}
+	switch r.protocol {
+	case isNetworkACLRuleICMP:
+		if r.icmpType != nil && *r.icmpType != *actual.icmpType {
+			patch.Type = r.icmpType
+		}
+		if r.icmpCode != nil && *r.icmpCode != *actual.icmpCode {
+			patch.Code = r.icmpCode
+		}
+	case isNetworkACLRuleTCP, isNetworkACLRuleUDP:
+		if r.portMin != actual.portMin {
+			patch.DestinationPortMin = core.Int64Ptr(r.portMin)
+		}
+		if r.portMax != actual.portMax {
+			patch.DestinationPortMax = core.Int64Ptr(r.portMax)
+		}
+		if r.sourcePortMin != actual.sourcePortMin {
+			patch.SourcePortMin = core.Int64Ptr(r.sourcePortMin)
+		}
+		if r.sourcePortMax != actual.sourcePortMax {
+			patch.SourcePortMax = core.Int64Ptr(r.sourcePortMax)
+		}
+	}
+	return patch
+}
+
+const (
+	networkACLRuleStepCreate = "create"
+	networkACLRuleStepUpdate = "update"
+	networkACLRuleStepDelete = "delete"
+)
+
+// networkACLRuleStep is one call of the plan that makes the rules of a network
+// ACL match the rules list. A create or a move places the rule at index
+// immediately before the rule at index+1 of the list, or last.
+type networkACLRuleStep struct {
+	operation string
+	index     int
+	rule      *networkACLRuleSpec
+	actual    *networkACLRuleSpec
+	move      bool
+}
+
+// planNetworkACLRules returns the shortest sequence of calls that makes the
+// ordered actual rules of a network ACL the desired rules. Rules are matched by
+// name. The matching rules that are already in the desired relative order (the
+// longest such subsequence) stay in place; every other rule is created or moved
+// immediately before its successor in the desired list, working back from the
+// end so that the successor is always in its final place. Rules that are not in
+// the desired list are deleted last.
+func planNetworkACLRules(desired, actual []*networkACLRuleSpec) []networkACLRuleStep {
+	byName := make(map[string]int, len(actual))
+	for i, rule := range actual {
+		byName[rule.name] = i
+	}
+
+	// The position in actual of each desired rule that can stay, or -1
+	positions := make([]int, len(desired))
+	for i, rule := range desired {
+		positions[i] = -1
+		if j, ok := byName[rule.name]; ok && !rule.replaces(actual[j]) {
+			positions[i] = j
+		}
+	}
+	stays := longestIncreasingPositions(positions)
+
+	steps := make([]networkACLRuleStep, 0)
+	kept := make(map[string]bool, len(desired))
+	for i := len(desired) - 1; i >= 0; i-- {
+		rule := desired[i]
+		j, found := byName[rule.name]
+		if found {
+			kept[rule.name] = true
+		}
+		switch {
+		case !found:
+			steps = append(steps, networkACLRuleStep{operation: networkACLRuleStepCreate, index: i, rule: rule})
+		case positions[i] < 0:
+			steps = append(steps,
+				networkACLRuleStep{operation: networkACLRuleStepDelete, index: i, actual: actual[j]},
+				networkACLRuleStep{operation: networkACLRuleStepCreate, index: i, rule: rule})
+		default:
+			move := !stays[i]
+			if move || rule.key() != actual[j].key() {
+				steps = append(steps, networkACLRuleStep{operation: networkACLRuleStepUpdate, index: i, rule: rule, actual: actual[j], move: move})
+			}
+		}
+	}
+	for _, rule := range actual {
+		if !kept[rule.name] {
+			steps = append(steps, networkACLRuleStep{operation: networkACLRuleStepDelete, index: -1, actual: rule})
+		}
+	}
+	return steps
+}
+
+// longestIncreasingPositions marks the indexes of a longest strictly increasing
+// subsequence of the non-negative positions.
+func longestIncreasingPositions(positions []int) []bool {
+	// tails[k] is the index of the smallest tail of an increasing subsequence of length k+1
+	tails := make([]int, 0, len(positions))
+	previous := make([]int, len(positions))
+	for i, position := range positions {
+		if position < 0 {
+			continue
+		}
+		k := sort.Search(len(tails), func(k int) bool { return positions[tails[k]] >= position })
+		previous[i] = -1
+		if k > 0 {
+			previous[i] = tails[k-1]
+		}
+		if k == len(tails) {
+			tails = append(tails, i)
+		} else {
+			tails[k] = i
+		}
+	}
+	stays := make([]bool, len(positions))
+	if len(tails) > 0 {
+		for i := tails[len(tails)-1]; i >= 0; i = previous[i] {
+			stays[i] = true
+		}
+	}
+	return stays
+}
+
+// getNetworkACLRuleSpecs returns the rules of the network ACL in order
+func getNetworkACLRuleSpecs(context context.Context, sess *vpcv1.VpcV1, nwACLID string) ([]*networkACLRuleSpec, error) {
+	start := ""
+	rules := []*networkACLRuleSpec{}
+	for {
+		listNetworkACLRulesOptions := &vpcv1.ListNetworkACLRulesOptions{
+			NetworkACLID: &nwACLID,
+		}
+		if start != "" {
+			listNetworkACLRulesOptions.Start = &start
+		}
+		collection, _, err := sess.ListNetworkACLRulesWithContext(context, listNetworkACLRulesOptions)
+		if err != nil {
+			return nil, err
+		}
+		for _, rule := range collection.Rules {
+			if spec := networkACLRuleSpecFromRule(rule); spec != nil {
+				rules = append(rules, spec)
+			}
+		}
+		start = flex.GetNext(collection.Next)
+		if start == "" {
+			break
+		}
+	}
+	return rules, nil
+}
+
+// applyNetworkACLRules makes the rules of the network ACL match the rules list
+func applyNetworkACLRules(context context.Context, d *schema.ResourceData, meta interface{}, nwACLID, operation string) diag.Diagnostics {
+	sess, err := vpcClient(meta)
+	if err != nil {
+		tfErr := flex.DiscriminatedTerraformErrorf(err, err.Error(), "ibm_is_network_acl_rules", operation, "initialize-client")
+		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+		return tfErr.GetDiag()
+	}
+	desired, err := expandNetworkACLRuleSpecs(networkACLRulesConfig(d))
+	if err != nil {
+		tfErr := flex.TerraformErrorf(err, err.Error(), "ibm_is_network_acl_rules", operation)
+		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+		return tfErr.GetDiag()
+	}
+
+	isNetworkACLRulesKey := "network_acl_rules_key_" + nwACLID
+	unlock, err := conns.IbmKeyedLock.Lock(context, isNetworkACLRulesKey)
+	if err != nil {
+		tfErr := flex.DiscriminatedTerraformErrorf(err, fmt.Sprintf("Error waiting for the lock on %s: %s", isNetworkACLRulesKey, err), "ibm_is_network_acl_rules", operation, "wait-for-lock")
+		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+		return tfErr.GetDiag()
+	}
+	defer unlock()
+
+	actual, err := getNetworkACLRuleSpecs(context, sess, nwACLID)
+	if err != nil {
+		tfErr := flex.TerraformErrorf(err, fmt.Sprintf("ListNetworkACLRulesWithContext failed: %s", err.Error()), "ibm_is_network_acl_rules", operation)
+		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+		return tfErr.GetDiag()
+	}
+	steps := planNetworkACLRules(desired, actual)
+	log.Printf("[DEBUG] Network ACL %s: applying %d changes to %d rules", nwACLID, len(steps), len(actual))
+
+	// ids[i] is the ID of desired[i], the rules that are created get theirs as the
+	// plan places them, before their predecessors refer to them.
+	ids := make([]string, len(desired)+1)
+	byName := make(map[string]*networkACLRuleSpec, len(actual))
+	for _, rule := range actual {
+		byName[rule.name] = rule
+	}
+	for i, rule := range desired {
+		if a, ok := byName[rule.name]; ok && !rule.replaces(a) {
+			ids[i] = a.id
+		}
+	}
+	for _, step := range steps {
+		before := ""
+		if step.index >= 0 {
+			before = ids[step.index+1]
+		}
+		switch step.operation {
+		case networkACLRuleStepCreate:
+			rule, _, err := sess.CreateNetworkACLRuleWithContext(context, &vpcv1.CreateNetworkACLRuleOptions{
+				NetworkACLID:            &nwACLID,
+				NetworkACLRulePrototype: step.rule.prototype(before),
+			})
+			if err != nil {
+				tfErr := flex.TerraformErrorf(err, fmt.Sprintf("CreateNetworkACLRuleWithContext failed for rule %s: %s", step.rule, err.Error()), "ibm_is_network_acl_rules", operation)
+				log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+				return tfErr.GetDiag()
+			}
+			ids[step.index] = networkACLRuleID(rule)
+		case networkACLRuleStepUpdate:
+			patchModel := step.rule.patch(step.actual)
+			if step.move && before != "" {
+				patchModel.Before = &vpcv1.NetworkACLRuleBeforePatchNetworkACLRuleIdentityByID{
+					ID: core.StringPtr(before),
+				}
+			}
+			patch, err := patchModel.AsPatch()
+			if err != nil {
+				tfErr := flex.TerraformErrorf(err, fmt.Sprintf("[ERROR] Error calling asPatch for NetworkACLRulePatch: %s", err.Error()), "ibm_is_network_acl_rules", operation)
+				log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+				return tfErr.GetDiag()
+			}
+			if step.move && before == "" {
+				// A rule without before is the last rule
+				patch["before"] = nil
+			}
+			_, _, err = sess.UpdateNetworkACLRuleWithContext(context, &vpcv1.UpdateNetworkACLRuleOptions{
+				NetworkACLID:        &nwACLID,
+				ID:                  &step.actual.id,
+				NetworkACLRulePatch: patch,
+			})
+			if err != nil {
+				tfErr := flex.TerraformErrorf(err, fmt.Sprintf("UpdateNetworkACLRuleWithContext failed for rule %s: %s", step.rule, err.Error()), "ibm_is_network_acl_rules", operation)
+				log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+				return tfErr.GetDiag()
+			}
+		case networkACLRuleStepDelete:
+			response, err := sess.DeleteNetworkACLRuleWithContext(context, &vpcv1.DeleteNetworkACLRuleOptions{
+				NetworkACLID: &nwACLID,
+				ID:           &step.actual.id,
+			})
+			if err != nil && (response == nil || response.StatusCode != 404) {
+				tfErr := flex.TerraformErrorf(err, fmt.Sprintf("DeleteNetworkACLRuleWithContext failed for rule %s: %s", step.actual, err.Error()), "ibm_is_network_acl_rules", operation)
+				log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+				return tfErr.GetDiag()
+			}
+		}
+	}
+	return nil
+}
+
+func networkACLRuleID(rule vpcv1.NetworkACLRuleIntf) string {
+	switch rule := rule.(type) {
+	case *vpcv1.NetworkACLRuleNetworkACLRuleProtocolAll:
+		return *rule.ID
+	case *vpcv1.NetworkACLRuleNetworkACLRuleProtocolIcmp:
+		return *rule.ID
+	case *vpcv1.NetworkACLRuleNetworkACLRuleProtocolTcpudp:
+		return *rule.ID
+	}
+	return ""
+}
+
+func resourceIBMISNetworkACLRulesCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	nwACLID := d.Get(isNwACLID).(string)
+	if diags := applyNetworkACLRules(context, d, meta, nwACLID, "create"); diags != nil {
+		return diags
+	}
+	d.SetId(nwACLID)
+	return resourceIBMISNetworkACLRulesRead(context, d, meta)
+}
+
+func resourceIBMISNetworkACLRulesRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	sess, err := vpcClient(meta)
+	if err != nil {
+		tfErr := flex.DiscriminatedTerraformErrorf(err, err.Error(), "ibm_is_network_acl_rules", "read", "initialize-client")
+		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+		return tfErr.GetDiag()
+	}
+	nwACLID := d.Id()
+	// Reads wait for the rules being changed, but not for each other
+	isNetworkACLRulesKey := "network_acl_rules_key_" + nwACLID
+	unlock, err := conns.IbmKeyedLock.RLock(context, isNetworkACLRulesKey)
+	if err != nil {
+		tfErr := flex.DiscriminatedTerraformErrorf(err, fmt.Sprintf("Error waiting for the lock on %s: %s", isNetworkACLRulesKey, err), "ibm_is_network_acl_rules", "read", "wait-for-lock")
+		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+		return tfErr.GetDiag()
+	}
+	defer unlock()
+	nwacl, response, err := sess.GetNetworkACLWithContext(context, &vpcv1.GetNetworkACLOptions{
+		ID: &nwACLID,
+	})
+	if err != nil {
+		if response != nil && response.StatusCode == 404 {
+			d.SetId("")
+			return nil
+		}
+		tfErr := flex.TerraformErrorf(err, fmt.Sprintf("GetNetworkACLWithContext failed: %s", err.Error()), "ibm_is_network_acl_rules", "read")
+		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+		return tfErr.GetDiag()
+	}
+	actual, err := getNetworkACLRuleSpecs(context, sess, nwACLID)
+	if err != nil {
+		tfErr := flex.TerraformErrorf(err, fmt.Sprintf("ListNetworkACLRulesWithContext failed: %s", err.Error()), "ibm_is_network_acl_rules", "read")
+		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+		return tfErr.GetDiag()
+	}
+
+	// The rules are set in the order of the network ACL, so that a rule that was
+	// moved outside of Terraform shows as a difference. A rule that matches its
+	// configuration keeps the configured form, so that equivalent rules such as a
+	// tcp rule without ports and a tcp rule for ports 1-65535 do not show one.
+	configured := make(map[string]map[string]interface{})
+	for _, rule := range networkACLRulesConfig(d) {
+		values, _ := rule.(map[string]interface{})
+		if spec, err := expandNetworkACLRuleSpec(values); err == nil {
+			configured[spec.name] = values
+		}
+	}
+	rules := make([]interface{}, 0, len(actual))
+	for _, spec := range actual {
+		if values, ok := configured[spec.name]; ok {
+			if configuredSpec, _ := expandNetworkACLRuleSpec(values); configuredSpec.key() == spec.key() {
+				rule := make(map[string]interface{}, len(values))
+				for k, v := range values {
+					rule[k] = v
+				}
+				rule[isNetworkACLRuleID] = spec.id
+				rules = append(rules, rule)
+				continue
+			}
+		} else {
+			log.Printf("[DEBUG] Network ACL %s has rule %s that is not in the rules list", nwACLID, spec)
+		}
+		rules = append(rules, spec.flatten())
+	}
+
+	if err = d.Set(isNwACLID, nwACLID); err != nil {
+		err = fmt.Errorf("Error setting network_acl: %s", err)
+		return flex.DiscriminatedTerraformErrorf(err, err.Error(), "ibm_is_network_acl_rules", "read", "set-network_acl").GetDiag()
+	}
+	if err = d.Set(isNetworkACLRules, rules); err != nil {
+		err = fmt.Errorf("Error setting rules: %s", err)
+		return flex.DiscriminatedTerraformErrorf(err, err.Error(), "ibm_is_network_acl_rules", "read", "set-rules").GetDiag()
+	}
+	if err = d.Set(flex.RelatedCRN, nwacl.CRN); err != nil {
+		err = fmt.Errorf("Error setting related_crn: %s", err)
+		return flex.DiscriminatedTerraformErrorf(err, err.Error(), "ibm_is_network_acl_rules", "read", "set-related_crn").GetDiag()
+	}
+	return nil
+}
+
+func resourceIBMISNetworkACLRulesUpdate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if d.HasChange(isNetworkACLRules) {
+		if diags := applyNetworkACLRules(context, d, meta, d.Id(), "update"); diags != nil {
+			return diags
+		}
+	}
+	return resourceIBMISNetworkACLRulesRead(context, d, meta)
+}
+
+// resourceIBMISNetworkACLRulesDelete removes the rules of the rules list. The
+// rules that were added outside of Terraform after the last refresh are kept.
+func resourceIBMISNetworkACLRulesDelete(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	sess, err := vpcClient(meta)
+	if err != nil {
+		tfErr := flex.DiscriminatedTerraformErrorf(err, err.Error(), "ibm_is_network_acl_rules", "delete", "initialize-client")
+		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+		return tfErr.GetDiag()
+	}
+	nwACLID := d.Id()
+	managed := make(map[string]bool)
+	for _, rule := range d.Get(isNetworkACLRules).([]interface{}) {
+		if values, ok := rule.(map[string]interface{}); ok {
+			if id, _ := values[isNetworkACLRuleID].(string); id != "" {
+				managed[id] = true
+			}
+		}
+	}
+
+	isNetworkACLRulesKey := "network_acl_rules_key_" + nwACLID
+	unlock, err := conns.IbmKeyedLock.Lock(context, isNetworkACLRulesKey)
+	if err != nil {
+		tfErr := flex.DiscriminatedTerraformErrorf(err, fmt.Sprintf("Error waiting for the lock on %s: %s", isNetworkACLRulesKey, err), "ibm_is_network_acl_rules", "delete", "wait-for-lock")
+		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+		return tfErr.GetDiag()
+	}
+	defer unlock()
+
+	for id := range managed {
+		ruleID := id
+		response, err := sess.DeleteNetworkACLRuleWithContext(context, &vpcv1.DeleteNetworkACLRuleOptions{
+			NetworkACLID: &nwACLID,
+			ID:           &ruleID,
+		})
+		if err != nil && (response == nil || response.StatusCode != 404) {
+			tfErr := flex.TerraformErrorf(err, fmt.Sprintf("DeleteNetworkACLRuleWithContext failed for rule %s: %s", ruleID, err.Error()), "ibm_is_network_acl_rules", "delete")
+			log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+			return tfErr.GetDiag()
+		}
+	}
+	d.SetId("")
+	return nil
+}
+
+// resourceIBMISNetworkACLRulesImport imports the rules of a network ACL by its
+// ID, all the rules of the network ACL are then managed by the resource.
+func resourceIBMISNetworkACLRulesImport(context context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	d.Set(isNwACLID, d.Id())
+	return []*schema.ResourceData{d}, nil
+}
@@ -0,0 +1,227 @@
+// Copyright IBM Corp. 2025 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package vpc
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/vpc-go-sdk/vpcv1"
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func testNetworkACLRuleSpec(name, action, protocol string) *networkACLRuleSpec {
+	return &networkACLRuleSpec{
+		name:        name,
+		action:      action,
+		direction:   "inbound",
+		source:      "0.0.0.0/0",
+		destination: "0.0.0.0/0",
+		protocol:    protocol,
+	}
+}
+
+func testNetworkACLRuleSpecs(names ...string) []*networkACLRuleSpec {
+	specs := make([]*networkACLRuleSpec, 0, len(names))
+	for _, name := range names {
+		specs = append(specs, testNetworkACLRuleSpec(name, "allow", "all"))
+	}
+	return specs
+}
+
+func describeNetworkACLRuleSteps(steps []networkACLRuleStep) []string {
+	described := make([]string, 0, len(steps))
+	for _, step := range steps {
+		switch step.operation {
+		case networkACLRuleStepCreate:
+			described = append(described, fmt.Sprintf("create %s at %d", step.rule.name, step.index))
+		case networkACLRuleStepUpdate:
+			s := fmt.Sprintf("update %s at %d", step.rule.name, step.index)
+			if step.move {
+				s += " and move"
+			}
+			described = append(described, s)
+		case networkACLRuleStepDelete:
+			described = append(described, "delete "+step.actual.name)
+		}
+	}
+	return described
+}
+
+func TestPlanNetworkACLRules(t *testing.T) {
+	tests := []struct {
+		name    string
+		desired []*networkACLRuleSpec
+		actual  []*networkACLRuleSpec
+		want    []string
+	}{
+		{
+			name:    "no changes",
+			desired: testNetworkACLRuleSpecs("a", "b", "c"),
+			actual:  testNetworkACLRuleSpecs("a", "b", "c"),
+			want:    []string{},
+		},
+		{
+			name:    "empty network ACL",
+			desired: testNetworkACLRuleSpecs("a", "b", "c"),
+			want:    []string{"create c at 2", "create b at 1", "create a at 0"},
+		},
+		{
+			name:   "no rules",
+			actual: testNetworkACLRuleSpecs("a", "b"),
+			want:   []string{"delete a", "delete b"},
+		},
+		{
+			name:    "last rule moved first",
+			desired: testNetworkACLRuleSpecs("c", "a", "b"),
+			actual:  testNetworkACLRuleSpecs("a", "b", "c"),
+			want:    []string{"update c at 0 and move"},
+		},
+		{
+			name:    "rule inserted in the middle",
+			desired: testNetworkACLRuleSpecs("a", "new", "b"),
+			actual:  testNetworkACLRuleSpecs("a", "b"),
+			want:    []string{"create new at 1"},
+		},
+		{
+			name:    "changed action",
+			desired: []*networkACLRuleSpec{testNetworkACLRuleSpec("a", "deny", "all")},
+			actual:  []*networkACLRuleSpec{testNetworkACLRuleSpec("a", "allow", "all")},
+			want:    []string{"update a at 0"},
+		},
+		{
+			name:    "changed protocol",
+			desired: []*networkACLRuleSpec{testNetworkACLRuleSpec("a", "allow", "tcp")},
+			actual:  []*networkACLRuleSpec{testNetworkACLRuleSpec("a", "allow", "all")},
+			want:    []string{"delete a", "create a at 0"},
+		},
+		{
+			name:    "created, moved and deleted rules",
+			desired: testNetworkACLRuleSpecs("b", "new", "a"),
+			actual:  testNetworkACLRuleSpecs("a", "b", "old"),
+			want:    []string{"create new at 1", "update b at 0 and move", "delete old"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := describeNetworkACLRuleSteps(planNetworkACLRules(tt.desired, tt.actual))
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("planNetworkACLRules() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestLongestIncreasingPositions(t *testing.T) {
+	tests := []struct {
+		name      string
+		positions []int
+		want      []bool
+	}{
+		{
+			name:      "empty",
+			positions: []int{},
+			want:      []bool{},
+		},
+		{
+			name:      "no positions",
+			positions: []int{-1, -1},
+			want:      []bool{false, false},
+		},
+		{
+			name:      "in order",
+			positions: []int{0, 1, 2},
+			want:      []bool{true, true, true},
+		},
+		{
+			name:      "last moved first",
+			positions: []int{2, 0, 1},
+			want:      []bool{false, true, true},
+		},
+		{
+			name:      "reversed",
+			positions: []int{1, -1, 0},
+			want:      []bool{false, false, true},
+		},
+		{
+			name:      "longest of several",
+			positions: []int{3, 1, 2, 0, 4},
+			want:      []bool{false, true, true, false, true},
+		},
+		{
+			name:      "gaps",
+			positions: []int{5, -1, 7, 2, -1, 9},
+			want:      []bool{true, false, true, false, false, true},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := longestIncreasingPositions(tt.positions); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("longestIncreasingPositions(%v) = %v, want %v", tt.positions, got, tt.want)
+			}
+		})
+	}
+}
+
+func testNetworkACLICMPRuleConfig(name string, icmpType, icmpCode cty.Value) cty.Value {
+	return cty.ObjectVal(map[string]cty.Value{
+		isNetworkACLRuleName:        cty.StringVal(name),
+		isNetworkACLRuleAction:      cty.StringVal("allow"),
+		isNetworkACLRuleDirection:   cty.StringVal("inbound"),
+		isNetworkACLRuleSource:      cty.StringVal("0.0.0.0/0"),
+		isNetworkACLRuleDestination: cty.StringVal("0.0.0.0/0"),
+		isNetworkACLRuleICMP: cty.ListVal([]cty.Value{cty.ObjectVal(map[string]cty.Value{
+			isNetworkACLRuleICMPType: icmpType,
+			isNetworkACLRuleICMPCode: icmpCode,
+		})}),
+	})
+}
+
+func TestNetworkACLRulesConfigICMPZero(t *testing.T) {
+	rawConfig := cty.ObjectVal(map[string]cty.Value{
+		isNetworkACLRules: cty.ListVal([]cty.Value{
+			testNetworkACLICMPRuleConfig("echo-reply", cty.NumberIntVal(0), cty.NumberIntVal(0)),
+			testNetworkACLICMPRuleConfig("all-icmp", cty.NullVal(cty.Number), cty.NullVal(cty.Number)),
+		}),
+	})
+	d := ResourceIBMISNetworkACLRules().Data(&terraform.InstanceState{RawConfig: rawConfig})
+
+	specs, err := expandNetworkACLRuleSpecs(networkACLRulesConfig(d))
+	if err != nil {
+		t.Fatalf("expandNetworkACLRuleSpecs() error = %v", err)
+	}
+	if len(specs) != 2 {
+		t.Fatalf("expandNetworkACLRuleSpecs() = %d rules, want 2", len(specs))
+	}
+	echoReply, allICMP := specs[0], specs[1]
+	if echoReply.icmpType == nil || *echoReply.icmpType != 0 || echoReply.icmpCode == nil || *echoReply.icmpCode != 0 {
+		t.Errorf("rule %s has icmp type %v and code %v, want 0 and 0", echoReply.name, echoReply.icmpType, echoReply.icmpCode)
+	}
+	if allICMP.icmpType != nil || allICMP.icmpCode != nil {
+		t.Errorf("rule %s has icmp type %v and code %v, want none", allICMP.name, allICMP.icmpType, allICMP.icmpCode)
+	}
+
+	// A rule for all icmp traffic does not match a rule for type 0 and code 0
+	actual := networkACLRuleSpecFromRule(&vpcv1.NetworkACLRuleItemNetworkACLRuleProtocolIcmp{
+		ID:          core.StringPtr("r006-rule"),
+		Name:        core.StringPtr("all-icmp"),
+		Action:      core.StringPtr("allow"),
+		Direction:   core.StringPtr("inbound"),
+		Source:      core.StringPtr("0.0.0.0/0"),
+		Destination: core.StringPtr("0.0.0.0/0"),
+		Protocol:    core.StringPtr(isNetworkACLRuleICMP),
+		Type:        core.Int64Ptr(0),
+		Code:        core.Int64Ptr(0),
+	})
+	if allICMP.key() == actual.key() {
+		t.Errorf("rule %s matches a rule for icmp type 0 and code 0", allICMP.name)
+	}
+	echoReply.name = actual.name
+	if echoReply.key() != actual.key() {
+		t.Errorf("rule %s does not match a rule for icmp type 0 and code 0", echoReply.name)
+	}
+}
@@ -0,0 +1,174 @@
+// Copyright IBM Corp. 2025 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package vpc_test
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+
+	"github.com/IBM/vpc-go-sdk/vpcv1"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccIBMISNetworkACLRules_basic(t *testing.T) {
+	vpcName := fmt.Sprintf("tf-nacl-rules-vpc-%d", acctest.RandIntRange(10, 100))
+	nwACLName := fmt.Sprintf("tf-nacl-rules-%d", acctest.RandIntRange(10, 100))
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acc.TestAccPreCheck(t) },
+		Providers:    acc.TestAccProviders,
+		CheckDestroy: testAccCheckIBMISNetworkACLRulesDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMISNetworkACLRulesConfig(vpcName, nwACLName, []string{"ssh", "icmp", "deny-all"}),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIBMISNetworkACLRulesOrder("ibm_is_network_acl_rules.testacc_nacl_rules", "ssh", "icmp", "deny-all"),
+					resource.TestCheckResourceAttr(
+						"ibm_is_network_acl_rules.testacc_nacl_rules", "rules.#", "3"),
+					resource.TestCheckResourceAttr(
+						"ibm_is_network_acl_rules.testacc_nacl_rules", "rules.0.name", "ssh"),
+					resource.TestCheckResourceAttrSet(
+						"ibm_is_network_acl_rules.testacc_nacl_rules", "rules.0.id"),
+				),
+			},
+			{
+				Config: testAccCheckIBMISNetworkACLRulesConfig(vpcName, nwACLName, []string{"icmp", "https", "ssh", "deny-all"}),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIBMISNetworkACLRulesOrder("ibm_is_network_acl_rules.testacc_nacl_rules", "icmp", "https", "ssh", "deny-all"),
+					resource.TestCheckResourceAttr(
+						"ibm_is_network_acl_rules.testacc_nacl_rules", "rules.#", "4"),
+					resource.TestCheckResourceAttr(
+						"ibm_is_network_acl_rules.testacc_nacl_rules", "rules.1.name", "https"),
+				),
+			},
+			{
+				ResourceName:      "ibm_is_network_acl_rules.testacc_nacl_rules",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckIBMISNetworkACLRulesDestroy(s *terraform.State) error {
+	sess, _ := acc.TestAccProvider.Meta().(conns.ClientSession).VpcV1API()
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "ibm_is_network_acl_rules" {
+			continue
+		}
+
+		listNetworkACLRulesOptions := &vpcv1.ListNetworkACLRulesOptions{
+			NetworkACLID: &rs.Primary.ID,
+		}
+		rules, _, err := sess.ListNetworkACLRules(listNetworkACLRulesOptions)
+		if err == nil && len(rules.Rules) != 0 {
+			return fmt.Errorf("network ACL %s still has %d rules", rs.Primary.ID, len(rules.Rules))
+		}
+	}
+	return nil
+}
+
+func testAccCheckIBMISNetworkACLRulesOrder(n string, names ...string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		sess, _ := acc.TestAccProvider.Meta().(conns.ClientSession).VpcV1API()
+		listNetworkACLRulesOptions := &vpcv1.ListNetworkACLRulesOptions{
+			NetworkACLID: &rs.Primary.ID,
+		}
+		rules, _, err := sess.ListNetworkACLRules(listNetworkACLRulesOptions)
+		if err != nil {
+			return err
+		}
+		actual := make([]string, 0, len(rules.Rules))
+		for _, rule := range rules.Rules {
+			switch rule := rule.(type) {
+			case *vpcv1.NetworkACLRuleItemNetworkACLRuleProtocolAll:
+				actual = append(actual, *rule.Name)
+			case *vpcv1.NetworkACLRuleItemNetworkACLRuleProtocolIcmp:
+				actual = append(actual, *rule.Name)
+			case *vpcv1.NetworkACLRuleItemNetworkACLRuleProtocolTcpudp:
+				actual = append(actual, *rule.Name)
+			}
+		}
+		if strings.Join(actual, ",") != strings.Join(names, ",") {
+			return fmt.Errorf("network ACL %s has rules %v, expected %v", rs.Primary.ID, actual, names)
+		}
+		return nil
+	}
+}
+
+func testAccCheckIBMISNetworkACLRulesConfig(vpcName, nwACLName string, names []string) string {
+	rules := map[string]string{
+		"ssh": `
+		rules {
+			name        = "ssh"
+			action      = "allow"
+			source      = "10.240.0.0/24"
+			destination = "0.0.0.0/0"
+			direction   = "inbound"
+			tcp {
+				port_min = 22
+				port_max = 22
+			}
+		}`,
+		"https": `
+		rules {
+			name        = "https"
+			action      = "allow"
+			source      = "0.0.0.0/0"
+			destination = "0.0.0.0/0"
+			direction   = "inbound"
+			tcp {
+				port_min = 443
+				port_max = 443
+			}
+		}`,
+		"icmp": `
+		rules {
+			name        = "icmp"
+			action      = "allow"
+			source      = "0.0.0.0/0"
+			destination = "0.0.0.0/0"
+			direction   = "inbound"
+			icmp {
+				type = 8
+			}
+		}`,
+		"deny-all": `
+		rules {
+			name        = "deny-all"
+			action      = "deny"
+			source      = "0.0.0.0/0"
+			destination = "0.0.0.0/0"
+			direction   = "inbound"
+		}`,
+	}
+	var config strings.Builder
+	for _, name := range names {
+		config.WriteString(rules[name])
+	}
+	return fmt.Sprintf(`
+	resource "ibm_is_vpc" "testacc_vpc" {
+		name = "%s"
+	}
+
+	resource "ibm_is_network_acl" "testacc_nacl" {
+		name = "%s"
+		vpc  = ibm_is_vpc.testacc_vpc.id
+	}
+
+	resource "ibm_is_network_acl_rules" "testacc_nacl_rules" {
+		network_acl = ibm_is_network_acl.testacc_nacl.id
+		%s
+	}`, vpcName, nwACLName, config.String())
+}
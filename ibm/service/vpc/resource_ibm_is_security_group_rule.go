@@ -4,6 +4,7 @@
 package vpc
 
 import (
+	"context"
 	"fmt"
 	"reflect"
 	"strings"
@@ -246,8 +247,13 @@ func resourceIBMISSecurityGroupRuleCreate(d *schema.ResourceData, meta interface
 		return err
 	}
 	isSecurityGroupRuleKey := "security_group_rule_key_" + parsed.secgrpID
-	conns.IbmMutexKV.Lock(isSecurityGroupRuleKey)
-	defer conns.IbmMutexKV.Unlock(isSecurityGroupRuleKey)
+	ctx, cancel := context.WithTimeout(context.Background(), d.Timeout(schema.TimeoutCreate))
+	defer cancel()
+	unlock, err := conns.IbmKeyedLock.Lock(ctx, isSecurityGroupRuleKey)
+	if err != nil {
+		return fmt.Errorf("[ERROR] Error waiting for the lock on %s: %s", isSecurityGroupRuleKey, err)
+	}
+	defer unlock()
 
 	options := &vpcv1.CreateSecurityGroupRuleOptions{
 		SecurityGroupID:            &parsed.secgrpID,
@@ -453,8 +459,13 @@ func resourceIBMISSecurityGroupRuleUpdate(d *schema.ResourceData, meta interface
 		return err
 	}
 	isSecurityGroupRuleKey := "security_group_rule_key_" + parsed.secgrpID
-	conns.IbmMutexKV.Lock(isSecurityGroupRuleKey)
-	defer conns.IbmMutexKV.Unlock(isSecurityGroupRuleKey)
+	ctx, cancel := context.WithTimeout(context.Background(), d.Timeout(schema.TimeoutUpdate))
+	defer cancel()
+	unlock, err := conns.IbmKeyedLock.Lock(ctx, isSecurityGroupRuleKey)
+	if err != nil {
+		return fmt.Errorf("[ERROR] Error waiting for the lock on %s: %s", isSecurityGroupRuleKey, err)
+	}
+	defer unlock()
 
 	updateSecurityGroupRuleOptions := sgTemplate
 	_, response, err := sess.UpdateSecurityGroupRule(updateSecurityGroupRuleOptions)
@@ -475,8 +486,13 @@ func resourceIBMISSecurityGroupRuleDelete(d *schema.ResourceData, meta interface
 	}
 
 	isSecurityGroupRuleKey := "security_group_rule_key_" + secgrpID
-	conns.IbmMutexKV.Lock(isSecurityGroupRuleKey)
-	defer conns.IbmMutexKV.Unlock(isSecurityGroupRuleKey)
+	ctx, cancel := context.WithTimeout(context.Background(), d.Timeout(schema.TimeoutDelete))
+	defer cancel()
+	unlock, err := conns.IbmKeyedLock.Lock(ctx, isSecurityGroupRuleKey)
+	if err != nil {
+		return fmt.Errorf("[ERROR] Error waiting for the lock on %s: %s", isSecurityGroupRuleKey, err)
+	}
+	defer unlock()
 
 	getSecurityGroupRuleOptions := &vpcv1.GetSecurityGroupRuleOptions{
 		SecurityGroupID: &secgrpID,
@@ -0,0 +1,693 @@
+// Copyright IBM Corp. 2025 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package vpc
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/vpc-go-sdk/vpcv1"
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const (
+	// isSecurityGroupRuleAnyAddress is the remote and local of a rule that does not set them
+	isSecurityGroupRuleAnyAddress = "0.0.0.0/0"
+)
+
+func ResourceIBMISSecurityGroupRules() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceIBMISSecurityGroupRulesCreate,
+		ReadContext:   resourceIBMISSecurityGroupRulesRead,
+		UpdateContext: resourceIBMISSecurityGroupRulesUpdate,
+		DeleteContext: resourceIBMISSecurityGroupRulesDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceIBMISSecurityGroupRulesImport,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			isSecurityGroupID: {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Security group id",
+			},
+
+			isSecurityGroupRules: {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Description: "The complete set of rules of the security group. Rules of the security group that are not in the set are removed",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						isSecurityGroupRuleDirection: {
+							Type:         schema.TypeString,
+							Required:     true,
+							Description:  "Direction of traffic to enforce, either inbound or outbound",
+							ValidateFunc: validate.InvokeValidator("ibm_is_security_group_rule", isSecurityGroupRuleDirection),
+						},
+						isSecurityGroupRuleIPVersion: {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Default:      isSecurityGroupRuleIPVersionDefault,
+							Description:  "IP version: ipv4",
+							ValidateFunc: validate.InvokeValidator("ibm_is_security_group_rule", isSecurityGroupRuleIPVersion),
+						},
+						isSecurityGroupRuleRemote: {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "Security group id: an IP address, a CIDR block, or a single security group identifier. Defaults to any address",
+						},
+						isSecurityGroupRuleLocal: {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "Security group local ip: an IP address, a CIDR block. Defaults to any address",
+						},
+						isSecurityGroupRuleProtocolICMP: {
+							Type:        schema.TypeList,
+							MaxItems:    1,
+							Optional:    true,
+							Description: "protocol=icmp",
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									isSecurityGroupRuleType: {
+										Type:         schema.TypeInt,
+										Optional:     true,
+										ValidateFunc: validate.InvokeValidator("ibm_is_security_group_rule", isSecurityGroupRuleType),
+									},
+									isSecurityGroupRuleCode: {
+										Type:         schema.TypeInt,
+										Optional:     true,
+										ValidateFunc: validate.InvokeValidator("ibm_is_security_group_rule", isSecurityGroupRuleCode),
+									},
+								},
+							},
+						},
+						isSecurityGroupRuleProtocolTCP: {
+							Type:        schema.TypeList,
+							MaxItems:    1,
+							Optional:    true,
+							Description: "protocol=tcp",
+							Elem:        makeIBMISSecurityGroupRulesPortsSchema(),
+						},
+						isSecurityGroupRuleProtocolUDP: {
+							Type:        schema.TypeList,
+							MaxItems:    1,
+							Optional:    true,
+							Description: "protocol=udp",
+							Elem:        makeIBMISSecurityGroupRulesPortsSchema(),
+						},
+					},
+				},
+			},
+
+			flex.RelatedCRN: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The crn of the Security Group",
+			},
+		},
+	}
+}
+
+func makeIBMISSecurityGroupRulesPortsSchema() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			isSecurityGroupRulePortMin: {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      1,
+				ValidateFunc: validate.InvokeValidator("ibm_is_security_group_rule", isSecurityGroupRulePortMin),
+			},
+			isSecurityGroupRulePortMax: {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      65535,
+				ValidateFunc: validate.InvokeValidator("ibm_is_security_group_rule", isSecurityGroupRulePortMax),
+			},
+		},
+	}
+}
+
+// securityGroupRuleSpec is the normalized form of a security group rule, used to
+// match the rules of the configuration with the rules of the security group.
+type securityGroupRuleSpec struct {
+	direction string
+	ipVersion string
+	protocol  string
+	remote    string
+	local     string
+	icmpType  *int64
+	icmpCode  *int64
+	portMin   int64
+	portMax   int64
+}
+
+func (r *securityGroupRuleSpec) key() string {
+	icmpType, icmpCode := "any", "any"
+	if r.icmpType != nil {
+		icmpType = fmt.Sprint(*r.icmpType)
+	}
+	if r.icmpCode != nil {
+		icmpCode = fmt.Sprint(*r.icmpCode)
+	}
+	return strings.Join([]string{
+		r.direction, strings.ToLower(r.ipVersion), r.protocol, r.remote, r.local,
+		icmpType, icmpCode, fmt.Sprint(r.portMin), fmt.Sprint(r.portMax),
+	}, "|")
+}
+
+// stateKey is the key of the rule as it is kept in the state, where an icmp
+// type or code that is not set is stored as 0.
+func (r *securityGroupRuleSpec) stateKey() string {
+	state := *r
+	zero := int64(0)
+	if state.icmpType == nil {
+		state.icmpType = &zero
+	}
+	if state.icmpCode == nil {
+		state.icmpCode = &zero
+	}
+	return state.key()
+}
+
+func (r *securityGroupRuleSpec) String() string {
+	s := fmt.Sprintf("%s %s from %s to %s", r.direction, r.protocol, r.remote, r.local)
+	if r.protocol == isSecurityGroupRuleProtocolTCP || r.protocol == isSecurityGroupRuleProtocolUDP {
+		s += fmt.Sprintf(" ports %d-%d", r.portMin, r.portMax)
+	}
+	return s
+}
+
+// expandSecurityGroupRuleSpec normalizes a rule of the rules set. An icmp type
+// or code that is missing from the rule matches any type or code.
+func expandSecurityGroupRuleSpec(rule map[string]interface{}) (*securityGroupRuleSpec, error) {
+	spec := &securityGroupRuleSpec{protocol: "all"}
+	spec.direction, _ = rule[isSecurityGroupRuleDirection].(string)
+	spec.ipVersion, _ = rule[isSecurityGroupRuleIPVersion].(string)
+	spec.remote, _ = rule[isSecurityGroupRuleRemote].(string)
+	spec.local, _ = rule[isSecurityGroupRuleLocal].(string)
+	if spec.ipVersion == "" {
+		spec.ipVersion = isSecurityGroupRuleIPVersionDefault
+	}
+	if spec.remote == "" {
+		spec.remote = isSecurityGroupRuleAnyAddress
+	}
+	if spec.local == "" {
+		spec.local = isSecurityGroupRuleAnyAddress
+	}
+
+	protocols := 0
+	if icmp, ok := rule[isSecurityGroupRuleProtocolICMP].([]interface{}); ok && len(icmp) > 0 {
+		protocols++
+		spec.protocol = isSecurityGroupRuleProtocolICMP
+		if icmp[0] != nil {
+			values := icmp[0].(map[string]interface{})
+			if v, ok := values[isSecurityGroupRuleType].(int); ok {
+				icmpType := int64(v)
+				spec.icmpType = &icmpType
+			}
+			if v, ok := values[isSecurityGroupRuleCode].(int); ok {
+				if spec.icmpType == nil {
+					return nil, fmt.Errorf("icmp code requires icmp type")
+				}
+				icmpCode := int64(v)
+				spec.icmpCode = &icmpCode
+			}
+		}
+	}
+	for _, protocol := range []string{isSecurityGroupRuleProtocolTCP, isSecurityGroupRuleProtocolUDP} {
+		if ports, ok := rule[protocol].([]interface{}); ok && len(ports) > 0 {
+			protocols++
+			spec.protocol = protocol
+			spec.portMin, spec.portMax = 1, 65535
+			if ports[0] != nil {
+				values := ports[0].(map[string]interface{})
+				if v, ok := values[isSecurityGroupRulePortMin].(int); ok {
+					spec.portMin = int64(v)
+				}
+				if v, ok := values[isSecurityGroupRulePortMax].(int); ok {
+					spec.portMax = int64(v)
+				}
+			}
+			if spec.portMin > spec.portMax {
+				return nil, fmt.Errorf("%s port_min %d is greater than port_max %d", protocol, spec.portMin, spec.portMax)
+			}
+		}
+	}
+	if protocols > 1 {
+		return nil, fmt.Errorf("a rule can set only one of icmp, tcp or udp")
+	}
+	return spec, nil
+}
+
+// prototype returns the prototype that creates the rule
+func (r *securityGroupRuleSpec) prototype() *vpcv1.SecurityGroupRulePrototype {
+	prototype := &vpcv1.SecurityGroupRulePrototype{
+		Direction: &r.direction,
+		IPVersion: &r.ipVersion,
+		Protocol:  &r.protocol,
+	}
+
+	address, cidr, id, _ := inferRemoteSecurityGroup(r.remote)
+	remote := &vpcv1.SecurityGroupRuleRemotePrototype{}
+	if address != "" {
+		remote.Address = &address
+	} else if cidr != "" {
+		remote.CIDRBlock = &cidr
+	} else {
+		remote.ID = &id
+	}
+	prototype.Remote = remote
+
+	address, cidr, _ = inferLocalSecurityGroup(r.local)
+	local := &vpcv1.SecurityGroupRuleLocalPrototype{}
+	if address != "" {
+		local.Address = &address
+	} else {
+		local.CIDRBlock = &cidr
+	}
+	prototype.Local = local
+
+	switch r.protocol {
+	case isSecurityGroupRuleProtocolICMP:
+		prototype.Type = r.icmpType
+		prototype.Code = r.icmpCode
+	case isSecurityGroupRuleProtocolTCP, isSecurityGroupRuleProtocolUDP:
+		prototype.PortMin = &r.portMin
+		prototype.PortMax = &r.portMax
+	}
+	return prototype
+}
+
+// flatten returns the rule as an element of the rules set
+func (r *securityGroupRuleSpec) flatten() map[string]interface{} {
+	rule := map[string]interface{}{
+		isSecurityGroupRuleDirection: r.direction,
+		isSecurityGroupRuleIPVersion: r.ipVersion,
+		isSecurityGroupRuleRemote:    r.remote,
+		isSecurityGroupRuleLocal:     r.local,
+	}
+	switch r.protocol {
+	case isSecurityGroupRuleProtocolICMP:
+		icmp := map[string]interface{}{}
+		if r.icmpType != nil {
+			icmp[isSecurityGroupRuleType] = int(*r.icmpType)
+		}
+		if r.icmpCode != nil {
+			icmp[isSecurityGroupRuleCode] = int(*r.icmpCode)
+		}
+		rule[isSecurityGroupRuleProtocolICMP] = []interface{}{icmp}
+	case isSecurityGroupRuleProtocolTCP, isSecurityGroupRuleProtocolUDP:
+		rule[r.protocol] = []interface{}{map[string]interface{}{
+			isSecurityGroupRulePortMin: int(r.portMin),
+			isSecurityGroupRulePortMax: int(r.portMax),
+		}}
+	}
+	return rule
+}
+
+// securityGroupRuleSpecFromRule normalizes a rule of the security group
+func securityGroupRuleSpecFromRule(rule vpcv1.SecurityGroupRuleIntf) (id string, spec *securityGroupRuleSpec) {
+	spec = &securityGroupRuleSpec{
+		remote: isSecurityGroupRuleAnyAddress,
+		local:  isSecurityGroupRuleAnyAddress,
+	}
+	var remoteIntf vpcv1.SecurityGroupRuleRemoteIntf
+	var localIntf vpcv1.SecurityGroupRuleLocalIntf
+	switch rule := rule.(type) {
+	case *vpcv1.SecurityGroupRuleSecurityGroupRuleProtocolAll:
+		id, spec.direction, spec.ipVersion, spec.protocol = *rule.ID, *rule.Direction, *rule.IPVersion, *rule.Protocol
+		remoteIntf, localIntf = rule.Remote, rule.Local
+	case *vpcv1.SecurityGroupRuleSecurityGroupRuleProtocolIcmp:
+		id, spec.direction, spec.ipVersion, spec.protocol = *rule.ID, *rule.Direction, *rule.IPVersion, *rule.Protocol
+		remoteIntf, localIntf = rule.Remote, rule.Local
+		spec.icmpType, spec.icmpCode = rule.Type, rule.Code
+	case *vpcv1.SecurityGroupRuleSecurityGroupRuleProtocolTcpudp:
+		id, spec.direction, spec.ipVersion, spec.protocol = *rule.ID, *rule.Direction, *rule.IPVersion, *rule.Protocol
+		remoteIntf, localIntf = rule.Remote, rule.Local
+		if rule.PortMin != nil {
+			spec.portMin = *rule.PortMin
+		}
+		if rule.PortMax != nil {
+			spec.portMax = *rule.PortMax
+		}
+	default:
+		return "", nil
+	}
+	if remote, ok := remoteIntf.(*vpcv1.SecurityGroupRuleRemote); ok && remote != nil {
+		if remote.ID != nil {
+			spec.remote = *remote.ID
+		} else if remote.Address != nil {
+			spec.remote = *remote.Address
+		} else if remote.CIDRBlock != nil {
+			spec.remote = *remote.CIDRBlock
+		}
+	}
+	if local, ok := localIntf.(*vpcv1.SecurityGroupRuleLocal); ok && local != nil {
+		if local.Address != nil {
+			spec.local = *local.Address
+		} else if local.CIDRBlock != nil {
+			spec.local = *local.CIDRBlock
+		}
+	}
+	return id, spec
+}
+
+// diffSecurityGroupRules returns the rules to add to the security group and the
+// IDs of the rules to remove from it, so that it has exactly the desired rules.
+func diffSecurityGroupRules(desired []*securityGroupRuleSpec, actual map[string]*securityGroupRuleSpec) (adds []*securityGroupRuleSpec, removes []string) {
+	wanted := make(map[string]bool, len(desired))
+	for _, rule := range desired {
+		wanted[rule.key()] = true
+	}
+	// Visit the rules by ID so that the same duplicate is kept on every run
+	ids := make([]string, 0, len(actual))
+	for id := range actual {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+	present := make(map[string]bool, len(actual))
+	for _, id := range ids {
+		key := actual[id].key()
+		if !wanted[key] || present[key] {
+			removes = append(removes, id)
+			continue
+		}
+		present[key] = true
+	}
+	for _, rule := range desired {
+		if key := rule.key(); !present[key] {
+			present[key] = true
+			adds = append(adds, rule)
+		}
+	}
+	return adds, removes
+}
+
+func expandSecurityGroupRuleSpecs(rules []interface{}) ([]*securityGroupRuleSpec, error) {
+	specs := make([]*securityGroupRuleSpec, 0, len(rules))
+	for _, rule := range rules {
+		spec, err := expandSecurityGroupRuleSpec(rule.(map[string]interface{}))
+		if err != nil {
+			return nil, err
+		}
+		specs = append(specs, spec)
+	}
+	return specs, nil
+}
+
+// securityGroupRulesConfig returns the rules of the configuration. Unlike the
+// rules set of the state, the icmp type and code that are not set are left out.
+func securityGroupRulesConfig(d *schema.ResourceData) []interface{} {
+	config := d.GetRawConfig()
+	if config.IsNull() {
+		return d.Get(isSecurityGroupRules).(*schema.Set).List()
+	}
+	rules, _ := securityGroupRuleConfigValue(config.GetAttr(isSecurityGroupRules)).([]interface{})
+	return rules
+}
+
+// securityGroupRuleConfigValue converts a value of the configuration of the rules
+// set, null values are returned as nil and left out of the objects.
+func securityGroupRuleConfigValue(v cty.Value) interface{} {
+	if v.IsNull() || !v.IsKnown() {
+		return nil
+	}
+	t := v.Type()
+	switch {
+	case t == cty.String:
+		return v.AsString()
+	case t == cty.Number:
+		i, _ := v.AsBigFloat().Int64()
+		return int(i)
+	case t == cty.Bool:
+		return v.True()
+	case t.IsListType() || t.IsSetType() || t.IsTupleType():
+		values := make([]interface{}, 0, v.LengthInt())
+		for it := v.ElementIterator(); it.Next(); {
+			_, element := it.Element()
+			values = append(values, securityGroupRuleConfigValue(element))
+		}
+		return values
+	case t.IsObjectType() || t.IsMapType():
+		values := make(map[string]interface{})
+		for name, attribute := range v.AsValueMap() {
+			if value := securityGroupRuleConfigValue(attribute); value != nil {
+				values[name] = value
+			}
+		}
+		return values
+	}
+	return nil
+}
+
+func getSecurityGroupRuleSpecs(context context.Context, sess *vpcv1.VpcV1, securityGroupID string) (*vpcv1.SecurityGroup, map[string]*securityGroupRuleSpec, *core.DetailedResponse, error) {
+	group, response, err := sess.GetSecurityGroupWithContext(context, &vpcv1.GetSecurityGroupOptions{
+		ID: &securityGroupID,
+	})
+	if err != nil {
+		return nil, nil, response, err
+	}
+	actual := make(map[string]*securityGroupRuleSpec, len(group.Rules))
+	for _, rule := range group.Rules {
+		if id, spec := securityGroupRuleSpecFromRule(rule); spec != nil {
+			actual[id] = spec
+		}
+	}
+	return group, actual, response, nil
+}
+
+// applySecurityGroupRules makes the rules of the security group match the rules
+// set. Rules are added before the others are removed, so that traffic allowed by
+// both the old and the new rules is never interrupted.
+func applySecurityGroupRules(context context.Context, d *schema.ResourceData, meta interface{}, securityGroupID, operation string) diag.Diagnostics {
+	sess, err := vpcClient(meta)
+	if err != nil {
+		tfErr := flex.DiscriminatedTerraformErrorf(err, err.Error(), "ibm_is_security_group_rules", operation, "initialize-client")
+		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+		return tfErr.GetDiag()
+	}
+	desired, err := expandSecurityGroupRuleSpecs(securityGroupRulesConfig(d))
+	if err != nil {
+		tfErr := flex.TerraformErrorf(err, err.Error(), "ibm_is_security_group_rules", operation)
+		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+		return tfErr.GetDiag()
+	}
+
+	// Shared with ibm_is_security_group_rule, which changes the same rules
+	isSecurityGroupRuleKey := "security_group_rule_key_" + securityGroupID
+	unlock, err := conns.IbmKeyedLock.Lock(context, isSecurityGroupRuleKey)
+	if err != nil {
+		tfErr := flex.DiscriminatedTerraformErrorf(err, fmt.Sprintf("Error waiting for the lock on %s: %s", isSecurityGroupRuleKey, err), "ibm_is_security_group_rules", operation, "wait-for-lock")
+		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+		return tfErr.GetDiag()
+	}
+	defer unlock()
+
+	_, actual, _, err := getSecurityGroupRuleSpecs(context, sess, securityGroupID)
+	if err != nil {
+		tfErr := flex.TerraformErrorf(err, fmt.Sprintf("GetSecurityGroupWithContext failed: %s", err.Error()), "ibm_is_security_group_rules", operation)
+		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+		return tfErr.GetDiag()
+	}
+	adds, removes := diffSecurityGroupRules(desired, actual)
+	log.Printf("[DEBUG] Security group %s: adding %d rules and removing %d rules", securityGroupID, len(adds), len(removes))
+
+	for _, rule := range adds {
+		_, _, err := sess.CreateSecurityGroupRuleWithContext(context, &vpcv1.CreateSecurityGroupRuleOptions{
+			SecurityGroupID:            &securityGroupID,
+			SecurityGroupRulePrototype: rule.prototype(),
+		})
+		if err != nil {
+			tfErr := flex.TerraformErrorf(err, fmt.Sprintf("CreateSecurityGroupRuleWithContext failed for rule %s: %s", rule, err.Error()), "ibm_is_security_group_rules", operation)
+			log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+			return tfErr.GetDiag()
+		}
+	}
+	for _, id := range removes {
+		ruleID := id
+		response, err := sess.DeleteSecurityGroupRuleWithContext(context, &vpcv1.DeleteSecurityGroupRuleOptions{
+			SecurityGroupID: &securityGroupID,
+			ID:              &ruleID,
+		})
+		if err != nil && (response == nil || response.StatusCode != 404) {
+			tfErr := flex.TerraformErrorf(err, fmt.Sprintf("DeleteSecurityGroupRuleWithContext failed for rule %s: %s", actual[id], err.Error()), "ibm_is_security_group_rules", operation)
+			log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+			return tfErr.GetDiag()
+		}
+	}
+	return nil
+}
+
+func resourceIBMISSecurityGroupRulesCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	securityGroupID := d.Get(isSecurityGroupID).(string)
+	if diags := applySecurityGroupRules(context, d, meta, securityGroupID, "create"); diags != nil {
+		return diags
+	}
+	d.SetId(securityGroupID)
+	return resourceIBMISSecurityGroupRulesRead(context, d, meta)
+}
+
+func resourceIBMISSecurityGroupRulesRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	sess, err := vpcClient(meta)
+	if err != nil {
+		tfErr := flex.DiscriminatedTerraformErrorf(err, err.Error(), "ibm_is_security_group_rules", "read", "initialize-client")
+		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+		return tfErr.GetDiag()
+	}
+	securityGroupID := d.Id()
+	// Reads wait for the rules being changed, but not for each other
+	isSecurityGroupRuleKey := "security_group_rule_key_" + securityGroupID
+	unlock, err := conns.IbmKeyedLock.RLock(context, isSecurityGroupRuleKey)
+	if err != nil {
+		tfErr := flex.DiscriminatedTerraformErrorf(err, fmt.Sprintf("Error waiting for the lock on %s: %s", isSecurityGroupRuleKey, err), "ibm_is_security_group_rules", "read", "wait-for-lock")
+		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+		return tfErr.GetDiag()
+	}
+	defer unlock()
+	group, actual, response, err := getSecurityGroupRuleSpecs(context, sess, securityGroupID)
+	if err != nil {
+		if response != nil && response.StatusCode == 404 {
+			d.SetId("")
+			return nil
+		}
+		tfErr := flex.TerraformErrorf(err, fmt.Sprintf("GetSecurityGroupWithContext failed: %s", err.Error()), "ibm_is_security_group_rules", "read")
+		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+		return tfErr.GetDiag()
+	}
+
+	// Keep the configured form of the rules that match, so that equivalent rules
+	// such as a tcp rule without ports and a tcp rule for ports 1-65535 do not show
+	// a difference. The other rules of the security group are reported as they are.
+	configured := make(map[string]interface{})
+	if rules, ok := d.Get(isSecurityGroupRules).(*schema.Set); ok {
+		for _, rule := range rules.List() {
+			if spec, err := expandSecurityGroupRuleSpec(rule.(map[string]interface{})); err == nil {
+				configured[spec.stateKey()] = rule
+			}
+		}
+	}
+	ids := make([]string, 0, len(actual))
+	for id := range actual {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+	rules := make([]interface{}, 0, len(actual))
+	for _, id := range ids {
+		spec := actual[id]
+		if rule, ok := configured[spec.stateKey()]; ok {
+			rules = append(rules, rule)
+			continue
+		}
+		log.Printf("[DEBUG] Security group %s has rule %s (%s) that is not in the rules set", securityGroupID, id, spec)
+		rules = append(rules, spec.flatten())
+	}
+
+	if err = d.Set(isSecurityGroupID, securityGroupID); err != nil {
+		err = fmt.Errorf("Error setting group: %s", err)
+		return flex.DiscriminatedTerraformErrorf(err, err.Error(), "ibm_is_security_group_rules", "read", "set-group").GetDiag()
+	}
+	if err = d.Set(isSecurityGroupRules, rules); err != nil {
+		err = fmt.Errorf("Error setting rules: %s", err)
+		return flex.DiscriminatedTerraformErrorf(err, err.Error(), "ibm_is_security_group_rules", "read", "set-rules").GetDiag()
+	}
+	if err = d.Set(flex.RelatedCRN, group.CRN); err != nil {
+		err = fmt.Errorf("Error setting related_crn: %s", err)
+		return flex.DiscriminatedTerraformErrorf(err, err.Error(), "ibm_is_security_group_rules", "read", "set-related_crn").GetDiag()
+	}
+	return nil
+}
+
+func resourceIBMISSecurityGroupRulesUpdate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if d.HasChange(isSecurityGroupRules) {
+		if diags := applySecurityGroupRules(context, d, meta, d.Id(), "update"); diags != nil {
+			return diags
+		}
+	}
+	return resourceIBMISSecurityGroupRulesRead(context, d, meta)
+}
+
+// resourceIBMISSecurityGroupRulesDelete removes the rules of the rules set. The
+// rules that were added outside of Terraform after the last refresh are kept.
+func resourceIBMISSecurityGroupRulesDelete(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	sess, err := vpcClient(meta)
+	if err != nil {
+		tfErr := flex.DiscriminatedTerraformErrorf(err, err.Error(), "ibm_is_security_group_rules", "delete", "initialize-client")
+		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+		return tfErr.GetDiag()
+	}
+	securityGroupID := d.Id()
+	managed, err := expandSecurityGroupRuleSpecs(d.Get(isSecurityGroupRules).(*schema.Set).List())
+	if err != nil {
+		tfErr := flex.TerraformErrorf(err, err.Error(), "ibm_is_security_group_rules", "delete")
+		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+		return tfErr.GetDiag()
+	}
+	// The state does not tell an icmp type or code of 0 from one that is not set,
+	// so the rules are matched as they are kept in the state
+	keys := make(map[string]bool, len(managed))
+	for _, rule := range managed {
+		keys[rule.stateKey()] = true
+	}
+
+	isSecurityGroupRuleKey := "security_group_rule_key_" + securityGroupID
+	unlock, err := conns.IbmKeyedLock.Lock(context, isSecurityGroupRuleKey)
+	if err != nil {
+		tfErr := flex.DiscriminatedTerraformErrorf(err, fmt.Sprintf("Error waiting for the lock on %s: %s", isSecurityGroupRuleKey, err), "ibm_is_security_group_rules", "delete", "wait-for-lock")
+		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+		return tfErr.GetDiag()
+	}
+	defer unlock()
+
+	_, actual, response, err := getSecurityGroupRuleSpecs(context, sess, securityGroupID)
+	if err != nil {
+		if response != nil && response.StatusCode == 404 {
+			d.SetId("")
+			return nil
+		}
+		tfErr := flex.TerraformErrorf(err, fmt.Sprintf("GetSecurityGroupWithContext failed: %s", err.Error()), "ibm_is_security_group_rules", "delete")
+		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+		return tfErr.GetDiag()
+	}
+	for id, spec := range actual {
+		if !keys[spec.stateKey()] {
+			continue
+		}
+		ruleID := id
+		response, err := sess.DeleteSecurityGroupRuleWithContext(context, &vpcv1.DeleteSecurityGroupRuleOptions{
+			SecurityGroupID: &securityGroupID,
+			ID:              &ruleID,
+		})
+		if err != nil && (response == nil || response.StatusCode != 404) {
+			tfErr := flex.TerraformErrorf(err, fmt.Sprintf("DeleteSecurityGroupRuleWithContext failed for rule %s: %s", spec, err.Error()), "ibm_is_security_group_rules", "delete")
+			log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+			return tfErr.GetDiag()
+		}
+	}
+	d.SetId("")
+	return nil
+}
+
+// resourceIBMISSecurityGroupRulesImport imports the rules of a security group by
+// its ID, all the rules of the group are then managed by the resource.
+func resourceIBMISSecurityGroupRulesImport(context context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	d.Set(isSecurityGroupID, d.Id())
+	return []*schema.ResourceData{d}, nil
+}
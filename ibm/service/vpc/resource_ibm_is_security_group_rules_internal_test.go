@@ -0,0 +1,206 @@
+// Copyright IBM Corp. 2025 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package vpc
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/go-cty/cty"
+)
+
+func testSecurityGroupRuleSpec(t *testing.T, rule map[string]interface{}) *securityGroupRuleSpec {
+	t.Helper()
+	spec, err := expandSecurityGroupRuleSpec(rule)
+	if err != nil {
+		t.Fatalf("expandSecurityGroupRuleSpec(%v) failed: %s", rule, err)
+	}
+	return spec
+}
+
+func TestExpandSecurityGroupRuleSpec(t *testing.T) {
+	tests := []struct {
+		name    string
+		rule    map[string]interface{}
+		want    string
+		wantErr bool
+	}{
+		{
+			name: "all protocols",
+			rule: map[string]interface{}{"direction": "inbound"},
+			want: "inbound|ipv4|all|0.0.0.0/0|0.0.0.0/0|any|any|0|0",
+		},
+		{
+			name: "icmp without type or code",
+			rule: map[string]interface{}{"direction": "inbound", "icmp": []interface{}{map[string]interface{}{}}},
+			want: "inbound|ipv4|icmp|0.0.0.0/0|0.0.0.0/0|any|any|0|0",
+		},
+		{
+			name: "icmp type 0",
+			rule: map[string]interface{}{"direction": "inbound", "icmp": []interface{}{map[string]interface{}{"type": 0}}},
+			want: "inbound|ipv4|icmp|0.0.0.0/0|0.0.0.0/0|0|any|0|0",
+		},
+		{
+			name: "icmp type and code",
+			rule: map[string]interface{}{"direction": "inbound", "icmp": []interface{}{map[string]interface{}{"type": 3, "code": 0}}},
+			want: "inbound|ipv4|icmp|0.0.0.0/0|0.0.0.0/0|3|0|0|0",
+		},
+		{
+			name:    "icmp code without type",
+			rule:    map[string]interface{}{"direction": "inbound", "icmp": []interface{}{map[string]interface{}{"code": 1}}},
+			wantErr: true,
+		},
+		{
+			name: "tcp without ports",
+			rule: map[string]interface{}{"direction": "outbound", "remote": "10.0.0.0/8", "tcp": []interface{}{nil}},
+			want: "outbound|ipv4|tcp|10.0.0.0/8|0.0.0.0/0|any|any|1|65535",
+		},
+		{
+			name:    "udp port_min greater than port_max",
+			rule:    map[string]interface{}{"direction": "inbound", "udp": []interface{}{map[string]interface{}{"port_min": 90, "port_max": 80}}},
+			wantErr: true,
+		},
+		{
+			name: "icmp and tcp",
+			rule: map[string]interface{}{
+				"direction": "inbound",
+				"icmp":      []interface{}{map[string]interface{}{}},
+				"tcp":       []interface{}{map[string]interface{}{"port_min": 22, "port_max": 22}},
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			spec, err := expandSecurityGroupRuleSpec(tt.rule)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatalf("expandSecurityGroupRuleSpec() = %s, want an error", spec.key())
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("expandSecurityGroupRuleSpec() failed: %s", err)
+			}
+			if got := spec.key(); got != tt.want {
+				t.Errorf("expandSecurityGroupRuleSpec() key = %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDiffSecurityGroupRules(t *testing.T) {
+	ssh := map[string]interface{}{"direction": "inbound", "tcp": []interface{}{map[string]interface{}{"port_min": 22, "port_max": 22}}}
+	https := map[string]interface{}{"direction": "inbound", "tcp": []interface{}{map[string]interface{}{"port_min": 443, "port_max": 443}}}
+	outbound := map[string]interface{}{"direction": "outbound"}
+	icmpAny := map[string]interface{}{"direction": "inbound", "icmp": []interface{}{map[string]interface{}{}}}
+	icmpZero := map[string]interface{}{"direction": "inbound", "icmp": []interface{}{map[string]interface{}{"type": 0}}}
+
+	tests := []struct {
+		name        string
+		desired     []map[string]interface{}
+		actual      map[string]map[string]interface{}
+		wantAdds    []map[string]interface{}
+		wantRemoves []string
+	}{
+		{
+			name:    "no changes",
+			desired: []map[string]interface{}{ssh, outbound},
+			actual:  map[string]map[string]interface{}{"r1": ssh, "r2": outbound},
+		},
+		{
+			name:     "empty security group",
+			desired:  []map[string]interface{}{ssh, outbound},
+			wantAdds: []map[string]interface{}{ssh, outbound},
+		},
+		{
+			name:        "no rules",
+			actual:      map[string]map[string]interface{}{"r1": ssh, "r2": outbound},
+			wantRemoves: []string{"r1", "r2"},
+		},
+		{
+			name:        "replaced rule",
+			desired:     []map[string]interface{}{https, outbound},
+			actual:      map[string]map[string]interface{}{"r1": ssh, "r2": outbound},
+			wantAdds:    []map[string]interface{}{https},
+			wantRemoves: []string{"r1"},
+		},
+		{
+			name:        "duplicate rules of the security group",
+			desired:     []map[string]interface{}{ssh},
+			actual:      map[string]map[string]interface{}{"r3": ssh, "r1": ssh, "r2": ssh},
+			wantRemoves: []string{"r2", "r3"},
+		},
+		{
+			name:     "duplicate rules of the configuration",
+			desired:  []map[string]interface{}{ssh, ssh},
+			wantAdds: []map[string]interface{}{ssh},
+		},
+		{
+			name:        "icmp type 0 is not any type",
+			desired:     []map[string]interface{}{icmpAny},
+			actual:      map[string]map[string]interface{}{"r1": icmpZero},
+			wantAdds:    []map[string]interface{}{icmpAny},
+			wantRemoves: []string{"r1"},
+		},
+		{
+			name:    "icmp any type",
+			desired: []map[string]interface{}{icmpAny},
+			actual:  map[string]map[string]interface{}{"r1": icmpAny},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			desired := make([]*securityGroupRuleSpec, 0, len(tt.desired))
+			for _, rule := range tt.desired {
+				desired = append(desired, testSecurityGroupRuleSpec(t, rule))
+			}
+			actual := make(map[string]*securityGroupRuleSpec, len(tt.actual))
+			for id, rule := range tt.actual {
+				actual[id] = testSecurityGroupRuleSpec(t, rule)
+			}
+			adds, removes := diffSecurityGroupRules(desired, actual)
+
+			var gotAdds, wantAdds []string
+			for _, rule := range adds {
+				gotAdds = append(gotAdds, rule.key())
+			}
+			for _, rule := range tt.wantAdds {
+				wantAdds = append(wantAdds, testSecurityGroupRuleSpec(t, rule).key())
+			}
+			if !reflect.DeepEqual(gotAdds, wantAdds) {
+				t.Errorf("diffSecurityGroupRules() adds = %v, want %v", gotAdds, wantAdds)
+			}
+			if !reflect.DeepEqual(removes, tt.wantRemoves) {
+				t.Errorf("diffSecurityGroupRules() removes = %v, want %v", removes, tt.wantRemoves)
+			}
+		})
+	}
+}
+
+func TestSecurityGroupRuleConfigValue(t *testing.T) {
+	icmp := cty.Object(map[string]cty.Type{"type": cty.Number, "code": cty.Number})
+	ports := cty.Object(map[string]cty.Type{"port_min": cty.Number, "port_max": cty.Number})
+	rule := cty.ObjectVal(map[string]cty.Value{
+		"direction":  cty.StringVal("inbound"),
+		"ip_version": cty.NullVal(cty.String),
+		"icmp": cty.ListVal([]cty.Value{cty.ObjectVal(map[string]cty.Value{
+			"type": cty.NumberIntVal(0),
+			"code": cty.NullVal(cty.Number),
+		})}),
+		"tcp": cty.ListValEmpty(ports),
+	})
+	want := []interface{}{map[string]interface{}{
+		"direction": "inbound",
+		"icmp":      []interface{}{map[string]interface{}{"type": 0}},
+		"tcp":       []interface{}{},
+	}}
+	got := securityGroupRuleConfigValue(cty.SetVal([]cty.Value{rule}))
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("securityGroupRuleConfigValue() = %#v, want %#v", got, want)
+	}
+	if got := securityGroupRuleConfigValue(cty.NullVal(cty.List(icmp))); got != nil {
+		t.Errorf("securityGroupRuleConfigValue() of null = %#v, want nil", got)
+	}
+}
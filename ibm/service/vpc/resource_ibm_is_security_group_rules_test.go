@@ -0,0 +1,131 @@
+// Copyright IBM Corp. 2025 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package vpc_test
+
+import (
+	"fmt"
+	"testing"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+
+	"github.com/IBM/vpc-go-sdk/vpcv1"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccIBMISSecurityGroupRules_basic(t *testing.T) {
+	vpcname := fmt.Sprintf("tfsgrules-vpc-%d", acctest.RandIntRange(10, 100))
+	name := fmt.Sprintf("tfsgrules-sg-%d", acctest.RandIntRange(10, 100))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acc.TestAccPreCheck(t) },
+		Providers:    acc.TestAccProviders,
+		CheckDestroy: testAccCheckIBMISSecurityGroupRulesDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMISSecurityGroupRulesConfig(vpcname, name, "22"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIBMISSecurityGroupRulesCount("ibm_is_security_group_rules.testacc_security_group_rules", 3),
+					resource.TestCheckResourceAttr(
+						"ibm_is_security_group_rules.testacc_security_group_rules", "rules.#", "3"),
+					resource.TestCheckResourceAttrSet(
+						"ibm_is_security_group_rules.testacc_security_group_rules", "related_crn"),
+				),
+			},
+			{
+				Config: testAccCheckIBMISSecurityGroupRulesConfig(vpcname, name, "2222"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIBMISSecurityGroupRulesCount("ibm_is_security_group_rules.testacc_security_group_rules", 3),
+					resource.TestCheckTypeSetElemNestedAttrs(
+						"ibm_is_security_group_rules.testacc_security_group_rules", "rules.*", map[string]string{
+							"direction":      "inbound",
+							"tcp.0.port_min": "2222",
+							"tcp.0.port_max": "2222",
+						}),
+				),
+			},
+			{
+				ResourceName:      "ibm_is_security_group_rules.testacc_security_group_rules",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckIBMISSecurityGroupRulesDestroy(s *terraform.State) error {
+	sess, _ := acc.TestAccProvider.Meta().(conns.ClientSession).VpcV1API()
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "ibm_is_security_group_rules" {
+			continue
+		}
+
+		listSecurityGroupRulesOptions := &vpcv1.ListSecurityGroupRulesOptions{
+			SecurityGroupID: &rs.Primary.ID,
+		}
+		rules, _, err := sess.ListSecurityGroupRules(listSecurityGroupRulesOptions)
+		if err == nil && len(rules.Rules) != 0 {
+			return fmt.Errorf("security group %s still has %d rules", rs.Primary.ID, len(rules.Rules))
+		}
+	}
+	return nil
+}
+
+func testAccCheckIBMISSecurityGroupRulesCount(n string, count int) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		sess, _ := acc.TestAccProvider.Meta().(conns.ClientSession).VpcV1API()
+		listSecurityGroupRulesOptions := &vpcv1.ListSecurityGroupRulesOptions{
+			SecurityGroupID: &rs.Primary.ID,
+		}
+		rules, _, err := sess.ListSecurityGroupRules(listSecurityGroupRulesOptions)
+		if err != nil {
+			return err
+		}
+		if len(rules.Rules) != count {
+			return fmt.Errorf("security group %s has %d rules, expected %d", rs.Primary.ID, len(rules.Rules), count)
+		}
+		return nil
+	}
+}
+
+func testAccCheckIBMISSecurityGroupRulesConfig(vpcname, name, port string) string {
+	return fmt.Sprintf(`
+	resource "ibm_is_vpc" "testacc_vpc" {
+		name = "%s"
+	}
+
+	resource "ibm_is_security_group" "testacc_security_group" {
+		name = "%s"
+		vpc  = ibm_is_vpc.testacc_vpc.id
+	}
+
+	resource "ibm_is_security_group_rules" "testacc_security_group_rules" {
+		group = ibm_is_security_group.testacc_security_group.id
+		rules {
+			direction = "outbound"
+		}
+		rules {
+			direction = "inbound"
+			remote    = "10.240.0.0/24"
+			icmp {
+				type = 8
+			}
+		}
+		rules {
+			direction = "inbound"
+			remote    = "10.240.0.0/24"
+			tcp {
+				port_min = %s
+				port_max = %s
+			}
+		}
+	}`, vpcname, name, port, port)
+}
@@ -4,6 +4,7 @@
 package vpc
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"strings"
@@ -112,8 +113,13 @@ func resourceIBMISSecurityGroupTargetCreate(d *schema.ResourceData, meta interfa
 	createSecurityGroupTargetBindingOptions.SecurityGroupID = &securityGroupID
 	createSecurityGroupTargetBindingOptions.ID = &targetID
 	isSGTargetPrefixKey := "security_group_key_" + targetID
-	conns.IbmMutexKV.Lock(isSGTargetPrefixKey)
-	defer conns.IbmMutexKV.Unlock(isSGTargetPrefixKey)
+	ctx, cancel := context.WithTimeout(context.Background(), d.Timeout(schema.TimeoutCreate))
+	defer cancel()
+	unlock, err := conns.IbmKeyedLock.Lock(ctx, isSGTargetPrefixKey)
+	if err != nil {
+		return fmt.Errorf("[ERROR] Error waiting for the lock on %s: %s", isSGTargetPrefixKey, err)
+	}
+	defer unlock()
 
 	sg, response, err := sess.CreateSecurityGroupTargetBinding(createSecurityGroupTargetBindingOptions)
 	if err != nil || sg == nil {
@@ -228,8 +234,13 @@ func resourceIBMISSecurityGroupTargetDelete(d *schema.ResourceData, meta interfa
 	}
 	// Acquire a lock based on the target ID to prevent simultaneous delete on same target
 	isSGTargetPrefixKey := "security_group_key_" + securityGroupTargetID
-	conns.IbmMutexKV.Lock(isSGTargetPrefixKey)
-	defer conns.IbmMutexKV.Unlock(isSGTargetPrefixKey)
+	ctx, cancel := context.WithTimeout(context.Background(), d.Timeout(schema.TimeoutDelete))
+	defer cancel()
+	unlock, err := conns.IbmKeyedLock.Lock(ctx, isSGTargetPrefixKey)
+	if err != nil {
+		return fmt.Errorf("[ERROR] Error waiting for the lock on %s: %s", isSGTargetPrefixKey, err)
+	}
+	defer unlock()
 
 	deleteSecurityGroupTargetBindingOptions := sess.NewDeleteSecurityGroupTargetBindingOptions(securityGroupID, securityGroupTargetID)
 	response, err = sess.DeleteSecurityGroupTargetBinding(deleteSecurityGroupTargetBindingOptions)
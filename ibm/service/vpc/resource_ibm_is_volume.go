@@ -14,6 +14,7 @@ import (
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
 	"github.com/IBM/vpc-go-sdk/vpcv1"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -51,6 +52,8 @@ const (
 	isVolumeHealthReasonsMessage  = "message"
 	isVolumeHealthReasonsMoreInfo = "more_info"
 	isVolumeHealthState           = "health_state"
+	isVolumeReplaceUsingSnapshot  = "replace_using_snapshot"
+	isVolumeReplacementSnapshot   = "replacement_snapshot"
 
 	isVolumeCatalogOffering           = "catalog_offering"
 	isVolumeCatalogOfferingPlanCrn    = "plan_crn"
@@ -59,22 +62,27 @@ const (
 
 func ResourceIBMISVolume() *schema.Resource {
 	return &schema.Resource{
-		Create:   resourceIBMISVolumeCreate,
-		Read:     resourceIBMISVolumeRead,
-		Update:   resourceIBMISVolumeUpdate,
-		Delete:   resourceIBMISVolumeDelete,
-		Exists:   resourceIBMISVolumeExists,
-		Importer: &schema.ResourceImporter{},
+		Create:        resourceIBMISVolumeCreate,
+		Read:          resourceIBMISVolumeRead,
+		UpdateContext: resourceIBMISVolumeUpdate,
+		Delete:        resourceIBMISVolumeDelete,
+		Exists:        resourceIBMISVolumeExists,
+		Importer:      &schema.ResourceImporter{},
 
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
 			Delete: schema.DefaultTimeout(10 * time.Minute),
 		},
 
 		CustomizeDiff: customdiff.All(
 			customdiff.Sequence(
 				func(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
-					return flex.ResourceTagsCustomizeDiff(diff)
+					return resourceIBMISVolumeReplacementCustomizeDiff(diff)
+				}),
+			customdiff.Sequence(
+				func(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
+					return flex.ResourceTagsCustomizeDiff(diff, v)
 				},
 			),
 			customdiff.Sequence(
@@ -112,14 +120,12 @@ func ResourceIBMISVolume() *schema.Resource {
 			isVolumeZone: {
 				Type:        schema.TypeString,
 				Required:    true,
-				ForceNew:    true,
 				Description: "Zone name",
 			},
 
 			isVolumeEncryptionKey: {
 				Type:        schema.TypeString,
 				Optional:    true,
-				ForceNew:    true,
 				Description: "Volume encryption key info",
 			},
 
@@ -140,7 +146,6 @@ func ResourceIBMISVolume() *schema.Resource {
 			isVolumeSourceSnapshot: {
 				Type:          schema.TypeString,
 				Optional:      true,
-				ForceNew:      true,
 				Computed:      true,
 				ConflictsWith: []string{isVolumeSourceSnapshotCrn},
 				ValidateFunc:  validate.InvokeValidator("ibm_is_volume", isVolumeSourceSnapshot),
@@ -149,7 +154,6 @@ func ResourceIBMISVolume() *schema.Resource {
 			isVolumeSourceSnapshotCrn: {
 				Type:          schema.TypeString,
 				Optional:      true,
-				ForceNew:      true,
 				Computed:      true,
 				ConflictsWith: []string{isVolumeSourceSnapshot},
 				Description:   "The crn for this snapshot",
@@ -158,7 +162,6 @@ func ResourceIBMISVolume() *schema.Resource {
 				Type:        schema.TypeString,
 				Optional:    true,
 				Computed:    true,
-				ForceNew:    true,
 				Description: "Resource group name",
 			},
 			isVolumeIops: {
@@ -286,6 +289,17 @@ func ResourceIBMISVolume() *schema.Resource {
 				Optional:    true,
 				Description: "Deletes all snapshots created from this volume",
 			},
+			isVolumeReplaceUsingSnapshot: {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Replace the volume with a volume restored from a snapshot of it, instead of deleting and creating it again, when an attribute that cannot be updated in place is changed",
+			},
+			isVolumeReplacementSnapshot: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The unique identifier of the snapshot of the previous volume that this volume was restored from when it was last replaced",
+			},
 			isVolumeTags: {
 				Type:        schema.TypeSet,
 				Optional:    true,
@@ -620,7 +634,8 @@ func volGet(d *schema.ResourceData, meta interface{}, id string) error {
 	d.Set(isVolumeIops, *vol.Iops)
 	d.Set(isVolumeCapacity, *vol.Capacity)
 	d.Set(isVolumeCrn, *vol.CRN)
-	if vol.SourceSnapshot != nil {
+	// a volume restored from a replacement snapshot keeps the source snapshot it was configured with
+	if vol.SourceSnapshot != nil && *vol.SourceSnapshot.ID != d.Get(isVolumeReplacementSnapshot).(string) {
 		d.Set(isVolumeSourceSnapshot, *vol.SourceSnapshot.ID)
 		d.Set(isVolumeSourceSnapshotCrn, *vol.SourceSnapshot.CRN)
 	}
@@ -729,7 +744,27 @@ func volGet(d *schema.ResourceData, meta interface{}, id string) error {
 	return nil
 }
 
-func resourceIBMISVolumeUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceIBMISVolumeUpdate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+
+	if d.Get(isVolumeReplaceUsingSnapshot).(bool) {
+		id := d.Id()
+		replaced, err := volReplaceUsingSnapshot(d, meta)
+		var diags diag.Diagnostics
+		if replaced {
+			// the plan could not show the new id, so the change of the identity of the volume is reported
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Warning,
+				Summary:  fmt.Sprintf("Volume %s was replaced by volume %s", id, d.Id()),
+				Detail:   "The volume was replaced using a snapshot. Resources that reference the id of the volume are updated on the next apply.",
+			})
+		}
+		if err != nil {
+			return append(diags, diag.FromErr(err)...)
+		}
+		if replaced {
+			return append(diags, diag.FromErr(resourceIBMISVolumeRead(d, meta))...)
+		}
+	}
 
 	id := d.Id()
 	name := ""
@@ -747,9 +782,9 @@ func resourceIBMISVolumeUpdate(d *schema.ResourceData, meta interface{}) error {
 
 	err := volUpdate(d, meta, id, name, hasNameChanged, delete)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
-	return resourceIBMISVolumeRead(d, meta)
+	return diag.FromErr(resourceIBMISVolumeRead(d, meta))
 }
 
 func volUpdate(d *schema.ResourceData, meta interface{}, id, name string, hasNameChanged, delete bool) error {
@@ -1093,6 +1128,7 @@ func volDelete(d *schema.ResourceData, meta interface{}, id string) error {
 	if err != nil {
 		return err
 	}
+	volDeleteReplacementSnapshot(d, sess, d.Get(isVolumeReplacementSnapshot).(string))
 	d.SetId("")
 	return nil
 }
@@ -1196,3 +1232,384 @@ func deleteAllSnapshots(sess *vpcv1.VpcV1, id string) error {
 	}
 	return nil
 }
+
+// volReplacementAttributes are the volume attributes that cannot be updated in place. A change to any of them
+// replaces the volume, either by deleting and creating it again or, with replace_using_snapshot, from a snapshot.
+var volReplacementAttributes = []string{isVolumeZone, isVolumeEncryptionKey, isVolumeResourceGroup, isVolumeSourceSnapshot, isVolumeSourceSnapshotCrn}
+
+func resourceIBMISVolumeReplacementCustomizeDiff(diff *schema.ResourceDiff) error {
+	if diff.Id() == "" {
+		return nil
+	}
+	replaceUsingSnapshot := diff.Get(isVolumeReplaceUsingSnapshot).(bool)
+	replaced := false
+	for _, attr := range volReplacementAttributes {
+		if !diff.HasChange(attr) {
+			continue
+		}
+		if !replaceUsingSnapshot {
+			if err := diff.ForceNew(attr); err != nil {
+				return err
+			}
+			continue
+		}
+		replaced = true
+	}
+	if !replaced {
+		return nil
+	}
+	if protected, _ := diff.GetChange(flex.DeletionProtection); protected == true {
+		return fmt.Errorf("[ERROR] Volume (%s) can't be replaced using a snapshot while deletion_protection is enabled, set it to false and apply before the volume is replaced", diff.Id())
+	}
+	for _, attr := range []string{isVolumeCrn, flex.ResourceCRN, isVolumeStatus, isVolumeReplacementSnapshot} {
+		if err := diff.SetNewComputed(attr); err != nil {
+			return err
+		}
+	}
+	if diff.HasChange(isVolumeSourceSnapshot) && !diff.HasChange(isVolumeSourceSnapshotCrn) {
+		return diff.SetNewComputed(isVolumeSourceSnapshotCrn)
+	}
+	if diff.HasChange(isVolumeSourceSnapshotCrn) && !diff.HasChange(isVolumeSourceSnapshot) {
+		return diff.SetNewComputed(isVolumeSourceSnapshot)
+	}
+	return nil
+}
+
+// volReplacementRequired reports whether the changes to the volume can only be applied by replacing it. Profile
+// changes, and capacity and iops changes outside the sdp profile, are only supported for attached volumes.
+func volReplacementRequired(d *schema.ResourceData, vol *vpcv1.Volume) bool {
+	for _, attr := range volReplacementAttributes {
+		if d.HasChange(attr) {
+			return true
+		}
+	}
+	if len(vol.VolumeAttachments) > 0 {
+		return false
+	}
+	return d.HasChange(isVolumeProfileName) || (*vol.Profile.Name != "sdp" && (d.HasChange(isVolumeIops) || d.HasChange(isVolumeCapacity)))
+}
+
+// volReplacementName returns a name derived from name that is unique enough to be used while the volume is replaced.
+func volReplacementName(name string) string {
+	suffix := fmt.Sprintf("-%d", time.Now().Unix())
+	if len(name)+len(suffix) > 63 {
+		name = strings.TrimRight(name[:63-len(suffix)], "-")
+	}
+	return name + suffix
+}
+
+// volReplaceUsingSnapshot replaces the volume when the changes cannot be applied in place. The replacement volume
+// is restored from a snapshot of the volume, or from the new source snapshot when it changed, and is attached to
+// the instances of the volume in its place. The volume is deleted only after all the attachments are replaced.
+func volReplaceUsingSnapshot(d *schema.ResourceData, meta interface{}) (bool, error) {
+	sess, err := vpcClient(meta)
+	if err != nil {
+		return false, err
+	}
+	id := d.Id()
+	vol, response, err := sess.GetVolume(&vpcv1.GetVolumeOptions{
+		ID: &id,
+	})
+	if err != nil {
+		return false, fmt.Errorf("[ERROR] Error getting Volume (%s): %s\n%s", id, err, response)
+	}
+	if !volReplacementRequired(d, vol) {
+		return false, nil
+	}
+	// the volume is deleted by the replacement, which deletion_protection in state does not allow
+	if protected, _ := d.GetChange(flex.DeletionProtection); protected == true {
+		return false, fmt.Errorf("[ERROR] Error replacing Volume (%s): deletion_protection is enabled, set it to false and apply before the volume is replaced", id)
+	}
+	zone := d.Get(isVolumeZone).(string)
+	if len(vol.VolumeAttachments) > 0 && zone != *vol.Zone.Name {
+		return false, fmt.Errorf("[ERROR] Error replacing Volume (%s): the volume is attached to instance %s in zone %s and can't be moved to zone %s", id, *vol.VolumeAttachments[0].Instance.ID, *vol.Zone.Name, zone)
+	}
+	// the snapshot is only consistent with the data of the volume when nothing writes to it
+	for _, volAtt := range vol.VolumeAttachments {
+		instanceID := *volAtt.Instance.ID
+		instance, response, err := sess.GetInstance(&vpcv1.GetInstanceOptions{
+			ID: &instanceID,
+		})
+		if err != nil {
+			return false, fmt.Errorf("[ERROR] Error getting instance %s of Volume (%s): %s\n%s", instanceID, id, err, response)
+		}
+		if *instance.Status != isInstanceActionStatusStopped {
+			return false, fmt.Errorf("[ERROR] Error replacing Volume (%s): the volume is attached to instance %s, which is %s, stop the instance before the volume is replaced", id, instanceID, *instance.Status)
+		}
+	}
+
+	replacementSnapshot := ""
+	var sourceSnapshot vpcv1.SnapshotIdentityIntf
+	if snapshot := d.Get(isVolumeSourceSnapshot).(string); d.HasChange(isVolumeSourceSnapshot) && snapshot != "" {
+		sourceSnapshot = &vpcv1.SnapshotIdentity{
+			ID: &snapshot,
+		}
+	} else if snapshotCrn := d.Get(isVolumeSourceSnapshotCrn).(string); d.HasChange(isVolumeSourceSnapshotCrn) && snapshotCrn != "" {
+		sourceSnapshot = &vpcv1.SnapshotIdentity{
+			CRN: &snapshotCrn,
+		}
+	} else {
+		snapshotName := volReplacementName(*vol.Name)
+		snapshotPrototype := &vpcv1.SnapshotPrototypeSnapshotBySourceVolume{
+			Name: &snapshotName,
+			SourceVolume: &vpcv1.VolumeIdentity{
+				ID: &id,
+			},
+		}
+		if rg, ok := d.GetOk(isVolumeResourceGroup); ok {
+			rgID := rg.(string)
+			snapshotPrototype.ResourceGroup = &vpcv1.ResourceGroupIdentity{
+				ID: &rgID,
+			}
+		}
+		snapshot, response, err := sess.CreateSnapshot(&vpcv1.CreateSnapshotOptions{
+			SnapshotPrototype: snapshotPrototype,
+		})
+		if err != nil {
+			return false, fmt.Errorf("[ERROR] Error creating Snapshot of Volume (%s) for its replacement: %s\n%s", id, err, response)
+		}
+		log.Printf("[INFO] Snapshot %s of Volume %s created for its replacement", *snapshot.ID, id)
+		_, err = isWaitForSnapshotAvailable(sess, *snapshot.ID, d.Timeout(schema.TimeoutUpdate))
+		if err != nil {
+			return false, err
+		}
+		replacementSnapshot = *snapshot.ID
+		sourceSnapshot = &vpcv1.SnapshotIdentity{
+			ID: snapshot.ID,
+		}
+	}
+
+	newVol, err := volCreateReplacement(d, meta, sess, sourceSnapshot)
+	if err != nil {
+		volDeleteReplacementSnapshot(d, sess, replacementSnapshot)
+		return false, err
+	}
+	newID := *newVol.ID
+
+	swapped := make([]vpcv1.VolumeAttachmentReferenceVolumeContext, 0, len(vol.VolumeAttachments))
+	for _, volAtt := range vol.VolumeAttachments {
+		err = volSwapAttachment(d, sess, volAtt, newID)
+		if err != nil {
+			// put the volume back in place of the replacement volume, and remove the replacement volume
+			for _, swappedAtt := range swapped {
+				if rollbackErr := volRestoreAttachment(d, sess, swappedAtt, newID, id); rollbackErr != nil {
+					return false, fmt.Errorf("%s\n[ERROR] Error restoring attachment %s of Volume (%s) on instance %s, the replacement Volume (%s) is left in place: %s", err, *swappedAtt.Name, id, *swappedAtt.Instance.ID, newID, rollbackErr)
+				}
+			}
+			if _, rollbackErr := sess.DeleteVolume(&vpcv1.DeleteVolumeOptions{ID: &newID}); rollbackErr != nil {
+				log.Printf("[ERROR] Error deleting replacement Volume (%s) of Volume (%s): %s", newID, id, rollbackErr)
+			} else if _, rollbackErr = isWaitForVolumeDeleted(sess, newID, d.Timeout(schema.TimeoutUpdate)); rollbackErr == nil {
+				volDeleteReplacementSnapshot(d, sess, replacementSnapshot)
+			}
+			return false, err
+		}
+		swapped = append(swapped, volAtt)
+	}
+
+	// from here on the replacement volume is in use, so it is the volume managed by this resource. The snapshot
+	// of a previous replacement is no longer needed to find the attachments of the volume.
+	previousSnapshot, _ := d.GetChange(isVolumeReplacementSnapshot)
+	d.SetId(newID)
+	d.Set(isVolumeReplacementSnapshot, replacementSnapshot)
+	volDeleteReplacementSnapshot(d, sess, previousSnapshot.(string))
+
+	response, err = sess.DeleteVolume(&vpcv1.DeleteVolumeOptions{
+		ID: &id,
+	})
+	if err != nil {
+		return true, fmt.Errorf("[ERROR] Error deleting Volume (%s) after it was replaced by Volume (%s): %s\n%s", id, newID, err, response)
+	}
+	_, err = isWaitForVolumeDeleted(sess, id, d.Timeout(schema.TimeoutUpdate))
+	if err != nil {
+		return true, err
+	}
+
+	name := d.Get(isVolumeName).(string)
+	volumePatch, err := (&vpcv1.VolumePatch{Name: &name}).AsPatch()
+	if err != nil {
+		return true, fmt.Errorf("[ERROR] Error calling asPatch for volumeNamePatch: %s", err)
+	}
+	_, response, err = sess.UpdateVolume(&vpcv1.UpdateVolumeOptions{
+		ID:          &newID,
+		VolumePatch: volumePatch,
+	})
+	if err != nil {
+		return true, fmt.Errorf("[ERROR] Error renaming replacement Volume (%s) to %s: %s\n%s", newID, name, err, response)
+	}
+	_, err = isWaitForVolumeAvailable(sess, newID, d.Timeout(schema.TimeoutUpdate))
+	if err != nil {
+		return true, err
+	}
+	return true, nil
+}
+
+// volDeleteReplacementSnapshot deletes the snapshot that a volume was replaced from. A failure is only logged, the
+// snapshot is not part of the state of the volume once it is no longer recorded as its replacement_snapshot.
+func volDeleteReplacementSnapshot(d *schema.ResourceData, sess *vpcv1.VpcV1, snapshotID string) {
+	if snapshotID == "" {
+		return
+	}
+	response, err := sess.DeleteSnapshot(&vpcv1.DeleteSnapshotOptions{
+		ID: &snapshotID,
+	})
+	if err != nil {
+		if response == nil || response.StatusCode != 404 {
+			log.Printf("[WARN] Error deleting replacement Snapshot (%s) of Volume (%s), delete it manually: %s\n%s", snapshotID, d.Id(), err, response)
+		}
+		return
+	}
+	if _, err = isWaitForSnapshotDeleted(sess, snapshotID, d.Timeout(schema.TimeoutDelete)); err != nil {
+		log.Printf("[WARN] Error waiting for replacement Snapshot (%s) of Volume (%s) to be deleted: %s", snapshotID, d.Id(), err)
+	}
+}
+
+// volCreateReplacement creates the replacement volume from sourceSnapshot with the configured attributes. The
+// volume gets a temporary name, volume names are unique in a region.
+func volCreateReplacement(d *schema.ResourceData, meta interface{}, sess *vpcv1.VpcV1, sourceSnapshot vpcv1.SnapshotIdentityIntf) (*vpcv1.Volume, error) {
+	name := volReplacementName(d.Get(isVolumeName).(string))
+	zone := d.Get(isVolumeZone).(string)
+	profile := d.Get(isVolumeProfileName).(string)
+	volTemplate := &vpcv1.VolumePrototype{
+		Name: &name,
+		Zone: &vpcv1.ZoneIdentity{
+			Name: &zone,
+		},
+		Profile: &vpcv1.VolumeProfileIdentity{
+			Name: &profile,
+		},
+		SourceSnapshot: sourceSnapshot,
+	}
+	rawConfig := d.GetRawConfig()
+	if !rawConfig.GetAttr(isVolumeCapacity).IsNull() {
+		capacity := int64(d.Get(isVolumeCapacity).(int))
+		volTemplate.Capacity = &capacity
+	}
+	if !rawConfig.GetAttr(isVolumeIops).IsNull() {
+		iops := int64(d.Get(isVolumeIops).(int))
+		volTemplate.Iops = &iops
+	}
+	if !rawConfig.GetAttr(isVolumeBandwidth).IsNull() {
+		bandwidth := int64(d.Get(isVolumeBandwidth).(int))
+		volTemplate.Bandwidth = &bandwidth
+	}
+	if key, ok := d.GetOk(isVolumeEncryptionKey); ok {
+		encryptionKey := key.(string)
+		volTemplate.EncryptionKey = &vpcv1.EncryptionKeyIdentity{
+			CRN: &encryptionKey,
+		}
+	}
+	if rgrp, ok := d.GetOk(isVolumeResourceGroup); ok {
+		rg := rgrp.(string)
+		volTemplate.ResourceGroup = &vpcv1.ResourceGroupIdentity{
+			ID: &rg,
+		}
+	}
+	if v, ok := d.GetOk(isVolumeTags); ok {
+		userTags := flex.ExpandStringList(v.(*schema.Set).List())
+		if schematicTags := os.Getenv("IC_ENV_TAGS"); schematicTags != "" {
+			userTags = append(userTags, strings.Split(schematicTags, ",")...)
+		}
+		volTemplate.UserTags = userTags
+	}
+
+	vol, response, err := sess.CreateVolume(&vpcv1.CreateVolumeOptions{
+		VolumePrototype: volTemplate,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("[ERROR] Error creating replacement of Volume (%s): %s\n%s", d.Id(), err, response)
+	}
+	log.Printf("[INFO] Volume %s created to replace Volume %s", *vol.ID, d.Id())
+	_, err = isWaitForVolumeAvailable(sess, *vol.ID, d.Timeout(schema.TimeoutUpdate))
+	if err != nil {
+		return nil, err
+	}
+	if v, ok := d.GetOk(isVolumeAccessTags); ok {
+		err = flex.UpdateGlobalTagsUsingCRN(schema.NewSet(flex.ResourceIBMVPCHash, nil), v, meta, *vol.CRN, "", isVolumeAccessTagType)
+		if err != nil {
+			log.Printf(
+				"Error on create of replacement vpc volume (%s) access tags: %s", *vol.ID, err)
+		}
+	}
+	return vol, nil
+}
+
+// volSwapAttachment detaches the volume of volAtt and attaches the volume volID in its place, with the same
+// attachment name and delete_volume_on_instance_delete. The volume of volAtt is attached back on failure.
+func volSwapAttachment(d *schema.ResourceData, sess *vpcv1.VpcV1, volAtt vpcv1.VolumeAttachmentReferenceVolumeContext, volID string) error {
+	err := volDetach(d, sess, *volAtt.Instance.ID, *volAtt.ID)
+	if err != nil {
+		return err
+	}
+	_, err = volAttach(d, sess, *volAtt.Instance.ID, *volAtt.Name, volID, *volAtt.DeleteVolumeOnInstanceDelete)
+	if err != nil {
+		oldVolID := d.Id()
+		if _, restoreErr := volAttach(d, sess, *volAtt.Instance.ID, *volAtt.Name, oldVolID, *volAtt.DeleteVolumeOnInstanceDelete); restoreErr != nil {
+			return fmt.Errorf("%s\n[ERROR] Error attaching Volume (%s) back to instance %s: %s", err, oldVolID, *volAtt.Instance.ID, restoreErr)
+		}
+		return err
+	}
+	return nil
+}
+
+// volRestoreAttachment attaches the volume volID back in place of the replacement volume replacementID that
+// volSwapAttachment attached for volAtt.
+func volRestoreAttachment(d *schema.ResourceData, sess *vpcv1.VpcV1, volAtt vpcv1.VolumeAttachmentReferenceVolumeContext, replacementID, volID string) error {
+	instanceID := *volAtt.Instance.ID
+	atts, response, err := sess.ListInstanceVolumeAttachments(&vpcv1.ListInstanceVolumeAttachmentsOptions{
+		InstanceID: &instanceID,
+	})
+	if err != nil {
+		return fmt.Errorf("[ERROR] Error listing volume attachments of instance %s: %s\n%s", instanceID, err, response)
+	}
+	for _, att := range atts.VolumeAttachments {
+		if att.Volume != nil && *att.Volume.ID == replacementID {
+			if err = volDetach(d, sess, instanceID, *att.ID); err != nil {
+				return err
+			}
+		}
+	}
+	_, err = volAttach(d, sess, instanceID, *volAtt.Name, volID, *volAtt.DeleteVolumeOnInstanceDelete)
+	return err
+}
+
+func volDetach(d *schema.ResourceData, sess *vpcv1.VpcV1, instanceID, attID string) error {
+	response, err := sess.DeleteInstanceVolumeAttachment(&vpcv1.DeleteInstanceVolumeAttachmentOptions{
+		InstanceID: &instanceID,
+		ID:         &attID,
+	})
+	if err != nil {
+		return fmt.Errorf("[ERROR] Error while removing volume attachment %q for instance %s: %s\n%s", attID, instanceID, err, response)
+	}
+	_, err = isWaitForInstanceVolumeDetached(sess, d, instanceID, attID)
+	return err
+}
+
+// volAttach attaches the volume volID to the instance and waits until the attachment is confirmed.
+func volAttach(d *schema.ResourceData, sess *vpcv1.VpcV1, instanceID, name, volID string, deleteVolumeOnInstanceDelete bool) (*vpcv1.VolumeAttachment, error) {
+	att, response, err := sess.CreateInstanceVolumeAttachment(&vpcv1.CreateInstanceVolumeAttachmentOptions{
+		InstanceID: &instanceID,
+		Name:       &name,
+		Volume: &vpcv1.VolumeAttachmentPrototypeVolumeVolumeIdentity{
+			ID: &volID,
+		},
+		DeleteVolumeOnInstanceDelete: &deleteVolumeOnInstanceDelete,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("[ERROR] Error attaching Volume (%s) to instance %s: %s\n%s", volID, instanceID, err, response)
+	}
+	_, err = isWaitForInstanceVolumeAttached(sess, d, instanceID, *att.ID)
+	if err != nil {
+		return nil, err
+	}
+	att, response, err = sess.GetInstanceVolumeAttachment(&vpcv1.GetInstanceVolumeAttachmentOptions{
+		InstanceID: &instanceID,
+		ID:         att.ID,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("[ERROR] Error getting volume attachment of Volume (%s) on instance %s: %s\n%s", volID, instanceID, err, response)
+	}
+	if *att.Status != isInstanceVolumeAttached {
+		return nil, fmt.Errorf("[ERROR] Error attaching Volume (%s) to instance %s: the volume attachment %s is %s", volID, instanceID, *att.ID, *att.Status)
+	}
+	return att, nil
+}
@@ -465,6 +465,50 @@ ssh-rsa AAAAB3NzaC1yc2EAAAADAQABAAABAQCKVmnMOlHKcZK8tpt3MP1lqOLAcqcJzhsvJcjscgVE
 	})
 }
 
+func TestAccIBMISVolumeReplaceUsingSnapshot_basic(t *testing.T) {
+	var vol string
+	name := fmt.Sprintf("tf-vol-%d", acctest.RandIntRange(10, 100))
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acc.TestAccPreCheck(t) },
+		Providers:    acc.TestAccProviders,
+		CheckDestroy: testAccCheckIBMISVolumeDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMISVolumeReplaceUsingSnapshotConfig(name, "general-purpose"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrWith("ibm_is_volume.storage", "id", func(id string) error {
+						vol = id
+						return nil
+					}),
+					resource.TestCheckResourceAttr(
+						"ibm_is_volume.storage", "profile", "general-purpose"),
+					resource.TestCheckResourceAttr(
+						"ibm_is_volume.storage", "replacement_snapshot", ""),
+				),
+			},
+
+			{
+				// the profile of a volume that is not attached can only be changed by replacing it
+				Config: testAccCheckIBMISVolumeReplaceUsingSnapshotConfig(name, "5iops-tier"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrWith("ibm_is_volume.storage", "id", func(id string) error {
+						if id == vol {
+							return fmt.Errorf("Volume %s was not replaced", vol)
+						}
+						return nil
+					}),
+					resource.TestCheckResourceAttr(
+						"ibm_is_volume.storage", "name", name),
+					resource.TestCheckResourceAttr(
+						"ibm_is_volume.storage", "profile", "5iops-tier"),
+					resource.TestCheckResourceAttrSet(
+						"ibm_is_volume.storage", "replacement_snapshot"),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckIBMISVolumeDestroy(s *terraform.State) error {
 
 	sess, _ := acc.TestAccProvider.Meta().(conns.ClientSession).VpcV1API()
@@ -769,3 +813,15 @@ func testAccCheckIBMISVolumeConfigSnapshotCrn(vpcname, subnetname, sshname, publ
 		 }
 	`, volname, acc.ISZoneName)
 }
+
+func testAccCheckIBMISVolumeReplaceUsingSnapshotConfig(name, profile string) string {
+	return fmt.Sprintf(
+		`
+	resource "ibm_is_volume" "storage"{
+		name                   = "%s"
+		profile                = "%s"
+		zone                   = "%s"
+		capacity               = 20
+		replace_using_snapshot = true
+	}`, name, profile, acc.ISZoneName)
+}
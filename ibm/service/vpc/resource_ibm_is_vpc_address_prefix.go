@@ -129,12 +129,17 @@ func resourceIBMISVpcAddressPrefixCreate(context context.Context, d *schema.Reso
 	}
 
 	isVPCAddressPrefixKey := "vpc_address_prefix_key_" + vpcID
-	conns.IbmMutexKV.Lock(isVPCAddressPrefixKey)
-	defer conns.IbmMutexKV.Unlock(isVPCAddressPrefixKey)
-
-	err := vpcAddressPrefixCreate(context, d, meta, prefixName, zoneName, cidr, vpcID, isDefault)
+	unlock, err := conns.IbmKeyedLock.Lock(context, isVPCAddressPrefixKey)
 	if err != nil {
-		return err
+		tfErr := flex.DiscriminatedTerraformErrorf(err, fmt.Sprintf("Error waiting for the lock on %s: %s", isVPCAddressPrefixKey, err), "ibm_is_vpc_address_prefix", "create", "wait-for-lock")
+		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+		return tfErr.GetDiag()
+	}
+	defer unlock()
+
+	diagErr := vpcAddressPrefixCreate(context, d, meta, prefixName, zoneName, cidr, vpcID, isDefault)
+	if diagErr != nil {
+		return diagErr
 	}
 	return resourceIBMISVpcAddressPrefixRead(context, d, meta)
 }
@@ -272,8 +277,13 @@ func resourceIBMISVpcAddressPrefixUpdate(context context.Context, d *schema.Reso
 	addrPrefixID := parts[1]
 
 	isVPCAddressPrefixKey := "vpc_address_prefix_key_" + vpcID
-	conns.IbmMutexKV.Lock(isVPCAddressPrefixKey)
-	defer conns.IbmMutexKV.Unlock(isVPCAddressPrefixKey)
+	unlock, err := conns.IbmKeyedLock.Lock(context, isVPCAddressPrefixKey)
+	if err != nil {
+		tfErr := flex.DiscriminatedTerraformErrorf(err, fmt.Sprintf("Error waiting for the lock on %s: %s", isVPCAddressPrefixKey, err), "ibm_is_vpc_address_prefix", "update", "wait-for-lock")
+		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+		return tfErr.GetDiag()
+	}
+	defer unlock()
 
 	if d.HasChange(isVPCAddressPrefixPrefixName) {
 		name = d.Get(isVPCAddressPrefixPrefixName).(string)
@@ -339,8 +349,13 @@ func resourceIBMISVpcAddressPrefixDelete(context context.Context, d *schema.Reso
 	addrPrefixID := parts[1]
 
 	isVPCAddressPrefixKey := "vpc_address_prefix_key_" + vpcID
-	conns.IbmMutexKV.Lock(isVPCAddressPrefixKey)
-	defer conns.IbmMutexKV.Unlock(isVPCAddressPrefixKey)
+	unlock, err := conns.IbmKeyedLock.Lock(context, isVPCAddressPrefixKey)
+	if err != nil {
+		tfErr := flex.DiscriminatedTerraformErrorf(err, fmt.Sprintf("Error waiting for the lock on %s: %s", isVPCAddressPrefixKey, err), "ibm_is_vpc_address_prefix", "delete", "wait-for-lock")
+		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+		return tfErr.GetDiag()
+	}
+	defer unlock()
 
 	error := vpcAddressPrefixDelete(context, d, meta, vpcID, addrPrefixID)
 	if error != nil {
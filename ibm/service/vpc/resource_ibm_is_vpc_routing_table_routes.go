@@ -0,0 +1,669 @@
+// Copyright IBM Corp. 2025 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package vpc
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/vpc-go-sdk/vpcv1"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const (
+	rtIgnoreServiceRoutes = "ignore_service_routes"
+	rtServiceRoutes       = "service_routes"
+	rPriority             = "priority"
+	rAdvertise            = "advertise"
+
+	// rPriorityDefault is the priority of a route that does not set one
+	rPriorityDefault = 2
+)
+
+func ResourceIBMISVPCRoutingTableRoutes() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceIBMISVPCRoutingTableRoutesCreate,
+		ReadContext:   resourceIBMISVPCRoutingTableRoutesRead,
+		UpdateContext: resourceIBMISVPCRoutingTableRoutesUpdate,
+		DeleteContext: resourceIBMISVPCRoutingTableRoutesDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceIBMISVPCRoutingTableRoutesImport,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			rtVpcID: {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The VPC identifier.",
+			},
+			rtID: {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The routing table identifier.",
+			},
+			rtIgnoreServiceRoutes: {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+				Description: "Whether the routes created by a service (origin service) are ignored. If false, such routes are reported as unmanaged routes.",
+			},
+			rtRoutes: {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Description: "The complete set of routes of the routing table. Routes of the routing table that are not in the set are removed.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The routing table route identifier.",
+						},
+						rName: {
+							Type:         schema.TypeString,
+							Required:     true,
+							Description:  "The user-defined name for this route. Routes are matched with the routes of the routing table by name.",
+							ValidateFunc: validate.InvokeValidator("ibm_is_vpc_routing_table_route", rName),
+						},
+						rDestination: {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "The destination of the route.",
+						},
+						rZone: {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "The zone to apply the route to. Traffic from subnets in this zone will be subject to this route.",
+						},
+						rNextHop: {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "If action is deliver, the next hop that packets will be delivered to. For other action values, its address will be 0.0.0.0.",
+						},
+						rAction: {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Default:      "deliver",
+							Description:  "The action to perform with a packet matching the route.",
+							ValidateFunc: validate.InvokeValidator("ibm_is_vpc_routing_table_route", rAction),
+						},
+						rAdvertise: {
+							Type:        schema.TypeBool,
+							Optional:    true,
+							Default:     false,
+							Description: "Indicates whether this route will be advertised to the ingress sources specified by the `advertise_routes_to` routing table property.",
+						},
+						rPriority: {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Default:      rPriorityDefault,
+							Description:  "The route's priority. Smaller values have higher priority.",
+							ValidateFunc: validate.InvokeValidator("ibm_is_vpc_routing_table_route", rPriority),
+						},
+					},
+				},
+			},
+			rtServiceRoutes: {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The routes of the routing table that were created by a service and are ignored.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The routing table route identifier.",
+						},
+						rName: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The user-defined name for this route.",
+						},
+						rDestination: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The destination of the route.",
+						},
+						rZone: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The zone the route applies to.",
+						},
+						rNextHop: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The next hop of the route.",
+						},
+						"creator": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The CRN of the resource that created the route.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// routeSpec is the normalized form of a routing table route, used to match the
+// routes of the configuration with the routes of the routing table.
+type routeSpec struct {
+	id          string
+	name        string
+	destination string
+	zone        string
+	action      string
+	nextHop     string
+	priority    int64
+	advertise   bool
+	origin      string
+	creator     string
+}
+
+func (r *routeSpec) key() string {
+	return strings.Join([]string{
+		r.destination, r.zone, r.action, r.nextHop, fmt.Sprint(r.priority), fmt.Sprint(r.advertise),
+	}, "|")
+}
+
+func (r *routeSpec) String() string {
+	return fmt.Sprintf("%s (%s in %s %s %s)", r.name, r.destination, r.zone, r.action, r.nextHop)
+}
+
+// replaces reports whether the route must be deleted and created again to become
+// r, because the destination, zone and action of a route cannot be patched.
+func (r *routeSpec) replaces(actual *routeSpec) bool {
+	return r.destination != actual.destination || r.zone != actual.zone || r.action != actual.action
+}
+
+func expandRouteSpec(route map[string]interface{}) *routeSpec {
+	spec := &routeSpec{
+		action:   "deliver",
+		priority: rPriorityDefault,
+	}
+	spec.name, _ = route[rName].(string)
+	spec.destination, _ = route[rDestination].(string)
+	spec.zone, _ = route[rZone].(string)
+	spec.nextHop, _ = route[rNextHop].(string)
+	if action, _ := route[rAction].(string); action != "" {
+		spec.action = action
+	}
+	if priority, ok := route[rPriority].(int); ok {
+		spec.priority = int64(priority)
+	}
+	spec.advertise, _ = route[rAdvertise].(bool)
+	return spec
+}
+
+func expandRouteSpecs(routes *schema.Set) ([]*routeSpec, error) {
+	specs := make([]*routeSpec, 0, routes.Len())
+	names := make(map[string]bool, routes.Len())
+	for _, route := range routes.List() {
+		values, _ := route.(map[string]interface{})
+		spec := expandRouteSpec(values)
+		if names[spec.name] {
+			return nil, fmt.Errorf("route name %s is used more than once, names must be unique within the routing table", spec.name)
+		}
+		names[spec.name] = true
+		specs = append(specs, spec)
+	}
+	return specs, nil
+}
+
+func routeSpecFromRoute(route vpcv1.Route) *routeSpec {
+	spec := &routeSpec{
+		id:          *route.ID,
+		name:        *route.Name,
+		destination: *route.Destination,
+		action:      *route.Action,
+		origin:      *route.Origin,
+	}
+	if route.Zone != nil && route.Zone.Name != nil {
+		spec.zone = *route.Zone.Name
+	}
+	if route.Priority != nil {
+		spec.priority = *route.Priority
+	}
+	if route.Advertise != nil {
+		spec.advertise = *route.Advertise
+	}
+	switch nextHop := route.NextHop.(type) {
+	case *vpcv1.RouteNextHop:
+		if nextHop.Address != nil {
+			spec.nextHop = *nextHop.Address
+		} else if nextHop.ID != nil {
+			spec.nextHop = *nextHop.ID
+		}
+	case *vpcv1.RouteNextHopIP:
+		spec.nextHop = *nextHop.Address
+	case *vpcv1.RouteNextHopVPNGatewayConnectionReference:
+		spec.nextHop = *nextHop.ID
+	}
+	switch creator := route.Creator.(type) {
+	case *vpcv1.RouteCreator:
+		if creator.CRN != nil {
+			spec.creator = *creator.CRN
+		}
+	case *vpcv1.RouteCreatorVPNGatewayReference:
+		spec.creator = *creator.CRN
+	case *vpcv1.RouteCreatorVPNServerReference:
+		spec.creator = *creator.CRN
+	}
+	return spec
+}
+
+// flatten returns the route as an element of the routes set
+func (r *routeSpec) flatten() map[string]interface{} {
+	return map[string]interface{}{
+		"id":         r.id,
+		rName:        r.name,
+		rDestination: r.destination,
+		rZone:        r.zone,
+		rNextHop:     r.nextHop,
+		rAction:      r.action,
+		rAdvertise:   r.advertise,
+		rPriority:    int(r.priority),
+	}
+}
+
+func (r *routeSpec) prototype(vpcID, tableID string) *vpcv1.CreateVPCRoutingTableRouteOptions {
+	createVpcRoutingTableRouteOptions := &vpcv1.CreateVPCRoutingTableRouteOptions{
+		VPCID:          &vpcID,
+		RoutingTableID: &tableID,
+		Destination:    core.StringPtr(r.destination),
+		Zone: &vpcv1.ZoneIdentityByName{
+			Name: core.StringPtr(r.zone),
+		},
+		Action:    core.StringPtr(r.action),
+		Advertise: core.BoolPtr(r.advertise),
+		Name:      core.StringPtr(r.name),
+		Priority:  core.Int64Ptr(r.priority),
+	}
+	if net.ParseIP(r.nextHop) == nil {
+		createVpcRoutingTableRouteOptions.NextHop = &vpcv1.RouteNextHopPrototype{
+			ID: core.StringPtr(r.nextHop),
+		}
+	} else {
+		createVpcRoutingTableRouteOptions.NextHop = &vpcv1.RouteNextHopPrototype{
+			Address: core.StringPtr(r.nextHop),
+		}
+	}
+	return createVpcRoutingTableRouteOptions
+}
+
+// patch returns the patch of the fields of the actual route that differ from r
+func (r *routeSpec) patch(actual *routeSpec) *vpcv1.RoutePatch {
+	routePatchModel := &vpcv1.RoutePatch{}
+	if r.nextHop != actual.nextHop {
+		if net.ParseIP(r.nextHop) == nil {
+			routePatchModel.NextHop = &vpcv1.RouteNextHopPatch{
+				ID: core.StringPtr(r.nextHop),
+			}
+		} else {
+			routePatchModel.NextHop = &vpcv1.RouteNextHopPatch{
+				Address: core.StringPtr(r.nextHop),
+			}
+		}
+	}
+	if r.priority != actual.priority {
+		routePatchModel.Priority = core.Int64Ptr(r.priority)
+	}
+	if r.advertise != actual.advertise {
+		routePatchModel.Advertise = core.BoolPtr(r.advertise)
+	}
+	return routePatchModel
+}
+
+const (
+	routeStepCreate = "create"
+	routeStepUpdate = "update"
+	routeStepDelete = "delete"
+)
+
+type routeStep struct {
+	operation string
+	route     *routeSpec
+	actual    *routeSpec
+}
+
+// planRoutingTableRoutes returns the calls that make the managed actual routes
+// of a routing table the desired routes. Routes are matched by name. A route
+// whose destination, zone or action changed is deleted before it is created
+// again, because names are unique within the routing table, the other changes
+// are patched in place. The routes that are not desired are deleted last.
+func planRoutingTableRoutes(desired, actual []*routeSpec) []routeStep {
+	byName := make(map[string]*routeSpec, len(actual))
+	for _, route := range actual {
+		byName[route.name] = route
+	}
+	sort.Slice(desired, func(i, j int) bool { return desired[i].name < desired[j].name })
+
+	steps := make([]routeStep, 0)
+	kept := make(map[string]bool, len(desired))
+	for _, route := range desired {
+		current, ok := byName[route.name]
+		switch {
+		case !ok:
+			steps = append(steps, routeStep{operation: routeStepCreate, route: route})
+		case route.replaces(current):
+			kept[route.name] = true
+			steps = append(steps,
+				routeStep{operation: routeStepDelete, actual: current},
+				routeStep{operation: routeStepCreate, route: route})
+		case route.key() != current.key():
+			kept[route.name] = true
+			steps = append(steps, routeStep{operation: routeStepUpdate, route: route, actual: current})
+		default:
+			kept[route.name] = true
+		}
+	}
+	for _, route := range actual {
+		if !kept[route.name] {
+			steps = append(steps, routeStep{operation: routeStepDelete, actual: route})
+		}
+	}
+	return steps
+}
+
+// getRoutingTableRouteSpecs returns the routes of the routing table, split in the
+// managed routes and the routes created by a service that are ignored.
+func getRoutingTableRouteSpecs(context context.Context, sess *vpcv1.VpcV1, vpcID, tableID string, ignoreServiceRoutes bool) (managed, ignored []*routeSpec, response *core.DetailedResponse, err error) {
+	start := ""
+	for {
+		listVpcRoutingTableRoutesOptions := &vpcv1.ListVPCRoutingTableRoutesOptions{
+			VPCID:          &vpcID,
+			RoutingTableID: &tableID,
+		}
+		if start != "" {
+			listVpcRoutingTableRoutesOptions.Start = &start
+		}
+		var collection *vpcv1.RouteCollection
+		collection, response, err = sess.ListVPCRoutingTableRoutesWithContext(context, listVpcRoutingTableRoutesOptions)
+		if err != nil {
+			return nil, nil, response, err
+		}
+		for _, route := range collection.Routes {
+			spec := routeSpecFromRoute(route)
+			if ignoreServiceRoutes && spec.origin == vpcv1.RouteOriginServiceConst {
+				ignored = append(ignored, spec)
+				continue
+			}
+			managed = append(managed, spec)
+		}
+		start = flex.GetNext(collection.Next)
+		if start == "" {
+			break
+		}
+	}
+	return managed, ignored, response, nil
+}
+
+// applyRoutingTableRoutes makes the routes of the routing table match the routes set
+func applyRoutingTableRoutes(context context.Context, d *schema.ResourceData, meta interface{}, vpcID, tableID, operation string) diag.Diagnostics {
+	sess, err := vpcClient(meta)
+	if err != nil {
+		tfErr := flex.DiscriminatedTerraformErrorf(err, err.Error(), "ibm_is_vpc_routing_table_routes", operation, "initialize-client")
+		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+		return tfErr.GetDiag()
+	}
+	desired, err := expandRouteSpecs(d.Get(rtRoutes).(*schema.Set))
+	if err != nil {
+		tfErr := flex.TerraformErrorf(err, err.Error(), "ibm_is_vpc_routing_table_routes", operation)
+		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+		return tfErr.GetDiag()
+	}
+
+	isRoutingTableRoutesKey := "vpc_routing_table_routes_key_" + tableID
+	unlock, err := conns.IbmKeyedLock.Lock(context, isRoutingTableRoutesKey)
+	if err != nil {
+		tfErr := flex.DiscriminatedTerraformErrorf(err, fmt.Sprintf("Error waiting for the lock on %s: %s", isRoutingTableRoutesKey, err), "ibm_is_vpc_routing_table_routes", operation, "wait-for-lock")
+		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+		return tfErr.GetDiag()
+	}
+	defer unlock()
+
+	actual, _, _, err := getRoutingTableRouteSpecs(context, sess, vpcID, tableID, d.Get(rtIgnoreServiceRoutes).(bool))
+	if err != nil {
+		tfErr := flex.TerraformErrorf(err, fmt.Sprintf("ListVPCRoutingTableRoutesWithContext failed: %s", err.Error()), "ibm_is_vpc_routing_table_routes", operation)
+		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+		return tfErr.GetDiag()
+	}
+	steps := planRoutingTableRoutes(desired, actual)
+	log.Printf("[DEBUG] Routing table %s: applying %d changes to %d routes", tableID, len(steps), len(actual))
+
+	for _, step := range steps {
+		switch step.operation {
+		case routeStepCreate:
+			_, _, err := sess.CreateVPCRoutingTableRouteWithContext(context, step.route.prototype(vpcID, tableID))
+			if err != nil {
+				tfErr := flex.TerraformErrorf(err, fmt.Sprintf("CreateVPCRoutingTableRouteWithContext failed for route %s: %s", step.route, err.Error()), "ibm_is_vpc_routing_table_routes", operation)
+				log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+				return tfErr.GetDiag()
+			}
+		case routeStepUpdate:
+			routePatch, err := step.route.patch(step.actual).AsPatch()
+			if err != nil {
+				tfErr := flex.TerraformErrorf(err, fmt.Sprintf("routePatchModel.AsPatch() failed: %s", err.Error()), "ibm_is_vpc_routing_table_routes", operation)
+				log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+				return tfErr.GetDiag()
+			}
+			updateVpcRoutingTableRouteOptions := sess.NewUpdateVPCRoutingTableRouteOptions(vpcID, tableID, step.actual.id, routePatch)
+			_, _, err = sess.UpdateVPCRoutingTableRouteWithContext(context, updateVpcRoutingTableRouteOptions)
+			if err != nil {
+				tfErr := flex.TerraformErrorf(err, fmt.Sprintf("UpdateVPCRoutingTableRouteWithContext failed for route %s: %s", step.route, err.Error()), "ibm_is_vpc_routing_table_routes", operation)
+				log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+				return tfErr.GetDiag()
+			}
+		case routeStepDelete:
+			if step.actual.origin == vpcv1.RouteOriginServiceConst {
+				err = fmt.Errorf("route %s was created by %s and cannot be deleted, set %s to true to ignore the routes created by a service", step.actual, step.actual.creator, rtIgnoreServiceRoutes)
+				tfErr := flex.DiscriminatedTerraformErrorf(err, err.Error(), "ibm_is_vpc_routing_table_routes", operation, "service-route")
+				log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+				return tfErr.GetDiag()
+			}
+			deleteVpcRoutingTableRouteOptions := sess.NewDeleteVPCRoutingTableRouteOptions(vpcID, tableID, step.actual.id)
+			response, err := sess.DeleteVPCRoutingTableRouteWithContext(context, deleteVpcRoutingTableRouteOptions)
+			if err != nil && (response == nil || response.StatusCode != 404) {
+				tfErr := flex.TerraformErrorf(err, fmt.Sprintf("DeleteVPCRoutingTableRouteWithContext failed for route %s: %s", step.actual, err.Error()), "ibm_is_vpc_routing_table_routes", operation)
+				log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+				return tfErr.GetDiag()
+			}
+		}
+	}
+	return nil
+}
+
+func resourceIBMISVPCRoutingTableRoutesCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	vpcID := d.Get(rtVpcID).(string)
+	tableID := d.Get(rtID).(string)
+	if diags := applyRoutingTableRoutes(context, d, meta, vpcID, tableID, "create"); diags != nil {
+		return diags
+	}
+	d.SetId(fmt.Sprintf("%s/%s", vpcID, tableID))
+	return resourceIBMISVPCRoutingTableRoutesRead(context, d, meta)
+}
+
+func resourceIBMISVPCRoutingTableRoutesRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	sess, err := vpcClient(meta)
+	if err != nil {
+		tfErr := flex.DiscriminatedTerraformErrorf(err, err.Error(), "ibm_is_vpc_routing_table_routes", "read", "initialize-client")
+		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+		return tfErr.GetDiag()
+	}
+	vpcID, tableID, err := parseRoutingTableRoutesID(d.Id())
+	if err != nil {
+		return flex.DiscriminatedTerraformErrorf(err, err.Error(), "ibm_is_vpc_routing_table_routes", "read", "sep-id-parts").GetDiag()
+	}
+	// Reads wait for the routes being changed, but not for each other
+	isRoutingTableRoutesKey := "vpc_routing_table_routes_key_" + tableID
+	unlock, err := conns.IbmKeyedLock.RLock(context, isRoutingTableRoutesKey)
+	if err != nil {
+		tfErr := flex.DiscriminatedTerraformErrorf(err, fmt.Sprintf("Error waiting for the lock on %s: %s", isRoutingTableRoutesKey, err), "ibm_is_vpc_routing_table_routes", "read", "wait-for-lock")
+		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+		return tfErr.GetDiag()
+	}
+	defer unlock()
+	ignoreServiceRoutes := true
+	if v, ok := d.GetOkExists(rtIgnoreServiceRoutes); ok {
+		ignoreServiceRoutes = v.(bool)
+	}
+	actual, ignored, response, err := getRoutingTableRouteSpecs(context, sess, vpcID, tableID, ignoreServiceRoutes)
+	if err != nil {
+		if response != nil && response.StatusCode == 404 {
+			d.SetId("")
+			return nil
+		}
+		tfErr := flex.TerraformErrorf(err, fmt.Sprintf("ListVPCRoutingTableRoutesWithContext failed: %s", err.Error()), "ibm_is_vpc_routing_table_routes", "read")
+		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+		return tfErr.GetDiag()
+	}
+
+	// Keep the configured form of the routes that match, the other routes of the
+	// routing table are reported as they are.
+	configured := make(map[string]map[string]interface{})
+	if routes, ok := d.Get(rtRoutes).(*schema.Set); ok {
+		for _, route := range routes.List() {
+			values, _ := route.(map[string]interface{})
+			configured[expandRouteSpec(values).name] = values
+		}
+	}
+	routes := make([]interface{}, 0, len(actual))
+	for _, spec := range actual {
+		if values, ok := configured[spec.name]; ok && expandRouteSpec(values).key() == spec.key() {
+			route := make(map[string]interface{}, len(values))
+			for k, v := range values {
+				route[k] = v
+			}
+			route["id"] = spec.id
+			routes = append(routes, route)
+			continue
+		}
+		if _, ok := configured[spec.name]; !ok {
+			log.Printf("[DEBUG] Routing table %s has %s route %s that is not in the routes set", tableID, spec.origin, spec)
+		}
+		routes = append(routes, spec.flatten())
+	}
+	serviceRoutes := make([]map[string]interface{}, 0, len(ignored))
+	for _, spec := range ignored {
+		serviceRoutes = append(serviceRoutes, map[string]interface{}{
+			"id":         spec.id,
+			rName:        spec.name,
+			rDestination: spec.destination,
+			rZone:        spec.zone,
+			rNextHop:     spec.nextHop,
+			"creator":    spec.creator,
+		})
+	}
+
+	if err = d.Set(rtVpcID, vpcID); err != nil {
+		return flex.DiscriminatedTerraformErrorf(err, fmt.Sprintf("Error setting vpc: %s", err), "ibm_is_vpc_routing_table_routes", "read", "set-vpc").GetDiag()
+	}
+	if err = d.Set(rtID, tableID); err != nil {
+		return flex.DiscriminatedTerraformErrorf(err, fmt.Sprintf("Error setting routing_table: %s", err), "ibm_is_vpc_routing_table_routes", "read", "set-routing_table").GetDiag()
+	}
+	if err = d.Set(rtIgnoreServiceRoutes, ignoreServiceRoutes); err != nil {
+		return flex.DiscriminatedTerraformErrorf(err, fmt.Sprintf("Error setting ignore_service_routes: %s", err), "ibm_is_vpc_routing_table_routes", "read", "set-ignore_service_routes").GetDiag()
+	}
+	if err = d.Set(rtRoutes, routes); err != nil {
+		return flex.DiscriminatedTerraformErrorf(err, fmt.Sprintf("Error setting routes: %s", err), "ibm_is_vpc_routing_table_routes", "read", "set-routes").GetDiag()
+	}
+	if err = d.Set(rtServiceRoutes, serviceRoutes); err != nil {
+		return flex.DiscriminatedTerraformErrorf(err, fmt.Sprintf("Error setting service_routes: %s", err), "ibm_is_vpc_routing_table_routes", "read", "set-service_routes").GetDiag()
+	}
+	return nil
+}
+
+func resourceIBMISVPCRoutingTableRoutesUpdate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if d.HasChange(rtRoutes) || d.HasChange(rtIgnoreServiceRoutes) {
+		vpcID, tableID, err := parseRoutingTableRoutesID(d.Id())
+		if err != nil {
+			return flex.DiscriminatedTerraformErrorf(err, err.Error(), "ibm_is_vpc_routing_table_routes", "update", "sep-id-parts").GetDiag()
+		}
+		if diags := applyRoutingTableRoutes(context, d, meta, vpcID, tableID, "update"); diags != nil {
+			return diags
+		}
+	}
+	return resourceIBMISVPCRoutingTableRoutesRead(context, d, meta)
+}
+
+// resourceIBMISVPCRoutingTableRoutesDelete removes the routes of the routes set.
+// The routes that were added outside of Terraform after the last refresh are kept.
+func resourceIBMISVPCRoutingTableRoutesDelete(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	sess, err := vpcClient(meta)
+	if err != nil {
+		tfErr := flex.DiscriminatedTerraformErrorf(err, err.Error(), "ibm_is_vpc_routing_table_routes", "delete", "initialize-client")
+		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+		return tfErr.GetDiag()
+	}
+	vpcID, tableID, err := parseRoutingTableRoutesID(d.Id())
+	if err != nil {
+		return flex.DiscriminatedTerraformErrorf(err, err.Error(), "ibm_is_vpc_routing_table_routes", "delete", "sep-id-parts").GetDiag()
+	}
+
+	isRoutingTableRoutesKey := "vpc_routing_table_routes_key_" + tableID
+	unlock, err := conns.IbmKeyedLock.Lock(context, isRoutingTableRoutesKey)
+	if err != nil {
+		tfErr := flex.DiscriminatedTerraformErrorf(err, fmt.Sprintf("Error waiting for the lock on %s: %s", isRoutingTableRoutesKey, err), "ibm_is_vpc_routing_table_routes", "delete", "wait-for-lock")
+		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+		return tfErr.GetDiag()
+	}
+	defer unlock()
+
+	for _, route := range d.Get(rtRoutes).(*schema.Set).List() {
+		values, _ := route.(map[string]interface{})
+		routeID, _ := values["id"].(string)
+		if routeID == "" {
+			continue
+		}
+		deleteVpcRoutingTableRouteOptions := sess.NewDeleteVPCRoutingTableRouteOptions(vpcID, tableID, routeID)
+		response, err := sess.DeleteVPCRoutingTableRouteWithContext(context, deleteVpcRoutingTableRouteOptions)
+		if err != nil && (response == nil || response.StatusCode != 404) {
+			tfErr := flex.TerraformErrorf(err, fmt.Sprintf("DeleteVPCRoutingTableRouteWithContext failed for route %s: %s", routeID, err.Error()), "ibm_is_vpc_routing_table_routes", "delete")
+			log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+			return tfErr.GetDiag()
+		}
+	}
+	d.SetId("")
+	return nil
+}
+
+// resourceIBMISVPCRoutingTableRoutesImport imports the routes of a routing table
+// by the ID vpcID/routingTableID. All the routes of the routing table, except the
+// routes created by a service, are then managed by the resource.
+func resourceIBMISVPCRoutingTableRoutesImport(context context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	vpcID, tableID, err := parseRoutingTableRoutesID(d.Id())
+	if err != nil {
+		return nil, err
+	}
+	d.Set(rtVpcID, vpcID)
+	d.Set(rtID, tableID)
+	d.Set(rtIgnoreServiceRoutes, true)
+	return []*schema.ResourceData{d}, nil
+}
+
+func parseRoutingTableRoutesID(id string) (vpcID, tableID string, err error) {
+	idSet := strings.Split(id, "/")
+	if len(idSet) != 2 || idSet[0] == "" || idSet[1] == "" {
+		return "", "", fmt.Errorf("[ERROR] Incorrect ID %s: ID should be a combination of vpcID/routingTableID", id)
+	}
+	return idSet[0], idSet[1], nil
+}
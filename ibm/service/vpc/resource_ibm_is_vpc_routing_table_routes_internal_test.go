@@ -0,0 +1,124 @@
+// Copyright IBM Corp. 2025 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package vpc
+
+import (
+	"reflect"
+	"testing"
+)
+
+func testRouteSpec(name, destination, nextHop string) *routeSpec {
+	return &routeSpec{
+		name:        name,
+		destination: destination,
+		zone:        "us-south-1",
+		action:      "deliver",
+		nextHop:     nextHop,
+		priority:    rPriorityDefault,
+	}
+}
+
+func describeRouteSteps(steps []routeStep) []string {
+	described := make([]string, 0, len(steps))
+	for _, step := range steps {
+		switch step.operation {
+		case routeStepCreate:
+			described = append(described, "create "+step.route.name)
+		case routeStepUpdate:
+			described = append(described, "update "+step.route.name)
+		case routeStepDelete:
+			described = append(described, "delete "+step.actual.name)
+		}
+	}
+	return described
+}
+
+func TestPlanRoutingTableRoutes(t *testing.T) {
+	withPriority := testRouteSpec("a", "10.10.0.0/24", "10.0.0.4")
+	withPriority.priority = 1
+	inOtherZone := testRouteSpec("a", "10.10.0.0/24", "10.0.0.4")
+	inOtherZone.zone = "us-south-2"
+	dropped := testRouteSpec("a", "10.10.0.0/24", "10.0.0.4")
+	dropped.action = "drop"
+
+	tests := []struct {
+		name    string
+		desired []*routeSpec
+		actual  []*routeSpec
+		want    []string
+	}{
+		{
+			name:    "no changes",
+			desired: []*routeSpec{testRouteSpec("a", "10.10.0.0/24", "10.0.0.4")},
+			actual:  []*routeSpec{testRouteSpec("a", "10.10.0.0/24", "10.0.0.4")},
+			want:    []string{},
+		},
+		{
+			name:    "empty routing table",
+			desired: []*routeSpec{testRouteSpec("b", "10.20.0.0/24", "10.0.0.4"), testRouteSpec("a", "10.10.0.0/24", "10.0.0.4")},
+			want:    []string{"create a", "create b"},
+		},
+		{
+			name:   "no routes",
+			actual: []*routeSpec{testRouteSpec("a", "10.10.0.0/24", "10.0.0.4"), testRouteSpec("b", "10.20.0.0/24", "10.0.0.4")},
+			want:   []string{"delete a", "delete b"},
+		},
+		{
+			name:    "changed next hop",
+			desired: []*routeSpec{testRouteSpec("a", "10.10.0.0/24", "10.0.0.5")},
+			actual:  []*routeSpec{testRouteSpec("a", "10.10.0.0/24", "10.0.0.4")},
+			want:    []string{"update a"},
+		},
+		{
+			name:    "changed priority",
+			desired: []*routeSpec{withPriority},
+			actual:  []*routeSpec{testRouteSpec("a", "10.10.0.0/24", "10.0.0.4")},
+			want:    []string{"update a"},
+		},
+		{
+			name:    "changed destination",
+			desired: []*routeSpec{testRouteSpec("a", "10.30.0.0/24", "10.0.0.4")},
+			actual:  []*routeSpec{testRouteSpec("a", "10.10.0.0/24", "10.0.0.4")},
+			want:    []string{"delete a", "create a"},
+		},
+		{
+			name:    "changed zone",
+			desired: []*routeSpec{inOtherZone},
+			actual:  []*routeSpec{testRouteSpec("a", "10.10.0.0/24", "10.0.0.4")},
+			want:    []string{"delete a", "create a"},
+		},
+		{
+			name:    "changed action",
+			desired: []*routeSpec{dropped},
+			actual:  []*routeSpec{testRouteSpec("a", "10.10.0.0/24", "10.0.0.4")},
+			want:    []string{"delete a", "create a"},
+		},
+		{
+			name:    "renamed route",
+			desired: []*routeSpec{testRouteSpec("b", "10.10.0.0/24", "10.0.0.4")},
+			actual:  []*routeSpec{testRouteSpec("a", "10.10.0.0/24", "10.0.0.4")},
+			want:    []string{"create b", "delete a"},
+		},
+		{
+			name: "created, updated and deleted routes",
+			desired: []*routeSpec{
+				testRouteSpec("c", "10.30.0.0/24", "10.0.0.4"),
+				testRouteSpec("a", "10.10.0.0/24", "10.0.0.5"),
+			},
+			actual: []*routeSpec{
+				testRouteSpec("a", "10.10.0.0/24", "10.0.0.4"),
+				testRouteSpec("b", "10.20.0.0/24", "10.0.0.4"),
+			},
+			want: []string{"update a", "create c", "delete b"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := describeRouteSteps(planRoutingTableRoutes(tt.desired, tt.actual))
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("planRoutingTableRoutes() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
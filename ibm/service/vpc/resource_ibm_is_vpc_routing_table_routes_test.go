@@ -0,0 +1,139 @@
+// Copyright IBM Corp. 2025 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package vpc_test
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+
+	"github.com/IBM/vpc-go-sdk/vpcv1"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccIBMISVPCRoutingTableRoutes_basic(t *testing.T) {
+	name := fmt.Sprintf("tfrtroutes-vpc-%d", acctest.RandIntRange(10, 100))
+	rtName := fmt.Sprintf("tfrtroutes-rt-%d", acctest.RandIntRange(10, 100))
+	subnetName := fmt.Sprintf("tfrtroutes-subnet-%d", acctest.RandIntRange(10, 100))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acc.TestAccPreCheck(t) },
+		Providers:    acc.TestAccProviders,
+		CheckDestroy: testAccCheckIBMISVPCRoutingTableRoutesDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMISVPCRoutingTableRoutesConfig(name, rtName, subnetName, 2),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIBMISVPCRoutingTableRoutesCount("ibm_is_vpc_routing_table_routes.testacc_routes", 2),
+					resource.TestCheckResourceAttr(
+						"ibm_is_vpc_routing_table_routes.testacc_routes", "routes.#", "2"),
+					resource.TestCheckResourceAttr(
+						"ibm_is_vpc_routing_table_routes.testacc_routes", "ignore_service_routes", "true"),
+				),
+			},
+			{
+				Config: testAccCheckIBMISVPCRoutingTableRoutesConfig(name, rtName, subnetName, 1),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIBMISVPCRoutingTableRoutesCount("ibm_is_vpc_routing_table_routes.testacc_routes", 2),
+					resource.TestCheckTypeSetElemNestedAttrs(
+						"ibm_is_vpc_routing_table_routes.testacc_routes", "routes.*", map[string]string{
+							"name":     "tfrtroutes-deliver",
+							"priority": "1",
+						}),
+				),
+			},
+			{
+				ResourceName:      "ibm_is_vpc_routing_table_routes.testacc_routes",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckIBMISVPCRoutingTableRoutesDestroy(s *terraform.State) error {
+	sess, _ := acc.TestAccProvider.Meta().(conns.ClientSession).VpcV1API()
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "ibm_is_vpc_routing_table_routes" {
+			continue
+		}
+
+		parts := strings.Split(rs.Primary.ID, "/")
+		listVpcRoutingTableRoutesOptions := &vpcv1.ListVPCRoutingTableRoutesOptions{
+			VPCID:          &parts[0],
+			RoutingTableID: &parts[1],
+		}
+		routes, _, err := sess.ListVPCRoutingTableRoutes(listVpcRoutingTableRoutesOptions)
+		if err == nil && len(routes.Routes) != 0 {
+			return fmt.Errorf("routing table %s still has %d routes", parts[1], len(routes.Routes))
+		}
+	}
+	return nil
+}
+
+func testAccCheckIBMISVPCRoutingTableRoutesCount(n string, count int) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		sess, _ := acc.TestAccProvider.Meta().(conns.ClientSession).VpcV1API()
+		parts := strings.Split(rs.Primary.ID, "/")
+		listVpcRoutingTableRoutesOptions := &vpcv1.ListVPCRoutingTableRoutesOptions{
+			VPCID:          &parts[0],
+			RoutingTableID: &parts[1],
+		}
+		routes, _, err := sess.ListVPCRoutingTableRoutes(listVpcRoutingTableRoutesOptions)
+		if err != nil {
+			return err
+		}
+		if len(routes.Routes) != count {
+			return fmt.Errorf("routing table %s has %d routes, expected %d", parts[1], len(routes.Routes), count)
+		}
+		return nil
+	}
+}
+
+func testAccCheckIBMISVPCRoutingTableRoutesConfig(name, rtName, subnetName string, priority int) string {
+	return fmt.Sprintf(`
+resource "ibm_is_vpc" "testacc_vpc" {
+	name = "%s"
+}
+resource "ibm_is_vpc_routing_table" "testacc_routing_table" {
+	vpc  = ibm_is_vpc.testacc_vpc.id
+	name = "%s"
+}
+resource "ibm_is_subnet" "testacc_subnet" {
+	name            = "%s"
+	vpc             = ibm_is_vpc.testacc_vpc.id
+	zone            = "%s"
+	ipv4_cidr_block = "%s"
+	routing_table   = ibm_is_vpc_routing_table.testacc_routing_table.routing_table
+}
+resource "ibm_is_vpc_routing_table_routes" "testacc_routes" {
+	vpc           = ibm_is_vpc.testacc_vpc.id
+	routing_table = ibm_is_vpc_routing_table.testacc_routing_table.routing_table
+	routes {
+		name        = "tfrtroutes-deliver"
+		zone        = "%s"
+		destination = ibm_is_subnet.testacc_subnet.ipv4_cidr_block
+		next_hop    = "%s"
+		priority    = %d
+	}
+	routes {
+		name        = "tfrtroutes-drop"
+		zone        = "%s"
+		destination = "192.168.10.0/24"
+		action      = "drop"
+		next_hop    = "0.0.0.0"
+	}
+}
+`, name, rtName, subnetName, acc.ISZoneName, acc.ISCIDR, acc.ISZoneName, acc.ISRouteNextHop, priority, acc.ISZoneName)
+}
@@ -42,6 +42,7 @@ const (
 	isVPNGatewayConnectionResourcetype              = "resource_type"
 	isVPNGatewayConnectionCreatedat                 = "created_at"
 	isVPNGatewayConnectionStatusreasons             = "status_reasons"
+	isVPNGatewayConnectionWaitForTunnelsUp          = "wait_for_tunnels_up"
 )
 
 func ResourceIBMISVPNGatewayConnection() *schema.Resource {
@@ -54,6 +55,8 @@ func ResourceIBMISVPNGatewayConnection() *schema.Resource {
 		Importer: &schema.ResourceImporter{},
 
 		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
 			Delete: schema.DefaultTimeout(10 * time.Minute),
 		},
 
@@ -276,6 +279,13 @@ func ResourceIBMISVPNGatewayConnection() *schema.Resource {
 				Description: "The unique identifier for this VPN gateway connection",
 			},
 
+			isVPNGatewayConnectionWaitForTunnelsUp: {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "If set to true, create and update wait until the connection is up and, in static route mode, at least one of its tunnels is up, within the create and update timeouts",
+			},
+
 			isVPNGatewayConnectionStatus: {
 				Type:        schema.TypeString,
 				Computed:    true,
@@ -447,6 +457,12 @@ func resourceIBMISVPNGatewayConnectionCreate(d *schema.ResourceData, meta interf
 	if err != nil {
 		return err
 	}
+	if d.Id() != "" && d.Get(isVPNGatewayConnectionWaitForTunnelsUp).(bool) {
+		err = vpngwconWaitForTunnelsUp(d, meta, d.Timeout(schema.TimeoutCreate))
+		if err != nil {
+			return err
+		}
+	}
 	return resourceIBMISVPNGatewayConnectionRead(d, meta)
 }
 
@@ -695,6 +711,7 @@ func vpngwconGet(d *schema.ResourceData, meta interface{}, gID, gConnID string)
 		return fmt.Errorf("[ERROR] Error Getting Vpn Gateway Connection (%s): %s\n%s", gConnID, err, response)
 	}
 	d.Set(isVPNGatewayConnection, gConnID)
+	d.Set(isVPNGatewayConnectionWaitForTunnelsUp, d.Get(isVPNGatewayConnectionWaitForTunnelsUp).(bool))
 	setvpnGatewayConnectionIntfResource(d, gID, vpnGatewayConnectionIntf)
 	getVPNGatewayOptions := &vpcv1.GetVPNGatewayOptions{
 		ID: &gID,
@@ -722,6 +739,12 @@ func resourceIBMISVPNGatewayConnectionUpdate(d *schema.ResourceData, meta interf
 	if err != nil {
 		return err
 	}
+	if d.Get(isVPNGatewayConnectionWaitForTunnelsUp).(bool) {
+		err = vpngwconWaitForTunnelsUp(d, meta, d.Timeout(schema.TimeoutUpdate))
+		if err != nil {
+			return err
+		}
+	}
 	return resourceIBMISVPNGatewayConnectionRead(d, meta)
 }
 
@@ -1025,6 +1048,166 @@ func isVPNGatewayConnectionDeleteRefreshFunc(vpnGatewayConnection *vpcv1.VpcV1,
 	}
 }
 
+func vpngwconWaitForTunnelsUp(d *schema.ResourceData, meta interface{}, timeout time.Duration) error {
+	sess, err := vpcClient(meta)
+	if err != nil {
+		return err
+	}
+	parts, err := flex.IdParts(d.Id())
+	if err != nil {
+		return err
+	}
+	_, err = isWaitForVPNGatewayConnectionTunnelsUp(sess, parts[0], parts[1], timeout)
+	if err != nil {
+		return fmt.Errorf("[ERROR] Error waiting for the tunnels of Vpn Gateway Connection (%s) to be up: %s", parts[1], err)
+	}
+	return nil
+}
+
+// isWaitForVPNGatewayConnectionTunnelsUp waits until the connection is up and, in static route mode,
+// at least one of its tunnels is up. A peer that is not configured for the second tunnel of the
+// high availability pair leaves it down, so one tunnel up is enough.
+func isWaitForVPNGatewayConnectionTunnelsUp(sess *vpcv1.VpcV1, gID, gConnID string, timeout time.Duration) (interface{}, error) {
+	log.Printf("Waiting for the tunnels of VPNGatewayConnection (%s) to be up.", gConnID)
+
+	var last *vpnGatewayConnectionHealth
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{vpcv1.VPNGatewayConnectionStatusDownConst},
+		Target:  []string{vpcv1.VPNGatewayConnectionStatusUpConst},
+		Refresh: func() (interface{}, string, error) {
+			getVpnGatewayConnectionOptions := &vpcv1.GetVPNGatewayConnectionOptions{
+				VPNGatewayID: &gID,
+				ID:           &gConnID,
+			}
+			vpnGatewayConnectionIntf, response, err := sess.GetVPNGatewayConnection(getVpnGatewayConnectionOptions)
+			if err != nil {
+				return nil, "", fmt.Errorf("[ERROR] Error Getting Vpn Gateway Connection (%s): %s\n%s", gConnID, err, response)
+			}
+			health := getVPNGatewayConnectionHealth(vpnGatewayConnectionIntf)
+			last = health
+			if !health.adminStateUp {
+				return nil, "", fmt.Errorf("[ERROR] Vpn Gateway Connection (%s) has admin_state_up false, its tunnels will not come up", gConnID)
+			}
+			if health.healthy() {
+				return vpnGatewayConnectionIntf, vpcv1.VPNGatewayConnectionStatusUpConst, nil
+			}
+			return vpnGatewayConnectionIntf, vpcv1.VPNGatewayConnectionStatusDownConst, nil
+		},
+		Timeout:    timeout,
+		Delay:      10 * time.Second,
+		MinTimeout: 10 * time.Second,
+	}
+
+	result, err := stateConf.WaitForState()
+	if err != nil && last != nil {
+		return result, fmt.Errorf("%s, last status: %s", err, last)
+	}
+	return result, err
+}
+
+// vpnGatewayConnectionHealth is the status of a VPN gateway connection and of its tunnels,
+// whatever the mode of the connection
+type vpnGatewayConnectionHealth struct {
+	id            string
+	name          string
+	mode          string
+	adminStateUp  bool
+	status        string
+	statusReasons []vpcv1.VPNGatewayConnectionStatusReason
+	peerAddress   string
+	peerFqdn      string
+	tunnels       []vpcv1.VPNGatewayConnectionStaticRouteModeTunnel
+}
+
+// healthy reports whether the connection is up and, in static route mode, at least one of its tunnels is up
+func (h *vpnGatewayConnectionHealth) healthy() bool {
+	if h.status != vpcv1.VPNGatewayConnectionStatusUpConst {
+		return false
+	}
+	if h.mode == vpcv1.VPNGatewayConnectionModePolicyConst {
+		return true
+	}
+	return h.tunnelsUp() > 0
+}
+
+func (h *vpnGatewayConnectionHealth) tunnelsUp() int {
+	up := 0
+	for _, tunnel := range h.tunnels {
+		if tunnel.Status != nil && *tunnel.Status == vpcv1.VPNGatewayConnectionStaticRouteModeTunnelStatusUpConst {
+			up++
+		}
+	}
+	return up
+}
+
+func (h *vpnGatewayConnectionHealth) String() string {
+	reasons := ""
+	for _, reason := range h.statusReasons {
+		reasons += fmt.Sprintf(" (%s: %s)", *reason.Code, *reason.Message)
+	}
+	status := fmt.Sprintf("connection %s%s", h.status, reasons)
+	for _, tunnel := range h.tunnels {
+		address := ""
+		if tunnel.PublicIP != nil && tunnel.PublicIP.Address != nil {
+			address = *tunnel.PublicIP.Address
+		}
+		reasons := ""
+		for _, reason := range tunnel.StatusReasons {
+			reasons += fmt.Sprintf(" (%s: %s)", *reason.Code, *reason.Message)
+		}
+		status += fmt.Sprintf(", tunnel %s %s%s", address, *tunnel.Status, reasons)
+	}
+	return status
+}
+
+func getVPNGatewayConnectionHealth(vpnGatewayConnectionIntf vpcv1.VPNGatewayConnectionIntf) *vpnGatewayConnectionHealth {
+	health := &vpnGatewayConnectionHealth{}
+	var peer interface{}
+	switch vpnGatewayConnection := vpnGatewayConnectionIntf.(type) {
+	case *vpcv1.VPNGatewayConnection:
+		health.id, health.name, health.mode = *vpnGatewayConnection.ID, *vpnGatewayConnection.Name, *vpnGatewayConnection.Mode
+		health.adminStateUp, health.status, health.statusReasons = *vpnGatewayConnection.AdminStateUp, *vpnGatewayConnection.Status, vpnGatewayConnection.StatusReasons
+		health.tunnels = vpnGatewayConnection.Tunnels
+		peer = vpnGatewayConnection.Peer
+	case *vpcv1.VPNGatewayConnectionRouteMode:
+		health.id, health.name, health.mode = *vpnGatewayConnection.ID, *vpnGatewayConnection.Name, *vpnGatewayConnection.Mode
+		health.adminStateUp, health.status, health.statusReasons = *vpnGatewayConnection.AdminStateUp, *vpnGatewayConnection.Status, vpnGatewayConnection.StatusReasons
+		health.tunnels = vpnGatewayConnection.Tunnels
+		peer = vpnGatewayConnection.Peer
+	case *vpcv1.VPNGatewayConnectionRouteModeVPNGatewayConnectionStaticRouteMode:
+		health.id, health.name, health.mode = *vpnGatewayConnection.ID, *vpnGatewayConnection.Name, *vpnGatewayConnection.Mode
+		health.adminStateUp, health.status, health.statusReasons = *vpnGatewayConnection.AdminStateUp, *vpnGatewayConnection.Status, vpnGatewayConnection.StatusReasons
+		health.tunnels = vpnGatewayConnection.Tunnels
+		peer = vpnGatewayConnection.Peer
+	case *vpcv1.VPNGatewayConnectionPolicyMode:
+		health.id, health.name, health.mode = *vpnGatewayConnection.ID, *vpnGatewayConnection.Name, *vpnGatewayConnection.Mode
+		health.adminStateUp, health.status, health.statusReasons = *vpnGatewayConnection.AdminStateUp, *vpnGatewayConnection.Status, vpnGatewayConnection.StatusReasons
+		peer = vpnGatewayConnection.Peer
+	}
+	var address, fqdn *string
+	switch peer := peer.(type) {
+	case *vpcv1.VPNGatewayConnectionStaticRouteModePeer:
+		address, fqdn = peer.Address, peer.Fqdn
+	case *vpcv1.VPNGatewayConnectionStaticRouteModePeerVPNGatewayConnectionPeerByAddress:
+		address = peer.Address
+	case *vpcv1.VPNGatewayConnectionStaticRouteModePeerVPNGatewayConnectionPeerByFqdn:
+		fqdn = peer.Fqdn
+	case *vpcv1.VPNGatewayConnectionPolicyModePeer:
+		address, fqdn = peer.Address, peer.Fqdn
+	case *vpcv1.VPNGatewayConnectionPolicyModePeerVPNGatewayConnectionPeerByAddress:
+		address = peer.Address
+	case *vpcv1.VPNGatewayConnectionPolicyModePeerVPNGatewayConnectionPeerByFqdn:
+		fqdn = peer.Fqdn
+	}
+	if address != nil {
+		health.peerAddress = *address
+	}
+	if fqdn != nil {
+		health.peerFqdn = *fqdn
+	}
+	return health
+}
+
 func resourceIBMISVPNGatewayConnectionExists(d *schema.ResourceData, meta interface{}) (bool, error) {
 
 	parts, err := flex.IdParts(d.Id())
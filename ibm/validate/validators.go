@@ -447,19 +447,29 @@ func validateCIDRAddress() schema.SchemaValidateFunc {
 	}
 }
 
+// ReservedAddressRanges returns the address ranges that IBM Cloud reserves and
+// that must not be used for the address prefixes and subnets of a VPC.
+func ReservedAddressRanges() []string {
+	return []string{
+		"127.0.0.0/8",
+		"161.26.0.0/16",
+		"166.8.0.0/14",
+		"169.254.0.0/16",
+		"224.0.0.0/4",
+	}
+}
+
 // validateOverlappingAddress...
 func validateOverlappingAddress() schema.SchemaValidateFunc {
 	return func(v interface{}, k string) (ws []string, errors []error) {
-		nonOverlappingCIDR := map[string]bool{
-			"127.0.0.0/8":    true,
-			"161.26.0.0/16":  true,
-			"166.8.0.0/14":   true,
-			"169.254.0.0/16": true,
-			"224.0.0.0/4":    true,
-		}
-
 		address := v.(string)
-		_, found := nonOverlappingCIDR[address]
+		found := false
+		for _, reserved := range ReservedAddressRanges() {
+			if address == reserved {
+				found = true
+				break
+			}
+		}
 		if found {
 			errors = append(errors, fmt.Errorf(
 				"%q the request is overlapping with reserved address ranges",